@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/mwhittaker/emojis"
+)
+
+// versionRegex matches the plain dotted versions that files are cached by
+// (e.g., 15.1 or 7.0.1).
+var versionRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+
+// fetchCached returns the named file for the provided version, downloading it
+// with fetch unless it is cached in -cache-dir. Downloaded files are cached
+// by name and version (e.g., 15.1/emoji-test.txt), but only if validate
+// accepts them, so that an error page served with a 200 OK response isn't
+// cached in place of the file. Files for the "latest" version change over
+// time, so they are never cached. If there is no -cache-dir and no user cache
+// directory, files aren't cached either. Other versions must be plain dotted
+// versions (e.g., 15.1 or 7.0.1), so that they can't escape the cache
+// directory (e.g., ../../x).
+func fetchCached(ctx context.Context, name, version string, fetch func(context.Context, string) (io.ReadCloser, error), validate func(io.Reader) error) (io.ReadCloser, error) {
+	if *noCacheFlag || version == "latest" {
+		return fetch(ctx, version)
+	}
+	dir := *cacheDirFlag
+	if dir == "" {
+		cache, err := os.UserCacheDir()
+		if err != nil {
+			logger.Warn("cannot find cache directory, not caching downloads", "err", err)
+			return fetch(ctx, version)
+		}
+		dir = filepath.Join(cache, "emojis")
+	}
+	if !versionRegex.MatchString(version) {
+		return nil, fmt.Errorf("invalid version %q: want a dotted version (e.g., 15.1)", version)
+	}
+	filename := filepath.Join(dir, version, name)
+
+	f, err := os.Open(filename)
+	if err == nil {
+		logger.Debug("read cached file", "file", filename)
+		return f, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	body, err := fetch(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return nil, err
+	}
+	err = writeAtomic(filename, func(f *os.File) error {
+		if _, err := io.Copy(f, body); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := validate(f); err != nil {
+			return fmt.Errorf("invalid %s %s: %w", name, version, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cache %s: %w", filename, err)
+	}
+	return os.Open(filename)
+}
+
+// validateEmojiTest returns an error if r isn't an emoji-test.txt file with
+// at least -min-emoji emojis, and at least one. See fetchCached.
+func validateEmojiTest(r io.Reader) error {
+	in, err := gunzip(io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	all, err := emojis.Parse(in)
+	if err != nil {
+		return err
+	}
+	if want := max(*minEmojiFlag, 1); len(all) < want {
+		return fmt.Errorf("got %d emojis, want at least %d", len(all), want)
+	}
+	return nil
+}
+
+// validateTags returns an error if r isn't a data.json file with the tags of
+// at least one emoji. See fetchCached.
+func validateTags(r io.Reader) error {
+	in, err := gunzip(io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	tags, _, err := emojis.ParseTags(in)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return errors.New("got no tags")
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// cacheServer returns a fake fetch function backed by a test server that
+// serves contents for every version, and a pointer to the number of requests
+// the server has handled.
+func cacheServer(t *testing.T, contents string) (func(context.Context, string) (io.ReadCloser, error), *int) {
+	t.Helper()
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		io.WriteString(w, contents)
+	}))
+	t.Cleanup(server.Close)
+	fetch := func(ctx context.Context, version string) (io.ReadCloser, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/"+version+"/emoji-test.txt", nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Body, nil
+	}
+	return fetch, &hits
+}
+
+// mustFetchCached calls fetchCached for emoji-test.txt and returns the
+// contents.
+func mustFetchCached(t *testing.T, version string, fetch func(context.Context, string) (io.ReadCloser, error)) string {
+	t.Helper()
+	f, err := fetchCached(context.Background(), "emoji-test.txt", version, fetch, validateEmojiTest)
+	if err != nil {
+		t.Fatalf("fetchCached(%s): %v", version, err)
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestFetchCached(t *testing.T) {
+	dir := t.TempDir()
+	setFlag(t, "cache-dir", dir)
+	fetch, hits := cacheServer(t, testEmojiTest)
+
+	// The second fetch is served from the cache.
+	for i := 1; i <= 2; i++ {
+		if got := mustFetchCached(t, "15.1", fetch); got != testEmojiTest {
+			t.Errorf("fetch %d: got %q, want %q", i, got, testEmojiTest)
+		}
+		if *hits != 1 {
+			t.Errorf("fetch %d: got %d requests, want 1", i, *hits)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "15.1", "emoji-test.txt")); err != nil {
+		t.Errorf("emoji-test.txt isn't cached: %v", err)
+	}
+
+	// Other versions aren't served from the cache.
+	mustFetchCached(t, "15.0", fetch)
+	if *hits != 2 {
+		t.Errorf("fetch of another version: got %d requests, want 2", *hits)
+	}
+
+	// Neither are the latest version and fetches with -no-cache.
+	mustFetchCached(t, "latest", fetch)
+	mustFetchCached(t, "latest", fetch)
+	setFlag(t, "no-cache", "true")
+	mustFetchCached(t, "15.1", fetch)
+	if *hits != 5 {
+		t.Errorf("uncached fetches: got %d requests, want 5", *hits)
+	}
+}
+
+func TestFetchCachedInvalid(t *testing.T) {
+	dir := t.TempDir()
+	setFlag(t, "cache-dir", dir)
+	fetch, hits := cacheServer(t, "<html><body>Service Unavailable</body></html>")
+
+	// An error page isn't cached, so it's downloaded again.
+	for i := 1; i <= 2; i++ {
+		if _, err := fetchCached(context.Background(), "emoji-test.txt", "15.1", fetch, validateEmojiTest); err == nil {
+			t.Errorf("fetch %d: got no error, want error", i)
+		}
+		if *hits != i {
+			t.Errorf("fetch %d: got %d requests, want %d", i, *hits, i)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "15.1", "emoji-test.txt")); err == nil {
+		t.Errorf("the error page was cached")
+	}
+
+	// A truncated emoji-test.txt isn't cached either.
+	setFlag(t, "min-emoji", "100")
+	fetch, _ = cacheServer(t, testEmojiTest)
+	if _, err := fetchCached(context.Background(), "emoji-test.txt", "15.1", fetch, validateEmojiTest); err == nil {
+		t.Errorf("fetch with -min-emoji=100: got no error, want error")
+	}
+}
+
+func TestFetchCachedInvalidVersion(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "a", "b")
+	setFlag(t, "cache-dir", dir)
+	fetch, hits := cacheServer(t, testEmojiTest)
+
+	// Versions that aren't plain dotted versions could escape -cache-dir, so
+	// they're rejected without being downloaded.
+	for _, version := range []string{"../../x", "15.1/../..", "/tmp", "15.", ".", ""} {
+		if _, err := fetchCached(context.Background(), "emoji-test.txt", version, fetch, validateEmojiTest); err == nil {
+			t.Errorf("fetchCached(%q): got no error, want error", version)
+		}
+	}
+	if *hits != 0 {
+		t.Errorf("got %d requests, want 0", *hits)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "..", "..", "x")); err == nil {
+		t.Errorf("../../x was cached outside -cache-dir")
+	}
+
+	// Versions with more than two numbers (e.g., emojibase-data's) are fine.
+	if got := mustFetchCached(t, "7.0.1", fetch); got != testEmojiTest {
+		t.Errorf("fetchCached(7.0.1): got %q, want %q", got, testEmojiTest)
+	}
+}
+
+func TestFetchCachedNoCacheDir(t *testing.T) {
+	// Without a user cache directory, files are downloaded every time.
+	t.Setenv("XDG_CACHE_HOME", "")
+	t.Setenv("HOME", "")
+	if dir, err := os.UserCacheDir(); err == nil {
+		t.Skipf("found cache directory %s", dir)
+	}
+	fetch, hits := cacheServer(t, testEmojiTest)
+	for i := 1; i <= 2; i++ {
+		if got := mustFetchCached(t, "15.1", fetch); got != testEmojiTest {
+			t.Errorf("fetch %d: got %q, want %q", i, got, testEmojiTest)
+		}
+	}
+	if *hits != 2 {
+		t.Errorf("got %d requests, want 2", *hits)
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	for _, test := range []struct {
+		data string
+		ok   bool
+	}{
+		{`[{"emoji": "😀", "tags": ["face", "grin"]}]`, true},
+		{`{"1F600": {"tags": ["face", "grin"]}}`, true},
+		{`[]`, false},
+		{`<html>Not Found</html>`, false},
+	} {
+		if err := validateTags(strings.NewReader(test.data)); (err == nil) != test.ok {
+			t.Errorf("validateTags(%q): got error %v, want ok %t", test.data, err, test.ok)
+		}
+	}
+}
@@ -0,0 +1,796 @@
+// Command emojis parses emoji-test.txt and data.json and writes emojis.json,
+// emojis.go, tokens.go, shortcodes.go, and names.go. By default, all files
+// are read from and written to the current directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mwhittaker/emojis"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	emojiTestFlag   = flag.String("emoji-test", "emoji-test.txt", "emoji-test.txt file to parse, or - for stdin")
+	dataFlag        = &filesFlag{files: []string{"data.json"}}
+	synonymsFlag    = flag.String("synonyms", "", "if set, json file mapping extra tags to graphemes (e.g., synonyms.json)")
+	localeFlag      = flag.String("locale", "", "if set, the locale (e.g., fr) of the names in -annotations to add to every emoji")
+	annotationsFlag = &filesFlag{}
+	jsonOutFlag     = flag.String("json-out", "emojis.json", "output json file")
+	jsonShapeFlag   = flag.String("json-shape", "array", "shape of -json-out: array, or object keyed by grapheme")
+	compactFlag     = flag.Bool("compact", false, "if true, output compact rather than indented json")
+	fileModeFlag    = flag.String("file-mode", "0644", "permissions of output files, in octal")
+	goOutFlag       = flag.String("go-out", "emojis.go", "output go file")
+	goPackageFlag   = flag.String("go-package", "emojis", "package name of -go-out, -tokens-go-out, -shortcodes-go-out, and -names-go-out")
+
+	emojidataGoOutFlag   = flag.String("emojidata-go-out", "", "if set, output go file declaring a slice of every emoji (e.g., emojidata/emojidata.go)")
+	emojidataPackageFlag = flag.String("emojidata-package", "emojidata", "package name of -emojidata-go-out; must not be a package that declares an Emoji type")
+	noCategoryTokensFlag = flag.Bool("no-category-tokens", false, "if true, don't tokenize groups and subgroups in -go-out and -tokens-go-out")
+	stemTokensFlag       = flag.Bool("stem-tokens", false, "if true, fold plural tokens into their singular forms (e.g., cats into cat)")
+	phraseTokensFlag     = flag.Bool("phrase-tokens", false, "if true, also tokenize multi-word tags into phrase tokens (e.g., rolling_on_the_floor)")
+
+	tokensGoOutFlag     = flag.String("tokens-go-out", "tokens.go", "output go file mapping tokens to emojis")
+	shortcodesGoOutFlag = flag.String("shortcodes-go-out", "shortcodes.go", "output go file mapping shortcodes to emojis")
+	namesGoOutFlag      = flag.String("names-go-out", "names.go", "output go file mapping emojis to names")
+
+	ndjsonOutFlag      = flag.String("ndjson-out", "", "if set, output newline delimited json file with one emoji per line (e.g., emojis.ndjson)")
+	groupedJSONOutFlag = flag.String("grouped-json-out", "", "if set, output json file of emojis grouped by group and subgroup (e.g., emojis_grouped.json)")
+	csvOutFlag         = flag.String("csv-out", "", "if set, output csv file (e.g., emojis.csv)")
+	gobOutFlag         = flag.String("gob-out", "", "if set, output gob file (e.g., emojis.gob)")
+	sqliteOutFlag      = flag.String("sqlite-out", "", "if set, output SQLite database (e.g., emojis.db)")
+	xmlOutFlag         = flag.String("xml-out", "", "if set, output xml file (e.g., emojis.xml)")
+	jsOutFlag          = flag.String("js-out", "", "if set, output minified JavaScript file setting window.EMOJIS to a map from emojis to tokens (e.g., emojis.min.js)")
+	tsOutFlag          = flag.String("ts-out", "", "if set, output TypeScript file mapping emojis to tokens (e.g., emojis.ts)")
+
+	minEmojiFlag        = flag.Int("min-emoji", 0, "if positive, fail if fewer than this many emojis are parsed (e.g., to catch a truncated or error page download)")
+	groupsFlag          = flag.String("groups", "", "if set, comma separated groups to output (e.g., \"animals,food\"); a group is output if any of them is a case-insensitive substring of its name, so one may select multiple groups; all groups are output by default")
+	ranksFlag           = flag.String("ranks", "", "if set, json file mapping emojis to ranks (e.g., {\"😂\": 1}) like a list of the most frequently used emojis; see -top")
+	topFlag             = flag.Int("top", 0, "if positive, output only this many of the best ranked emojis in -ranks, in rank order unless -sort is set; emojis without a rank sort last")
+	sortTagsFlag        = flag.Bool("sort-tags", false, "if true, sort every emoji's tags rather than keeping the order of -data")
+	sortFlag            = flag.String("sort", "file", "order of the emojis in the output: file, name, or group")
+	maxVersionFlag      = flag.String("max-version", "", "if set, omit emojis newer than this emoji version (e.g., 13.0)")
+	normalizeFlag       = flag.Bool("normalize", false, "if true, normalize emoji names to Unicode Normalization Form C")
+	verboseFlag         = flag.Bool("verbose", false, "if true, log at least at info level (e.g., parse statistics); an alias for -log-level info")
+	logLevelFlag        = flag.String("log-level", "warn", "minimum level of logs to print to stderr: debug (e.g., every skipped line), info (e.g., counts), warn, or error")
+	requireTagsFlag     = flag.Bool("require-tags", false, "if true, omit emojis without tags in -data or -synonyms; note that this omits many symbol-like emojis (e.g., clock faces)")
+	warnMissingTagsFlag = flag.Bool("warn-missing-tags", false, "if true, warn about emojis without tags in -data")
+	warnOrphanTagsFlag  = flag.Bool("warn-orphan-tags", false, "if true, warn about emojis in -data that aren't in -emoji-test (e.g., because -data is stale)")
+
+	diffFlag         = flag.Bool("diff", false, "if true, compare two emoji-test.txt files passed as arguments (e.g., -diff old.txt new.txt) instead of generating output")
+	sinceVersionFlag = flag.String("since-version", "", "if set, list the embedded emojis introduced in this emoji version or later (e.g., 15.0) instead of generating output")
+
+	fetchFlag        = flag.String("fetch", "", "if set, download emoji-test.txt for this emoji version (e.g., 15.1) instead of reading -emoji-test")
+	fetchTagsFlag    = flag.String("fetch-tags", "", "if set, download data.json for this emojibase-data version (e.g., 7.0.1) instead of reading -data")
+	cacheDirFlag     = flag.String("cache-dir", "", "directory to cache -fetch and -fetch-tags downloads in; defaults to emojis in the user cache directory (e.g., ~/.cache/emojis)")
+	noCacheFlag      = flag.Bool("no-cache", false, "if true, always download -fetch and -fetch-tags files rather than reading them from -cache-dir")
+	fetchTimeoutFlag = flag.Duration("fetch-timeout", 30*time.Second, "timeout for -fetch and -fetch-tags")
+)
+
+func init() {
+	flag.Var(annotationsFlag, "annotations", "CLDR annotations xml or json file to parse -locale names from (e.g., annotations/fr.xml); may be repeated to merge names from multiple files (e.g., annotationsDerived/fr.xml)")
+	flag.Var(dataFlag, "data", "data.json file to parse tags from, or - for stdin; may be repeated to merge tags from multiple files")
+}
+
+// filesFlag is a repeatable flag of file names. Setting the flag replaces the
+// default file names instead of appending to them.
+type filesFlag struct {
+	files []string
+	set   bool
+}
+
+func (f *filesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(f.files, ",")
+}
+
+func (f *filesFlag) Set(filename string) error {
+	if !f.set {
+		f.files = nil
+		f.set = true
+	}
+	f.files = append(f.files, filename)
+	return nil
+}
+
+// logger logs to stderr at -log-level.
+var logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+func main() {
+	flag.Parse()
+	level, err := logLevel()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "emojis: %v\n", err)
+		os.Exit(1)
+	}
+	logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	switch {
+	case *diffFlag:
+		err = runDiff()
+	case *sinceVersionFlag != "":
+		err = runSinceVersion()
+	default:
+		err = run()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "emojis: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// logLevel returns the level to log at, -log-level or info if -verbose is set
+// and -log-level is less verbose.
+func logLevel() (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(*logLevelFlag)); err != nil {
+		return 0, fmt.Errorf("invalid -log-level: %w", err)
+	}
+	if *verboseFlag {
+		level = min(level, slog.LevelInfo)
+	}
+	return level, nil
+}
+
+// openEmojiTest opens the emoji-test.txt file, either by downloading it if
+// -fetch is set or by opening -emoji-test otherwise. It also returns a
+// description of where the file came from for use in error messages.
+func openEmojiTest(ctx context.Context) (io.ReadCloser, string, error) {
+	if *fetchFlag != "" {
+		in, err := fetchCached(ctx, "emoji-test.txt", *fetchFlag, emojis.FetchEmojiTest, validateEmojiTest)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot fetch emoji-test.txt: %w", err)
+		}
+		in, err = gunzip(in)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot fetch emoji-test.txt: %w", err)
+		}
+		return in, "emoji-test.txt " + *fetchFlag, nil
+	}
+
+	in, err := openInput(*emojiTestFlag)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot read -emoji-test: %w", err)
+	}
+	return in, *emojiTestFlag, nil
+}
+
+// openInput opens the named input file, or stdin if the name is "-".
+func openInput(filename string) (io.ReadCloser, error) {
+	if filename == "-" {
+		return gunzip(io.NopCloser(os.Stdin))
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	return gunzip(f)
+}
+
+// gunzip returns a reader that decompresses in if it is gzip compressed (e.g.,
+// emoji-test.txt.gz from a mirror), and otherwise reads in as is. Compression
+// is detected from the content rather than the file name, so that it works
+// for stdin and downloads too. Closing the returned reader closes in.
+func gunzip(in io.ReadCloser) (io.ReadCloser, error) {
+	// gzip streams begin with the magic bytes 0x1f 0x8b.
+	br := bufio.NewReader(in)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		in.Close()
+		return nil, err
+	}
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		return readCloser{br, in}, nil
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		in.Close()
+		return nil, fmt.Errorf("gzip: %w", err)
+	}
+	return readCloser{zr, multiCloser{zr, in}}, nil
+}
+
+// readCloser is an io.ReadCloser built from a separate io.Reader and
+// io.Closer.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// multiCloser is an io.Closer that closes every closer, returning the first
+// error.
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var first error
+	for _, c := range m {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// run parses the input files and writes the output files.
+func run() error {
+	if *emojiTestFlag == "-" && slices.Contains(dataFlag.files, "-") && *fetchFlag == "" && *fetchTagsFlag == "" {
+		return fmt.Errorf("-emoji-test and -data cannot both be stdin")
+	}
+	mode, err := parseFileMode(*fileModeFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -file-mode: %w", err)
+	}
+	fileMode = mode
+
+	// Parse emojis.
+	ctx, cancel := context.WithTimeout(context.Background(), *fetchTimeoutFlag)
+	defer cancel()
+	in, inName, err := openEmojiTest(ctx)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	var input io.Reader = in
+	var data []byte
+	if *warnOrphanTagsFlag {
+		// The input is parsed again by knownEmojis, so it's buffered in case
+		// it's stdin.
+		data, err = io.ReadAll(in)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", inName, err)
+		}
+		input = bytes.NewReader(data)
+	}
+	var stats emojis.Stats
+	all, err := emojis.ParseWithOptions(input, emojis.ParseOptions{
+		NormalizeNames: *normalizeFlag,
+		Stats:          &stats,
+		Logger:         logger,
+	})
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", inName, err)
+	}
+	if len(all) < *minEmojiFlag {
+		return fmt.Errorf("parse %s: got %d emojis, want at least -min-emoji=%d", inName, len(all), *minEmojiFlag)
+	}
+
+	// Filter emojis.
+	if *maxVersionFlag != "" {
+		// Comparing -max-version with itself validates it.
+		if _, err := emojis.CompareVersions(*maxVersionFlag, *maxVersionFlag); err != nil {
+			return fmt.Errorf("invalid -max-version: %w", err)
+		}
+		var filtered []*emojis.Emoji
+		for _, emoji := range all {
+			cmp, err := emojis.CompareVersions(emoji.Version, *maxVersionFlag)
+			if err != nil {
+				return fmt.Errorf("%s: %w", emoji.Grapheme, err)
+			}
+			if cmp <= 0 {
+				filtered = append(filtered, emoji)
+			}
+		}
+		all = filtered
+	}
+	if *groupsFlag != "" {
+		all = filterGroups(all, *groupsFlag)
+	}
+
+	if *topFlag > 0 {
+		if *ranksFlag == "" {
+			return fmt.Errorf("-top requires -ranks")
+		}
+		f, err := openInput(*ranksFlag)
+		if err != nil {
+			return fmt.Errorf("cannot read -ranks: %w", err)
+		}
+		ranks, err := emojis.ParseRanks(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", *ranksFlag, err)
+		}
+		all = emojis.Top(all, ranks, *topFlag)
+	}
+
+	// Sort emojis.
+	if err := sortEmojis(all, *sortFlag); err != nil {
+		return err
+	}
+
+	// Parse tags.
+	var tagSources, skinSources []map[string][]string
+	filenames := dataFlag.files
+	if *fetchTagsFlag != "" {
+		filenames = nil
+		data, err := fetchCached(ctx, "data.json", *fetchTagsFlag, emojis.FetchTags, validateTags)
+		if err != nil {
+			return fmt.Errorf("cannot fetch data.json: %w", err)
+		}
+		data, err = gunzip(data)
+		if err != nil {
+			return fmt.Errorf("cannot fetch data.json: %w", err)
+		}
+		tags, skins, err := emojis.ParseTags(data)
+		data.Close()
+		if err != nil {
+			return fmt.Errorf("parse data.json %s: %w", *fetchTagsFlag, err)
+		}
+		tagSources = append(tagSources, tags)
+		skinSources = append(skinSources, skins)
+	}
+	for _, filename := range filenames {
+		data, err := openInput(filename)
+		if err != nil {
+			return fmt.Errorf("cannot read -data: %w", err)
+		}
+		tags, skins, err := emojis.ParseTags(data)
+		data.Close()
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", filename, err)
+		}
+		tagSources = append(tagSources, tags)
+		skinSources = append(skinSources, skins)
+	}
+	tags := emojis.MergeTags(tagSources...)
+	var provider emojis.TagProvider = emojis.TagMap(tags)
+	emojis.AssignTags(all, provider)
+	skins := emojis.MergeTags(skinSources...)
+	missing := 0
+	for _, emoji := range all {
+		emoji.Skins = skins[emoji.Grapheme]
+		if len(emoji.Tags) == 0 {
+			missing++
+			if *warnMissingTagsFlag {
+				logger.Warn("no tags", "grapheme", emoji.Grapheme, "name", emoji.Name)
+			}
+		}
+	}
+	if *warnMissingTagsFlag {
+		logger.Warn("emojis without tags", "count", missing)
+	}
+	if *warnOrphanTagsFlag {
+		known, err := knownEmojis(data)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", inName, err)
+		}
+		orphans := orphanTags(tags, known)
+		for _, grapheme := range orphans {
+			logger.Warn("tags for unknown emoji", "grapheme", grapheme, "codes", emojis.CodesKey([]rune(grapheme)))
+		}
+		logger.Warn("tagged emojis not in input", "file", inName, "count", len(orphans))
+	}
+	logger.Info("parsed emojis",
+		"file", inName,
+		"lines", stats.Lines,
+		"emojis", stats.Emojis,
+		"skipped", stats.Skipped,
+		"duplicates", stats.Duplicates)
+	logger.Info("parsed tags", "emojis", len(all), "tagged", len(all)-missing, "untagged", missing)
+
+	// Parse synonyms.
+	if *synonymsFlag != "" {
+		f, err := os.Open(*synonymsFlag)
+		if err != nil {
+			return fmt.Errorf("cannot read -synonyms: %w", err)
+		}
+		defer f.Close()
+		synonyms, err := emojis.ParseSynonyms(f)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", *synonymsFlag, err)
+		}
+		for _, emoji := range all {
+			for _, tag := range synonyms[emoji.Grapheme] {
+				if !slices.Contains(emoji.Tags, tag) {
+					emoji.Tags = append(emoji.Tags, tag)
+				}
+			}
+		}
+	}
+
+	// Optionally drop emojis without tags. data.json doesn't tag every emoji
+	// (e.g., most clock faces and zodiac signs), so this drops them even
+	// though they can still be found by name.
+	if *requireTagsFlag {
+		all = filter(all, func(emoji *emojis.Emoji) bool {
+			return len(emoji.Tags) > 0
+		})
+	}
+
+	// Parse localized names.
+	if *localeFlag != "" {
+		if len(annotationsFlag.files) == 0 {
+			return fmt.Errorf("-locale requires -annotations")
+		}
+		names := map[string]string{}
+		for _, filename := range annotationsFlag.files {
+			f, err := openInput(filename)
+			if err != nil {
+				return fmt.Errorf("cannot read -annotations: %w", err)
+			}
+			parsed, err := emojis.ParseAnnotations(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("parse %s: %w", filename, err)
+			}
+			maps.Copy(names, parsed)
+		}
+		emojis.AssignLocalizedNames(all, *localeFlag, names)
+	}
+
+	// Optionally sort tags, so that output doesn't change when data.json
+	// reorders them.
+	if *sortTagsFlag {
+		for _, emoji := range all {
+			sort.Strings(emoji.Tags)
+		}
+	}
+
+	// Assign ids, shortcodes, and tokens.
+	tokensOpts := emojis.TokensOptions{
+		NoCategories: *noCategoryTokensFlag,
+		Phrases:      *phraseTokensFlag,
+		Stem:         *stemTokensFlag,
+	}
+	emojis.AssignIDs(all)
+	emojis.AssignShortcodes(all)
+	emojis.AssignTokens(all, tokensOpts)
+
+	// Output the emojis as json.
+	shaped, err := shapeJSON(all, *jsonShapeFlag)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(*jsonOutFlag, shaped); err != nil {
+		return err
+	}
+
+	// Optionally output the emojis as newline delimited json.
+	if *ndjsonOutFlag != "" {
+		if err := writeNDJSON(*ndjsonOutFlag, all); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output the emojis grouped by category as json.
+	if *groupedJSONOutFlag != "" {
+		if err := writeJSON(*groupedJSONOutFlag, emojis.GroupByCategory(all)); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output the emojis as csv.
+	if *csvOutFlag != "" {
+		bytes, err := formatCSV(all)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(*csvOutFlag, bytes); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output the emojis as xml.
+	if *xmlOutFlag != "" {
+		bytes, err := formatXML(all)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(*xmlOutFlag, bytes); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output the emojis as gob.
+	if *gobOutFlag != "" {
+		err := writeAtomic(*gobOutFlag, func(f *os.File) error {
+			return emojis.WriteGob(f, all)
+		})
+		if err != nil {
+			return fmt.Errorf("write %s: %w", *gobOutFlag, err)
+		}
+	}
+
+	// Optionally output the emojis as a SQLite database.
+	if *sqliteOutFlag != "" {
+		if err := writeSQLite(*sqliteOutFlag, all); err != nil {
+			return fmt.Errorf("write %s: %w", *sqliteOutFlag, err)
+		}
+	}
+
+	// Output tokens as go map.
+	source, err := emojis.GenerateGoMapWithOptions(all, *goPackageFlag, tokensOpts)
+	if err != nil {
+		return fmt.Errorf("generate %s: %w", *goOutFlag, err)
+	}
+	if err := writeFile(*goOutFlag, source); err != nil {
+		return err
+	}
+
+	// Output the inverted token index as go map.
+	source, err = emojis.GenerateGoTokenMapWithOptions(all, *goPackageFlag, tokensOpts)
+	if err != nil {
+		return fmt.Errorf("generate %s: %w", *tokensGoOutFlag, err)
+	}
+	if err := writeFile(*tokensGoOutFlag, source); err != nil {
+		return err
+	}
+
+	// Optionally output tokens as TypeScript map.
+	if *tsOutFlag != "" {
+		source, err := emojis.GenerateTSMap(all, tokensOpts)
+		if err != nil {
+			return fmt.Errorf("generate %s: %w", *tsOutFlag, err)
+		}
+		if err := writeFile(*tsOutFlag, source); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output tokens as minified JavaScript map.
+	if *jsOutFlag != "" {
+		source, err := emojis.GenerateJSMap(all, tokensOpts)
+		if err != nil {
+			return fmt.Errorf("generate %s: %w", *jsOutFlag, err)
+		}
+		if err := writeFile(*jsOutFlag, source); err != nil {
+			return err
+		}
+	}
+
+	// Optionally output the emojis as a go slice.
+	if *emojidataGoOutFlag != "" {
+		source, err := emojis.GenerateGoStructs(all, *emojidataPackageFlag)
+		if err != nil {
+			return fmt.Errorf("generate %s: %w", *emojidataGoOutFlag, err)
+		}
+		if err := writeFile(*emojidataGoOutFlag, source); err != nil {
+			return err
+		}
+	}
+
+	// Output shortcodes as go map.
+	source, err = emojis.GenerateGoShortcodeMap(all, *goPackageFlag)
+	if err != nil {
+		return fmt.Errorf("generate %s: %w", *shortcodesGoOutFlag, err)
+	}
+	if err := writeFile(*shortcodesGoOutFlag, source); err != nil {
+		return err
+	}
+
+	// Output names as go map.
+	source, err = emojis.GenerateGoNameMap(all, *goPackageFlag)
+	if err != nil {
+		return fmt.Errorf("generate %s: %w", *namesGoOutFlag, err)
+	}
+	return writeFile(*namesGoOutFlag, source)
+}
+
+// runDiff parses the two emoji-test.txt files passed as arguments and prints
+// the emojis that were added, removed, and renamed between them.
+func runDiff() error {
+	if flag.NArg() != 2 {
+		return fmt.Errorf("-diff requires two arguments: old.txt new.txt")
+	}
+	var parsed [2][]*emojis.Emoji
+	for i, filename := range flag.Args() {
+		in, err := openInput(filename)
+		if err != nil {
+			return err
+		}
+		parsed[i], err = emojis.Parse(in)
+		in.Close()
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", filename, err)
+		}
+	}
+
+	diff := emojis.Compare(parsed[0], parsed[1])
+	fmt.Printf("added (%d):\n", len(diff.Added))
+	for _, emoji := range diff.Added {
+		fmt.Printf("  %s %s (%s)\n", emoji.Grapheme, emoji.Name, emoji.CodesString())
+	}
+	fmt.Printf("removed (%d):\n", len(diff.Removed))
+	for _, emoji := range diff.Removed {
+		fmt.Printf("  %s %s (%s)\n", emoji.Grapheme, emoji.Name, emoji.CodesString())
+	}
+	fmt.Printf("renamed (%d):\n", len(diff.Renamed))
+	for _, rename := range diff.Renamed {
+		fmt.Printf("  %s %s -> %s (%s)\n", rename.New.Grapheme, rename.Old.Name, rename.New.Name, rename.New.CodesString())
+	}
+	return nil
+}
+
+// runSinceVersion prints the emojis embedded in the emojis package that were
+// introduced in -since-version or later.
+func runSinceVersion() error {
+	all, err := emojis.All()
+	if err != nil {
+		return err
+	}
+	since, err := emojis.SinceVersion(all, *sinceVersionFlag)
+	if err != nil {
+		return fmt.Errorf("invalid -since-version: %w", err)
+	}
+	fmt.Printf("since %s (%d):\n", *sinceVersionFlag, len(since))
+	for _, emoji := range since {
+		fmt.Printf("  %s %s (E%s)\n", emoji.Grapheme, emoji.Name, emoji.Version)
+	}
+	return nil
+}
+
+// filter returns the emojis for which keep returns true.
+func filter(all []*emojis.Emoji, keep func(*emojis.Emoji) bool) []*emojis.Emoji {
+	var filtered []*emojis.Emoji
+	for _, emoji := range all {
+		if keep(emoji) {
+			filtered = append(filtered, emoji)
+		}
+	}
+	return filtered
+}
+
+// knownEmojis parses every emoji in the emoji-test.txt data, keyed by
+// grapheme. Unlike the emojis that are output, these
+// include components (e.g., 🏻) and emojis that aren't fully qualified, and
+// they aren't filtered (e.g., by -max-version), because data.json tags them
+// too and they aren't orphans. See -warn-orphan-tags.
+func knownEmojis(data []byte) (map[string]*emojis.Emoji, error) {
+	all, err := emojis.ParseWithOptions(bytes.NewReader(data), emojis.ParseOptions{
+		IncludeUnqualified:        true,
+		IncludeMinimallyQualified: true,
+		IncludeComponents:         true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return emojis.ByGrapheme(all), nil
+}
+
+// orphanTags returns the sorted graphemes of the emojis with tags that aren't
+// in parsed (e.g., because data.json is newer than emoji-test.txt). Emojis
+// without any tags aren't orphans, and neither are emojis that differ from a
+// parsed emoji only in variation selectors (e.g., data.json's 🚹️ for
+// emoji-test.txt's 🚹). See -warn-orphan-tags.
+func orphanTags(tags map[string][]string, parsed map[string]*emojis.Emoji) []string {
+	normalized := map[string]bool{}
+	for grapheme := range parsed {
+		normalized[emojis.Normalize(grapheme)] = true
+	}
+	var orphans []string
+	for grapheme, t := range tags {
+		if len(t) > 0 && !normalized[emojis.Normalize(grapheme)] {
+			orphans = append(orphans, grapheme)
+		}
+	}
+	sort.Strings(orphans)
+	return orphans
+}
+
+// filterGroups returns the emojis in the comma separated groups. An emoji is
+// in a group if the group is a case-insensitive substring of the emoji's
+// group, so a single group may select multiple groups. Spaces around groups
+// are ignored. See -groups.
+func filterGroups(all []*emojis.Emoji, groups string) []*emojis.Emoji {
+	gs := strings.Split(strings.ToLower(groups), ",")
+	for i, g := range gs {
+		gs[i] = strings.TrimSpace(g)
+	}
+	return filter(all, func(emoji *emojis.Emoji) bool {
+		group := strings.ToLower(emoji.Group)
+		return slices.ContainsFunc(gs, func(g string) bool {
+			return g != "" && strings.Contains(group, g)
+		})
+	})
+}
+
+// sortEmojis sorts emojis in place according to mode. "file" leaves emojis in
+// the order they appear in emoji-test.txt, "name" sorts emojis by name, and
+// "group" sorts emojis by group and then subgroup. All sorts are stable.
+func sortEmojis(all []*emojis.Emoji, mode string) error {
+	switch mode {
+	case "file":
+		return nil
+	case "name":
+		sort.SliceStable(all, func(i, j int) bool {
+			return all[i].Name < all[j].Name
+		})
+		return nil
+	case "group":
+		sort.SliceStable(all, func(i, j int) bool {
+			if all[i].Group != all[j].Group {
+				return all[i].Group < all[j].Group
+			}
+			return all[i].Subgroup < all[j].Subgroup
+		})
+		return nil
+	default:
+		return fmt.Errorf("invalid -sort %q: want file, name, or group", mode)
+	}
+}
+
+// shapeJSON returns the value to encode as the json output of emojis for the
+// provided -json-shape: "array" for the emojis themselves, or "object" for a
+// map from grapheme to emoji.
+func shapeJSON(all []*emojis.Emoji, shape string) (any, error) {
+	switch shape {
+	case "array":
+		return all, nil
+	case "object":
+		// encoding/json sorts map keys, so the output is stable.
+		return emojis.ByGrapheme(all), nil
+	default:
+		return nil, fmt.Errorf("invalid -json-shape %q: want array or object", shape)
+	}
+}
+
+// formatCSV formats emojis as a csv file with a header row followed by one row
+// per emoji. Codes are space separated hex code points (e.g., "2639 FE0F"),
+// and tags are semicolon separated.
+func formatCSV(all []*emojis.Emoji) ([]byte, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if err := w.Write([]string{"grapheme", "name", "group", "subgroup", "codes", "tags"}); err != nil {
+		return nil, err
+	}
+	for _, emoji := range all {
+		record := []string{
+			emoji.Grapheme,
+			emoji.Name,
+			emoji.Group,
+			emoji.Subgroup,
+			emojis.CodesKey(emoji.Codes),
+			strings.Join(emoji.Tags, ";"),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+// xmlEmojis and xmlEmoji are the xml encodings of emojis.
+type xmlEmojis struct {
+	XMLName xml.Name   `xml:"emojis"`
+	Emojis  []xmlEmoji `xml:"emoji"`
+}
+
+type xmlEmoji struct {
+	Grapheme string   `xml:"grapheme,attr"`
+	Name     string   `xml:"name,attr"`
+	Group    string   `xml:"group,attr"`
+	Tags     []string `xml:"tag"`
+}
+
+// formatXML formats emojis as an xml file with one emoji element per emoji
+// (e.g., <emoji grapheme="😀" name="grinning face" group="Smileys &amp;
+// Emotion">) containing one tag element per tag.
+func formatXML(all []*emojis.Emoji) ([]byte, error) {
+	doc := xmlEmojis{Emojis: make([]xmlEmoji, len(all))}
+	for i, emoji := range all {
+		doc.Emojis[i] = xmlEmoji{emoji.Grapheme, emoji.Name, emoji.Group, emoji.Tags}
+	}
+	bytes, err := xml.MarshalIndent(doc, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("xml encode: %w", err)
+	}
+	return append([]byte(xml.Header), append(bytes, '\n')...), nil
+}
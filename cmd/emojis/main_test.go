@@ -0,0 +1,498 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mwhittaker/emojis"
+	"golang.org/x/exp/slices"
+)
+
+// testEmojiTest is a small excerpt of emoji-test.txt shared by the tests.
+const testEmojiTest = `# group: Smileys & Emotion
+# subgroup: face-smiling
+1F600                                                  ; fully-qualified     # 😀 E1.0 grinning face
+1F603                                                  ; fully-qualified     # 😃 E0.6 grinning face with big eyes
+
+# subgroup: face-concerned
+2639 FE0F                                              ; fully-qualified     # ☹️ E0.7 frowning face
+2639                                                   ; unqualified         # ☹ E0.7 frowning face
+
+# group: Animals & Nature
+# subgroup: animal-mammal
+1F408                                                  ; fully-qualified     # 🐈 E0.7 cat
+1F408 200D 2B1B                                        ; fully-qualified     # 🐈‍⬛ E13.0 black cat
+
+# group: Component
+# subgroup: skin-tone
+1F3FB                                                  ; component           # 🏻 E1.0 light skin tone
+`
+
+// testEmojis returns the emojis of testEmojiTest with a few tags.
+func testEmojis(t *testing.T) []*emojis.Emoji {
+	t.Helper()
+	all, err := emojis.ParseString(testEmojiTest)
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+	emojis.AssignTags(all, emojis.TagMap{
+		"😀":   {"face", "grin"},
+		"☹️":  {"face", "frown"},
+		"🐈":   {"cat", "pet"},
+		"🐈‍⬛": {"black, or dark", "cat"},
+	})
+	return all
+}
+
+func TestFormatCSV(t *testing.T) {
+	all := testEmojis(t)
+	b, err := formatCSV(all)
+	if err != nil {
+		t.Fatalf("formatCSV: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(string(b))).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll: %v", err)
+	}
+	if got, want := len(records), len(all)+1; got != want {
+		t.Fatalf("got %d rows, want %d", got, want)
+	}
+	for row, want := range map[int][]string{
+		0: {"grapheme", "name", "group", "subgroup", "codes", "tags"},
+		1: {"😀", "grinning face", "Smileys & Emotion", "face-smiling", "1F600", "face;grin"},
+		5: {"🐈‍⬛", "black cat", "Animals & Nature", "animal-mammal", "1F408 200D 2B1B", "black, or dark;cat"},
+	} {
+		if got := records[row]; !slices.Equal(got, want) {
+			t.Errorf("row %d: got %q, want %q", row, got, want)
+		}
+	}
+}
+
+func TestSortEmojis(t *testing.T) {
+	for _, test := range []struct {
+		mode string
+		want []string
+	}{
+		{"file", []string{"😀", "😃", "☹️", "🐈", "🐈‍⬛"}},
+		{"name", []string{"🐈‍⬛", "🐈", "☹️", "😀", "😃"}},
+		{"group", []string{"🐈", "🐈‍⬛", "☹️", "😀", "😃"}},
+	} {
+		all := testEmojis(t)
+		if err := sortEmojis(all, test.mode); err != nil {
+			t.Errorf("sortEmojis(%q): %v", test.mode, err)
+			continue
+		}
+		if got := graphemes(all); !slices.Equal(got, test.want) {
+			t.Errorf("sortEmojis(%q): got %v, want %v", test.mode, got, test.want)
+		}
+	}
+	if err := sortEmojis(testEmojis(t), "version"); err == nil {
+		t.Errorf(`sortEmojis("version"): got no error, want error`)
+	}
+}
+
+// graphemes returns the graphemes of all.
+func graphemes(all []*emojis.Emoji) []string {
+	var gs []string
+	for _, emoji := range all {
+		gs = append(gs, emoji.Grapheme)
+	}
+	return gs
+}
+
+func TestOpenInput(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "emoji-test.txt")
+	if err := os.WriteFile(filename, []byte("from file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdin := filepath.Join(dir, "stdin")
+	if err := os.WriteFile(stdin, []byte("from stdin"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer func(old *os.File) { os.Stdin = old }(os.Stdin)
+	os.Stdin = f
+
+	for name, want := range map[string]string{filename: "from file", "-": "from stdin"} {
+		in, err := openInput(name)
+		if err != nil {
+			t.Errorf("openInput(%q): %v", name, err)
+			continue
+		}
+		got, err := io.ReadAll(in)
+		in.Close()
+		if err != nil {
+			t.Errorf("openInput(%q): %v", name, err)
+		} else if string(got) != want {
+			t.Errorf("openInput(%q): got %q, want %q", name, got, want)
+		}
+	}
+	if _, err := openInput(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Errorf("openInput of a missing file: got no error, want error")
+	}
+}
+
+func TestFilterGroups(t *testing.T) {
+	for _, test := range []struct {
+		groups string
+		want   []string
+	}{
+		{"Animals & Nature", []string{"🐈", "🐈‍⬛"}},
+		{"Smileys & Emotion", []string{"😀", "😃", "☹️"}},
+		{"Animals & Nature,Smileys & Emotion", []string{"😀", "😃", "☹️", "🐈", "🐈‍⬛"}},
+		{"Food & Drink", nil},
+		{"smileys & emotion", []string{"😀", "😃", "☹️"}},
+		{"ANIMALS", []string{"🐈", "🐈‍⬛"}},
+		{"smiley", []string{"😀", "😃", "☹️"}},
+		{"&", []string{"😀", "😃", "☹️", "🐈", "🐈‍⬛"}},
+		{"animals, smileys", []string{"😀", "😃", "☹️", "🐈", "🐈‍⬛"}},
+		{" animals ,", []string{"🐈", "🐈‍⬛"}},
+		{" , ", nil},
+	} {
+		if got := graphemes(filterGroups(testEmojis(t), test.groups)); !slices.Equal(got, test.want) {
+			t.Errorf("filterGroups(%q): got %v, want %v", test.groups, got, test.want)
+		}
+	}
+}
+
+// setFlag sets the named flag for the duration of the test.
+func setFlag(t *testing.T, name, value string) {
+	t.Helper()
+	old := flag.Lookup(name).Value.String()
+	if err := flag.Set(name, value); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { flag.Set(name, old) })
+}
+
+func TestRunMinEmoji(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "emoji-test.txt")
+	if err := os.WriteFile(empty, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	jsonOut := filepath.Join(dir, "emojis.json")
+	setFlag(t, "emoji-test", empty)
+	setFlag(t, "json-out", jsonOut)
+	setFlag(t, "min-emoji", "1")
+
+	err := run()
+	if err == nil || !strings.Contains(err.Error(), "want at least -min-emoji=1") {
+		t.Errorf("got error %v, want a -min-emoji error", err)
+	}
+	if _, err := os.Stat(jsonOut); err == nil {
+		t.Errorf("%s was written despite too few emojis", jsonOut)
+	}
+}
+
+func TestFormatXML(t *testing.T) {
+	all := testEmojis(t)
+	b, err := formatXML(all)
+	if err != nil {
+		t.Fatalf("formatXML: %v", err)
+	}
+	var doc xmlEmojis
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("xml.Unmarshal: %v\n%s", err, b)
+	}
+	if got, want := len(doc.Emojis), len(all); got != want {
+		t.Fatalf("got %d emojis, want %d", got, want)
+	}
+	// The ampersand in the group is escaped and decoded.
+	want := xmlEmoji{Grapheme: "😀", Name: "grinning face", Group: "Smileys & Emotion", Tags: []string{"face", "grin"}}
+	if got := doc.Emojis[0]; got.Grapheme != want.Grapheme || got.Name != want.Name || got.Group != want.Group || !slices.Equal(got.Tags, want.Tags) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if !strings.Contains(string(b), `group="Smileys &amp; Emotion"`) {
+		t.Errorf("xml doesn't escape the ampersand in Smileys & Emotion:\n%s", b)
+	}
+}
+
+func TestOpenInputGzip(t *testing.T) {
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	zw.Write([]byte(testEmojiTest))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+
+	// Compression is detected from the contents, not the file name.
+	for _, name := range []string{"emoji-test.txt.gz", "emoji-test.txt"} {
+		filename := filepath.Join(dir, name)
+		if err := os.WriteFile(filename, b.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		in, err := openInput(filename)
+		if err != nil {
+			t.Fatalf("openInput(%s): %v", name, err)
+		}
+		all, err := emojis.Parse(in)
+		in.Close()
+		if err != nil {
+			t.Errorf("parse %s: %v", name, err)
+		} else if got, want := len(all), 5; got != want {
+			t.Errorf("parse %s: got %d emojis, want %d", name, got, want)
+		}
+	}
+
+	// Short and empty files aren't mistaken for gzip.
+	for _, contents := range []string{"", "x", "\x1f"} {
+		got, err := io.ReadAll(mustGunzip(t, contents))
+		if err != nil || string(got) != contents {
+			t.Errorf("gunzip(%q): got %q, %v, want %q", contents, got, err, contents)
+		}
+	}
+}
+
+// mustGunzip returns gunzip of the provided contents.
+func mustGunzip(t *testing.T, contents string) io.Reader {
+	t.Helper()
+	in, err := gunzip(io.NopCloser(strings.NewReader(contents)))
+	if err != nil {
+		t.Fatalf("gunzip(%q): %v", contents, err)
+	}
+	return in
+}
+
+// runFixture runs the command on testEmojiTest and the provided data.json,
+// writing every output to a temporary directory, and returns the emojis in
+// -json-out. Other flags can be set beforehand with setFlag.
+func runFixture(t *testing.T, data string) []emojis.Emoji {
+	t.Helper()
+	var all []emojis.Emoji
+	if err := json.Unmarshal(runFixtureJSON(t, data), &all); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	return all
+}
+
+// runFixtureJSON is like runFixture but returns the contents of -json-out.
+func runFixtureJSON(t *testing.T, data string) []byte {
+	t.Helper()
+	dir := t.TempDir()
+	emojiTest := filepath.Join(dir, "emoji-test.txt")
+	if err := os.WriteFile(emojiTest, []byte(testEmojiTest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dataJSON := filepath.Join(dir, "data.json")
+	if err := os.WriteFile(dataJSON, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := *dataFlag
+	t.Cleanup(func() { *dataFlag = old })
+	*dataFlag = filesFlag{files: []string{dataJSON}, set: true}
+	setFlag(t, "emoji-test", emojiTest)
+	for _, name := range []string{"json-out", "go-out", "tokens-go-out", "shortcodes-go-out", "names-go-out"} {
+		setFlag(t, name, filepath.Join(dir, name))
+	}
+
+	if err := run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "json-out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// updateFlag rewrites golden files in testdata rather than comparing with
+// them (e.g., go test -run TestRunSortTags -update).
+var updateFlag = flag.Bool("update", false, "if true, update the golden files in testdata")
+
+func TestRunSortTags(t *testing.T) {
+	const data = `[
+		{"emoji": "😀", "tags": ["grin", "face"]},
+		{"emoji": "🐈", "tags": ["pet", "cat", "animal"]}
+	]`
+	for _, sortTags := range []string{"false", "true"} {
+		setFlag(t, "sort-tags", sortTags)
+		got := runFixtureJSON(t, data)
+		golden := filepath.Join("testdata", "sort_tags_"+sortTags+".json")
+		if *updateFlag {
+			if err := os.WriteFile(golden, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("-sort-tags=%s: got\n%s\nwant (%s)\n%s", sortTags, got, golden, want)
+		}
+	}
+}
+
+func TestRunRequireTags(t *testing.T) {
+	const data = `[
+		{"emoji": "😀", "tags": ["face", "grin"]},
+		{"emoji": "🐈", "tags": ["cat", "pet"]},
+		{"emoji": "😃", "tags": []}
+	]`
+	for _, test := range []struct {
+		requireTags string
+		want        []string
+	}{
+		{"false", []string{"😀", "😃", "☹️", "🐈", "🐈‍⬛"}},
+		{"true", []string{"😀", "🐈"}},
+	} {
+		setFlag(t, "require-tags", test.requireTags)
+		var got []string
+		for _, emoji := range runFixture(t, data) {
+			got = append(got, emoji.Grapheme)
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("-require-tags=%s: got %v, want %v", test.requireTags, got, test.want)
+		}
+	}
+}
+
+func TestOrphanTags(t *testing.T) {
+	parse := func(opts emojis.ParseOptions) map[string]*emojis.Emoji {
+		all, err := emojis.ParseWithOptions(strings.NewReader(testEmojiTest), opts)
+		if err != nil {
+			t.Fatalf("ParseWithOptions: %v", err)
+		}
+		return emojis.ByGrapheme(all)
+	}
+	tags := map[string][]string{
+		"😀": {"face", "grin"},
+		"☹": {"face", "frown"}, // unqualified
+		"🦄": {"face", "unicorn"},
+		"🐉": {"dragon"},
+		// Emojis without tags aren't orphans.
+		"🦖":   nil,
+		"🦊":   {},
+		"😃":   {"smile"},
+		"🐈‍⬛": {"black", "cat"},
+		"🐈️":  {"cat"}, // with a variation selector
+		"🏻":   {"light skin tone"},
+	}
+	if got, want := orphanTags(tags, parse(emojis.ParseOptions{})), []string{"🏻", "🐉", "🦄"}; !slices.Equal(got, want) {
+		t.Errorf("fully qualified: got %v, want %v", got, want)
+	}
+	all := emojis.ParseOptions{IncludeUnqualified: true, IncludeMinimallyQualified: true, IncludeComponents: true}
+	if got, want := orphanTags(tags, parse(all)), []string{"🐉", "🦄"}; !slices.Equal(got, want) {
+		t.Errorf("every qualification: got %v, want %v", got, want)
+	}
+}
+
+func TestRunWarnOrphanTags(t *testing.T) {
+	var handler recordHandler
+	old := logger
+	t.Cleanup(func() { logger = old })
+	logger = slog.New(&handler)
+
+	// Neither the components and emojis that aren't fully qualified in
+	// emoji-test.txt nor emojis filtered by -max-version (e.g., 😀) are
+	// orphans.
+	const data = `[
+		{"emoji": "😀", "tags": ["face", "grin"]},
+		{"emoji": "☹", "tags": ["frown"]},
+		{"emoji": "🏻", "tags": ["light skin tone"]},
+		{"emoji": "🦄", "tags": ["unicorn"]}
+	]`
+	setFlag(t, "max-version", "0.7")
+	want := runFixtureJSON(t, data)
+	setFlag(t, "warn-orphan-tags", "true")
+	// Warning about orphans doesn't change the output.
+	if got := runFixtureJSON(t, data); !bytes.Equal(got, want) {
+		t.Errorf("-warn-orphan-tags: got\n%s\nwant\n%s", got, want)
+	}
+	if want := []string{"tags for unknown emoji 🦄", "tagged emojis not in input 1"}; !slices.Equal(handler.warnings, want) {
+		t.Errorf("got warnings %q, want %q", handler.warnings, want)
+	}
+}
+
+// recordHandler is a slog.Handler that records the messages and graphemes of
+// warnings.
+type recordHandler struct {
+	warnings []string
+}
+
+func (h *recordHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= slog.LevelWarn
+}
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler      { return h }
+
+func (h *recordHandler) Handle(_ context.Context, r slog.Record) error {
+	warning := r.Message
+	r.Attrs(func(a slog.Attr) bool {
+		if a.Key == "grapheme" || a.Key == "count" {
+			warning += " " + a.Value.String()
+		}
+		return true
+	})
+	h.warnings = append(h.warnings, warning)
+	return nil
+}
+
+func TestRunWarnings(t *testing.T) {
+	var handler recordHandler
+	old := logger
+	t.Cleanup(func() { logger = old })
+	logger = slog.New(&handler)
+
+	const data = `[
+		{"emoji": "😀", "tags": ["face", "grin"]},
+		{"emoji": "🐈", "tags": ["cat", "pet"]},
+		{"emoji": "🏻", "tags": ["light skin tone"]},
+		{"emoji": "🦄", "tags": ["unicorn"]}
+	]`
+	setFlag(t, "warn-missing-tags", "true")
+	setFlag(t, "warn-orphan-tags", "true")
+	runFixture(t, data)
+	want := []string{
+		"no tags 😃",
+		"no tags ☹️",
+		"no tags 🐈‍⬛",
+		"emojis without tags 3",
+		"tags for unknown emoji 🦄",
+		"tagged emojis not in input 1",
+	}
+	if !slices.Equal(handler.warnings, want) {
+		t.Errorf("got warnings %q, want %q", handler.warnings, want)
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	for _, test := range []struct {
+		logLevel, verbose string
+		want              slog.Level
+	}{
+		{"warn", "false", slog.LevelWarn},
+		{"warn", "true", slog.LevelInfo},
+		{"error", "true", slog.LevelInfo},
+		{"debug", "false", slog.LevelDebug},
+		{"debug", "true", slog.LevelDebug},
+	} {
+		setFlag(t, "log-level", test.logLevel)
+		setFlag(t, "verbose", test.verbose)
+		if got, err := logLevel(); err != nil || got != test.want {
+			t.Errorf("logLevel(-log-level=%s -verbose=%s): got %v, %v, want %v", test.logLevel, test.verbose, got, err, test.want)
+		}
+	}
+	setFlag(t, "log-level", "loud")
+	if got, err := logLevel(); err == nil {
+		t.Errorf("logLevel(-log-level=loud): got %v, want error", got)
+	}
+}
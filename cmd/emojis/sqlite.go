@@ -0,0 +1,59 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mwhittaker/emojis"
+	_ "modernc.org/sqlite"
+)
+
+// writeSQLite writes emojis to a new SQLite database with a single emoji
+// table in the named file, replacing it atomically if it exists. Codes and tags
+// are formatted like in formatCSV.
+func writeSQLite(filename string, all []*emojis.Emoji) error {
+	return writeAtomic(filename, func(f *os.File) error {
+		// SQLite treats the empty temporary file as an empty database.
+		return writeSQLiteDatabase(f.Name(), all)
+	})
+}
+
+// writeSQLiteDatabase writes emojis to the SQLite database in the named file,
+// which must be empty.
+func writeSQLiteDatabase(filename string, all []*emojis.Emoji) error {
+	db, err := sql.Open("sqlite", filename)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// "group" is a keyword, so it has to be quoted.
+	if _, err := db.Exec(`CREATE TABLE emoji (grapheme TEXT PRIMARY KEY, name TEXT, "group" TEXT, subgroup TEXT, codes TEXT, tags TEXT)`); err != nil {
+		return fmt.Errorf("create table: %w", err)
+	}
+
+	// Insert all the emojis in a single transaction, which is much faster than
+	// one transaction per emoji.
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.Prepare(`INSERT INTO emoji VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, emoji := range all {
+		_, err := stmt.Exec(emoji.Grapheme, emoji.Name, emoji.Group, emoji.Subgroup, emojis.CodesKey(emoji.Codes), strings.Join(emoji.Tags, ";"))
+		if err != nil {
+			return fmt.Errorf("insert %s: %w", emoji.Grapheme, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return db.Close()
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteSQLite(t *testing.T) {
+	all := testEmojis(t)
+	filename := filepath.Join(t.TempDir(), "emojis.db")
+	if err := writeSQLite(filename, all); err != nil {
+		t.Fatalf("writeSQLite: %v", err)
+	}
+	db, err := sql.Open("sqlite", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM emoji`).Scan(&count); err != nil {
+		t.Fatalf("count rows: %v", err)
+	}
+	if count != len(all) {
+		t.Errorf("got %d rows, want %d", count, len(all))
+	}
+	var name, codes, tags string
+	err = db.QueryRow(`SELECT name, codes, tags FROM emoji WHERE grapheme = ?`, "☹️").Scan(&name, &codes, &tags)
+	if err != nil {
+		t.Fatalf("select ☹️: %v", err)
+	}
+	if name != "frowning face" || codes != "2639 FE0F" || tags != "face;frown" {
+		t.Errorf("got ☹️ row (%q, %q, %q), want (\"frowning face\", \"2639 FE0F\", \"face;frown\")", name, codes, tags)
+	}
+}
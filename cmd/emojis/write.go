@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mwhittaker/emojis"
+)
+
+// fileMode is the permissions of written files. See -file-mode.
+var fileMode os.FileMode = 0644
+
+// parseFileMode parses file permissions in octal (e.g., "0644" or "640").
+func parseFileMode(s string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid file mode %q: %w", s, err)
+	}
+	if mode > 0777 {
+		return 0, fmt.Errorf("invalid file mode %q: want permission bits between 0000 and 0777", s)
+	}
+	return os.FileMode(mode), nil
+}
+
+// writeAtomic writes the named file by calling write on a temporary file in
+// the same directory and then renaming the temporary file into place. Readers
+// of the named file never see a partially written file, even if the process
+// is killed mid-write. If the named file already has the written contents, it
+// is left unmodified. The file's permissions are set to fileMode.
+func writeAtomic(filename string, write func(f *os.File) error) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Chmod(fileMode); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if same, err := sameContents(tmp, filename); err != nil {
+		os.Remove(tmp)
+		return err
+	} else if same {
+		// Leave an unchanged file in place, so that its modification time
+		// doesn't change and trigger spurious rebuilds.
+		os.Remove(tmp)
+		logger.Debug("skipped unchanged file", "file", filename)
+		return os.Chmod(filename, fileMode)
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	logger.Debug("wrote file", "file", filename)
+	return nil
+}
+
+// sameContents returns whether the named files have the same contents. It
+// returns false if the second file doesn't exist.
+func sameContents(a, b string) (bool, error) {
+	y, err := os.ReadFile(b)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	x, err := os.ReadFile(a)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(x, y), nil
+}
+
+// writeFile is like os.WriteFile but writes the named file atomically. See
+// writeAtomic.
+func writeFile(filename string, data []byte) error {
+	return writeAtomic(filename, func(f *os.File) error {
+		_, err := f.Write(data)
+		return err
+	})
+}
+
+// writeJSON writes v to the named file as json, indented unless -compact is
+// set. The json is streamed to the file rather than built in memory first.
+func writeJSON(filename string, v any) error {
+	return writeAtomic(filename, func(f *os.File) error {
+		encoder := json.NewEncoder(f)
+		if !*compactFlag {
+			encoder.SetIndent("", "    ")
+		}
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("json encode %s: %w", filename, err)
+		}
+		return nil
+	})
+}
+
+// writeNDJSON writes emojis to the named file as newline delimited json, one
+// compact json object per line.
+func writeNDJSON(filename string, all []*emojis.Emoji) error {
+	return writeAtomic(filename, func(f *os.File) error {
+		encoder := json.NewEncoder(f)
+		for _, emoji := range all {
+			if err := encoder.Encode(emoji); err != nil {
+				return fmt.Errorf("json encode %s: %w", filename, err)
+			}
+		}
+		return nil
+	})
+}
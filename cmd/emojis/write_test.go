@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mwhittaker/emojis"
+)
+
+func TestWriteJSON(t *testing.T) {
+	all := testEmojis(t)
+	filename := filepath.Join(t.TempDir(), "emojis.json")
+	if err := writeJSON(filename, all); err != nil {
+		t.Fatalf("writeJSON: %v", err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The streamed json is the same as the marshaled json, plus a trailing
+	// newline.
+	want, err := json.MarshalIndent(all, "", "    ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, '\n')
+	if string(got) != string(want) {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteJSONShapes(t *testing.T) {
+	all := testEmojis(t)
+	dir := t.TempDir()
+	for _, test := range []struct {
+		shape string
+		want  string // the start of the json
+	}{
+		{"array", "[\n    {\n        \"Grapheme\": \"😀\","},
+		// Object keys are sorted.
+		{"object", "{\n    \"☹️\": {\n        \"Grapheme\": \"☹️\","},
+	} {
+		shaped, err := shapeJSON(all, test.shape)
+		if err != nil {
+			t.Fatalf("shapeJSON(%q): %v", test.shape, err)
+		}
+		filename := filepath.Join(dir, test.shape+".json")
+		if err := writeJSON(filename, shaped); err != nil {
+			t.Fatalf("writeJSON: %v", err)
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(got), test.want) {
+			t.Errorf("-json-shape=%s: got json starting with %.40q, want %q", test.shape, got, test.want)
+		}
+
+		// Both shapes decode to the same emojis.
+		if test.shape == "array" {
+			var decoded []*emojis.Emoji
+			if err := json.Unmarshal(got, &decoded); err != nil || len(decoded) != len(all) {
+				t.Errorf("-json-shape=array: got %d emojis (%v), want %d", len(decoded), err, len(all))
+			}
+		} else {
+			var decoded map[string]*emojis.Emoji
+			if err := json.Unmarshal(got, &decoded); err != nil || len(decoded) != len(all) {
+				t.Errorf("-json-shape=object: got %d emojis (%v), want %d", len(decoded), err, len(all))
+			}
+		}
+	}
+	if _, err := shapeJSON(all, "tree"); err == nil {
+		t.Errorf(`shapeJSON("tree"): got no error, want error`)
+	}
+}
+
+func TestWriteJSONCompact(t *testing.T) {
+	defer func(old bool) { *compactFlag = old }(*compactFlag)
+	all := testEmojis(t)
+	dir := t.TempDir()
+	for _, test := range []struct {
+		compact bool
+		marshal func(any) ([]byte, error)
+	}{
+		{false, func(v any) ([]byte, error) { return json.MarshalIndent(v, "", "    ") }},
+		{true, json.Marshal},
+	} {
+		*compactFlag = test.compact
+		filename := filepath.Join(dir, "emojis.json")
+		if err := writeJSON(filename, all); err != nil {
+			t.Fatalf("writeJSON: %v", err)
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := test.marshal(all)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want)+"\n" {
+			t.Errorf("-compact=%t: got:\n%s\nwant:\n%s", test.compact, got, want)
+		}
+		if lines := strings.Count(string(got), "\n"); test.compact && lines != 1 {
+			t.Errorf("-compact=true: got %d lines, want 1", lines)
+		}
+	}
+}
+
+func TestWriteAtomic(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "emojis.json")
+	if err := writeFile(filename, []byte("old")); err != nil {
+		t.Fatalf("writeFile: %v", err)
+	}
+
+	// A failed write leaves the old file in place.
+	err := writeAtomic(filename, func(f *os.File) error {
+		f.WriteString("partial")
+		return errors.New("killed")
+	})
+	if err == nil {
+		t.Errorf("writeAtomic: got no error, want error")
+	}
+	if got, _ := os.ReadFile(filename); string(got) != "old" {
+		t.Errorf("got %q after a failed write, want %q", got, "old")
+	}
+
+	if err := writeFile(filename, []byte("new")); err != nil {
+		t.Fatalf("writeFile: %v", err)
+	}
+	if got, _ := os.ReadFile(filename); string(got) != "new" {
+		t.Errorf("got %q, want %q", got, "new")
+	}
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		t.Errorf("got files %v, want only emojis.json", names)
+	}
+}
+
+func TestParseFileMode(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want os.FileMode
+	}{
+		{"0644", 0644},
+		{"644", 0644},
+		{"0640", 0640},
+		{"0664", 0664},
+		{"0", 0},
+		{"0777", 0777},
+	} {
+		if got, err := parseFileMode(test.s); err != nil || got != test.want {
+			t.Errorf("parseFileMode(%q): got %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+
+	for _, s := range []string{"", "rw-r--r--", "0x1a4", "-644", "0648", "1000", "07777"} {
+		if got, err := parseFileMode(s); err == nil {
+			t.Errorf("parseFileMode(%q): got %v, want error", s, got)
+		}
+	}
+}
+
+func TestWriteFileMode(t *testing.T) {
+	old := fileMode
+	t.Cleanup(func() { fileMode = old })
+
+	filename := filepath.Join(t.TempDir(), "emojis.json")
+	for _, mode := range []os.FileMode{0640, 0664} {
+		fileMode = mode
+		if err := writeFile(filename, []byte("{}")); err != nil {
+			t.Fatalf("writeFile: %v", err)
+		}
+		info, err := os.Stat(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := info.Mode().Perm(); got != mode {
+			t.Errorf("got mode %v, want %v", got, mode)
+		}
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	all := testEmojis(t)
+	filename := filepath.Join(t.TempDir(), "emojis.ndjson")
+	if err := writeNDJSON(filename, all); err != nil {
+		t.Fatalf("writeNDJSON: %v", err)
+	}
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(contents), "\n") {
+		t.Errorf("got %q, want a trailing newline", contents)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+	if got, want := len(lines), len(all); got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+	for i, line := range lines {
+		var emoji emojis.Emoji
+		if err := json.Unmarshal([]byte(line), &emoji); err != nil {
+			t.Errorf("line %d: %v", i+1, err)
+		} else if emoji.Grapheme != all[i].Grapheme {
+			t.Errorf("line %d: got %s, want %s", i+1, emoji.Grapheme, all[i].Grapheme)
+		}
+	}
+}
+
+func TestSameContents(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) string {
+		filename := filepath.Join(dir, name)
+		if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return filename
+	}
+	a := write("a", "same")
+	for _, test := range []struct {
+		b    string
+		want bool
+	}{
+		{write("b", "same"), true},
+		{write("c", "different"), false},
+		{write("d", "sam"), false},
+		{write("e", ""), false},
+		{filepath.Join(dir, "missing"), false},
+	} {
+		if got, err := sameContents(a, test.b); err != nil || got != test.want {
+			t.Errorf("sameContents(a, %s): got %t, %v, want %t", filepath.Base(test.b), got, err, test.want)
+		}
+	}
+}
+
+func TestWriteFileUnchanged(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "emojis.json")
+	if err := writeFile(filename, []byte("old")); err != nil {
+		t.Fatalf("writeFile: %v", err)
+	}
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filename, past, past); err != nil {
+		t.Fatal(err)
+	}
+	modTime := func() time.Time {
+		info, err := os.Stat(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.ModTime()
+	}
+
+	// Rewriting the same contents leaves the file in place.
+	if err := writeFile(filename, []byte("old")); err != nil {
+		t.Fatalf("writeFile: %v", err)
+	}
+	if got := modTime(); !got.Equal(past) {
+		t.Errorf("unchanged file: got modification time %v, want %v", got, past)
+	}
+
+	// Writing new contents replaces it.
+	if err := writeFile(filename, []byte("new")); err != nil {
+		t.Fatalf("writeFile: %v", err)
+	}
+	if got := modTime(); got.Equal(past) {
+		t.Errorf("changed file: got modification time %v, want a newer one", got)
+	}
+	if got, _ := os.ReadFile(filename); string(got) != "new" {
+		t.Errorf("got %q, want %q", got, "new")
+	}
+}
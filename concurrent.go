@@ -0,0 +1,96 @@
+package emojis
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ParseConcurrent is like Parse but parses chunks of the file concurrently
+// with the provided number of workers (e.g., runtime.NumCPU()). The file is
+// split into chunks at empty lines. It returns the same emojis, in the same
+// order, as Parse. This is only faster for large inputs, like many
+// concatenated emoji-test.txt files.
+func ParseConcurrent(r io.Reader, workers int) ([]*Emoji, error) {
+	return ParseConcurrentWithOptions(r, workers, ParseOptions{})
+}
+
+// ParseConcurrentWithOptions is like ParseWithOptions but parses chunks of the
+// file concurrently. See ParseConcurrent.
+func ParseConcurrentWithOptions(r io.Reader, workers int, opts ParseOptions) ([]*Emoji, error) {
+	if err := opts.check(); err != nil {
+		return nil, err
+	}
+
+	// Split the file into chunks. Every chunk's lines are parsed in the group
+	// and subgroup of the last group and subgroup lines before the chunk.
+	type chunk struct {
+		lines    []string
+		start    int // the line number of the first line
+		group    string
+		subgroup string
+	}
+	var chunks []*chunk
+	current := &chunk{start: 1}
+	group, subgroup := "", ""
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		// Trim the carriage return from files with CRLF line endings.
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		current.lines = append(current.lines, line)
+		if strings.HasPrefix(line, "#") {
+			if matches := groupRegex.FindStringSubmatch(line); matches != nil {
+				if matches[1] == "group" {
+					group = matches[2]
+				} else {
+					subgroup = matches[2]
+				}
+			}
+		}
+		if strings.TrimSpace(line) == "" {
+			chunks = append(chunks, current)
+			current = &chunk{start: number + 1, group: group, subgroup: subgroup}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	chunks = append(chunks, current)
+
+	// Parse the chunks concurrently.
+	parsers := make([]*parser, len(chunks))
+	errs := make([]error, len(chunks))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(workers, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				c := chunks[i]
+				parsers[i] = newParser(opts, c.group, c.subgroup)
+				for j, line := range c.lines {
+					if errs[i] = parsers[i].parseLine(line, c.start+j); errs[i] != nil {
+						break
+					}
+				}
+			}
+		}()
+	}
+	for i := range chunks {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// Merge the chunks in order.
+	merged := newParser(opts, "", "")
+	for i, p := range parsers {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		merged.merge(p)
+	}
+	return merged.finish(), nil
+}
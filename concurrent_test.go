@@ -0,0 +1,94 @@
+package emojis
+
+import (
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseConcurrent(t *testing.T) {
+	var lines []string
+	for _, line := range strings.Split(testEmojiTest, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	inputs := map[string]string{
+		"fixture": testEmojiTest,
+		// A single chunk.
+		"no empty lines": strings.Join(lines, "\n"),
+		// A chunk per line, so that every group and subgroup spans many
+		// chunks, and ☹ is in a different chunk than ☹️.
+		"empty lines": strings.Join(lines, "\n\n"),
+		// Every emoji is a duplicate of one in an earlier chunk.
+		"concatenated": strings.Repeat(testEmojiTest, 3),
+	}
+	for name, input := range inputs {
+		for _, opts := range []ParseOptions{
+			{},
+			{IncludeUnqualified: true, IncludeMinimallyQualified: true, IncludeComponents: true, SourceLines: true},
+		} {
+			var wantStats Stats
+			opts.Stats = &wantStats
+			want, err := ParseWithOptions(strings.NewReader(input), opts)
+			if err != nil {
+				t.Fatalf("%s: ParseWithOptions: %v", name, err)
+			}
+			for _, workers := range []int{0, 1, 2, 8} {
+				var gotStats Stats
+				opts.Stats = &gotStats
+				got, err := ParseConcurrentWithOptions(strings.NewReader(input), workers, opts)
+				if err != nil {
+					t.Fatalf("%s: ParseConcurrentWithOptions(%d): %v", name, workers, err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: ParseConcurrentWithOptions(%d, %+v): got %v, want %v", name, workers, opts, graphemes(got), graphemes(want))
+				}
+				if !reflect.DeepEqual(gotStats, wantStats) {
+					t.Errorf("%s: ParseConcurrentWithOptions(%d, %+v): got stats %+v, want %+v", name, workers, opts, gotStats, wantStats)
+				}
+			}
+		}
+	}
+
+	// Errors are reported like Parse's.
+	_, want := ParseString(testEmojiTest + testMismatch)
+	if _, err := ParseConcurrent(strings.NewReader(testEmojiTest+testMismatch), 4); err == nil || err.Error() != want.Error() {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+// benchmarkInput returns the repository's emoji-test.txt repeated, to make an
+// input large enough to benefit from concurrency.
+func benchmarkInput(b *testing.B) string {
+	b.Helper()
+	data, err := os.ReadFile("emoji-test.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return strings.Repeat(string(data), 10)
+}
+
+func BenchmarkParse(b *testing.B) {
+	input := benchmarkInput(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(strings.NewReader(input)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseConcurrent(b *testing.B) {
+	input := benchmarkInput(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseConcurrent(strings.NewReader(input), runtime.NumCPU()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
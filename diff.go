@@ -0,0 +1,58 @@
+package emojis
+
+import "sort"
+
+// Diff describes the differences between two sets of emojis, typically
+// parsed from two versions of emoji-test.txt.
+type Diff struct {
+	Added   []*Emoji // emojis in the new set but not the old set
+	Removed []*Emoji // emojis in the old set but not the new set
+	Renamed []Rename // emojis in both sets with different names
+}
+
+// Rename is an emoji that was renamed.
+type Rename struct {
+	Old *Emoji // the emoji in the old set
+	New *Emoji // the emoji in the new set
+}
+
+// Compare compares an old and a new set of emojis. Emojis are matched by
+// their code points. All fields of the returned Diff are sorted by code
+// points.
+func Compare(old, new []*Emoji) *Diff {
+	olds := map[string]*Emoji{}
+	for _, emoji := range old {
+		olds[string(emoji.Codes)] = emoji
+	}
+	news := map[string]*Emoji{}
+	for _, emoji := range new {
+		news[string(emoji.Codes)] = emoji
+	}
+
+	diff := &Diff{}
+	for key, n := range news {
+		o, ok := olds[key]
+		if !ok {
+			diff.Added = append(diff.Added, n)
+		} else if o.Name != n.Name {
+			diff.Renamed = append(diff.Renamed, Rename{o, n})
+		}
+	}
+	for key, o := range olds {
+		if _, ok := news[key]; !ok {
+			diff.Removed = append(diff.Removed, o)
+		}
+	}
+
+	byCodes := func(emojis []*Emoji) {
+		sort.Slice(emojis, func(i, j int) bool {
+			return string(emojis[i].Codes) < string(emojis[j].Codes)
+		})
+	}
+	byCodes(diff.Added)
+	byCodes(diff.Removed)
+	sort.Slice(diff.Renamed, func(i, j int) bool {
+		return string(diff.Renamed[i].New.Codes) < string(diff.Renamed[j].New.Codes)
+	})
+	return diff
+}
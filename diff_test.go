@@ -0,0 +1,40 @@
+package emojis
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestCompare(t *testing.T) {
+	old := mustParse(t, ParseOptions{})
+
+	// The new version adds 🦄 and renames 🐈.
+	input := strings.Replace(testEmojiTest, "# 🐈 E0.7 cat", "# 🐈 E0.7 house cat", 1) + `
+# group: Animals & Nature
+# subgroup: animal-mammal
+1F984                                                  ; fully-qualified     # 🦄 E1.0 unicorn
+`
+	new, err := ParseString(input)
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+
+	diff := Compare(old, new)
+	if got, want := graphemes(diff.Added), []string{"🦄"}; !slices.Equal(got, want) {
+		t.Errorf("added: got %v, want %v", got, want)
+	}
+	if len(diff.Removed) != 0 {
+		t.Errorf("removed: got %v, want none", graphemes(diff.Removed))
+	}
+	if len(diff.Renamed) != 1 || diff.Renamed[0].Old.Name != "cat" || diff.Renamed[0].New.Name != "house cat" {
+		t.Errorf("renamed: got %v, want 🐈 from cat to house cat", diff.Renamed)
+	}
+
+	// Comparing the other way around removes rather than adds 🦄.
+	diff = Compare(new, old)
+	if got, want := graphemes(diff.Removed), []string{"🦄"}; !slices.Equal(got, want) || len(diff.Added) != 0 {
+		t.Errorf("got added %v and removed %v, want only removed %v", graphemes(diff.Added), got, want)
+	}
+}
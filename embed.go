@@ -0,0 +1,33 @@
+package emojis
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// emojisJSON is the emojis.json generated by cmd/emojis with the default
+// -json-shape of array.
+//
+//go:embed emojis.json
+var emojisJSON []byte
+
+var (
+	allOnce   sync.Once
+	allEmojis []*Emoji
+	allErr    error
+)
+
+// All returns every emoji in the emojis.json embedded in this package, so
+// that the emojis can be used without reading any files. The embedded json is
+// decoded only once, and every call returns the same slice, so callers must
+// not modify it.
+func All() ([]*Emoji, error) {
+	allOnce.Do(func() {
+		if err := json.Unmarshal(emojisJSON, &allEmojis); err != nil {
+			allErr = fmt.Errorf("json decode: %w", err)
+		}
+	})
+	return allEmojis, allErr
+}
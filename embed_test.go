@@ -0,0 +1,25 @@
+package emojis
+
+import "testing"
+
+func TestAll(t *testing.T) {
+	emojis, err := All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	if len(emojis) == 0 {
+		t.Fatal("All returned no emojis")
+	}
+	if emoji, ok := LookupByGrapheme(emojis, "😀"); !ok || emoji.Name != "grinning face" {
+		t.Errorf("All is missing 😀 grinning face")
+	}
+
+	// The embedded json is decoded only once.
+	again, err := All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	if &again[0] != &emojis[0] {
+		t.Errorf("All returned a different slice on its second call")
+	}
+}
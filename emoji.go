@@ -0,0 +1,114 @@
+// Package emojis parses the list of all emojis and emoji sequences from
+// unicode.org's emoji-test.txt, along with their names, groups, subgroups,
+// and tags.
+package emojis
+
+import "golang.org/x/exp/slices"
+
+// The qualifications of an emoji. See https://unicode.org/reports/tr51/ for
+// details.
+const (
+	FullyQualified     = "fully-qualified"
+	MinimallyQualified = "minimally-qualified"
+	Unqualified        = "unqualified"
+	Component          = "component"
+)
+
+// Emoji represents an emoji or emoji sequence. Note that not every emoji is a
+// single code point. For example, the black cat emoji is actually three code
+// points: the cat code point, the zero width joiner code point, and the black
+// square codepoint.
+//
+// When encoded as json, empty fields (e.g., the tags of an emoji without any)
+// are omitted.
+type Emoji struct {
+	Grapheme string   // the emoji or emoji sequence (e.g., 😀)
+	Codes    []rune   // the code points in grapheme (e.g., [0x1F600])
+	Name     string   // the name of the emoji (e.g., "grinning face")
+	Group    string   // the emoji's group (e.g., "Smileys & Emotion")
+	Subgroup string   `json:",omitempty"` // the emoji's subgroup (e.g., "face-smiling")
+	Version  string   `json:",omitempty"` // the emoji version that introduced the emoji (e.g., "1.0")
+	Tags     []string `json:",omitempty"` // tags describing the emoji, in source order (e.g., "happy", "content")
+	Tokens   []string `json:",omitempty"` // sorted search tokens (e.g., "content", "face", "happy")
+	Skins    []string `json:",omitempty"` // the emoji's skin tone variants (e.g., 👋🏻, 👋🏼)
+
+	// The emoji's shortcode without colons (e.g., "grinning_face"). See
+	// AssignShortcodes.
+	Shortcode string `json:",omitempty"`
+
+	// The emoji's numeric id. See AssignIDs.
+	ID int `json:",omitempty"`
+
+	// The emoji's qualification (e.g., "fully-qualified"). See the
+	// FullyQualified, MinimallyQualified, Unqualified, and Component
+	// constants.
+	Qualification string
+
+	// Whether the first code point of the fully qualified emoji is followed
+	// by an emoji variation selector (U+FE0F), because it has text
+	// presentation by default (e.g., ☹️ and #️⃣ but not 😀 or 🧑‍⚕️). A
+	// minimally qualified or unqualified emoji needs a variation selector if
+	// its fully qualified version does.
+	NeedsVariationSelector bool `json:",omitempty"`
+
+	// The emoji's names in other locales (e.g., {"fr": "visage rieur"}), keyed
+	// by locale. See AssignLocalizedNames.
+	LocalizedNames map[string]string `json:",omitempty"`
+
+	// The 1-based line of emoji-test.txt that lists the emoji, if parsed
+	// with ParseOptions.SourceLines, and 0 otherwise.
+	SourceLine int `json:",omitempty"`
+}
+
+// IsComponent returns whether the emoji is a component, like the five skin
+// tone modifiers (U+1F3FB to U+1F3FF) or the hair styles (e.g., U+1F9B0, red
+// hair). Components are only parsed with ParseOptions.IncludeComponents. They
+// aren't standalone emojis, but they can be combined with other emojis into
+// emoji sequences (e.g., 👋 and 🏻 into 👋🏻).
+func (e *Emoji) IsComponent() bool {
+	return e.Qualification == Component
+}
+
+// zeroWidthJoiner is the zero width joiner code point used to join emojis into
+// emoji sequences (e.g., 🐈‍⬛).
+const zeroWidthJoiner = 0x200D
+
+// IsZWJSequence returns whether the emoji is a zero width joiner sequence,
+// like the black cat emoji. Platforms that don't support a sequence typically
+// render its component emojis side by side.
+func (e *Emoji) IsZWJSequence() bool {
+	return slices.Contains(e.Codes, zeroWidthJoiner)
+}
+
+// FallbackGraphemes returns the graphemes of the emojis that a zero width
+// joiner sequence joins, without emoji variation selectors (e.g., 🐈 and ⬛ for
+// 🐈‍⬛). Platforms that don't support a sequence typically render these
+// instead. For emojis that aren't sequences, it returns the emoji's grapheme.
+func (e *Emoji) FallbackGraphemes() []string {
+	if !e.IsZWJSequence() {
+		return []string{e.Grapheme}
+	}
+	var graphemes []string
+	var component []rune
+	for _, code := range append(slices.Clone(e.Codes), zeroWidthJoiner) {
+		switch code {
+		case emojiVariationSelector:
+		case zeroWidthJoiner:
+			if len(component) > 0 {
+				graphemes = append(graphemes, string(component))
+			}
+			component = nil
+		default:
+			component = append(component, code)
+		}
+	}
+	return graphemes
+}
+
+// CodePointCount returns the number of code points in the emoji (e.g., 1 for
+// 😀 and 3 for 🐈‍⬛). Note that len(e.Grapheme) is the number of bytes in the
+// emoji's UTF-8 encoding, not the number of code points, and that every emoji
+// is a single grapheme cluster, no matter how many code points it has.
+func (e *Emoji) CodePointCount() int {
+	return len(e.Codes)
+}
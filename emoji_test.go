@@ -0,0 +1,111 @@
+package emojis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestIsZWJSequence(t *testing.T) {
+	byGrapheme := ByGrapheme(mustParse(t, ParseOptions{}))
+	for grapheme, want := range map[string]bool{
+		"😀":    false,
+		"☹️":   false,
+		"👋🏻":   false,
+		"🐈‍⬛":  true,
+		"🧑‍⚕️": true,
+	} {
+		if got := byGrapheme[grapheme].IsZWJSequence(); got != want {
+			t.Errorf("%s.IsZWJSequence(): got %t, want %t", grapheme, got, want)
+		}
+	}
+}
+
+func TestFallbackGraphemes(t *testing.T) {
+	for _, test := range []struct {
+		codes []rune
+		want  []string
+	}{
+		{[]rune{0x1F600}, []string{"😀"}},
+		{[]rune{0x2639, 0xFE0F}, []string{"☹️"}},
+		{[]rune{0x1F44B, 0x1F3FB}, []string{"👋🏻"}},
+		{[]rune{0x1F408, 0x200D, 0x2B1B}, []string{"🐈", "⬛"}},
+		{[]rune{0x1F9D1, 0x200D, 0x2695, 0xFE0F}, []string{"🧑", "⚕"}},
+		// family: man, woman, girl
+		{[]rune{0x1F468, 0x200D, 0x1F469, 0x200D, 0x1F467}, []string{"👨", "👩", "👧"}},
+		// couple with heart: woman, man
+		{[]rune{0x1F469, 0x200D, 0x2764, 0xFE0F, 0x200D, 0x1F468}, []string{"👩", "❤", "👨"}},
+	} {
+		emoji := &Emoji{Grapheme: string(test.codes), Codes: test.codes}
+		if got := emoji.FallbackGraphemes(); !slices.Equal(got, test.want) {
+			t.Errorf("%s.FallbackGraphemes(): got %q, want %q", emoji.Grapheme, got, test.want)
+		}
+	}
+}
+
+func TestCodePointCount(t *testing.T) {
+	byGrapheme := ByGrapheme(mustParse(t, ParseOptions{}))
+	for grapheme, want := range map[string]int{
+		"😀":    1,
+		"☹️":   2,
+		"👋🏻":   2,
+		"🐈‍⬛":  3,
+		"#️⃣":  3,
+		"🧑‍⚕️": 4,
+	} {
+		if got := byGrapheme[grapheme].CodePointCount(); got != want {
+			t.Errorf("%s.CodePointCount(): got %d, want %d", grapheme, got, want)
+		}
+	}
+}
+
+func TestEmojiJSON(t *testing.T) {
+	for _, test := range []struct {
+		emoji Emoji
+		want  string
+	}{
+		{
+			Emoji{Grapheme: "🈁", Codes: []rune{0x1F201}, Name: "Japanese “here” button", Group: "Symbols", Qualification: FullyQualified},
+			`{"Grapheme":"🈁","Codes":[127489],"Name":"Japanese “here” button","Group":"Symbols","Qualification":"fully-qualified"}`,
+		},
+		{
+			Emoji{
+				Grapheme: "☹️", Codes: []rune{0x2639, 0xFE0F}, Name: "frowning face",
+				Group: "Smileys & Emotion", Subgroup: "face-concerned", Version: "0.7",
+				Tags: []string{"face", "frown"}, Qualification: FullyQualified, NeedsVariationSelector: true,
+			},
+			`{"Grapheme":"☹️","Codes":[9785,65039],"Name":"frowning face","Group":"Smileys \u0026 Emotion","Subgroup":"face-concerned","Version":"0.7","Tags":["face","frown"],"Qualification":"fully-qualified","NeedsVariationSelector":true}`,
+		},
+	} {
+		got, err := json.Marshal(test.emoji)
+		if err != nil {
+			t.Fatalf("json.Marshal(%s): %v", test.emoji.Grapheme, err)
+		}
+		if string(got) != test.want {
+			t.Errorf("json.Marshal(%s):\ngot  %s\nwant %s", test.emoji.Grapheme, got, test.want)
+		}
+	}
+}
+
+func TestIsComponent(t *testing.T) {
+	// Components aren't parsed by default.
+	if emoji, ok := ByGrapheme(mustParse(t, ParseOptions{}))["🏻"]; ok {
+		t.Errorf("default parse: got %v, want no 🏻", emoji)
+	}
+
+	byGrapheme := ByGrapheme(mustParse(t, ParseOptions{IncludeComponents: true}))
+	skinTone, ok := byGrapheme["🏻"]
+	if !ok {
+		t.Fatalf("IncludeComponents parse: missing 🏻")
+	}
+	if !skinTone.IsComponent() || skinTone.Name != "light skin tone" || !slices.Equal(skinTone.Codes, []rune{0x1F3FB}) {
+		t.Errorf("got %+v, want the light skin tone component U+1F3FB", skinTone)
+	}
+	// Emojis with the modifier aren't components.
+	for _, grapheme := range []string{"👋", "👋🏻"} {
+		if byGrapheme[grapheme].IsComponent() {
+			t.Errorf("%s.IsComponent(): got true, want false", grapheme)
+		}
+	}
+}
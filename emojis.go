@@ -1,1979 +1,1979 @@
-package main
+package emojis
 
 // Taken from https://github.com/mwhittaker/emojis.
-var emojis = map[string][]string {
-	"😀": {"emotion", "face", "grin", "grinning", "smileys", "smiling"},
-	"😃": {"big", "emotion", "eyes", "face", "grinning", "mouth", "open", "smile", "smileys", "smiling", "with"},
-	"😄": {"emotion", "eye", "eyes", "face", "grinning", "mouth", "open", "smile", "smileys", "smiling", "with"},
-	"😁": {"beaming", "emotion", "eye", "eyes", "face", "grin", "smile", "smileys", "smiling", "with"},
-	"😆": {"emotion", "face", "grinning", "laugh", "mouth", "satisfied", "smile", "smileys", "smiling", "squinting"},
-	"😅": {"cold", "emotion", "face", "grinning", "open", "smile", "smileys", "smiling", "sweat", "with"},
-	"🤣": {"emotion", "face", "floor", "laugh", "laughing", "on", "rofl", "rolling", "rotfl", "smileys", "smiling", "the"},
-	"😂": {"emotion", "face", "joy", "laugh", "of", "smileys", "smiling", "tear", "tears", "with"},
-	"🙂": {"emotion", "face", "slightly", "smile", "smileys", "smiling"},
-	"🙃": {"down", "emotion", "face", "smileys", "smiling", "upside"},
-	"🫠": {"disappear", "dissolve", "emotion", "face", "liquid", "melt", "melting", "smileys", "smiling"},
-	"😉": {"emotion", "face", "smileys", "smiling", "wink", "winking"},
-	"😊": {"blush", "emotion", "eye", "eyes", "face", "smile", "smileys", "smiling", "with"},
-	"😇": {"angel", "emotion", "face", "fantasy", "halo", "innocent", "smileys", "smiling", "with"},
-	"🥰": {"adore", "affection", "crush", "emotion", "face", "hearts", "in", "love", "smileys", "smiling", "with"},
-	"😍": {"affection", "emotion", "eye", "eyes", "face", "heart", "love", "smile", "smileys", "smiling", "with"},
-	"🤩": {"affection", "emotion", "eyes", "face", "grinning", "smileys", "star", "struck"},
-	"😘": {"a", "affection", "blowing", "emotion", "face", "kiss", "smileys"},
-	"😗": {"affection", "emotion", "face", "kiss", "kissing", "smileys"},
-	"☺️": {"affection", "emotion", "face", "outlined", "relaxed", "smile", "smileys", "smiling"},
-	"😚": {"affection", "closed", "emotion", "eye", "eyes", "face", "kiss", "kissing", "smileys", "with"},
-	"😙": {"affection", "emotion", "eye", "eyes", "face", "kiss", "kissing", "smile", "smileys", "smiling", "with"},
-	"🥲": {"affection", "emotion", "face", "grateful", "proud", "relieved", "smileys", "smiling", "tear", "touched", "with"},
-	"😋": {"delicious", "emotion", "face", "food", "savoring", "savouring", "smile", "smileys", "tongue", "yum"},
-	"😛": {"emotion", "face", "smileys", "tongue", "with"},
-	"😜": {"emotion", "eye", "face", "joke", "smileys", "tongue", "wink", "winking", "with"},
-	"🤪": {"emotion", "eye", "face", "goofy", "large", "small", "smileys", "tongue", "zany"},
-	"😝": {"emotion", "eye", "face", "horrible", "smileys", "squinting", "taste", "tongue", "with"},
-	"🤑": {"emotion", "face", "money", "mouth", "smileys", "tongue"},
-	"🤗": {"emotion", "face", "hand", "hands", "hug", "hugging", "open", "smileys", "smiling", "with"},
-	"🤭": {"emotion", "face", "hand", "mouth", "over", "smileys", "whoops", "with"},
-	"🫢": {"amazement", "and", "awe", "disbelief", "embarrass", "emotion", "eyes", "face", "hand", "mouth", "open", "over", "scared", "smileys", "surprise", "with"},
-	"🫣": {"captivated", "emotion", "eye", "face", "hand", "peeking", "peep", "smileys", "stare", "with"},
-	"🤫": {"emotion", "face", "hand", "quiet", "shush", "shushing", "smileys"},
-	"🤔": {"emotion", "face", "hand", "smileys", "thinking"},
-	"🫡": {"emotion", "face", "hand", "ok", "salute", "saluting", "smileys", "sunny", "troops", "yes"},
-	"🤐": {"emotion", "face", "mouth", "neutral", "skeptical", "smileys", "zipper"},
-	"🤨": {"distrust", "emotion", "eyebrow", "face", "neutral", "raised", "skeptic", "skeptical", "smileys", "with"},
-	"😐": {"emotion", "face", "neutral", "skeptical", "smileys"},
-	"😑": {"emotion", "expressionless", "face", "inexpressive", "meh", "neutral", "skeptical", "smileys", "unexpressive"},
-	"😶": {"emotion", "face", "mouth", "neutral", "quiet", "silent", "skeptical", "smileys", "without"},
-	"🫥": {"depressed", "disappear", "dotted", "emotion", "face", "hide", "introvert", "invisible", "line", "neutral", "skeptical", "smileys"},
-	"😶\u200d🌫️": {"absentminded", "clouds", "emotion", "face", "fog", "head", "in", "neutral", "skeptical", "smileys", "the"},
-	"😏": {"emotion", "face", "neutral", "skeptical", "smileys", "smirk", "smirking"},
-	"😒": {"emotion", "face", "neutral", "skeptical", "smileys", "unamused", "unhappy"},
-	"🙄": {"emotion", "eyeroll", "eyes", "face", "neutral", "rolling", "skeptical", "smileys", "with"},
-	"😬": {"emotion", "face", "grimace", "grimacing", "neutral", "skeptical", "smileys"},
-	"😮\u200d💨": {"emotion", "exhale", "exhaling", "face", "gasp", "groan", "neutral", "relief", "skeptical", "smileys", "whisper", "whistle"},
-	"🤥": {"emotion", "face", "lie", "lying", "neutral", "pinocchio", "skeptical", "smileys"},
-	"🫨": {"emotion", "face", "neutral", "shaking", "skeptical", "smileys"},
-	"😌": {"emotion", "face", "relieved", "sleepy", "smileys"},
-	"😔": {"dejected", "emotion", "face", "pensive", "sleepy", "smileys"},
-	"😪": {"emotion", "face", "good", "night", "sleep", "sleepy", "smileys"},
-	"🤤": {"drooling", "emotion", "face", "sleepy", "smileys"},
-	"😴": {"emotion", "face", "good", "night", "sleep", "sleeping", "sleepy", "smileys", "zzz"},
-	"😷": {"cold", "doctor", "emotion", "face", "mask", "medical", "sick", "smileys", "unwell", "with"},
-	"🤒": {"emotion", "face", "ill", "sick", "smileys", "thermometer", "unwell", "with"},
-	"🤕": {"bandage", "emotion", "face", "head", "hurt", "injury", "smileys", "unwell", "with"},
-	"🤢": {"emotion", "face", "nauseated", "smileys", "unwell", "vomit"},
-	"🤮": {"emotion", "face", "puke", "sick", "smileys", "unwell", "vomit", "vomiting"},
-	"🤧": {"emotion", "face", "gesundheit", "smileys", "sneeze", "sneezing", "unwell"},
-	"🥵": {"emotion", "face", "faced", "feverish", "heat", "hot", "red", "smileys", "stroke", "sweating", "unwell"},
-	"🥶": {"blue", "cold", "emotion", "face", "faced", "freezing", "frostbite", "icicles", "smileys", "unwell"},
-	"🥴": {"dizzy", "emotion", "eyes", "face", "intoxicated", "mouth", "smileys", "tipsy", "uneven", "unwell", "wavy", "woozy"},
-	"😵": {"crossed", "dead", "emotion", "eyes", "face", "knocked", "out", "smileys", "unwell", "with"},
-	"😵\u200d💫": {"dizzy", "emotion", "eyes", "face", "hypnotized", "smileys", "spiral", "trouble", "unwell", "whoa", "with"},
-	"🤯": {"blown", "emotion", "exploding", "face", "head", "mind", "shocked", "smileys", "unwell"},
-	"🤠": {"cowboy", "cowgirl", "emotion", "face", "hat", "smileys"},
-	"🥳": {"celebration", "emotion", "face", "hat", "horn", "party", "partying", "smileys"},
-	"🥸": {"disguise", "disguised", "emotion", "face", "glasses", "hat", "incognito", "nose", "smileys"},
-	"😎": {"bright", "cool", "emotion", "face", "glasses", "smileys", "smiling", "sun", "sunglasses", "with"},
-	"🤓": {"emotion", "face", "geek", "glasses", "nerd", "smileys"},
-	"🧐": {"emotion", "face", "glasses", "monocle", "smileys", "stuffy", "with"},
-	"😕": {"concerned", "confused", "emotion", "face", "meh", "smileys"},
-	"🫤": {"concerned", "diagonal", "disappointed", "emotion", "face", "meh", "mouth", "skeptical", "smileys", "unsure", "with"},
-	"😟": {"concerned", "emotion", "face", "smileys", "worried"},
-	"🙁": {"concerned", "emotion", "face", "frown", "frowning", "slightly", "smileys"},
-	"☹️": {"concerned", "emotion", "face", "frown", "frowning", "smileys"},
-	"😮": {"concerned", "emotion", "face", "mouth", "open", "smileys", "sympathy", "with"},
-	"😯": {"concerned", "emotion", "face", "hushed", "smileys", "stunned", "surprised"},
-	"😲": {"astonished", "concerned", "emotion", "face", "shocked", "smileys", "totally"},
-	"😳": {"concerned", "dazed", "emotion", "face", "flushed", "smileys"},
-	"🥺": {"begging", "concerned", "emotion", "eyes", "face", "mercy", "pleading", "puppy", "smileys"},
-	"🥹": {"angry", "back", "concerned", "cry", "emotion", "face", "holding", "proud", "resist", "sad", "smileys", "tears"},
-	"😦": {"concerned", "emotion", "face", "frown", "frowning", "mouth", "open", "smileys", "with"},
-	"😧": {"anguished", "concerned", "emotion", "face", "smileys"},
-	"😨": {"concerned", "emotion", "face", "fear", "fearful", "scared", "smileys"},
-	"😰": {"anxious", "blue", "cold", "concerned", "emotion", "face", "rushed", "smileys", "sweat", "with"},
-	"😥": {"but", "concerned", "disappointed", "emotion", "face", "relieved", "sad", "smileys", "whew"},
-	"😢": {"concerned", "cry", "crying", "emotion", "face", "sad", "smileys", "tear"},
-	"😭": {"concerned", "cry", "crying", "emotion", "face", "loudly", "sad", "smileys", "sob", "tear"},
-	"😱": {"concerned", "emotion", "face", "fear", "in", "munch", "scared", "scream", "screaming", "smileys"},
-	"😖": {"concerned", "confounded", "emotion", "face", "smileys"},
-	"😣": {"concerned", "emotion", "face", "persevere", "persevering", "smileys"},
-	"😞": {"concerned", "disappointed", "emotion", "face", "smileys"},
-	"😓": {"cold", "concerned", "downcast", "emotion", "face", "smileys", "sweat", "with"},
-	"😩": {"concerned", "emotion", "face", "smileys", "tired", "weary"},
-	"😫": {"concerned", "emotion", "face", "smileys", "tired"},
-	"🥱": {"bored", "concerned", "emotion", "face", "smileys", "tired", "yawn", "yawning"},
-	"😤": {"emotion", "face", "from", "negative", "nose", "smileys", "steam", "triumph", "with", "won"},
-	"😡": {"angry", "emotion", "enraged", "face", "mad", "negative", "pouting", "rage", "red", "smileys"},
-	"😠": {"anger", "angry", "emotion", "face", "mad", "negative", "smileys"},
-	"🤬": {"emotion", "face", "mouth", "negative", "on", "smileys", "swearing", "symbols", "with"},
-	"😈": {"emotion", "face", "fairy", "fantasy", "horns", "negative", "smile", "smileys", "smiling", "tale", "with"},
-	"👿": {"angry", "demon", "devil", "emotion", "face", "fantasy", "horns", "imp", "negative", "smileys", "with"},
-	"💀": {"death", "emotion", "face", "fairy", "monster", "negative", "skull", "smileys", "tale"},
-	"☠️": {"and", "crossbones", "death", "emotion", "face", "monster", "negative", "skull", "smileys"},
-	"💩": {"costume", "dung", "emotion", "face", "monster", "of", "pile", "poo", "poop", "smileys"},
-	"🤡": {"clown", "costume", "emotion", "face", "smileys"},
-	"👹": {"costume", "creature", "emotion", "face", "fairy", "fantasy", "monster", "ogre", "smileys", "tale"},
-	"👺": {"costume", "creature", "emotion", "face", "fairy", "fantasy", "goblin", "monster", "smileys", "tale"},
-	"👻": {"costume", "creature", "emotion", "face", "fairy", "fantasy", "ghost", "monster", "smileys", "tale"},
-	"👽": {"alien", "costume", "emotion", "face", "smileys"},
-	"👾": {"alien", "costume", "creature", "emotion", "extraterrestrial", "face", "monster", "smileys", "ufo"},
-	"🤖": {"costume", "emotion", "face", "monster", "robot", "smileys"},
-	"😺": {"cat", "emotion", "face", "grinning", "mouth", "open", "smile", "smileys"},
-	"😸": {"cat", "emotion", "eye", "eyes", "face", "grin", "grinning", "smile", "smileys", "smiling", "with"},
-	"😹": {"cat", "emotion", "face", "joy", "of", "smileys", "tear", "tears", "with"},
-	"😻": {"cat", "emotion", "eye", "eyes", "face", "heart", "love", "smile", "smileys", "smiling", "with"},
-	"😼": {"cat", "emotion", "face", "ironic", "smile", "smileys", "with", "wry"},
-	"😽": {"cat", "emotion", "eye", "face", "kiss", "kissing", "smileys"},
-	"🙀": {"cat", "emotion", "face", "oh", "smileys", "surprised", "weary"},
-	"😿": {"cat", "cry", "crying", "emotion", "face", "sad", "smileys", "tear"},
-	"😾": {"cat", "emotion", "face", "pouting", "smileys"},
-	"🙈": {"emotion", "evil", "face", "forbidden", "monkey", "no", "see", "smileys"},
-	"🙉": {"emotion", "evil", "face", "forbidden", "hear", "monkey", "no", "smileys"},
-	"🙊": {"emotion", "evil", "face", "forbidden", "monkey", "no", "smileys", "speak"},
-	"💌": {"emotion", "heart", "letter", "love", "mail", "smileys"},
-	"💘": {"arrow", "cupid", "emotion", "heart", "smileys", "with"},
-	"💝": {"emotion", "heart", "ribbon", "smileys", "valentine", "with"},
-	"💖": {"emotion", "excited", "heart", "smileys", "sparkle", "sparkling"},
-	"💗": {"emotion", "excited", "growing", "heart", "nervous", "pulse", "smileys"},
-	"💓": {"beating", "emotion", "heart", "heartbeat", "pulsating", "smileys"},
-	"💞": {"emotion", "heart", "hearts", "revolving", "smileys"},
-	"💕": {"emotion", "heart", "hearts", "love", "smileys", "two"},
-	"💟": {"decoration", "emotion", "heart", "smileys"},
-	"❣️": {"emotion", "exclamation", "heart", "mark", "punctuation", "smileys"},
-	"💔": {"break", "broken", "emotion", "heart", "smileys"},
-	"❤️\u200d🔥": {"burn", "emotion", "fire", "heart", "love", "lust", "on", "sacred", "smileys"},
-	"❤️\u200d🩹": {"emotion", "healthier", "heart", "improving", "mending", "recovering", "recuperating", "smileys", "well"},
-	"❤️": {"emotion", "heart", "red", "smileys"},
-	"🩷": {"emotion", "heart", "pink", "smileys"},
-	"🧡": {"emotion", "heart", "orange", "smileys"},
-	"💛": {"emotion", "heart", "smileys", "yellow"},
-	"💚": {"emotion", "green", "heart", "smileys"},
-	"💙": {"blue", "emotion", "heart", "smileys"},
-	"🩵": {"blue", "emotion", "heart", "light", "smileys"},
-	"💜": {"emotion", "heart", "purple", "smileys"},
-	"🤎": {"brown", "emotion", "heart", "smileys"},
-	"🖤": {"black", "emotion", "evil", "heart", "smileys", "wicked"},
-	"🩶": {"emotion", "grey", "heart", "smileys"},
-	"🤍": {"emotion", "heart", "smileys", "white"},
-	"💋": {"emotion", "kiss", "lips", "mark", "smileys"},
-	"💯": {"emotion", "full", "hundred", "points", "score", "smileys"},
-	"💢": {"anger", "angry", "comic", "emotion", "mad", "smileys", "symbol"},
-	"💥": {"boom", "collision", "comic", "emotion", "smileys"},
-	"💫": {"comic", "dizzy", "emotion", "smileys", "star"},
-	"💦": {"comic", "droplets", "emotion", "smileys", "splashing", "sweat"},
-	"💨": {"away", "comic", "dash", "dashing", "emotion", "running", "smileys"},
-	"🕳️": {"emotion", "hole", "smileys"},
-	"💬": {"balloon", "bubble", "comic", "dialog", "emotion", "smileys", "speech"},
-	"👁️\u200d🗨️": {"balloon", "bubble", "emotion", "eye", "in", "smileys", "speech", "witness"},
-	"🗨️": {"balloon", "bubble", "dialog", "emotion", "left", "smileys", "speech"},
-	"🗯️": {"anger", "angry", "balloon", "bubble", "emotion", "mad", "right", "smileys"},
-	"💭": {"balloon", "bubble", "comic", "emotion", "smileys", "thought"},
-	"💤": {"comic", "emotion", "good", "night", "sleep", "smileys", "zzz"},
-	"👋": {"body", "fingers", "hand", "open", "people", "wave", "waving"},
-	"👋🏻": {"body", "fingers", "hand", "light", "open", "people", "skin", "tone", "wave", "waving"},
-	"👋🏼": {"body", "fingers", "hand", "light", "medium", "open", "people", "skin", "tone", "wave", "waving"},
-	"👋🏽": {"body", "fingers", "hand", "medium", "open", "people", "skin", "tone", "wave", "waving"},
-	"👋🏾": {"body", "dark", "fingers", "hand", "medium", "open", "people", "skin", "tone", "wave", "waving"},
-	"👋🏿": {"body", "dark", "fingers", "hand", "open", "people", "skin", "tone", "wave", "waving"},
-	"🤚": {"back", "backhand", "body", "fingers", "hand", "of", "open", "people", "raised"},
-	"🤚🏻": {"back", "backhand", "body", "fingers", "hand", "light", "of", "open", "people", "raised", "skin", "tone"},
-	"🤚🏼": {"back", "backhand", "body", "fingers", "hand", "light", "medium", "of", "open", "people", "raised", "skin", "tone"},
-	"🤚🏽": {"back", "backhand", "body", "fingers", "hand", "medium", "of", "open", "people", "raised", "skin", "tone"},
-	"🤚🏾": {"back", "backhand", "body", "dark", "fingers", "hand", "medium", "of", "open", "people", "raised", "skin", "tone"},
-	"🤚🏿": {"back", "backhand", "body", "dark", "fingers", "hand", "of", "open", "people", "raised", "skin", "tone"},
-	"🖐️": {"body", "finger", "fingers", "hand", "open", "people", "splayed", "with"},
-	"🖐🏻": {"body", "finger", "fingers", "hand", "light", "open", "people", "skin", "splayed", "tone", "with"},
-	"🖐🏼": {"body", "finger", "fingers", "hand", "light", "medium", "open", "people", "skin", "splayed", "tone", "with"},
-	"🖐🏽": {"body", "finger", "fingers", "hand", "medium", "open", "people", "skin", "splayed", "tone", "with"},
-	"🖐🏾": {"body", "dark", "finger", "fingers", "hand", "medium", "open", "people", "skin", "splayed", "tone", "with"},
-	"🖐🏿": {"body", "dark", "finger", "fingers", "hand", "open", "people", "skin", "splayed", "tone", "with"},
-	"✋": {"body", "fingers", "five", "hand", "high", "open", "people", "raised"},
-	"✋🏻": {"body", "fingers", "five", "hand", "high", "light", "open", "people", "raised", "skin", "tone"},
-	"✋🏼": {"body", "fingers", "five", "hand", "high", "light", "medium", "open", "people", "raised", "skin", "tone"},
-	"✋🏽": {"body", "fingers", "five", "hand", "high", "medium", "open", "people", "raised", "skin", "tone"},
-	"✋🏾": {"body", "dark", "fingers", "five", "hand", "high", "medium", "open", "people", "raised", "skin", "tone"},
-	"✋🏿": {"body", "dark", "fingers", "five", "hand", "high", "open", "people", "raised", "skin", "tone"},
-	"🖖": {"body", "finger", "fingers", "hand", "open", "people", "salute", "spock", "vulcan"},
-	"🖖🏻": {"body", "finger", "fingers", "hand", "light", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
-	"🖖🏼": {"body", "finger", "fingers", "hand", "light", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
-	"🖖🏽": {"body", "finger", "fingers", "hand", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
-	"🖖🏾": {"body", "dark", "finger", "fingers", "hand", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
-	"🖖🏿": {"body", "dark", "finger", "fingers", "hand", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
-	"🫱": {"body", "fingers", "hand", "open", "people", "right", "rightward", "rightwards"},
-	"🫱🏻": {"body", "fingers", "hand", "light", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
-	"🫱🏼": {"body", "fingers", "hand", "light", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
-	"🫱🏽": {"body", "fingers", "hand", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
-	"🫱🏾": {"body", "dark", "fingers", "hand", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
-	"🫱🏿": {"body", "dark", "fingers", "hand", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
-	"🫲": {"body", "fingers", "hand", "left", "leftward", "leftwards", "open", "people"},
-	"🫲🏻": {"body", "fingers", "hand", "left", "leftward", "leftwards", "light", "open", "people", "skin", "tone"},
-	"🫲🏼": {"body", "fingers", "hand", "left", "leftward", "leftwards", "light", "medium", "open", "people", "skin", "tone"},
-	"🫲🏽": {"body", "fingers", "hand", "left", "leftward", "leftwards", "medium", "open", "people", "skin", "tone"},
-	"🫲🏾": {"body", "dark", "fingers", "hand", "left", "leftward", "leftwards", "medium", "open", "people", "skin", "tone"},
-	"🫲🏿": {"body", "dark", "fingers", "hand", "left", "leftward", "leftwards", "open", "people", "skin", "tone"},
-	"🫳": {"body", "dismiss", "down", "drop", "fingers", "hand", "open", "palm", "people", "shoo"},
-	"🫳🏻": {"body", "dismiss", "down", "drop", "fingers", "hand", "light", "open", "palm", "people", "shoo", "skin", "tone"},
-	"🫳🏼": {"body", "dismiss", "down", "drop", "fingers", "hand", "light", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
-	"🫳🏽": {"body", "dismiss", "down", "drop", "fingers", "hand", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
-	"🫳🏾": {"body", "dark", "dismiss", "down", "drop", "fingers", "hand", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
-	"🫳🏿": {"body", "dark", "dismiss", "down", "drop", "fingers", "hand", "open", "palm", "people", "shoo", "skin", "tone"},
-	"🫴": {"beckon", "body", "catch", "come", "fingers", "hand", "offer", "open", "palm", "people", "up"},
-	"🫴🏻": {"beckon", "body", "catch", "come", "fingers", "hand", "light", "offer", "open", "palm", "people", "skin", "tone", "up"},
-	"🫴🏼": {"beckon", "body", "catch", "come", "fingers", "hand", "light", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
-	"🫴🏽": {"beckon", "body", "catch", "come", "fingers", "hand", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
-	"🫴🏾": {"beckon", "body", "catch", "come", "dark", "fingers", "hand", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
-	"🫴🏿": {"beckon", "body", "catch", "come", "dark", "fingers", "hand", "offer", "open", "palm", "people", "skin", "tone", "up"},
-	"🫷": {"body", "fingers", "hand", "leftwards", "open", "people", "pushing"},
-	"🫷🏻": {"body", "fingers", "hand", "leftwards", "light", "open", "people", "pushing", "skin", "tone"},
-	"🫷🏼": {"body", "fingers", "hand", "leftwards", "light", "medium", "open", "people", "pushing", "skin", "tone"},
-	"🫷🏽": {"body", "fingers", "hand", "leftwards", "medium", "open", "people", "pushing", "skin", "tone"},
-	"🫷🏾": {"body", "dark", "fingers", "hand", "leftwards", "medium", "open", "people", "pushing", "skin", "tone"},
-	"🫷🏿": {"body", "dark", "fingers", "hand", "leftwards", "open", "people", "pushing", "skin", "tone"},
-	"🫸": {"body", "fingers", "hand", "open", "people", "pushing", "rightwards"},
-	"🫸🏻": {"body", "fingers", "hand", "light", "open", "people", "pushing", "rightwards", "skin", "tone"},
-	"🫸🏼": {"body", "fingers", "hand", "light", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
-	"🫸🏽": {"body", "fingers", "hand", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
-	"🫸🏾": {"body", "dark", "fingers", "hand", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
-	"🫸🏿": {"body", "dark", "fingers", "hand", "open", "people", "pushing", "rightwards", "skin", "tone"},
-	"👌": {"body", "fingers", "hand", "ok", "partial", "people"},
-	"👌🏻": {"body", "fingers", "hand", "light", "ok", "partial", "people", "skin", "tone"},
-	"👌🏼": {"body", "fingers", "hand", "light", "medium", "ok", "partial", "people", "skin", "tone"},
-	"👌🏽": {"body", "fingers", "hand", "medium", "ok", "partial", "people", "skin", "tone"},
-	"👌🏾": {"body", "dark", "fingers", "hand", "medium", "ok", "partial", "people", "skin", "tone"},
-	"👌🏿": {"body", "dark", "fingers", "hand", "ok", "partial", "people", "skin", "tone"},
-	"🤌": {"body", "fingers", "gesture", "hand", "interrogation", "partial", "people", "pinched", "sarcastic"},
-	"🤌🏻": {"body", "fingers", "gesture", "hand", "interrogation", "light", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
-	"🤌🏼": {"body", "fingers", "gesture", "hand", "interrogation", "light", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
-	"🤌🏽": {"body", "fingers", "gesture", "hand", "interrogation", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
-	"🤌🏾": {"body", "dark", "fingers", "gesture", "hand", "interrogation", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
-	"🤌🏿": {"body", "dark", "fingers", "gesture", "hand", "interrogation", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
-	"🤏": {"amount", "body", "fingers", "hand", "partial", "people", "pinching", "small"},
-	"🤏🏻": {"amount", "body", "fingers", "hand", "light", "partial", "people", "pinching", "skin", "small", "tone"},
-	"🤏🏼": {"amount", "body", "fingers", "hand", "light", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
-	"🤏🏽": {"amount", "body", "fingers", "hand", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
-	"🤏🏾": {"amount", "body", "dark", "fingers", "hand", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
-	"🤏🏿": {"amount", "body", "dark", "fingers", "hand", "partial", "people", "pinching", "skin", "small", "tone"},
-	"✌️": {"body", "fingers", "hand", "partial", "people", "v", "victory"},
-	"✌🏻": {"body", "fingers", "hand", "light", "partial", "people", "skin", "tone", "v", "victory"},
-	"✌🏼": {"body", "fingers", "hand", "light", "medium", "partial", "people", "skin", "tone", "v", "victory"},
-	"✌🏽": {"body", "fingers", "hand", "medium", "partial", "people", "skin", "tone", "v", "victory"},
-	"✌🏾": {"body", "dark", "fingers", "hand", "medium", "partial", "people", "skin", "tone", "v", "victory"},
-	"✌🏿": {"body", "dark", "fingers", "hand", "partial", "people", "skin", "tone", "v", "victory"},
-	"🤞": {"body", "cross", "crossed", "finger", "fingers", "hand", "luck", "partial", "people"},
-	"🤞🏻": {"body", "cross", "crossed", "finger", "fingers", "hand", "light", "luck", "partial", "people", "skin", "tone"},
-	"🤞🏼": {"body", "cross", "crossed", "finger", "fingers", "hand", "light", "luck", "medium", "partial", "people", "skin", "tone"},
-	"🤞🏽": {"body", "cross", "crossed", "finger", "fingers", "hand", "luck", "medium", "partial", "people", "skin", "tone"},
-	"🤞🏾": {"body", "cross", "crossed", "dark", "finger", "fingers", "hand", "luck", "medium", "partial", "people", "skin", "tone"},
-	"🤞🏿": {"body", "cross", "crossed", "dark", "finger", "fingers", "hand", "luck", "partial", "people", "skin", "tone"},
-	"🫰": {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "money", "partial", "people", "snap", "thumb", "with"},
-	"🫰🏻": {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "light", "love", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
-	"🫰🏼": {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "light", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
-	"🫰🏽": {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
-	"🫰🏾": {"and", "body", "crossed", "dark", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
-	"🫰🏿": {"and", "body", "crossed", "dark", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
-	"🤟": {"body", "fingers", "gesture", "hand", "ily", "love", "partial", "people", "you"},
-	"🤟🏻": {"body", "fingers", "gesture", "hand", "ily", "light", "love", "partial", "people", "skin", "tone", "you"},
-	"🤟🏼": {"body", "fingers", "gesture", "hand", "ily", "light", "love", "medium", "partial", "people", "skin", "tone", "you"},
-	"🤟🏽": {"body", "fingers", "gesture", "hand", "ily", "love", "medium", "partial", "people", "skin", "tone", "you"},
-	"🤟🏾": {"body", "dark", "fingers", "gesture", "hand", "ily", "love", "medium", "partial", "people", "skin", "tone", "you"},
-	"🤟🏿": {"body", "dark", "fingers", "gesture", "hand", "ily", "love", "partial", "people", "skin", "tone", "you"},
-	"🤘": {"body", "finger", "fingers", "hand", "horns", "of", "on", "partial", "people", "rock", "sign", "the"},
-	"🤘🏻": {"body", "finger", "fingers", "hand", "horns", "light", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
-	"🤘🏼": {"body", "finger", "fingers", "hand", "horns", "light", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
-	"🤘🏽": {"body", "finger", "fingers", "hand", "horns", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
-	"🤘🏾": {"body", "dark", "finger", "fingers", "hand", "horns", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
-	"🤘🏿": {"body", "dark", "finger", "fingers", "hand", "horns", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
-	"🤙": {"body", "call", "fingers", "hand", "hang", "loose", "me", "partial", "people", "shaka"},
-	"🤙🏻": {"body", "call", "fingers", "hand", "hang", "light", "loose", "me", "partial", "people", "shaka", "skin", "tone"},
-	"🤙🏼": {"body", "call", "fingers", "hand", "hang", "light", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
-	"🤙🏽": {"body", "call", "fingers", "hand", "hang", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
-	"🤙🏾": {"body", "call", "dark", "fingers", "hand", "hang", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
-	"🤙🏿": {"body", "call", "dark", "fingers", "hand", "hang", "loose", "me", "partial", "people", "shaka", "skin", "tone"},
-	"👈": {"backhand", "body", "finger", "hand", "index", "left", "people", "pointing", "single"},
-	"👈🏻": {"backhand", "body", "finger", "hand", "index", "left", "light", "people", "point", "pointing", "single", "skin", "tone"},
-	"👈🏼": {"backhand", "body", "finger", "hand", "index", "left", "light", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👈🏽": {"backhand", "body", "finger", "hand", "index", "left", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👈🏾": {"backhand", "body", "dark", "finger", "hand", "index", "left", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👈🏿": {"backhand", "body", "dark", "finger", "hand", "index", "left", "people", "point", "pointing", "single", "skin", "tone"},
-	"👉": {"backhand", "body", "finger", "hand", "index", "people", "pointing", "right", "single"},
-	"👉🏻": {"backhand", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "right", "single", "skin", "tone"},
-	"👉🏼": {"backhand", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
-	"👉🏽": {"backhand", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
-	"👉🏾": {"backhand", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
-	"👉🏿": {"backhand", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "right", "single", "skin", "tone"},
-	"👆": {"backhand", "body", "finger", "hand", "index", "people", "pointing", "single", "up"},
-	"👆🏻": {"backhand", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"👆🏼": {"backhand", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"👆🏽": {"backhand", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"👆🏾": {"backhand", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"👆🏿": {"backhand", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"🖕": {"body", "finger", "hand", "middle", "people", "single"},
-	"🖕🏻": {"body", "finger", "hand", "light", "middle", "people", "single", "skin", "tone"},
-	"🖕🏼": {"body", "finger", "hand", "light", "medium", "middle", "people", "single", "skin", "tone"},
-	"🖕🏽": {"body", "finger", "hand", "medium", "middle", "people", "single", "skin", "tone"},
-	"🖕🏾": {"body", "dark", "finger", "hand", "medium", "middle", "people", "single", "skin", "tone"},
-	"🖕🏿": {"body", "dark", "finger", "hand", "middle", "people", "single", "skin", "tone"},
-	"👇": {"backhand", "body", "down", "finger", "hand", "index", "people", "pointing", "single"},
-	"👇🏻": {"backhand", "body", "down", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone"},
-	"👇🏼": {"backhand", "body", "down", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👇🏽": {"backhand", "body", "down", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👇🏾": {"backhand", "body", "dark", "down", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone"},
-	"👇🏿": {"backhand", "body", "dark", "down", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone"},
-	"☝️": {"body", "finger", "hand", "index", "people", "point", "pointing", "single", "up"},
-	"☝🏻": {"body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"☝🏼": {"body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"☝🏽": {"body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"☝🏾": {"body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"☝🏿": {"body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone", "up"},
-	"🫵": {"at", "body", "finger", "hand", "index", "people", "point", "pointing", "single", "the", "viewer", "you"},
-	"🫵🏻": {"at", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
-	"🫵🏼": {"at", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
-	"🫵🏽": {"at", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
-	"🫵🏾": {"at", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
-	"🫵🏿": {"at", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
-	"👍": {"body", "closed", "fingers", "hand", "people", "thumbs", "up"},
-	"👍🏻": {"body", "closed", "fingers", "hand", "light", "people", "skin", "thumb", "thumbs", "tone", "up"},
-	"👍🏼": {"body", "closed", "fingers", "hand", "light", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
-	"👍🏽": {"body", "closed", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
-	"👍🏾": {"body", "closed", "dark", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
-	"👍🏿": {"body", "closed", "dark", "fingers", "hand", "people", "skin", "thumb", "thumbs", "tone", "up"},
-	"👎": {"body", "closed", "down", "fingers", "hand", "people", "thumbs"},
-	"👎🏻": {"body", "closed", "down", "fingers", "hand", "light", "people", "skin", "thumb", "thumbs", "tone"},
-	"👎🏼": {"body", "closed", "down", "fingers", "hand", "light", "medium", "people", "skin", "thumb", "thumbs", "tone"},
-	"👎🏽": {"body", "closed", "down", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone"},
-	"👎🏾": {"body", "closed", "dark", "down", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone"},
-	"👎🏿": {"body", "closed", "dark", "down", "fingers", "hand", "people", "skin", "thumb", "thumbs", "tone"},
-	"✊": {"body", "clenched", "closed", "fingers", "fist", "hand", "people", "punch", "raised"},
-	"✊🏻": {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "people", "punch", "raised", "skin", "tone"},
-	"✊🏼": {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "medium", "people", "punch", "raised", "skin", "tone"},
-	"✊🏽": {"body", "clenched", "closed", "fingers", "fist", "hand", "medium", "people", "punch", "raised", "skin", "tone"},
-	"✊🏾": {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "medium", "people", "punch", "raised", "skin", "tone"},
-	"✊🏿": {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "people", "punch", "raised", "skin", "tone"},
-	"👊": {"body", "clenched", "closed", "fingers", "fist", "hand", "oncoming", "people", "punch"},
-	"👊🏻": {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "oncoming", "people", "punch", "skin", "tone"},
-	"👊🏼": {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "medium", "oncoming", "people", "punch", "skin", "tone"},
-	"👊🏽": {"body", "clenched", "closed", "fingers", "fist", "hand", "medium", "oncoming", "people", "punch", "skin", "tone"},
-	"👊🏾": {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "medium", "oncoming", "people", "punch", "skin", "tone"},
-	"👊🏿": {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "oncoming", "people", "punch", "skin", "tone"},
-	"🤛": {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "people"},
-	"🤛🏻": {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "light", "people", "skin", "tone"},
-	"🤛🏼": {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "light", "medium", "people", "skin", "tone"},
-	"🤛🏽": {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "medium", "people", "skin", "tone"},
-	"🤛🏾": {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "left", "leftwards", "medium", "people", "skin", "tone"},
-	"🤛🏿": {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "left", "leftwards", "people", "skin", "tone"},
-	"🤜": {"body", "closed", "facing", "fingers", "fist", "hand", "people", "right", "rightwards"},
-	"🤜🏻": {"body", "closed", "facing", "fingers", "fist", "hand", "light", "people", "right", "rightwards", "skin", "tone"},
-	"🤜🏼": {"body", "closed", "facing", "fingers", "fist", "hand", "light", "medium", "people", "right", "rightwards", "skin", "tone"},
-	"🤜🏽": {"body", "closed", "facing", "fingers", "fist", "hand", "medium", "people", "right", "rightwards", "skin", "tone"},
-	"🤜🏾": {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "medium", "people", "right", "rightwards", "skin", "tone"},
-	"🤜🏿": {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "people", "right", "rightwards", "skin", "tone"},
-	"👏": {"body", "clap", "clapping", "hand", "hands", "people"},
-	"👏🏻": {"body", "clap", "clapping", "hand", "hands", "light", "people", "skin", "tone"},
-	"👏🏼": {"body", "clap", "clapping", "hand", "hands", "light", "medium", "people", "skin", "tone"},
-	"👏🏽": {"body", "clap", "clapping", "hand", "hands", "medium", "people", "skin", "tone"},
-	"👏🏾": {"body", "clap", "clapping", "dark", "hand", "hands", "medium", "people", "skin", "tone"},
-	"👏🏿": {"body", "clap", "clapping", "dark", "hand", "hands", "people", "skin", "tone"},
-	"🙌": {"body", "celebration", "gesture", "hand", "hands", "hooray", "people", "raised", "raising"},
-	"🙌🏻": {"body", "celebration", "gesture", "hand", "hands", "hooray", "light", "people", "raised", "raising", "skin", "tone"},
-	"🙌🏼": {"body", "celebration", "gesture", "hand", "hands", "hooray", "light", "medium", "people", "raised", "raising", "skin", "tone"},
-	"🙌🏽": {"body", "celebration", "gesture", "hand", "hands", "hooray", "medium", "people", "raised", "raising", "skin", "tone"},
-	"🙌🏾": {"body", "celebration", "dark", "gesture", "hand", "hands", "hooray", "medium", "people", "raised", "raising", "skin", "tone"},
-	"🙌🏿": {"body", "celebration", "dark", "gesture", "hand", "hands", "hooray", "people", "raised", "raising", "skin", "tone"},
-	"🫶": {"body", "hands", "heart", "love", "people"},
-	"🫶🏻": {"body", "hands", "heart", "light", "love", "people", "skin", "tone"},
-	"🫶🏼": {"body", "hands", "heart", "light", "love", "medium", "people", "skin", "tone"},
-	"🫶🏽": {"body", "hands", "heart", "love", "medium", "people", "skin", "tone"},
-	"🫶🏾": {"body", "dark", "hands", "heart", "love", "medium", "people", "skin", "tone"},
-	"🫶🏿": {"body", "dark", "hands", "heart", "love", "people", "skin", "tone"},
-	"👐": {"body", "hand", "hands", "open", "people"},
-	"👐🏻": {"body", "hand", "hands", "light", "open", "people", "skin", "tone"},
-	"👐🏼": {"body", "hand", "hands", "light", "medium", "open", "people", "skin", "tone"},
-	"👐🏽": {"body", "hand", "hands", "medium", "open", "people", "skin", "tone"},
-	"👐🏾": {"body", "dark", "hand", "hands", "medium", "open", "people", "skin", "tone"},
-	"👐🏿": {"body", "dark", "hand", "hands", "open", "people", "skin", "tone"},
-	"🤲": {"body", "hands", "palms", "people", "prayer", "together", "up"},
-	"🤲🏻": {"body", "hands", "light", "palms", "people", "prayer", "skin", "together", "tone", "up"},
-	"🤲🏼": {"body", "hands", "light", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
-	"🤲🏽": {"body", "hands", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
-	"🤲🏾": {"body", "dark", "hands", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
-	"🤲🏿": {"body", "dark", "hands", "palms", "people", "prayer", "skin", "together", "tone", "up"},
-	"🤝": {"agreement", "body", "hand", "hands", "handshake", "meeting", "people", "shake"},
-	"🤝🏻": {"agreement", "body", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
-	"🤝🏼": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🤝🏽": {"agreement", "body", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🤝🏾": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🤝🏿": {"agreement", "body", "dark", "hand", "hands", "handshake", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏻\u200d🫲🏼": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏻\u200d🫲🏽": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏻\u200d🫲🏾": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏻\u200d🫲🏿": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏼\u200d🫲🏻": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏼\u200d🫲🏽": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏼\u200d🫲🏾": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏼\u200d🫲🏿": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏽\u200d🫲🏻": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏽\u200d🫲🏼": {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏽\u200d🫲🏾": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏽\u200d🫲🏿": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏾\u200d🫲🏻": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏾\u200d🫲🏼": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏾\u200d🫲🏽": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏾\u200d🫲🏿": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏿\u200d🫲🏻": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏿\u200d🫲🏼": {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏿\u200d🫲🏽": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🫱🏿\u200d🫲🏾": {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
-	"🙏": {"ask", "body", "five", "folded", "hand", "hands", "high", "people", "please", "pray", "thanks"},
-	"🙏🏻": {"ask", "body", "five", "folded", "hand", "hands", "high", "light", "people", "please", "pray", "skin", "thanks", "tone"},
-	"🙏🏼": {"ask", "body", "five", "folded", "hand", "hands", "high", "light", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
-	"🙏🏽": {"ask", "body", "five", "folded", "hand", "hands", "high", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
-	"🙏🏾": {"ask", "body", "dark", "five", "folded", "hand", "hands", "high", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
-	"🙏🏿": {"ask", "body", "dark", "five", "folded", "hand", "hands", "high", "people", "please", "pray", "skin", "thanks", "tone"},
-	"✍️": {"body", "hand", "people", "prop", "write", "writing"},
-	"✍🏻": {"body", "hand", "light", "people", "prop", "skin", "tone", "write", "writing"},
-	"✍🏼": {"body", "hand", "light", "medium", "people", "prop", "skin", "tone", "write", "writing"},
-	"✍🏽": {"body", "hand", "medium", "people", "prop", "skin", "tone", "write", "writing"},
-	"✍🏾": {"body", "dark", "hand", "medium", "people", "prop", "skin", "tone", "write", "writing"},
-	"✍🏿": {"body", "dark", "hand", "people", "prop", "skin", "tone", "write", "writing"},
-	"💅": {"body", "care", "cosmetics", "hand", "manicure", "nail", "people", "polish", "prop"},
-	"💅🏻": {"body", "care", "cosmetics", "hand", "light", "manicure", "nail", "people", "polish", "prop", "skin", "tone"},
-	"💅🏼": {"body", "care", "cosmetics", "hand", "light", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
-	"💅🏽": {"body", "care", "cosmetics", "hand", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
-	"💅🏾": {"body", "care", "cosmetics", "dark", "hand", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
-	"💅🏿": {"body", "care", "cosmetics", "dark", "hand", "manicure", "nail", "people", "polish", "prop", "skin", "tone"},
-	"🤳": {"body", "camera", "hand", "people", "phone", "prop", "selfie"},
-	"🤳🏻": {"body", "camera", "hand", "light", "people", "phone", "prop", "selfie", "skin", "tone"},
-	"🤳🏼": {"body", "camera", "hand", "light", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
-	"🤳🏽": {"body", "camera", "hand", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
-	"🤳🏾": {"body", "camera", "dark", "hand", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
-	"🤳🏿": {"body", "camera", "dark", "hand", "people", "phone", "prop", "selfie", "skin", "tone"},
-	"💪": {"biceps", "body", "comic", "flex", "flexed", "muscle", "parts", "people"},
-	"💪🏻": {"biceps", "body", "comic", "flex", "flexed", "light", "muscle", "parts", "people", "skin", "tone"},
-	"💪🏼": {"biceps", "body", "comic", "flex", "flexed", "light", "medium", "muscle", "parts", "people", "skin", "tone"},
-	"💪🏽": {"biceps", "body", "comic", "flex", "flexed", "medium", "muscle", "parts", "people", "skin", "tone"},
-	"💪🏾": {"biceps", "body", "comic", "dark", "flex", "flexed", "medium", "muscle", "parts", "people", "skin", "tone"},
-	"💪🏿": {"biceps", "body", "comic", "dark", "flex", "flexed", "muscle", "parts", "people", "skin", "tone"},
-	"🦾": {"accessibility", "arm", "body", "mechanical", "parts", "people", "prosthetic"},
-	"🦿": {"accessibility", "body", "leg", "mechanical", "parts", "people", "prosthetic"},
-	"🦵": {"body", "kick", "leg", "limb", "parts", "people"},
-	"🦵🏻": {"body", "kick", "leg", "light", "limb", "parts", "people", "skin", "tone"},
-	"🦵🏼": {"body", "kick", "leg", "light", "limb", "medium", "parts", "people", "skin", "tone"},
-	"🦵🏽": {"body", "kick", "leg", "limb", "medium", "parts", "people", "skin", "tone"},
-	"🦵🏾": {"body", "dark", "kick", "leg", "limb", "medium", "parts", "people", "skin", "tone"},
-	"🦵🏿": {"body", "dark", "kick", "leg", "limb", "parts", "people", "skin", "tone"},
-	"🦶": {"body", "foot", "kick", "parts", "people", "stomp"},
-	"🦶🏻": {"body", "foot", "kick", "light", "parts", "people", "skin", "stomp", "tone"},
-	"🦶🏼": {"body", "foot", "kick", "light", "medium", "parts", "people", "skin", "stomp", "tone"},
-	"🦶🏽": {"body", "foot", "kick", "medium", "parts", "people", "skin", "stomp", "tone"},
-	"🦶🏾": {"body", "dark", "foot", "kick", "medium", "parts", "people", "skin", "stomp", "tone"},
-	"🦶🏿": {"body", "dark", "foot", "kick", "parts", "people", "skin", "stomp", "tone"},
-	"👂": {"body", "ear", "parts", "people"},
-	"👂🏻": {"body", "ear", "light", "parts", "people", "skin", "tone"},
-	"👂🏼": {"body", "ear", "light", "medium", "parts", "people", "skin", "tone"},
-	"👂🏽": {"body", "ear", "medium", "parts", "people", "skin", "tone"},
-	"👂🏾": {"body", "dark", "ear", "medium", "parts", "people", "skin", "tone"},
-	"👂🏿": {"body", "dark", "ear", "parts", "people", "skin", "tone"},
-	"🦻": {"accessibility", "aid", "body", "ear", "hard", "hearing", "of", "parts", "people", "with"},
-	"🦻🏻": {"accessibility", "aid", "body", "ear", "hard", "hearing", "light", "of", "parts", "people", "skin", "tone", "with"},
-	"🦻🏼": {"accessibility", "aid", "body", "ear", "hard", "hearing", "light", "medium", "of", "parts", "people", "skin", "tone", "with"},
-	"🦻🏽": {"accessibility", "aid", "body", "ear", "hard", "hearing", "medium", "of", "parts", "people", "skin", "tone", "with"},
-	"🦻🏾": {"accessibility", "aid", "body", "dark", "ear", "hard", "hearing", "medium", "of", "parts", "people", "skin", "tone", "with"},
-	"🦻🏿": {"accessibility", "aid", "body", "dark", "ear", "hard", "hearing", "of", "parts", "people", "skin", "tone", "with"},
-	"👃": {"body", "nose", "parts", "people"},
-	"👃🏻": {"body", "light", "nose", "parts", "people", "skin", "tone"},
-	"👃🏼": {"body", "light", "medium", "nose", "parts", "people", "skin", "tone"},
-	"👃🏽": {"body", "medium", "nose", "parts", "people", "skin", "tone"},
-	"👃🏾": {"body", "dark", "medium", "nose", "parts", "people", "skin", "tone"},
-	"👃🏿": {"body", "dark", "nose", "parts", "people", "skin", "tone"},
-	"🧠": {"body", "brain", "intelligent", "parts", "people"},
-	"🫀": {"anatomical", "body", "cardiology", "heart", "organ", "parts", "people", "pulse"},
-	"🫁": {"body", "breath", "exhalation", "inhalation", "lungs", "organ", "parts", "people", "respiration"},
-	"🦷": {"body", "dentist", "parts", "people", "tooth"},
-	"🦴": {"body", "bone", "parts", "people", "skeleton"},
-	"👀": {"body", "eye", "eyes", "face", "parts", "people"},
-	"👁️": {"body", "eye", "parts", "people"},
-	"👅": {"body", "parts", "people", "tongue"},
-	"👄": {"body", "lips", "mouth", "parts", "people"},
-	"🫦": {"anxious", "biting", "body", "fear", "flirting", "lip", "nervous", "parts", "people", "uncomfortable", "worried"},
-	"👶": {"baby", "body", "people", "person", "young"},
-	"👶🏻": {"baby", "body", "light", "people", "person", "skin", "tone", "young"},
-	"👶🏼": {"baby", "body", "light", "medium", "people", "person", "skin", "tone", "young"},
-	"👶🏽": {"baby", "body", "medium", "people", "person", "skin", "tone", "young"},
-	"👶🏾": {"baby", "body", "dark", "medium", "people", "person", "skin", "tone", "young"},
-	"👶🏿": {"baby", "body", "dark", "people", "person", "skin", "tone", "young"},
-	"🧒": {"body", "child", "gender", "neutral", "people", "person", "unspecified", "young"},
-	"🧒🏻": {"body", "child", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
-	"🧒🏼": {"body", "child", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
-	"🧒🏽": {"body", "child", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
-	"🧒🏾": {"body", "child", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
-	"🧒🏿": {"body", "child", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
-	"👦": {"body", "boy", "people", "person", "young"},
-	"👦🏻": {"body", "boy", "light", "people", "person", "skin", "tone", "young"},
-	"👦🏼": {"body", "boy", "light", "medium", "people", "person", "skin", "tone", "young"},
-	"👦🏽": {"body", "boy", "medium", "people", "person", "skin", "tone", "young"},
-	"👦🏾": {"body", "boy", "dark", "medium", "people", "person", "skin", "tone", "young"},
-	"👦🏿": {"body", "boy", "dark", "people", "person", "skin", "tone", "young"},
-	"👧": {"body", "girl", "people", "person", "virgo", "young", "zodiac"},
-	"👧🏻": {"body", "girl", "light", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
-	"👧🏼": {"body", "girl", "light", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
-	"👧🏽": {"body", "girl", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
-	"👧🏾": {"body", "dark", "girl", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
-	"👧🏿": {"body", "dark", "girl", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
-	"🧑": {"adult", "body", "gender", "neutral", "people", "person", "unspecified"},
-	"🧑🏻": {"adult", "body", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏼": {"adult", "body", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏽": {"adult", "body", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏾": {"adult", "body", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏿": {"adult", "body", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"👱": {"blond", "body", "hair", "haired", "people", "person"},
-	"👱🏻": {"blond", "body", "hair", "haired", "light", "people", "person", "skin", "tone"},
-	"👱🏼": {"blond", "body", "hair", "haired", "light", "medium", "people", "person", "skin", "tone"},
-	"👱🏽": {"blond", "body", "hair", "haired", "medium", "people", "person", "skin", "tone"},
-	"👱🏾": {"blond", "body", "dark", "hair", "haired", "medium", "people", "person", "skin", "tone"},
-	"👱🏿": {"blond", "body", "dark", "hair", "haired", "people", "person", "skin", "tone"},
-	"👨": {"adult", "body", "man", "people", "person"},
-	"👨🏻": {"adult", "body", "light", "man", "people", "person", "skin", "tone"},
-	"👨🏼": {"adult", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏽": {"adult", "body", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏾": {"adult", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏿": {"adult", "body", "dark", "man", "people", "person", "skin", "tone"},
-	"🧔": {"beard", "body", "people", "person"},
-	"🧔🏻": {"beard", "body", "light", "people", "person", "skin", "tone"},
-	"🧔🏼": {"beard", "body", "light", "medium", "people", "person", "skin", "tone"},
-	"🧔🏽": {"beard", "body", "medium", "people", "person", "skin", "tone"},
-	"🧔🏾": {"beard", "body", "dark", "medium", "people", "person", "skin", "tone"},
-	"🧔🏿": {"beard", "body", "dark", "people", "person", "skin", "tone"},
-	"🧔\u200d♂️": {"beard", "body", "man", "people", "person"},
-	"🧔🏻\u200d♂️": {"beard", "body", "light", "man", "people", "person", "skin", "tone"},
-	"🧔🏼\u200d♂️": {"beard", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🧔🏽\u200d♂️": {"beard", "body", "man", "medium", "people", "person", "skin", "tone"},
-	"🧔🏾\u200d♂️": {"beard", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
-	"🧔🏿\u200d♂️": {"beard", "body", "dark", "man", "people", "person", "skin", "tone"},
-	"🧔\u200d♀️": {"beard", "body", "people", "person", "woman"},
-	"🧔🏻\u200d♀️": {"beard", "body", "light", "people", "person", "skin", "tone", "woman"},
-	"🧔🏼\u200d♀️": {"beard", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧔🏽\u200d♀️": {"beard", "body", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧔🏾\u200d♀️": {"beard", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧔🏿\u200d♀️": {"beard", "body", "dark", "people", "person", "skin", "tone", "woman"},
-	"👨\u200d🦰": {"adult", "body", "hair", "man", "people", "person", "red"},
-	"👨🏻\u200d🦰": {"adult", "body", "hair", "light", "man", "people", "person", "red", "skin", "tone"},
-	"👨🏼\u200d🦰": {"adult", "body", "hair", "light", "man", "medium", "people", "person", "red", "skin", "tone"},
-	"👨🏽\u200d🦰": {"adult", "body", "hair", "man", "medium", "people", "person", "red", "skin", "tone"},
-	"👨🏾\u200d🦰": {"adult", "body", "dark", "hair", "man", "medium", "people", "person", "red", "skin", "tone"},
-	"👨🏿\u200d🦰": {"adult", "body", "dark", "hair", "man", "people", "person", "red", "skin", "tone"},
-	"👨\u200d🦱": {"adult", "body", "curly", "hair", "man", "people", "person"},
-	"👨🏻\u200d🦱": {"adult", "body", "curly", "hair", "light", "man", "people", "person", "skin", "tone"},
-	"👨🏼\u200d🦱": {"adult", "body", "curly", "hair", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏽\u200d🦱": {"adult", "body", "curly", "hair", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏾\u200d🦱": {"adult", "body", "curly", "dark", "hair", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏿\u200d🦱": {"adult", "body", "curly", "dark", "hair", "man", "people", "person", "skin", "tone"},
-	"👨\u200d🦳": {"adult", "body", "hair", "man", "people", "person", "white"},
-	"👨🏻\u200d🦳": {"adult", "body", "hair", "light", "man", "people", "person", "skin", "tone", "white"},
-	"👨🏼\u200d🦳": {"adult", "body", "hair", "light", "man", "medium", "people", "person", "skin", "tone", "white"},
-	"👨🏽\u200d🦳": {"adult", "body", "hair", "man", "medium", "people", "person", "skin", "tone", "white"},
-	"👨🏾\u200d🦳": {"adult", "body", "dark", "hair", "man", "medium", "people", "person", "skin", "tone", "white"},
-	"👨🏿\u200d🦳": {"adult", "body", "dark", "hair", "man", "people", "person", "skin", "tone", "white"},
-	"👨\u200d🦲": {"adult", "bald", "body", "man", "people", "person"},
-	"👨🏻\u200d🦲": {"adult", "bald", "body", "light", "man", "people", "person", "skin", "tone"},
-	"👨🏼\u200d🦲": {"adult", "bald", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏽\u200d🦲": {"adult", "bald", "body", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏾\u200d🦲": {"adult", "bald", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
-	"👨🏿\u200d🦲": {"adult", "bald", "body", "dark", "man", "people", "person", "skin", "tone"},
-	"👩": {"adult", "body", "people", "person", "woman"},
-	"👩🏻": {"adult", "body", "light", "people", "person", "skin", "tone", "woman"},
-	"👩🏼": {"adult", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏽": {"adult", "body", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏾": {"adult", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏿": {"adult", "body", "dark", "people", "person", "skin", "tone", "woman"},
-	"👩\u200d🦰": {"adult", "body", "hair", "people", "person", "red", "woman"},
-	"👩🏻\u200d🦰": {"adult", "body", "hair", "light", "people", "person", "red", "skin", "tone", "woman"},
-	"👩🏼\u200d🦰": {"adult", "body", "hair", "light", "medium", "people", "person", "red", "skin", "tone", "woman"},
-	"👩🏽\u200d🦰": {"adult", "body", "hair", "medium", "people", "person", "red", "skin", "tone", "woman"},
-	"👩🏾\u200d🦰": {"adult", "body", "dark", "hair", "medium", "people", "person", "red", "skin", "tone", "woman"},
-	"👩🏿\u200d🦰": {"adult", "body", "dark", "hair", "people", "person", "red", "skin", "tone", "woman"},
-	"🧑\u200d🦰": {"adult", "body", "gender", "hair", "neutral", "people", "person", "red", "unspecified"},
-	"🧑🏻\u200d🦰": {"adult", "body", "gender", "hair", "light", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
-	"🧑🏼\u200d🦰": {"adult", "body", "gender", "hair", "light", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
-	"🧑🏽\u200d🦰": {"adult", "body", "gender", "hair", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
-	"🧑🏾\u200d🦰": {"adult", "body", "dark", "gender", "hair", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
-	"🧑🏿\u200d🦰": {"adult", "body", "dark", "gender", "hair", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
-	"👩\u200d🦱": {"adult", "body", "curly", "hair", "people", "person", "woman"},
-	"👩🏻\u200d🦱": {"adult", "body", "curly", "hair", "light", "people", "person", "skin", "tone", "woman"},
-	"👩🏼\u200d🦱": {"adult", "body", "curly", "hair", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏽\u200d🦱": {"adult", "body", "curly", "hair", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏾\u200d🦱": {"adult", "body", "curly", "dark", "hair", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏿\u200d🦱": {"adult", "body", "curly", "dark", "hair", "people", "person", "skin", "tone", "woman"},
-	"🧑\u200d🦱": {"adult", "body", "curly", "gender", "hair", "neutral", "people", "person", "unspecified"},
-	"🧑🏻\u200d🦱": {"adult", "body", "curly", "gender", "hair", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏼\u200d🦱": {"adult", "body", "curly", "gender", "hair", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏽\u200d🦱": {"adult", "body", "curly", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏾\u200d🦱": {"adult", "body", "curly", "dark", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏿\u200d🦱": {"adult", "body", "curly", "dark", "gender", "hair", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"👩\u200d🦳": {"adult", "body", "hair", "people", "person", "white", "woman"},
-	"👩🏻\u200d🦳": {"adult", "body", "hair", "light", "people", "person", "skin", "tone", "white", "woman"},
-	"👩🏼\u200d🦳": {"adult", "body", "hair", "light", "medium", "people", "person", "skin", "tone", "white", "woman"},
-	"👩🏽\u200d🦳": {"adult", "body", "hair", "medium", "people", "person", "skin", "tone", "white", "woman"},
-	"👩🏾\u200d🦳": {"adult", "body", "dark", "hair", "medium", "people", "person", "skin", "tone", "white", "woman"},
-	"👩🏿\u200d🦳": {"adult", "body", "dark", "hair", "people", "person", "skin", "tone", "white", "woman"},
-	"🧑\u200d🦳": {"adult", "body", "gender", "hair", "neutral", "people", "person", "unspecified", "white"},
-	"🧑🏻\u200d🦳": {"adult", "body", "gender", "hair", "light", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
-	"🧑🏼\u200d🦳": {"adult", "body", "gender", "hair", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
-	"🧑🏽\u200d🦳": {"adult", "body", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
-	"🧑🏾\u200d🦳": {"adult", "body", "dark", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
-	"🧑🏿\u200d🦳": {"adult", "body", "dark", "gender", "hair", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
-	"👩\u200d🦲": {"adult", "bald", "body", "people", "person", "woman"},
-	"👩🏻\u200d🦲": {"adult", "bald", "body", "light", "people", "person", "skin", "tone", "woman"},
-	"👩🏼\u200d🦲": {"adult", "bald", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏽\u200d🦲": {"adult", "bald", "body", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏾\u200d🦲": {"adult", "bald", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
-	"👩🏿\u200d🦲": {"adult", "bald", "body", "dark", "people", "person", "skin", "tone", "woman"},
-	"🧑\u200d🦲": {"adult", "bald", "body", "gender", "neutral", "people", "person", "unspecified"},
-	"🧑🏻\u200d🦲": {"adult", "bald", "body", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏼\u200d🦲": {"adult", "bald", "body", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏽\u200d🦲": {"adult", "bald", "body", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏾\u200d🦲": {"adult", "bald", "body", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"🧑🏿\u200d🦲": {"adult", "bald", "body", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified"},
-	"👱\u200d♀️": {"blond", "blonde", "body", "hair", "haired", "people", "person", "woman"},
-	"👱🏻\u200d♀️": {"blond", "blonde", "body", "hair", "haired", "light", "people", "person", "skin", "tone", "woman"},
-	"👱🏼\u200d♀️": {"blond", "blonde", "body", "hair", "haired", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"👱🏽\u200d♀️": {"blond", "blonde", "body", "hair", "haired", "medium", "people", "person", "skin", "tone", "woman"},
-	"👱🏾\u200d♀️": {"blond", "blonde", "body", "dark", "hair", "haired", "medium", "people", "person", "skin", "tone", "woman"},
-	"👱🏿\u200d♀️": {"blond", "blonde", "body", "dark", "hair", "haired", "people", "person", "skin", "tone", "woman"},
-	"👱\u200d♂️": {"blond", "body", "hair", "haired", "man", "people", "person"},
-	"👱🏻\u200d♂️": {"blond", "body", "hair", "haired", "light", "man", "people", "person", "skin", "tone"},
-	"👱🏼\u200d♂️": {"blond", "body", "hair", "haired", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"👱🏽\u200d♂️": {"blond", "body", "hair", "haired", "man", "medium", "people", "person", "skin", "tone"},
-	"👱🏾\u200d♂️": {"blond", "body", "dark", "hair", "haired", "man", "medium", "people", "person", "skin", "tone"},
-	"👱🏿\u200d♂️": {"blond", "body", "dark", "hair", "haired", "man", "people", "person", "skin", "tone"},
-	"🧓": {"adult", "body", "gender", "neutral", "old", "older", "people", "person", "unspecified"},
-	"🧓🏻": {"adult", "body", "gender", "light", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
-	"🧓🏼": {"adult", "body", "gender", "light", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
-	"🧓🏽": {"adult", "body", "gender", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
-	"🧓🏾": {"adult", "body", "dark", "gender", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
-	"🧓🏿": {"adult", "body", "dark", "gender", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
-	"👴": {"adult", "body", "man", "old", "people", "person"},
-	"👴🏻": {"adult", "body", "light", "man", "old", "people", "person", "skin", "tone"},
-	"👴🏼": {"adult", "body", "light", "man", "medium", "old", "people", "person", "skin", "tone"},
-	"👴🏽": {"adult", "body", "man", "medium", "old", "people", "person", "skin", "tone"},
-	"👴🏾": {"adult", "body", "dark", "man", "medium", "old", "people", "person", "skin", "tone"},
-	"👴🏿": {"adult", "body", "dark", "man", "old", "people", "person", "skin", "tone"},
-	"👵": {"adult", "body", "old", "people", "person", "woman"},
-	"👵🏻": {"adult", "body", "light", "old", "people", "person", "skin", "tone", "woman"},
-	"👵🏼": {"adult", "body", "light", "medium", "old", "people", "person", "skin", "tone", "woman"},
-	"👵🏽": {"adult", "body", "medium", "old", "people", "person", "skin", "tone", "woman"},
-	"👵🏾": {"adult", "body", "dark", "medium", "old", "people", "person", "skin", "tone", "woman"},
-	"👵🏿": {"adult", "body", "dark", "old", "people", "person", "skin", "tone", "woman"},
-	"🙍": {"body", "frown", "frowning", "gesture", "people", "person"},
-	"🙍🏻": {"body", "frown", "frowning", "gesture", "light", "people", "person", "skin", "tone"},
-	"🙍🏼": {"body", "frown", "frowning", "gesture", "light", "medium", "people", "person", "skin", "tone"},
-	"🙍🏽": {"body", "frown", "frowning", "gesture", "medium", "people", "person", "skin", "tone"},
-	"🙍🏾": {"body", "dark", "frown", "frowning", "gesture", "medium", "people", "person", "skin", "tone"},
-	"🙍🏿": {"body", "dark", "frown", "frowning", "gesture", "people", "person", "skin", "tone"},
-	"🙍\u200d♂️": {"body", "frowning", "gesture", "man", "people", "person"},
-	"🙍🏻\u200d♂️": {"body", "frowning", "gesture", "light", "man", "people", "person", "skin", "tone"},
-	"🙍🏼\u200d♂️": {"body", "frowning", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🙍🏽\u200d♂️": {"body", "frowning", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🙍🏾\u200d♂️": {"body", "dark", "frowning", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🙍🏿\u200d♂️": {"body", "dark", "frowning", "gesture", "man", "people", "person", "skin", "tone"},
-	"🙍\u200d♀️": {"body", "frowning", "gesture", "people", "person", "woman"},
-	"🙍🏻\u200d♀️": {"body", "frowning", "gesture", "light", "people", "person", "skin", "tone", "woman"},
-	"🙍🏼\u200d♀️": {"body", "frowning", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🙍🏽\u200d♀️": {"body", "frowning", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🙍🏾\u200d♀️": {"body", "dark", "frowning", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🙍🏿\u200d♀️": {"body", "dark", "frowning", "gesture", "people", "person", "skin", "tone", "woman"},
-	"🙎": {"body", "gesture", "people", "person", "pouting"},
-	"🙎🏻": {"body", "gesture", "light", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏼": {"body", "gesture", "light", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏽": {"body", "gesture", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏾": {"body", "dark", "gesture", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏿": {"body", "dark", "gesture", "people", "person", "pouting", "skin", "tone"},
-	"🙎\u200d♂️": {"body", "gesture", "man", "people", "person", "pouting"},
-	"🙎🏻\u200d♂️": {"body", "gesture", "light", "man", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏼\u200d♂️": {"body", "gesture", "light", "man", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏽\u200d♂️": {"body", "gesture", "man", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏾\u200d♂️": {"body", "dark", "gesture", "man", "medium", "people", "person", "pouting", "skin", "tone"},
-	"🙎🏿\u200d♂️": {"body", "dark", "gesture", "man", "people", "person", "pouting", "skin", "tone"},
-	"🙎\u200d♀️": {"body", "gesture", "people", "person", "pouting", "woman"},
-	"🙎🏻\u200d♀️": {"body", "gesture", "light", "people", "person", "pouting", "skin", "tone", "woman"},
-	"🙎🏼\u200d♀️": {"body", "gesture", "light", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
-	"🙎🏽\u200d♀️": {"body", "gesture", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
-	"🙎🏾\u200d♀️": {"body", "dark", "gesture", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
-	"🙎🏿\u200d♀️": {"body", "dark", "gesture", "people", "person", "pouting", "skin", "tone", "woman"},
-	"🙅": {"body", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited"},
-	"🙅🏻": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏼": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏽": {"body", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏾": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏿": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅\u200d♂️": {"body", "forbidden", "gesture", "gesturing", "hand", "man", "no", "people", "person", "prohibited"},
-	"🙅🏻\u200d♂️": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "man", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏼\u200d♂️": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏽\u200d♂️": {"body", "forbidden", "gesture", "gesturing", "hand", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏾\u200d♂️": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅🏿\u200d♂️": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "man", "no", "people", "person", "prohibited", "skin", "tone"},
-	"🙅\u200d♀️": {"body", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "woman"},
-	"🙅🏻\u200d♀️": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
-	"🙅🏼\u200d♀️": {"body", "forbidden", "gesture", "gesturing", "hand", "light", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
-	"🙅🏽\u200d♀️": {"body", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
-	"🙅🏾\u200d♀️": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
-	"🙅🏿\u200d♀️": {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
-	"🙆": {"body", "gesture", "gesturing", "hand", "ok", "people", "person"},
-	"🙆🏻": {"body", "gesture", "gesturing", "hand", "light", "ok", "people", "person", "skin", "tone"},
-	"🙆🏼": {"body", "gesture", "gesturing", "hand", "light", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏽": {"body", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏾": {"body", "dark", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏿": {"body", "dark", "gesture", "gesturing", "hand", "ok", "people", "person", "skin", "tone"},
-	"🙆\u200d♂️": {"body", "gesture", "gesturing", "hand", "man", "ok", "people", "person"},
-	"🙆🏻\u200d♂️": {"body", "gesture", "gesturing", "hand", "light", "man", "ok", "people", "person", "skin", "tone"},
-	"🙆🏼\u200d♂️": {"body", "gesture", "gesturing", "hand", "light", "man", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏽\u200d♂️": {"body", "gesture", "gesturing", "hand", "man", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏾\u200d♂️": {"body", "dark", "gesture", "gesturing", "hand", "man", "medium", "ok", "people", "person", "skin", "tone"},
-	"🙆🏿\u200d♂️": {"body", "dark", "gesture", "gesturing", "hand", "man", "ok", "people", "person", "skin", "tone"},
-	"🙆\u200d♀️": {"body", "gesture", "gesturing", "hand", "ok", "people", "person", "woman"},
-	"🙆🏻\u200d♀️": {"body", "gesture", "gesturing", "hand", "light", "ok", "people", "person", "skin", "tone", "woman"},
-	"🙆🏼\u200d♀️": {"body", "gesture", "gesturing", "hand", "light", "medium", "ok", "people", "person", "skin", "tone", "woman"},
-	"🙆🏽\u200d♀️": {"body", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone", "woman"},
-	"🙆🏾\u200d♀️": {"body", "dark", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone", "woman"},
-	"🙆🏿\u200d♀️": {"body", "dark", "gesture", "gesturing", "hand", "ok", "people", "person", "skin", "tone", "woman"},
-	"💁": {"body", "gesture", "hand", "help", "information", "people", "person", "sassy", "tipping"},
-	"💁🏻": {"body", "gesture", "hand", "help", "information", "light", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏼": {"body", "gesture", "hand", "help", "information", "light", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏽": {"body", "gesture", "hand", "help", "information", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏾": {"body", "dark", "gesture", "hand", "help", "information", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏿": {"body", "dark", "gesture", "hand", "help", "information", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁\u200d♂️": {"body", "gesture", "hand", "man", "people", "person", "sassy", "tipping"},
-	"💁🏻\u200d♂️": {"body", "gesture", "hand", "light", "man", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏼\u200d♂️": {"body", "gesture", "hand", "light", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏽\u200d♂️": {"body", "gesture", "hand", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏾\u200d♂️": {"body", "dark", "gesture", "hand", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁🏿\u200d♂️": {"body", "dark", "gesture", "hand", "man", "people", "person", "sassy", "skin", "tipping", "tone"},
-	"💁\u200d♀️": {"body", "gesture", "hand", "people", "person", "sassy", "tipping", "woman"},
-	"💁🏻\u200d♀️": {"body", "gesture", "hand", "light", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
-	"💁🏼\u200d♀️": {"body", "gesture", "hand", "light", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
-	"💁🏽\u200d♀️": {"body", "gesture", "hand", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
-	"💁🏾\u200d♀️": {"body", "dark", "gesture", "hand", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
-	"💁🏿\u200d♀️": {"body", "dark", "gesture", "hand", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
-	"🙋": {"body", "gesture", "hand", "happy", "people", "person", "raised", "raising"},
-	"🙋🏻": {"body", "gesture", "hand", "happy", "light", "people", "person", "raised", "raising", "skin", "tone"},
-	"🙋🏼": {"body", "gesture", "hand", "happy", "light", "medium", "people", "person", "raised", "raising", "skin", "tone"},
-	"🙋🏽": {"body", "gesture", "hand", "happy", "medium", "people", "person", "raised", "raising", "skin", "tone"},
-	"🙋🏾": {"body", "dark", "gesture", "hand", "happy", "medium", "people", "person", "raised", "raising", "skin", "tone"},
-	"🙋🏿": {"body", "dark", "gesture", "hand", "happy", "people", "person", "raised", "raising", "skin", "tone"},
-	"🙋\u200d♂️": {"body", "gesture", "hand", "man", "people", "person", "raising"},
-	"🙋🏻\u200d♂️": {"body", "gesture", "hand", "light", "man", "people", "person", "raising", "skin", "tone"},
-	"🙋🏼\u200d♂️": {"body", "gesture", "hand", "light", "man", "medium", "people", "person", "raising", "skin", "tone"},
-	"🙋🏽\u200d♂️": {"body", "gesture", "hand", "man", "medium", "people", "person", "raising", "skin", "tone"},
-	"🙋🏾\u200d♂️": {"body", "dark", "gesture", "hand", "man", "medium", "people", "person", "raising", "skin", "tone"},
-	"🙋🏿\u200d♂️": {"body", "dark", "gesture", "hand", "man", "people", "person", "raising", "skin", "tone"},
-	"🙋\u200d♀️": {"body", "gesture", "hand", "people", "person", "raising", "woman"},
-	"🙋🏻\u200d♀️": {"body", "gesture", "hand", "light", "people", "person", "raising", "skin", "tone", "woman"},
-	"🙋🏼\u200d♀️": {"body", "gesture", "hand", "light", "medium", "people", "person", "raising", "skin", "tone", "woman"},
-	"🙋🏽\u200d♀️": {"body", "gesture", "hand", "medium", "people", "person", "raising", "skin", "tone", "woman"},
-	"🙋🏾\u200d♀️": {"body", "dark", "gesture", "hand", "medium", "people", "person", "raising", "skin", "tone", "woman"},
-	"🙋🏿\u200d♀️": {"body", "dark", "gesture", "hand", "people", "person", "raising", "skin", "tone", "woman"},
-	"🧏": {"accessibility", "body", "deaf", "ear", "gesture", "hear", "people", "person"},
-	"🧏🏻": {"accessibility", "body", "deaf", "ear", "gesture", "hear", "light", "people", "person", "skin", "tone"},
-	"🧏🏼": {"accessibility", "body", "deaf", "ear", "gesture", "hear", "light", "medium", "people", "person", "skin", "tone"},
-	"🧏🏽": {"accessibility", "body", "deaf", "ear", "gesture", "hear", "medium", "people", "person", "skin", "tone"},
-	"🧏🏾": {"accessibility", "body", "dark", "deaf", "ear", "gesture", "hear", "medium", "people", "person", "skin", "tone"},
-	"🧏🏿": {"accessibility", "body", "dark", "deaf", "ear", "gesture", "hear", "people", "person", "skin", "tone"},
-	"🧏\u200d♂️": {"body", "deaf", "gesture", "man", "people", "person"},
-	"🧏🏻\u200d♂️": {"body", "deaf", "gesture", "light", "man", "people", "person", "skin", "tone"},
-	"🧏🏼\u200d♂️": {"body", "deaf", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🧏🏽\u200d♂️": {"body", "deaf", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🧏🏾\u200d♂️": {"body", "dark", "deaf", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🧏🏿\u200d♂️": {"body", "dark", "deaf", "gesture", "man", "people", "person", "skin", "tone"},
-	"🧏\u200d♀️": {"body", "deaf", "gesture", "people", "person", "woman"},
-	"🧏🏻\u200d♀️": {"body", "deaf", "gesture", "light", "people", "person", "skin", "tone", "woman"},
-	"🧏🏼\u200d♀️": {"body", "deaf", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧏🏽\u200d♀️": {"body", "deaf", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧏🏾\u200d♀️": {"body", "dark", "deaf", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧏🏿\u200d♀️": {"body", "dark", "deaf", "gesture", "people", "person", "skin", "tone", "woman"},
-	"🙇": {"apology", "body", "bow", "bowing", "gesture", "people", "person", "sorry"},
-	"🙇🏻": {"apology", "body", "bow", "bowing", "gesture", "light", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏼": {"apology", "body", "bow", "bowing", "gesture", "light", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏽": {"apology", "body", "bow", "bowing", "gesture", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏾": {"apology", "body", "bow", "bowing", "dark", "gesture", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏿": {"apology", "body", "bow", "bowing", "dark", "gesture", "people", "person", "skin", "sorry", "tone"},
-	"🙇\u200d♂️": {"apology", "body", "bowing", "favor", "gesture", "man", "people", "person", "sorry"},
-	"🙇🏻\u200d♂️": {"apology", "body", "bowing", "favor", "gesture", "light", "man", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏼\u200d♂️": {"apology", "body", "bowing", "favor", "gesture", "light", "man", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏽\u200d♂️": {"apology", "body", "bowing", "favor", "gesture", "man", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏾\u200d♂️": {"apology", "body", "bowing", "dark", "favor", "gesture", "man", "medium", "people", "person", "skin", "sorry", "tone"},
-	"🙇🏿\u200d♂️": {"apology", "body", "bowing", "dark", "favor", "gesture", "man", "people", "person", "skin", "sorry", "tone"},
-	"🙇\u200d♀️": {"apology", "body", "bowing", "favor", "gesture", "people", "person", "sorry", "woman"},
-	"🙇🏻\u200d♀️": {"apology", "body", "bowing", "favor", "gesture", "light", "people", "person", "skin", "sorry", "tone", "woman"},
-	"🙇🏼\u200d♀️": {"apology", "body", "bowing", "favor", "gesture", "light", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
-	"🙇🏽\u200d♀️": {"apology", "body", "bowing", "favor", "gesture", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
-	"🙇🏾\u200d♀️": {"apology", "body", "bowing", "dark", "favor", "gesture", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
-	"🙇🏿\u200d♀️": {"apology", "body", "bowing", "dark", "favor", "gesture", "people", "person", "skin", "sorry", "tone", "woman"},
-	"🤦": {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "palm", "people", "person"},
-	"🤦🏻": {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "light", "palm", "people", "person", "skin", "tone"},
-	"🤦🏼": {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "light", "medium", "palm", "people", "person", "skin", "tone"},
-	"🤦🏽": {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "medium", "palm", "people", "person", "skin", "tone"},
-	"🤦🏾": {"body", "dark", "disbelief", "exasperation", "face", "facepalming", "gesture", "medium", "palm", "people", "person", "skin", "tone"},
-	"🤦🏿": {"body", "dark", "disbelief", "exasperation", "face", "facepalming", "gesture", "palm", "people", "person", "skin", "tone"},
-	"🤦\u200d♂️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "people", "person"},
-	"🤦🏻\u200d♂️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "man", "people", "person", "skin", "tone"},
-	"🤦🏼\u200d♂️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🤦🏽\u200d♂️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🤦🏾\u200d♂️": {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "medium", "people", "person", "skin", "tone"},
-	"🤦🏿\u200d♂️": {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "people", "person", "skin", "tone"},
-	"🤦\u200d♀️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "people", "person", "woman"},
-	"🤦🏻\u200d♀️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "people", "person", "skin", "tone", "woman"},
-	"🤦🏼\u200d♀️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🤦🏽\u200d♀️": {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🤦🏾\u200d♀️": {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
-	"🤦🏿\u200d♀️": {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "people", "person", "skin", "tone", "woman"},
-	"🤷": {"body", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging"},
-	"🤷🏻": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏼": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏽": {"body", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏾": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏿": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷\u200d♂️": {"body", "doubt", "gesture", "ignorance", "indifference", "man", "people", "person", "shrug", "shrugging"},
-	"🤷🏻\u200d♂️": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "man", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏼\u200d♂️": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏽\u200d♂️": {"body", "doubt", "gesture", "ignorance", "indifference", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏾\u200d♂️": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷🏿\u200d♂️": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "man", "people", "person", "shrug", "shrugging", "skin", "tone"},
-	"🤷\u200d♀️": {"body", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "woman"},
-	"🤷🏻\u200d♀️": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
-	"🤷🏼\u200d♀️": {"body", "doubt", "gesture", "ignorance", "indifference", "light", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
-	"🤷🏽\u200d♀️": {"body", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
-	"🤷🏾\u200d♀️": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
-	"🤷🏿\u200d♀️": {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
-	"🧑\u200d⚕️": {"body", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "therapist", "worker"},
-	"🧑🏻\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"🧑🏼\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"🧑🏽\u200d⚕️": {"body", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"🧑🏾\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"🧑🏿\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👨\u200d⚕️": {"body", "doctor", "health", "healthcare", "man", "nurse", "people", "person", "role", "therapist", "worker"},
-	"👨🏻\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "man", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👨🏼\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👨🏽\u200d⚕️": {"body", "doctor", "health", "healthcare", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👨🏾\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👨🏿\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "man", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
-	"👩\u200d⚕️": {"body", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "therapist", "woman", "worker"},
-	"👩🏻\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
-	"👩🏼\u200d⚕️": {"body", "doctor", "health", "healthcare", "light", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
-	"👩🏽\u200d⚕️": {"body", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
-	"👩🏾\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
-	"👩🏿\u200d⚕️": {"body", "dark", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
-	"🧑\u200d🎓": {"body", "graduate", "people", "person", "role", "student"},
-	"🧑🏻\u200d🎓": {"body", "graduate", "light", "people", "person", "role", "skin", "student", "tone"},
-	"🧑🏼\u200d🎓": {"body", "graduate", "light", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"🧑🏽\u200d🎓": {"body", "graduate", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"🧑🏾\u200d🎓": {"body", "dark", "graduate", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"🧑🏿\u200d🎓": {"body", "dark", "graduate", "people", "person", "role", "skin", "student", "tone"},
-	"👨\u200d🎓": {"body", "graduate", "man", "people", "person", "role", "student"},
-	"👨🏻\u200d🎓": {"body", "graduate", "light", "man", "people", "person", "role", "skin", "student", "tone"},
-	"👨🏼\u200d🎓": {"body", "graduate", "light", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"👨🏽\u200d🎓": {"body", "graduate", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"👨🏾\u200d🎓": {"body", "dark", "graduate", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
-	"👨🏿\u200d🎓": {"body", "dark", "graduate", "man", "people", "person", "role", "skin", "student", "tone"},
-	"👩\u200d🎓": {"body", "graduate", "people", "person", "role", "student", "woman"},
-	"👩🏻\u200d🎓": {"body", "graduate", "light", "people", "person", "role", "skin", "student", "tone", "woman"},
-	"👩🏼\u200d🎓": {"body", "graduate", "light", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
-	"👩🏽\u200d🎓": {"body", "graduate", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
-	"👩🏾\u200d🎓": {"body", "dark", "graduate", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
-	"👩🏿\u200d🎓": {"body", "dark", "graduate", "people", "person", "role", "skin", "student", "tone", "woman"},
-	"🧑\u200d🏫": {"body", "instructor", "people", "person", "professor", "role", "teacher"},
-	"🧑🏻\u200d🏫": {"body", "instructor", "light", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"🧑🏼\u200d🏫": {"body", "instructor", "light", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"🧑🏽\u200d🏫": {"body", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"🧑🏾\u200d🏫": {"body", "dark", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"🧑🏿\u200d🏫": {"body", "dark", "instructor", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👨\u200d🏫": {"body", "instructor", "man", "people", "person", "professor", "role", "teacher"},
-	"👨🏻\u200d🏫": {"body", "instructor", "light", "man", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👨🏼\u200d🏫": {"body", "instructor", "light", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👨🏽\u200d🏫": {"body", "instructor", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👨🏾\u200d🏫": {"body", "dark", "instructor", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👨🏿\u200d🏫": {"body", "dark", "instructor", "man", "people", "person", "professor", "role", "skin", "teacher", "tone"},
-	"👩\u200d🏫": {"body", "instructor", "people", "person", "professor", "role", "teacher", "woman"},
-	"👩🏻\u200d🏫": {"body", "instructor", "light", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
-	"👩🏼\u200d🏫": {"body", "instructor", "light", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
-	"👩🏽\u200d🏫": {"body", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
-	"👩🏾\u200d🏫": {"body", "dark", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
-	"👩🏿\u200d🏫": {"body", "dark", "instructor", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
-	"🧑\u200d⚖️": {"body", "judge", "justice", "people", "person", "role", "scales"},
-	"🧑🏻\u200d⚖️": {"body", "judge", "justice", "light", "people", "person", "role", "scales", "skin", "tone"},
-	"🧑🏼\u200d⚖️": {"body", "judge", "justice", "light", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"🧑🏽\u200d⚖️": {"body", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"🧑🏾\u200d⚖️": {"body", "dark", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"🧑🏿\u200d⚖️": {"body", "dark", "judge", "justice", "people", "person", "role", "scales", "skin", "tone"},
-	"👨\u200d⚖️": {"body", "judge", "justice", "man", "people", "person", "role", "scales"},
-	"👨🏻\u200d⚖️": {"body", "judge", "justice", "light", "man", "people", "person", "role", "scales", "skin", "tone"},
-	"👨🏼\u200d⚖️": {"body", "judge", "justice", "light", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"👨🏽\u200d⚖️": {"body", "judge", "justice", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"👨🏾\u200d⚖️": {"body", "dark", "judge", "justice", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
-	"👨🏿\u200d⚖️": {"body", "dark", "judge", "justice", "man", "people", "person", "role", "scales", "skin", "tone"},
-	"👩\u200d⚖️": {"body", "judge", "justice", "people", "person", "role", "scales", "woman"},
-	"👩🏻\u200d⚖️": {"body", "judge", "justice", "light", "people", "person", "role", "scales", "skin", "tone", "woman"},
-	"👩🏼\u200d⚖️": {"body", "judge", "justice", "light", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
-	"👩🏽\u200d⚖️": {"body", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
-	"👩🏾\u200d⚖️": {"body", "dark", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
-	"👩🏿\u200d⚖️": {"body", "dark", "judge", "justice", "people", "person", "role", "scales", "skin", "tone", "woman"},
-	"🧑\u200d🌾": {"body", "farmer", "gardener", "people", "person", "rancher", "role"},
-	"🧑🏻\u200d🌾": {"body", "farmer", "gardener", "light", "people", "person", "rancher", "role", "skin", "tone"},
-	"🧑🏼\u200d🌾": {"body", "farmer", "gardener", "light", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"🧑🏽\u200d🌾": {"body", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"🧑🏾\u200d🌾": {"body", "dark", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"🧑🏿\u200d🌾": {"body", "dark", "farmer", "gardener", "people", "person", "rancher", "role", "skin", "tone"},
-	"👨\u200d🌾": {"body", "farmer", "gardener", "man", "people", "person", "rancher", "role"},
-	"👨🏻\u200d🌾": {"body", "farmer", "gardener", "light", "man", "people", "person", "rancher", "role", "skin", "tone"},
-	"👨🏼\u200d🌾": {"body", "farmer", "gardener", "light", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"👨🏽\u200d🌾": {"body", "farmer", "gardener", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"👨🏾\u200d🌾": {"body", "dark", "farmer", "gardener", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
-	"👨🏿\u200d🌾": {"body", "dark", "farmer", "gardener", "man", "people", "person", "rancher", "role", "skin", "tone"},
-	"👩\u200d🌾": {"body", "farmer", "gardener", "people", "person", "rancher", "role", "woman"},
-	"👩🏻\u200d🌾": {"body", "farmer", "gardener", "light", "people", "person", "rancher", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🌾": {"body", "farmer", "gardener", "light", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🌾": {"body", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🌾": {"body", "dark", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🌾": {"body", "dark", "farmer", "gardener", "people", "person", "rancher", "role", "skin", "tone", "woman"},
-	"🧑\u200d🍳": {"body", "chef", "cook", "people", "person", "role"},
-	"🧑🏻\u200d🍳": {"body", "chef", "cook", "light", "people", "person", "role", "skin", "tone"},
-	"🧑🏼\u200d🍳": {"body", "chef", "cook", "light", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏽\u200d🍳": {"body", "chef", "cook", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏾\u200d🍳": {"body", "chef", "cook", "dark", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏿\u200d🍳": {"body", "chef", "cook", "dark", "people", "person", "role", "skin", "tone"},
-	"👨\u200d🍳": {"body", "chef", "cook", "man", "people", "person", "role"},
-	"👨🏻\u200d🍳": {"body", "chef", "cook", "light", "man", "people", "person", "role", "skin", "tone"},
-	"👨🏼\u200d🍳": {"body", "chef", "cook", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏽\u200d🍳": {"body", "chef", "cook", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏾\u200d🍳": {"body", "chef", "cook", "dark", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏿\u200d🍳": {"body", "chef", "cook", "dark", "man", "people", "person", "role", "skin", "tone"},
-	"👩\u200d🍳": {"body", "chef", "cook", "people", "person", "role", "woman"},
-	"👩🏻\u200d🍳": {"body", "chef", "cook", "light", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🍳": {"body", "chef", "cook", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🍳": {"body", "chef", "cook", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🍳": {"body", "chef", "cook", "dark", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🍳": {"body", "chef", "cook", "dark", "people", "person", "role", "skin", "tone", "woman"},
-	"🧑\u200d🔧": {"body", "electrician", "mechanic", "people", "person", "plumber", "role", "tradesperson"},
-	"🧑🏻\u200d🔧": {"body", "electrician", "light", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"🧑🏼\u200d🔧": {"body", "electrician", "light", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"🧑🏽\u200d🔧": {"body", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"🧑🏾\u200d🔧": {"body", "dark", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"🧑🏿\u200d🔧": {"body", "dark", "electrician", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👨\u200d🔧": {"body", "electrician", "man", "mechanic", "people", "person", "plumber", "role", "tradesperson"},
-	"👨🏻\u200d🔧": {"body", "electrician", "light", "man", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👨🏼\u200d🔧": {"body", "electrician", "light", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👨🏽\u200d🔧": {"body", "electrician", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👨🏾\u200d🔧": {"body", "dark", "electrician", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👨🏿\u200d🔧": {"body", "dark", "electrician", "man", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
-	"👩\u200d🔧": {"body", "electrician", "mechanic", "people", "person", "plumber", "role", "tradesperson", "woman"},
-	"👩🏻\u200d🔧": {"body", "electrician", "light", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
-	"👩🏼\u200d🔧": {"body", "electrician", "light", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
-	"👩🏽\u200d🔧": {"body", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
-	"👩🏾\u200d🔧": {"body", "dark", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
-	"👩🏿\u200d🔧": {"body", "dark", "electrician", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
-	"🧑\u200d🏭": {"assembly", "body", "factory", "industrial", "people", "person", "role", "worker"},
-	"🧑🏻\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "people", "person", "role", "skin", "tone", "worker"},
-	"🧑🏼\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"🧑🏽\u200d🏭": {"assembly", "body", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"🧑🏾\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"🧑🏿\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "people", "person", "role", "skin", "tone", "worker"},
-	"👨\u200d🏭": {"assembly", "body", "factory", "industrial", "man", "people", "person", "role", "worker"},
-	"👨🏻\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "man", "people", "person", "role", "skin", "tone", "worker"},
-	"👨🏼\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👨🏽\u200d🏭": {"assembly", "body", "factory", "industrial", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👨🏾\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👨🏿\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "man", "people", "person", "role", "skin", "tone", "worker"},
-	"👩\u200d🏭": {"assembly", "body", "factory", "industrial", "people", "person", "role", "woman", "worker"},
-	"👩🏻\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👩🏼\u200d🏭": {"assembly", "body", "factory", "industrial", "light", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👩🏽\u200d🏭": {"assembly", "body", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👩🏾\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👩🏿\u200d🏭": {"assembly", "body", "dark", "factory", "industrial", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"🧑\u200d💼": {"architect", "body", "business", "collar", "manager", "office", "people", "person", "role", "white", "worker"},
-	"🧑🏻\u200d💼": {"architect", "body", "business", "collar", "light", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"🧑🏼\u200d💼": {"architect", "body", "business", "collar", "light", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"🧑🏽\u200d💼": {"architect", "body", "business", "collar", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"🧑🏾\u200d💼": {"architect", "body", "business", "collar", "dark", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"🧑🏿\u200d💼": {"architect", "body", "business", "collar", "dark", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👨\u200d💼": {"architect", "body", "business", "collar", "man", "manager", "office", "people", "person", "role", "white", "worker"},
-	"👨🏻\u200d💼": {"architect", "body", "business", "collar", "light", "man", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👨🏼\u200d💼": {"architect", "body", "business", "collar", "light", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👨🏽\u200d💼": {"architect", "body", "business", "collar", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👨🏾\u200d💼": {"architect", "body", "business", "collar", "dark", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👨🏿\u200d💼": {"architect", "body", "business", "collar", "dark", "man", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
-	"👩\u200d💼": {"architect", "body", "business", "collar", "manager", "office", "people", "person", "role", "white", "woman", "worker"},
-	"👩🏻\u200d💼": {"architect", "body", "business", "collar", "light", "manager", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
-	"👩🏼\u200d💼": {"architect", "body", "business", "collar", "light", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
-	"👩🏽\u200d💼": {"architect", "body", "business", "collar", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
-	"👩🏾\u200d💼": {"architect", "body", "business", "collar", "dark", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
-	"👩🏿\u200d💼": {"architect", "body", "business", "collar", "dark", "manager", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
-	"🧑\u200d🔬": {"biologist", "body", "chemist", "engineer", "people", "person", "physicist", "role", "scientist"},
-	"🧑🏻\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"🧑🏼\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"🧑🏽\u200d🔬": {"biologist", "body", "chemist", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"🧑🏾\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"🧑🏿\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👨\u200d🔬": {"biologist", "body", "chemist", "engineer", "man", "people", "person", "physicist", "role", "scientist"},
-	"👨🏻\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "man", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👨🏼\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👨🏽\u200d🔬": {"biologist", "body", "chemist", "engineer", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👨🏾\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👨🏿\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "man", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
-	"👩\u200d🔬": {"biologist", "body", "chemist", "engineer", "people", "person", "physicist", "role", "scientist", "woman"},
-	"👩🏻\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
-	"👩🏼\u200d🔬": {"biologist", "body", "chemist", "engineer", "light", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
-	"👩🏽\u200d🔬": {"biologist", "body", "chemist", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
-	"👩🏾\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
-	"👩🏿\u200d🔬": {"biologist", "body", "chemist", "dark", "engineer", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
-	"🧑\u200d💻": {"body", "coder", "developer", "inventor", "people", "person", "role", "software", "technologist"},
-	"🧑🏻\u200d💻": {"body", "coder", "developer", "inventor", "light", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"🧑🏼\u200d💻": {"body", "coder", "developer", "inventor", "light", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"🧑🏽\u200d💻": {"body", "coder", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"🧑🏾\u200d💻": {"body", "coder", "dark", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"🧑🏿\u200d💻": {"body", "coder", "dark", "developer", "inventor", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👨\u200d💻": {"body", "coder", "developer", "inventor", "man", "people", "person", "role", "software", "technologist"},
-	"👨🏻\u200d💻": {"body", "coder", "developer", "inventor", "light", "man", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👨🏼\u200d💻": {"body", "coder", "developer", "inventor", "light", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👨🏽\u200d💻": {"body", "coder", "developer", "inventor", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👨🏾\u200d💻": {"body", "coder", "dark", "developer", "inventor", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👨🏿\u200d💻": {"body", "coder", "dark", "developer", "inventor", "man", "people", "person", "role", "skin", "software", "technologist", "tone"},
-	"👩\u200d💻": {"body", "coder", "developer", "inventor", "people", "person", "role", "software", "technologist", "woman"},
-	"👩🏻\u200d💻": {"body", "coder", "developer", "inventor", "light", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
-	"👩🏼\u200d💻": {"body", "coder", "developer", "inventor", "light", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
-	"👩🏽\u200d💻": {"body", "coder", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
-	"👩🏾\u200d💻": {"body", "coder", "dark", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
-	"👩🏿\u200d💻": {"body", "coder", "dark", "developer", "inventor", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
-	"🧑\u200d🎤": {"actor", "body", "entertainer", "people", "person", "rock", "role", "singer", "star"},
-	"🧑🏻\u200d🎤": {"actor", "body", "entertainer", "light", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"🧑🏼\u200d🎤": {"actor", "body", "entertainer", "light", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"🧑🏽\u200d🎤": {"actor", "body", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"🧑🏾\u200d🎤": {"actor", "body", "dark", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"🧑🏿\u200d🎤": {"actor", "body", "dark", "entertainer", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👨\u200d🎤": {"actor", "body", "entertainer", "man", "people", "person", "rock", "role", "singer", "star"},
-	"👨🏻\u200d🎤": {"actor", "body", "entertainer", "light", "man", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👨🏼\u200d🎤": {"actor", "body", "entertainer", "light", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👨🏽\u200d🎤": {"actor", "body", "entertainer", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👨🏾\u200d🎤": {"actor", "body", "dark", "entertainer", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👨🏿\u200d🎤": {"actor", "body", "dark", "entertainer", "man", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
-	"👩\u200d🎤": {"actor", "body", "entertainer", "people", "person", "rock", "role", "singer", "star", "woman"},
-	"👩🏻\u200d🎤": {"actor", "body", "entertainer", "light", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
-	"👩🏼\u200d🎤": {"actor", "body", "entertainer", "light", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
-	"👩🏽\u200d🎤": {"actor", "body", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
-	"👩🏾\u200d🎤": {"actor", "body", "dark", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
-	"👩🏿\u200d🎤": {"actor", "body", "dark", "entertainer", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
-	"🧑\u200d🎨": {"artist", "body", "palette", "people", "person", "role"},
-	"🧑🏻\u200d🎨": {"artist", "body", "light", "palette", "people", "person", "role", "skin", "tone"},
-	"🧑🏼\u200d🎨": {"artist", "body", "light", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"🧑🏽\u200d🎨": {"artist", "body", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"🧑🏾\u200d🎨": {"artist", "body", "dark", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"🧑🏿\u200d🎨": {"artist", "body", "dark", "palette", "people", "person", "role", "skin", "tone"},
-	"👨\u200d🎨": {"artist", "body", "man", "palette", "people", "person", "role"},
-	"👨🏻\u200d🎨": {"artist", "body", "light", "man", "palette", "people", "person", "role", "skin", "tone"},
-	"👨🏼\u200d🎨": {"artist", "body", "light", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"👨🏽\u200d🎨": {"artist", "body", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"👨🏾\u200d🎨": {"artist", "body", "dark", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
-	"👨🏿\u200d🎨": {"artist", "body", "dark", "man", "palette", "people", "person", "role", "skin", "tone"},
-	"👩\u200d🎨": {"artist", "body", "palette", "people", "person", "role", "woman"},
-	"👩🏻\u200d🎨": {"artist", "body", "light", "palette", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🎨": {"artist", "body", "light", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🎨": {"artist", "body", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🎨": {"artist", "body", "dark", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🎨": {"artist", "body", "dark", "palette", "people", "person", "role", "skin", "tone", "woman"},
-	"🧑\u200d✈️": {"body", "people", "person", "pilot", "plane", "role"},
-	"🧑🏻\u200d✈️": {"body", "light", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"🧑🏼\u200d✈️": {"body", "light", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"🧑🏽\u200d✈️": {"body", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"🧑🏾\u200d✈️": {"body", "dark", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"🧑🏿\u200d✈️": {"body", "dark", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👨\u200d✈️": {"body", "man", "people", "person", "pilot", "plane", "role"},
-	"👨🏻\u200d✈️": {"body", "light", "man", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👨🏼\u200d✈️": {"body", "light", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👨🏽\u200d✈️": {"body", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👨🏾\u200d✈️": {"body", "dark", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👨🏿\u200d✈️": {"body", "dark", "man", "people", "person", "pilot", "plane", "role", "skin", "tone"},
-	"👩\u200d✈️": {"body", "people", "person", "pilot", "plane", "role", "woman"},
-	"👩🏻\u200d✈️": {"body", "light", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d✈️": {"body", "light", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d✈️": {"body", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d✈️": {"body", "dark", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d✈️": {"body", "dark", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
-	"🧑\u200d🚀": {"astronaut", "body", "people", "person", "rocket", "role"},
-	"🧑🏻\u200d🚀": {"astronaut", "body", "light", "people", "person", "rocket", "role", "skin", "tone"},
-	"🧑🏼\u200d🚀": {"astronaut", "body", "light", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"🧑🏽\u200d🚀": {"astronaut", "body", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"🧑🏾\u200d🚀": {"astronaut", "body", "dark", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"🧑🏿\u200d🚀": {"astronaut", "body", "dark", "people", "person", "rocket", "role", "skin", "tone"},
-	"👨\u200d🚀": {"astronaut", "body", "man", "people", "person", "rocket", "role"},
-	"👨🏻\u200d🚀": {"astronaut", "body", "light", "man", "people", "person", "rocket", "role", "skin", "tone"},
-	"👨🏼\u200d🚀": {"astronaut", "body", "light", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"👨🏽\u200d🚀": {"astronaut", "body", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"👨🏾\u200d🚀": {"astronaut", "body", "dark", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
-	"👨🏿\u200d🚀": {"astronaut", "body", "dark", "man", "people", "person", "rocket", "role", "skin", "tone"},
-	"👩\u200d🚀": {"astronaut", "body", "people", "person", "rocket", "role", "woman"},
-	"👩🏻\u200d🚀": {"astronaut", "body", "light", "people", "person", "rocket", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🚀": {"astronaut", "body", "light", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🚀": {"astronaut", "body", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🚀": {"astronaut", "body", "dark", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🚀": {"astronaut", "body", "dark", "people", "person", "rocket", "role", "skin", "tone", "woman"},
-	"🧑\u200d🚒": {"body", "firefighter", "firetruck", "people", "person", "role"},
-	"🧑🏻\u200d🚒": {"body", "firefighter", "firetruck", "light", "people", "person", "role", "skin", "tone"},
-	"🧑🏼\u200d🚒": {"body", "firefighter", "firetruck", "light", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏽\u200d🚒": {"body", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏾\u200d🚒": {"body", "dark", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone"},
-	"🧑🏿\u200d🚒": {"body", "dark", "firefighter", "firetruck", "people", "person", "role", "skin", "tone"},
-	"👨\u200d🚒": {"body", "firefighter", "firetruck", "man", "people", "person", "role"},
-	"👨🏻\u200d🚒": {"body", "firefighter", "firetruck", "light", "man", "people", "person", "role", "skin", "tone"},
-	"👨🏼\u200d🚒": {"body", "firefighter", "firetruck", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏽\u200d🚒": {"body", "firefighter", "firetruck", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏾\u200d🚒": {"body", "dark", "firefighter", "firetruck", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"👨🏿\u200d🚒": {"body", "dark", "firefighter", "firetruck", "man", "people", "person", "role", "skin", "tone"},
-	"👩\u200d🚒": {"body", "firefighter", "firetruck", "people", "person", "role", "woman"},
-	"👩🏻\u200d🚒": {"body", "firefighter", "firetruck", "light", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🚒": {"body", "firefighter", "firetruck", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🚒": {"body", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🚒": {"body", "dark", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🚒": {"body", "dark", "firefighter", "firetruck", "people", "person", "role", "skin", "tone", "woman"},
-	"👮": {"body", "cop", "officer", "people", "person", "police", "role"},
-	"👮🏻": {"body", "cop", "light", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏼": {"body", "cop", "light", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏽": {"body", "cop", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏾": {"body", "cop", "dark", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏿": {"body", "cop", "dark", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮\u200d♂️": {"body", "cop", "man", "officer", "people", "person", "police", "role"},
-	"👮🏻\u200d♂️": {"body", "cop", "light", "man", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏼\u200d♂️": {"body", "cop", "light", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏽\u200d♂️": {"body", "cop", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏾\u200d♂️": {"body", "cop", "dark", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮🏿\u200d♂️": {"body", "cop", "dark", "man", "officer", "people", "person", "police", "role", "skin", "tone"},
-	"👮\u200d♀️": {"body", "cop", "officer", "people", "person", "police", "role", "woman"},
-	"👮🏻\u200d♀️": {"body", "cop", "light", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
-	"👮🏼\u200d♀️": {"body", "cop", "light", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
-	"👮🏽\u200d♀️": {"body", "cop", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
-	"👮🏾\u200d♀️": {"body", "cop", "dark", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
-	"👮🏿\u200d♀️": {"body", "cop", "dark", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
-	"🕵️": {"body", "detective", "people", "person", "role", "sleuth", "spy"},
-	"🕵🏻": {"body", "detective", "light", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏼": {"body", "detective", "light", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏽": {"body", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏾": {"body", "dark", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏿": {"body", "dark", "detective", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵️\u200d♂️": {"body", "detective", "man", "people", "person", "role", "sleuth", "spy"},
-	"🕵🏻\u200d♂️": {"body", "detective", "light", "man", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏼\u200d♂️": {"body", "detective", "light", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏽\u200d♂️": {"body", "detective", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏾\u200d♂️": {"body", "dark", "detective", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵🏿\u200d♂️": {"body", "dark", "detective", "man", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
-	"🕵️\u200d♀️": {"body", "detective", "people", "person", "role", "sleuth", "spy", "woman"},
-	"🕵🏻\u200d♀️": {"body", "detective", "light", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
-	"🕵🏼\u200d♀️": {"body", "detective", "light", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
-	"🕵🏽\u200d♀️": {"body", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
-	"🕵🏾\u200d♀️": {"body", "dark", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
-	"🕵🏿\u200d♀️": {"body", "dark", "detective", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
-	"💂": {"body", "guard", "people", "person", "role"},
-	"💂🏻": {"body", "guard", "light", "people", "person", "role", "skin", "tone"},
-	"💂🏼": {"body", "guard", "light", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏽": {"body", "guard", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏾": {"body", "dark", "guard", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏿": {"body", "dark", "guard", "people", "person", "role", "skin", "tone"},
-	"💂\u200d♂️": {"body", "guard", "man", "people", "person", "role"},
-	"💂🏻\u200d♂️": {"body", "guard", "light", "man", "people", "person", "role", "skin", "tone"},
-	"💂🏼\u200d♂️": {"body", "guard", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏽\u200d♂️": {"body", "guard", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏾\u200d♂️": {"body", "dark", "guard", "man", "medium", "people", "person", "role", "skin", "tone"},
-	"💂🏿\u200d♂️": {"body", "dark", "guard", "man", "people", "person", "role", "skin", "tone"},
-	"💂\u200d♀️": {"body", "guard", "people", "person", "role", "woman"},
-	"💂🏻\u200d♀️": {"body", "guard", "light", "people", "person", "role", "skin", "tone", "woman"},
-	"💂🏼\u200d♀️": {"body", "guard", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"💂🏽\u200d♀️": {"body", "guard", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"💂🏾\u200d♀️": {"body", "dark", "guard", "medium", "people", "person", "role", "skin", "tone", "woman"},
-	"💂🏿\u200d♀️": {"body", "dark", "guard", "people", "person", "role", "skin", "tone", "woman"},
-	"🥷": {"body", "fighter", "hidden", "ninja", "people", "person", "role", "stealth"},
-	"🥷🏻": {"body", "fighter", "hidden", "light", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
-	"🥷🏼": {"body", "fighter", "hidden", "light", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
-	"🥷🏽": {"body", "fighter", "hidden", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
-	"🥷🏾": {"body", "dark", "fighter", "hidden", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
-	"🥷🏿": {"body", "dark", "fighter", "hidden", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
-	"👷": {"body", "construction", "hat", "people", "person", "role", "worker"},
-	"👷🏻": {"body", "construction", "hat", "light", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏼": {"body", "construction", "hat", "light", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏽": {"body", "construction", "hat", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏾": {"body", "construction", "dark", "hat", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏿": {"body", "construction", "dark", "hat", "people", "person", "role", "skin", "tone", "worker"},
-	"👷\u200d♂️": {"body", "construction", "man", "people", "person", "role", "worker"},
-	"👷🏻\u200d♂️": {"body", "construction", "light", "man", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏼\u200d♂️": {"body", "construction", "light", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏽\u200d♂️": {"body", "construction", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏾\u200d♂️": {"body", "construction", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
-	"👷🏿\u200d♂️": {"body", "construction", "dark", "man", "people", "person", "role", "skin", "tone", "worker"},
-	"👷\u200d♀️": {"body", "construction", "people", "person", "role", "woman", "worker"},
-	"👷🏻\u200d♀️": {"body", "construction", "light", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👷🏼\u200d♀️": {"body", "construction", "light", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👷🏽\u200d♀️": {"body", "construction", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👷🏾\u200d♀️": {"body", "construction", "dark", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"👷🏿\u200d♀️": {"body", "construction", "dark", "people", "person", "role", "skin", "tone", "woman", "worker"},
-	"🫅": {"body", "crown", "monarch", "noble", "people", "person", "regal", "role", "royalty", "with"},
-	"🫅🏻": {"body", "crown", "light", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
-	"🫅🏼": {"body", "crown", "light", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
-	"🫅🏽": {"body", "crown", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
-	"🫅🏾": {"body", "crown", "dark", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
-	"🫅🏿": {"body", "crown", "dark", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
-	"🤴": {"body", "people", "person", "prince", "role"},
-	"🤴🏻": {"body", "light", "people", "person", "prince", "role", "skin", "tone"},
-	"🤴🏼": {"body", "light", "medium", "people", "person", "prince", "role", "skin", "tone"},
-	"🤴🏽": {"body", "medium", "people", "person", "prince", "role", "skin", "tone"},
-	"🤴🏾": {"body", "dark", "medium", "people", "person", "prince", "role", "skin", "tone"},
-	"🤴🏿": {"body", "dark", "people", "person", "prince", "role", "skin", "tone"},
-	"👸": {"body", "fairy", "fantasy", "people", "person", "princess", "role", "tale"},
-	"👸🏻": {"body", "fairy", "fantasy", "light", "people", "person", "princess", "role", "skin", "tale", "tone"},
-	"👸🏼": {"body", "fairy", "fantasy", "light", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
-	"👸🏽": {"body", "fairy", "fantasy", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
-	"👸🏾": {"body", "dark", "fairy", "fantasy", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
-	"👸🏿": {"body", "dark", "fairy", "fantasy", "people", "person", "princess", "role", "skin", "tale", "tone"},
-	"👳": {"body", "people", "person", "role", "turban", "wearing"},
-	"👳🏻": {"body", "light", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏼": {"body", "light", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏽": {"body", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏾": {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏿": {"body", "dark", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳\u200d♂️": {"body", "man", "people", "person", "role", "turban", "wearing"},
-	"👳🏻\u200d♂️": {"body", "light", "man", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏼\u200d♂️": {"body", "light", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏽\u200d♂️": {"body", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏾\u200d♂️": {"body", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳🏿\u200d♂️": {"body", "dark", "man", "people", "person", "role", "skin", "tone", "turban", "wearing"},
-	"👳\u200d♀️": {"body", "people", "person", "role", "turban", "wearing", "woman"},
-	"👳🏻\u200d♀️": {"body", "light", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
-	"👳🏼\u200d♀️": {"body", "light", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
-	"👳🏽\u200d♀️": {"body", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
-	"👳🏾\u200d♀️": {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
-	"👳🏿\u200d♀️": {"body", "dark", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
-	"👲": {"body", "cap", "gua", "hat", "mao", "people", "person", "pi", "role", "skullcap", "with"},
-	"👲🏻": {"body", "cap", "gua", "hat", "light", "mao", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
-	"👲🏼": {"body", "cap", "gua", "hat", "light", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
-	"👲🏽": {"body", "cap", "gua", "hat", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
-	"👲🏾": {"body", "cap", "dark", "gua", "hat", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
-	"👲🏿": {"body", "cap", "dark", "gua", "hat", "mao", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
-	"🧕": {"body", "headscarf", "hijab", "mantilla", "people", "person", "role", "tichel", "with", "woman"},
-	"🧕🏻": {"body", "headscarf", "hijab", "light", "mantilla", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
-	"🧕🏼": {"body", "headscarf", "hijab", "light", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
-	"🧕🏽": {"body", "headscarf", "hijab", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
-	"🧕🏾": {"body", "dark", "headscarf", "hijab", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
-	"🧕🏿": {"body", "dark", "headscarf", "hijab", "mantilla", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
-	"🤵": {"body", "groom", "in", "people", "person", "role", "tuxedo"},
-	"🤵🏻": {"body", "groom", "in", "light", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏼": {"body", "groom", "in", "light", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏽": {"body", "groom", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏾": {"body", "dark", "groom", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏿": {"body", "dark", "groom", "in", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵\u200d♂️": {"body", "in", "man", "people", "person", "role", "tuxedo"},
-	"🤵🏻\u200d♂️": {"body", "in", "light", "man", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏼\u200d♂️": {"body", "in", "light", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏽\u200d♂️": {"body", "in", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏾\u200d♂️": {"body", "dark", "in", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵🏿\u200d♂️": {"body", "dark", "in", "man", "people", "person", "role", "skin", "tone", "tuxedo"},
-	"🤵\u200d♀️": {"body", "in", "people", "person", "role", "tuxedo", "woman"},
-	"🤵🏻\u200d♀️": {"body", "in", "light", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
-	"🤵🏼\u200d♀️": {"body", "in", "light", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
-	"🤵🏽\u200d♀️": {"body", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
-	"🤵🏾\u200d♀️": {"body", "dark", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
-	"🤵🏿\u200d♀️": {"body", "dark", "in", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
-	"👰": {"body", "bride", "people", "person", "role", "veil", "wedding", "with"},
-	"👰🏻": {"body", "bride", "light", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
-	"👰🏼": {"body", "bride", "light", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
-	"👰🏽": {"body", "bride", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
-	"👰🏾": {"body", "bride", "dark", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
-	"👰🏿": {"body", "bride", "dark", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
-	"👰\u200d♂️": {"body", "man", "people", "person", "role", "veil", "with"},
-	"👰🏻\u200d♂️": {"body", "light", "man", "people", "person", "role", "skin", "tone", "veil", "with"},
-	"👰🏼\u200d♂️": {"body", "light", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
-	"👰🏽\u200d♂️": {"body", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
-	"👰🏾\u200d♂️": {"body", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
-	"👰🏿\u200d♂️": {"body", "dark", "man", "people", "person", "role", "skin", "tone", "veil", "with"},
-	"👰\u200d♀️": {"body", "people", "person", "role", "veil", "with", "woman"},
-	"👰🏻\u200d♀️": {"body", "light", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
-	"👰🏼\u200d♀️": {"body", "light", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
-	"👰🏽\u200d♀️": {"body", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
-	"👰🏾\u200d♀️": {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
-	"👰🏿\u200d♀️": {"body", "dark", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
-	"🤰": {"body", "people", "person", "pregnant", "role", "woman"},
-	"🤰🏻": {"body", "light", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
-	"🤰🏼": {"body", "light", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
-	"🤰🏽": {"body", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
-	"🤰🏾": {"body", "dark", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
-	"🤰🏿": {"body", "dark", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
-	"🫃": {"belly", "bloated", "body", "full", "man", "people", "person", "pregnant", "role"},
-	"🫃🏻": {"belly", "bloated", "body", "full", "light", "man", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫃🏼": {"belly", "bloated", "body", "full", "light", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫃🏽": {"belly", "bloated", "body", "full", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫃🏾": {"belly", "bloated", "body", "dark", "full", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫃🏿": {"belly", "bloated", "body", "dark", "full", "man", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫄": {"belly", "bloated", "body", "full", "people", "person", "pregnant", "role"},
-	"🫄🏻": {"belly", "bloated", "body", "full", "light", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫄🏼": {"belly", "bloated", "body", "full", "light", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫄🏽": {"belly", "bloated", "body", "full", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫄🏾": {"belly", "bloated", "body", "dark", "full", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🫄🏿": {"belly", "bloated", "body", "dark", "full", "people", "person", "pregnant", "role", "skin", "tone"},
-	"🤱": {"baby", "body", "breast", "feeding", "nursing", "people", "person", "role"},
-	"🤱🏻": {"baby", "body", "breast", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone"},
-	"🤱🏼": {"baby", "body", "breast", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🤱🏽": {"baby", "body", "breast", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🤱🏾": {"baby", "body", "breast", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🤱🏿": {"baby", "body", "breast", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone"},
-	"👩\u200d🍼": {"baby", "body", "feeding", "nursing", "people", "person", "role", "woman"},
-	"👩🏻\u200d🍼": {"baby", "body", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏼\u200d🍼": {"baby", "body", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏽\u200d🍼": {"baby", "body", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏾\u200d🍼": {"baby", "body", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
-	"👩🏿\u200d🍼": {"baby", "body", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone", "woman"},
-	"👨\u200d🍼": {"baby", "body", "feeding", "man", "nursing", "people", "person", "role"},
-	"👨🏻\u200d🍼": {"baby", "body", "feeding", "light", "man", "nursing", "people", "person", "role", "skin", "tone"},
-	"👨🏼\u200d🍼": {"baby", "body", "feeding", "light", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"👨🏽\u200d🍼": {"baby", "body", "feeding", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"👨🏾\u200d🍼": {"baby", "body", "dark", "feeding", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"👨🏿\u200d🍼": {"baby", "body", "dark", "feeding", "man", "nursing", "people", "person", "role", "skin", "tone"},
-	"🧑\u200d🍼": {"baby", "body", "feeding", "nursing", "people", "person", "role"},
-	"🧑🏻\u200d🍼": {"baby", "body", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone"},
-	"🧑🏼\u200d🍼": {"baby", "body", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🧑🏽\u200d🍼": {"baby", "body", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🧑🏾\u200d🍼": {"baby", "body", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
-	"🧑🏿\u200d🍼": {"baby", "body", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone"},
-	"👼": {"angel", "baby", "body", "face", "fairy", "fantasy", "people", "person", "tale"},
-	"👼🏻": {"angel", "baby", "body", "face", "fairy", "fantasy", "light", "people", "person", "skin", "tale", "tone"},
-	"👼🏼": {"angel", "baby", "body", "face", "fairy", "fantasy", "light", "medium", "people", "person", "skin", "tale", "tone"},
-	"👼🏽": {"angel", "baby", "body", "face", "fairy", "fantasy", "medium", "people", "person", "skin", "tale", "tone"},
-	"👼🏾": {"angel", "baby", "body", "dark", "face", "fairy", "fantasy", "medium", "people", "person", "skin", "tale", "tone"},
-	"👼🏿": {"angel", "baby", "body", "dark", "face", "fairy", "fantasy", "people", "person", "skin", "tale", "tone"},
-	"🎅": {"body", "celebration", "christmas", "claus", "fantasy", "father", "people", "person", "santa"},
-	"🎅🏻": {"body", "celebration", "christmas", "claus", "fantasy", "father", "light", "people", "person", "santa", "skin", "tone"},
-	"🎅🏼": {"body", "celebration", "christmas", "claus", "fantasy", "father", "light", "medium", "people", "person", "santa", "skin", "tone"},
-	"🎅🏽": {"body", "celebration", "christmas", "claus", "fantasy", "father", "medium", "people", "person", "santa", "skin", "tone"},
-	"🎅🏾": {"body", "celebration", "christmas", "claus", "dark", "fantasy", "father", "medium", "people", "person", "santa", "skin", "tone"},
-	"🎅🏿": {"body", "celebration", "christmas", "claus", "dark", "fantasy", "father", "people", "person", "santa", "skin", "tone"},
-	"🤶": {"body", "celebration", "christmas", "claus", "fantasy", "mother", "mrs", "people", "person"},
-	"🤶🏻": {"body", "celebration", "christmas", "claus", "fantasy", "light", "mother", "mrs", "people", "person", "skin", "tone"},
-	"🤶🏼": {"body", "celebration", "christmas", "claus", "fantasy", "light", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
-	"🤶🏽": {"body", "celebration", "christmas", "claus", "fantasy", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
-	"🤶🏾": {"body", "celebration", "christmas", "claus", "dark", "fantasy", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
-	"🤶🏿": {"body", "celebration", "christmas", "claus", "dark", "fantasy", "mother", "mrs", "people", "person", "skin", "tone"},
-	"🧑\u200d🎄": {"body", "christmas", "claus", "fantasy", "mx", "people", "person"},
-	"🧑🏻\u200d🎄": {"body", "christmas", "claus", "fantasy", "light", "mx", "people", "person", "skin", "tone"},
-	"🧑🏼\u200d🎄": {"body", "christmas", "claus", "fantasy", "light", "medium", "mx", "people", "person", "skin", "tone"},
-	"🧑🏽\u200d🎄": {"body", "christmas", "claus", "fantasy", "medium", "mx", "people", "person", "skin", "tone"},
-	"🧑🏾\u200d🎄": {"body", "christmas", "claus", "dark", "fantasy", "medium", "mx", "people", "person", "skin", "tone"},
-	"🧑🏿\u200d🎄": {"body", "christmas", "claus", "dark", "fantasy", "mx", "people", "person", "skin", "tone"},
-	"🦸": {"body", "fantasy", "good", "hero", "heroine", "people", "person", "superhero", "superpower"},
-	"🦸🏻": {"body", "fantasy", "good", "hero", "heroine", "light", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏼": {"body", "fantasy", "good", "hero", "heroine", "light", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏽": {"body", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏾": {"body", "dark", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏿": {"body", "dark", "fantasy", "good", "hero", "heroine", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸\u200d♂️": {"body", "fantasy", "good", "hero", "man", "people", "person", "superhero", "superpower"},
-	"🦸🏻\u200d♂️": {"body", "fantasy", "good", "hero", "light", "man", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏼\u200d♂️": {"body", "fantasy", "good", "hero", "light", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏽\u200d♂️": {"body", "fantasy", "good", "hero", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏾\u200d♂️": {"body", "dark", "fantasy", "good", "hero", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸🏿\u200d♂️": {"body", "dark", "fantasy", "good", "hero", "man", "people", "person", "skin", "superhero", "superpower", "tone"},
-	"🦸\u200d♀️": {"body", "fantasy", "good", "hero", "heroine", "people", "person", "superhero", "superpower", "woman"},
-	"🦸🏻\u200d♀️": {"body", "fantasy", "good", "hero", "heroine", "light", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
-	"🦸🏼\u200d♀️": {"body", "fantasy", "good", "hero", "heroine", "light", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
-	"🦸🏽\u200d♀️": {"body", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
-	"🦸🏾\u200d♀️": {"body", "dark", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
-	"🦸🏿\u200d♀️": {"body", "dark", "fantasy", "good", "hero", "heroine", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
-	"🦹": {"body", "criminal", "evil", "fantasy", "people", "person", "superpower", "supervillain", "villain"},
-	"🦹🏻": {"body", "criminal", "evil", "fantasy", "light", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏼": {"body", "criminal", "evil", "fantasy", "light", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏽": {"body", "criminal", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏾": {"body", "criminal", "dark", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏿": {"body", "criminal", "dark", "evil", "fantasy", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹\u200d♂️": {"body", "criminal", "evil", "fantasy", "man", "people", "person", "superpower", "supervillain", "villain"},
-	"🦹🏻\u200d♂️": {"body", "criminal", "evil", "fantasy", "light", "man", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏼\u200d♂️": {"body", "criminal", "evil", "fantasy", "light", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏽\u200d♂️": {"body", "criminal", "evil", "fantasy", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏾\u200d♂️": {"body", "criminal", "dark", "evil", "fantasy", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹🏿\u200d♂️": {"body", "criminal", "dark", "evil", "fantasy", "man", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
-	"🦹\u200d♀️": {"body", "criminal", "evil", "fantasy", "people", "person", "superpower", "supervillain", "villain", "woman"},
-	"🦹🏻\u200d♀️": {"body", "criminal", "evil", "fantasy", "light", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
-	"🦹🏼\u200d♀️": {"body", "criminal", "evil", "fantasy", "light", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
-	"🦹🏽\u200d♀️": {"body", "criminal", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
-	"🦹🏾\u200d♀️": {"body", "criminal", "dark", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
-	"🦹🏿\u200d♀️": {"body", "criminal", "dark", "evil", "fantasy", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
-	"🧙": {"body", "fantasy", "mage", "people", "person", "sorcerer", "sorceress", "witch", "wizard"},
-	"🧙🏻": {"body", "fantasy", "light", "mage", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
-	"🧙🏼": {"body", "fantasy", "light", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
-	"🧙🏽": {"body", "fantasy", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
-	"🧙🏾": {"body", "dark", "fantasy", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
-	"🧙🏿": {"body", "dark", "fantasy", "mage", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
-	"🧙\u200d♂️": {"body", "fantasy", "mage", "man", "people", "person", "sorcerer", "wizard"},
-	"🧙🏻\u200d♂️": {"body", "fantasy", "light", "mage", "man", "people", "person", "skin", "sorcerer", "tone", "wizard"},
-	"🧙🏼\u200d♂️": {"body", "fantasy", "light", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
-	"🧙🏽\u200d♂️": {"body", "fantasy", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
-	"🧙🏾\u200d♂️": {"body", "dark", "fantasy", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
-	"🧙🏿\u200d♂️": {"body", "dark", "fantasy", "mage", "man", "people", "person", "skin", "sorcerer", "tone", "wizard"},
-	"🧙\u200d♀️": {"body", "fantasy", "mage", "people", "person", "sorceress", "witch", "woman"},
-	"🧙🏻\u200d♀️": {"body", "fantasy", "light", "mage", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
-	"🧙🏼\u200d♀️": {"body", "fantasy", "light", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
-	"🧙🏽\u200d♀️": {"body", "fantasy", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
-	"🧙🏾\u200d♀️": {"body", "dark", "fantasy", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
-	"🧙🏿\u200d♀️": {"body", "dark", "fantasy", "mage", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
-	"🧚": {"body", "fairy", "fantasy", "oberon", "people", "person", "puck", "titania"},
-	"🧚🏻": {"body", "fairy", "fantasy", "light", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
-	"🧚🏼": {"body", "fairy", "fantasy", "light", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
-	"🧚🏽": {"body", "fairy", "fantasy", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
-	"🧚🏾": {"body", "dark", "fairy", "fantasy", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
-	"🧚🏿": {"body", "dark", "fairy", "fantasy", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
-	"🧚\u200d♂️": {"body", "fairy", "fantasy", "man", "oberon", "people", "person", "puck"},
-	"🧚🏻\u200d♂️": {"body", "fairy", "fantasy", "light", "man", "oberon", "people", "person", "puck", "skin", "tone"},
-	"🧚🏼\u200d♂️": {"body", "fairy", "fantasy", "light", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
-	"🧚🏽\u200d♂️": {"body", "fairy", "fantasy", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
-	"🧚🏾\u200d♂️": {"body", "dark", "fairy", "fantasy", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
-	"🧚🏿\u200d♂️": {"body", "dark", "fairy", "fantasy", "man", "oberon", "people", "person", "puck", "skin", "tone"},
-	"🧚\u200d♀️": {"body", "fairy", "fantasy", "people", "person", "titania", "woman"},
-	"🧚🏻\u200d♀️": {"body", "fairy", "fantasy", "light", "people", "person", "skin", "titania", "tone", "woman"},
-	"🧚🏼\u200d♀️": {"body", "fairy", "fantasy", "light", "medium", "people", "person", "skin", "titania", "tone", "woman"},
-	"🧚🏽\u200d♀️": {"body", "fairy", "fantasy", "medium", "people", "person", "skin", "titania", "tone", "woman"},
-	"🧚🏾\u200d♀️": {"body", "dark", "fairy", "fantasy", "medium", "people", "person", "skin", "titania", "tone", "woman"},
-	"🧚🏿\u200d♀️": {"body", "dark", "fairy", "fantasy", "people", "person", "skin", "titania", "tone", "woman"},
-	"🧛": {"body", "dracula", "fantasy", "people", "person", "undead", "vampire"},
-	"🧛🏻": {"body", "dracula", "fantasy", "light", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏼": {"body", "dracula", "fantasy", "light", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏽": {"body", "dracula", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏾": {"body", "dark", "dracula", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏿": {"body", "dark", "dracula", "fantasy", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛\u200d♂️": {"body", "dracula", "fantasy", "man", "people", "person", "undead", "vampire"},
-	"🧛🏻\u200d♂️": {"body", "dracula", "fantasy", "light", "man", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏼\u200d♂️": {"body", "dracula", "fantasy", "light", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏽\u200d♂️": {"body", "dracula", "fantasy", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏾\u200d♂️": {"body", "dark", "dracula", "fantasy", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛🏿\u200d♂️": {"body", "dark", "dracula", "fantasy", "man", "people", "person", "skin", "tone", "undead", "vampire"},
-	"🧛\u200d♀️": {"body", "fantasy", "people", "person", "undead", "vampire", "woman"},
-	"🧛🏻\u200d♀️": {"body", "fantasy", "light", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
-	"🧛🏼\u200d♀️": {"body", "fantasy", "light", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
-	"🧛🏽\u200d♀️": {"body", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
-	"🧛🏾\u200d♀️": {"body", "dark", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
-	"🧛🏿\u200d♀️": {"body", "dark", "fantasy", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
-	"🧜": {"body", "fantasy", "mermaid", "merman", "merperson", "merwoman", "people", "person"},
-	"🧜🏻": {"body", "fantasy", "light", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏼": {"body", "fantasy", "light", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏽": {"body", "fantasy", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏾": {"body", "dark", "fantasy", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏿": {"body", "dark", "fantasy", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
-	"🧜\u200d♂️": {"body", "fantasy", "merman", "people", "person", "triton"},
-	"🧜🏻\u200d♂️": {"body", "fantasy", "light", "merman", "people", "person", "skin", "tone", "triton"},
-	"🧜🏼\u200d♂️": {"body", "fantasy", "light", "medium", "merman", "people", "person", "skin", "tone", "triton"},
-	"🧜🏽\u200d♂️": {"body", "fantasy", "medium", "merman", "people", "person", "skin", "tone", "triton"},
-	"🧜🏾\u200d♂️": {"body", "dark", "fantasy", "medium", "merman", "people", "person", "skin", "tone", "triton"},
-	"🧜🏿\u200d♂️": {"body", "dark", "fantasy", "merman", "people", "person", "skin", "tone", "triton"},
-	"🧜\u200d♀️": {"body", "fantasy", "mermaid", "merwoman", "people", "person"},
-	"🧜🏻\u200d♀️": {"body", "fantasy", "light", "mermaid", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏼\u200d♀️": {"body", "fantasy", "light", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏽\u200d♀️": {"body", "fantasy", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏾\u200d♀️": {"body", "dark", "fantasy", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
-	"🧜🏿\u200d♀️": {"body", "dark", "fantasy", "mermaid", "merwoman", "people", "person", "skin", "tone"},
-	"🧝": {"body", "elf", "fantasy", "magical", "people", "person"},
-	"🧝🏻": {"body", "elf", "fantasy", "light", "magical", "people", "person", "skin", "tone"},
-	"🧝🏼": {"body", "elf", "fantasy", "light", "magical", "medium", "people", "person", "skin", "tone"},
-	"🧝🏽": {"body", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone"},
-	"🧝🏾": {"body", "dark", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone"},
-	"🧝🏿": {"body", "dark", "elf", "fantasy", "magical", "people", "person", "skin", "tone"},
-	"🧝\u200d♂️": {"body", "elf", "fantasy", "magical", "man", "people", "person"},
-	"🧝🏻\u200d♂️": {"body", "elf", "fantasy", "light", "magical", "man", "people", "person", "skin", "tone"},
-	"🧝🏼\u200d♂️": {"body", "elf", "fantasy", "light", "magical", "man", "medium", "people", "person", "skin", "tone"},
-	"🧝🏽\u200d♂️": {"body", "elf", "fantasy", "magical", "man", "medium", "people", "person", "skin", "tone"},
-	"🧝🏾\u200d♂️": {"body", "dark", "elf", "fantasy", "magical", "man", "medium", "people", "person", "skin", "tone"},
-	"🧝🏿\u200d♂️": {"body", "dark", "elf", "fantasy", "magical", "man", "people", "person", "skin", "tone"},
-	"🧝\u200d♀️": {"body", "elf", "fantasy", "magical", "people", "person", "woman"},
-	"🧝🏻\u200d♀️": {"body", "elf", "fantasy", "light", "magical", "people", "person", "skin", "tone", "woman"},
-	"🧝🏼\u200d♀️": {"body", "elf", "fantasy", "light", "magical", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧝🏽\u200d♀️": {"body", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧝🏾\u200d♀️": {"body", "dark", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧝🏿\u200d♀️": {"body", "dark", "elf", "fantasy", "magical", "people", "person", "skin", "tone", "woman"},
-	"🧞": {"body", "djinn", "fantasy", "genie", "people", "person"},
-	"🧞\u200d♂️": {"body", "djinn", "fantasy", "genie", "man", "people", "person"},
-	"🧞\u200d♀️": {"body", "djinn", "fantasy", "genie", "people", "person", "woman"},
-	"🧟": {"body", "dead", "fantasy", "people", "person", "undead", "walking", "zombie"},
-	"🧟\u200d♂️": {"body", "dead", "fantasy", "man", "people", "person", "undead", "walking", "zombie"},
-	"🧟\u200d♀️": {"body", "dead", "fantasy", "people", "person", "undead", "walking", "woman", "zombie"},
-	"🧌": {"body", "fairy", "fantasy", "monster", "people", "person", "tale", "troll"},
-	"💆": {"activity", "body", "face", "getting", "massage", "people", "person", "salon"},
-	"💆🏻": {"activity", "body", "face", "getting", "light", "massage", "people", "person", "salon", "skin", "tone"},
-	"💆🏼": {"activity", "body", "face", "getting", "light", "massage", "medium", "people", "person", "salon", "skin", "tone"},
-	"💆🏽": {"activity", "body", "face", "getting", "massage", "medium", "people", "person", "salon", "skin", "tone"},
-	"💆🏾": {"activity", "body", "dark", "face", "getting", "massage", "medium", "people", "person", "salon", "skin", "tone"},
-	"💆🏿": {"activity", "body", "dark", "face", "getting", "massage", "people", "person", "salon", "skin", "tone"},
-	"💆\u200d♂️": {"activity", "body", "face", "getting", "man", "massage", "people", "person"},
-	"💆🏻\u200d♂️": {"activity", "body", "face", "getting", "light", "man", "massage", "people", "person", "skin", "tone"},
-	"💆🏼\u200d♂️": {"activity", "body", "face", "getting", "light", "man", "massage", "medium", "people", "person", "skin", "tone"},
-	"💆🏽\u200d♂️": {"activity", "body", "face", "getting", "man", "massage", "medium", "people", "person", "skin", "tone"},
-	"💆🏾\u200d♂️": {"activity", "body", "dark", "face", "getting", "man", "massage", "medium", "people", "person", "skin", "tone"},
-	"💆🏿\u200d♂️": {"activity", "body", "dark", "face", "getting", "man", "massage", "people", "person", "skin", "tone"},
-	"💆\u200d♀️": {"activity", "body", "face", "getting", "massage", "people", "person", "woman"},
-	"💆🏻\u200d♀️": {"activity", "body", "face", "getting", "light", "massage", "people", "person", "skin", "tone", "woman"},
-	"💆🏼\u200d♀️": {"activity", "body", "face", "getting", "light", "massage", "medium", "people", "person", "skin", "tone", "woman"},
-	"💆🏽\u200d♀️": {"activity", "body", "face", "getting", "massage", "medium", "people", "person", "skin", "tone", "woman"},
-	"💆🏾\u200d♀️": {"activity", "body", "dark", "face", "getting", "massage", "medium", "people", "person", "skin", "tone", "woman"},
-	"💆🏿\u200d♀️": {"activity", "body", "dark", "face", "getting", "massage", "people", "person", "skin", "tone", "woman"},
-	"💇": {"activity", "barber", "beauty", "body", "getting", "haircut", "parlor", "people", "person"},
-	"💇🏻": {"activity", "barber", "beauty", "body", "getting", "haircut", "light", "parlor", "people", "person", "skin", "tone"},
-	"💇🏼": {"activity", "barber", "beauty", "body", "getting", "haircut", "light", "medium", "parlor", "people", "person", "skin", "tone"},
-	"💇🏽": {"activity", "barber", "beauty", "body", "getting", "haircut", "medium", "parlor", "people", "person", "skin", "tone"},
-	"💇🏾": {"activity", "barber", "beauty", "body", "dark", "getting", "haircut", "medium", "parlor", "people", "person", "skin", "tone"},
-	"💇🏿": {"activity", "barber", "beauty", "body", "dark", "getting", "haircut", "parlor", "people", "person", "skin", "tone"},
-	"💇\u200d♂️": {"activity", "body", "getting", "haircut", "man", "people", "person"},
-	"💇🏻\u200d♂️": {"activity", "body", "getting", "haircut", "light", "man", "people", "person", "skin", "tone"},
-	"💇🏼\u200d♂️": {"activity", "body", "getting", "haircut", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"💇🏽\u200d♂️": {"activity", "body", "getting", "haircut", "man", "medium", "people", "person", "skin", "tone"},
-	"💇🏾\u200d♂️": {"activity", "body", "dark", "getting", "haircut", "man", "medium", "people", "person", "skin", "tone"},
-	"💇🏿\u200d♂️": {"activity", "body", "dark", "getting", "haircut", "man", "people", "person", "skin", "tone"},
-	"💇\u200d♀️": {"activity", "body", "getting", "haircut", "people", "person", "woman"},
-	"💇🏻\u200d♀️": {"activity", "body", "getting", "haircut", "light", "people", "person", "skin", "tone", "woman"},
-	"💇🏼\u200d♀️": {"activity", "body", "getting", "haircut", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"💇🏽\u200d♀️": {"activity", "body", "getting", "haircut", "medium", "people", "person", "skin", "tone", "woman"},
-	"💇🏾\u200d♀️": {"activity", "body", "dark", "getting", "haircut", "medium", "people", "person", "skin", "tone", "woman"},
-	"💇🏿\u200d♀️": {"activity", "body", "dark", "getting", "haircut", "people", "person", "skin", "tone", "woman"},
-	"🚶": {"activity", "body", "hike", "people", "person", "walk", "walking"},
-	"🚶🏻": {"activity", "body", "hike", "light", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏼": {"activity", "body", "hike", "light", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏽": {"activity", "body", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏾": {"activity", "body", "dark", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏿": {"activity", "body", "dark", "hike", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶\u200d♂️": {"activity", "body", "hike", "man", "people", "person", "walk", "walking"},
-	"🚶🏻\u200d♂️": {"activity", "body", "hike", "light", "man", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏼\u200d♂️": {"activity", "body", "hike", "light", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏽\u200d♂️": {"activity", "body", "hike", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏾\u200d♂️": {"activity", "body", "dark", "hike", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶🏿\u200d♂️": {"activity", "body", "dark", "hike", "man", "people", "person", "skin", "tone", "walk", "walking"},
-	"🚶\u200d♀️": {"activity", "body", "hike", "people", "person", "walk", "walking", "woman"},
-	"🚶🏻\u200d♀️": {"activity", "body", "hike", "light", "people", "person", "skin", "tone", "walk", "walking", "woman"},
-	"🚶🏼\u200d♀️": {"activity", "body", "hike", "light", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
-	"🚶🏽\u200d♀️": {"activity", "body", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
-	"🚶🏾\u200d♀️": {"activity", "body", "dark", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
-	"🚶🏿\u200d♀️": {"activity", "body", "dark", "hike", "people", "person", "skin", "tone", "walk", "walking", "woman"},
-	"🧍": {"activity", "body", "people", "person", "stand", "standing"},
-	"🧍🏻": {"activity", "body", "light", "people", "person", "skin", "stand", "standing", "tone"},
-	"🧍🏼": {"activity", "body", "light", "medium", "people", "person", "skin", "stand", "standing", "tone"},
-	"🧍🏽": {"activity", "body", "medium", "people", "person", "skin", "stand", "standing", "tone"},
-	"🧍🏾": {"activity", "body", "dark", "medium", "people", "person", "skin", "stand", "standing", "tone"},
-	"🧍🏿": {"activity", "body", "dark", "people", "person", "skin", "stand", "standing", "tone"},
-	"🧍\u200d♂️": {"activity", "body", "man", "people", "person", "standing"},
-	"🧍🏻\u200d♂️": {"activity", "body", "light", "man", "people", "person", "skin", "standing", "tone"},
-	"🧍🏼\u200d♂️": {"activity", "body", "light", "man", "medium", "people", "person", "skin", "standing", "tone"},
-	"🧍🏽\u200d♂️": {"activity", "body", "man", "medium", "people", "person", "skin", "standing", "tone"},
-	"🧍🏾\u200d♂️": {"activity", "body", "dark", "man", "medium", "people", "person", "skin", "standing", "tone"},
-	"🧍🏿\u200d♂️": {"activity", "body", "dark", "man", "people", "person", "skin", "standing", "tone"},
-	"🧍\u200d♀️": {"activity", "body", "people", "person", "standing", "woman"},
-	"🧍🏻\u200d♀️": {"activity", "body", "light", "people", "person", "skin", "standing", "tone", "woman"},
-	"🧍🏼\u200d♀️": {"activity", "body", "light", "medium", "people", "person", "skin", "standing", "tone", "woman"},
-	"🧍🏽\u200d♀️": {"activity", "body", "medium", "people", "person", "skin", "standing", "tone", "woman"},
-	"🧍🏾\u200d♀️": {"activity", "body", "dark", "medium", "people", "person", "skin", "standing", "tone", "woman"},
-	"🧍🏿\u200d♀️": {"activity", "body", "dark", "people", "person", "skin", "standing", "tone", "woman"},
-	"🧎": {"activity", "body", "kneel", "kneeling", "people", "person"},
-	"🧎🏻": {"activity", "body", "kneel", "kneeling", "light", "people", "person", "skin", "tone"},
-	"🧎🏼": {"activity", "body", "kneel", "kneeling", "light", "medium", "people", "person", "skin", "tone"},
-	"🧎🏽": {"activity", "body", "kneel", "kneeling", "medium", "people", "person", "skin", "tone"},
-	"🧎🏾": {"activity", "body", "dark", "kneel", "kneeling", "medium", "people", "person", "skin", "tone"},
-	"🧎🏿": {"activity", "body", "dark", "kneel", "kneeling", "people", "person", "skin", "tone"},
-	"🧎\u200d♂️": {"activity", "body", "kneeling", "man", "people", "person"},
-	"🧎🏻\u200d♂️": {"activity", "body", "kneeling", "light", "man", "people", "person", "skin", "tone"},
-	"🧎🏼\u200d♂️": {"activity", "body", "kneeling", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🧎🏽\u200d♂️": {"activity", "body", "kneeling", "man", "medium", "people", "person", "skin", "tone"},
-	"🧎🏾\u200d♂️": {"activity", "body", "dark", "kneeling", "man", "medium", "people", "person", "skin", "tone"},
-	"🧎🏿\u200d♂️": {"activity", "body", "dark", "kneeling", "man", "people", "person", "skin", "tone"},
-	"🧎\u200d♀️": {"activity", "body", "kneeling", "people", "person", "woman"},
-	"🧎🏻\u200d♀️": {"activity", "body", "kneeling", "light", "people", "person", "skin", "tone", "woman"},
-	"🧎🏼\u200d♀️": {"activity", "body", "kneeling", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧎🏽\u200d♀️": {"activity", "body", "kneeling", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧎🏾\u200d♀️": {"activity", "body", "dark", "kneeling", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧎🏿\u200d♀️": {"activity", "body", "dark", "kneeling", "people", "person", "skin", "tone", "woman"},
-	"🧑\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "people", "person", "white", "with"},
-	"🧑🏻\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "people", "person", "skin", "tone", "white", "with"},
-	"🧑🏼\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"🧑🏽\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"🧑🏾\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"🧑🏿\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "people", "person", "skin", "tone", "white", "with"},
-	"👨\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "man", "people", "person", "white", "with"},
-	"👨🏻\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "man", "people", "person", "skin", "tone", "white", "with"},
-	"👨🏼\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"👨🏽\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"👨🏾\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
-	"👨🏿\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "man", "people", "person", "skin", "tone", "white", "with"},
-	"👩\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "people", "person", "white", "with", "woman"},
-	"👩🏻\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "people", "person", "skin", "tone", "white", "with", "woman"},
-	"👩🏼\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "light", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
-	"👩🏽\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
-	"👩🏾\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
-	"👩🏿\u200d🦯": {"accessibility", "activity", "blind", "body", "cane", "dark", "people", "person", "skin", "tone", "white", "with", "woman"},
-	"🧑\u200d🦼": {"accessibility", "activity", "body", "in", "motorized", "people", "person", "wheelchair"},
-	"🧑🏻\u200d🦼": {"accessibility", "activity", "body", "in", "light", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏼\u200d🦼": {"accessibility", "activity", "body", "in", "light", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏽\u200d🦼": {"accessibility", "activity", "body", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏾\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏿\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👨\u200d🦼": {"accessibility", "activity", "body", "in", "man", "motorized", "people", "person", "wheelchair"},
-	"👨🏻\u200d🦼": {"accessibility", "activity", "body", "in", "light", "man", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏼\u200d🦼": {"accessibility", "activity", "body", "in", "light", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏽\u200d🦼": {"accessibility", "activity", "body", "in", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏾\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏿\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "man", "motorized", "people", "person", "skin", "tone", "wheelchair"},
-	"👩\u200d🦼": {"accessibility", "activity", "body", "in", "motorized", "people", "person", "wheelchair", "woman"},
-	"👩🏻\u200d🦼": {"accessibility", "activity", "body", "in", "light", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏼\u200d🦼": {"accessibility", "activity", "body", "in", "light", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏽\u200d🦼": {"accessibility", "activity", "body", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏾\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏿\u200d🦼": {"accessibility", "activity", "body", "dark", "in", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"🧑\u200d🦽": {"accessibility", "activity", "body", "in", "manual", "people", "person", "wheelchair"},
-	"🧑🏻\u200d🦽": {"accessibility", "activity", "body", "in", "light", "manual", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏼\u200d🦽": {"accessibility", "activity", "body", "in", "light", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏽\u200d🦽": {"accessibility", "activity", "body", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏾\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"🧑🏿\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "manual", "people", "person", "skin", "tone", "wheelchair"},
-	"👨\u200d🦽": {"accessibility", "activity", "body", "in", "man", "manual", "people", "person", "wheelchair"},
-	"👨🏻\u200d🦽": {"accessibility", "activity", "body", "in", "light", "man", "manual", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏼\u200d🦽": {"accessibility", "activity", "body", "in", "light", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏽\u200d🦽": {"accessibility", "activity", "body", "in", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏾\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
-	"👨🏿\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "man", "manual", "people", "person", "skin", "tone", "wheelchair"},
-	"👩\u200d🦽": {"accessibility", "activity", "body", "in", "manual", "people", "person", "wheelchair", "woman"},
-	"👩🏻\u200d🦽": {"accessibility", "activity", "body", "in", "light", "manual", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏼\u200d🦽": {"accessibility", "activity", "body", "in", "light", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏽\u200d🦽": {"accessibility", "activity", "body", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏾\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"👩🏿\u200d🦽": {"accessibility", "activity", "body", "dark", "in", "manual", "people", "person", "skin", "tone", "wheelchair", "woman"},
-	"🏃": {"activity", "body", "marathon", "people", "person", "running"},
-	"🏃🏻": {"activity", "body", "light", "marathon", "people", "person", "running", "skin", "tone"},
-	"🏃🏼": {"activity", "body", "light", "marathon", "medium", "people", "person", "running", "skin", "tone"},
-	"🏃🏽": {"activity", "body", "marathon", "medium", "people", "person", "running", "skin", "tone"},
-	"🏃🏾": {"activity", "body", "dark", "marathon", "medium", "people", "person", "running", "skin", "tone"},
-	"🏃🏿": {"activity", "body", "dark", "marathon", "people", "person", "running", "skin", "tone"},
-	"🏃\u200d♂️": {"activity", "body", "man", "marathon", "people", "person", "racing", "running"},
-	"🏃🏻\u200d♂️": {"activity", "body", "light", "man", "marathon", "people", "person", "racing", "running", "skin", "tone"},
-	"🏃🏼\u200d♂️": {"activity", "body", "light", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
-	"🏃🏽\u200d♂️": {"activity", "body", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
-	"🏃🏾\u200d♂️": {"activity", "body", "dark", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
-	"🏃🏿\u200d♂️": {"activity", "body", "dark", "man", "marathon", "people", "person", "racing", "running", "skin", "tone"},
-	"🏃\u200d♀️": {"activity", "body", "marathon", "people", "person", "racing", "running", "woman"},
-	"🏃🏻\u200d♀️": {"activity", "body", "light", "marathon", "people", "person", "racing", "running", "skin", "tone", "woman"},
-	"🏃🏼\u200d♀️": {"activity", "body", "light", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
-	"🏃🏽\u200d♀️": {"activity", "body", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
-	"🏃🏾\u200d♀️": {"activity", "body", "dark", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
-	"🏃🏿\u200d♀️": {"activity", "body", "dark", "marathon", "people", "person", "racing", "running", "skin", "tone", "woman"},
-	"💃": {"activity", "body", "dance", "dancing", "people", "person", "woman"},
-	"💃🏻": {"activity", "body", "dance", "dancing", "light", "people", "person", "skin", "tone", "woman"},
-	"💃🏼": {"activity", "body", "dance", "dancing", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"💃🏽": {"activity", "body", "dance", "dancing", "medium", "people", "person", "skin", "tone", "woman"},
-	"💃🏾": {"activity", "body", "dance", "dancing", "dark", "medium", "people", "person", "skin", "tone", "woman"},
-	"💃🏿": {"activity", "body", "dance", "dancing", "dark", "people", "person", "skin", "tone", "woman"},
-	"🕺": {"activity", "body", "dance", "dancing", "man", "people", "person"},
-	"🕺🏻": {"activity", "body", "dance", "dancing", "light", "man", "people", "person", "skin", "tone"},
-	"🕺🏼": {"activity", "body", "dance", "dancing", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🕺🏽": {"activity", "body", "dance", "dancing", "man", "medium", "people", "person", "skin", "tone"},
-	"🕺🏾": {"activity", "body", "dance", "dancing", "dark", "man", "medium", "people", "person", "skin", "tone"},
-	"🕺🏿": {"activity", "body", "dance", "dancing", "dark", "man", "people", "person", "skin", "tone"},
-	"🕴️": {"activity", "body", "business", "in", "levitating", "people", "person", "suit"},
-	"🕴🏻": {"activity", "body", "business", "in", "levitating", "light", "people", "person", "skin", "suit", "tone"},
-	"🕴🏼": {"activity", "body", "business", "in", "levitating", "light", "medium", "people", "person", "skin", "suit", "tone"},
-	"🕴🏽": {"activity", "body", "business", "in", "levitating", "medium", "people", "person", "skin", "suit", "tone"},
-	"🕴🏾": {"activity", "body", "business", "dark", "in", "levitating", "medium", "people", "person", "skin", "suit", "tone"},
-	"🕴🏿": {"activity", "body", "business", "dark", "in", "levitating", "people", "person", "skin", "suit", "tone"},
-	"👯": {"activity", "body", "bunny", "dancer", "ear", "ears", "partying", "people", "person", "with"},
-	"👯\u200d♂️": {"activity", "body", "bunny", "dancer", "ear", "ears", "men", "partying", "people", "person", "with"},
-	"👯\u200d♀️": {"activity", "body", "bunny", "dancer", "ear", "ears", "partying", "people", "person", "with", "women"},
-	"🧖": {"activity", "body", "in", "people", "person", "room", "sauna", "steam", "steamy"},
-	"🧖🏻": {"activity", "body", "in", "light", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏼": {"activity", "body", "in", "light", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏽": {"activity", "body", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏾": {"activity", "body", "dark", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏿": {"activity", "body", "dark", "in", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖\u200d♂️": {"activity", "body", "in", "man", "people", "person", "room", "sauna", "steam", "steamy"},
-	"🧖🏻\u200d♂️": {"activity", "body", "in", "light", "man", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏼\u200d♂️": {"activity", "body", "in", "light", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏽\u200d♂️": {"activity", "body", "in", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏾\u200d♂️": {"activity", "body", "dark", "in", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖🏿\u200d♂️": {"activity", "body", "dark", "in", "man", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
-	"🧖\u200d♀️": {"activity", "body", "in", "people", "person", "room", "sauna", "steam", "steamy", "woman"},
-	"🧖🏻\u200d♀️": {"activity", "body", "in", "light", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
-	"🧖🏼\u200d♀️": {"activity", "body", "in", "light", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
-	"🧖🏽\u200d♀️": {"activity", "body", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
-	"🧖🏾\u200d♀️": {"activity", "body", "dark", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
-	"🧖🏿\u200d♀️": {"activity", "body", "dark", "in", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
-	"🧗": {"activity", "body", "climber", "climbing", "people", "person"},
-	"🧗🏻": {"activity", "body", "climber", "climbing", "light", "people", "person", "skin", "tone"},
-	"🧗🏼": {"activity", "body", "climber", "climbing", "light", "medium", "people", "person", "skin", "tone"},
-	"🧗🏽": {"activity", "body", "climber", "climbing", "medium", "people", "person", "skin", "tone"},
-	"🧗🏾": {"activity", "body", "climber", "climbing", "dark", "medium", "people", "person", "skin", "tone"},
-	"🧗🏿": {"activity", "body", "climber", "climbing", "dark", "people", "person", "skin", "tone"},
-	"🧗\u200d♂️": {"activity", "body", "climber", "climbing", "man", "people", "person"},
-	"🧗🏻\u200d♂️": {"activity", "body", "climber", "climbing", "light", "man", "people", "person", "skin", "tone"},
-	"🧗🏼\u200d♂️": {"activity", "body", "climber", "climbing", "light", "man", "medium", "people", "person", "skin", "tone"},
-	"🧗🏽\u200d♂️": {"activity", "body", "climber", "climbing", "man", "medium", "people", "person", "skin", "tone"},
-	"🧗🏾\u200d♂️": {"activity", "body", "climber", "climbing", "dark", "man", "medium", "people", "person", "skin", "tone"},
-	"🧗🏿\u200d♂️": {"activity", "body", "climber", "climbing", "dark", "man", "people", "person", "skin", "tone"},
-	"🧗\u200d♀️": {"activity", "body", "climber", "climbing", "people", "person", "woman"},
-	"🧗🏻\u200d♀️": {"activity", "body", "climber", "climbing", "light", "people", "person", "skin", "tone", "woman"},
-	"🧗🏼\u200d♀️": {"activity", "body", "climber", "climbing", "light", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧗🏽\u200d♀️": {"activity", "body", "climber", "climbing", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧗🏾\u200d♀️": {"activity", "body", "climber", "climbing", "dark", "medium", "people", "person", "skin", "tone", "woman"},
-	"🧗🏿\u200d♀️": {"activity", "body", "climber", "climbing", "dark", "people", "person", "skin", "tone", "woman"},
-	"🤺": {"body", "fencer", "fencing", "people", "person", "sport", "sword"},
-	"🏇": {"body", "horse", "jockey", "people", "person", "racehorse", "racing", "sport"},
-	"🏇🏻": {"body", "horse", "jockey", "light", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
-	"🏇🏼": {"body", "horse", "jockey", "light", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
-	"🏇🏽": {"body", "horse", "jockey", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
-	"🏇🏾": {"body", "dark", "horse", "jockey", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
-	"🏇🏿": {"body", "dark", "horse", "jockey", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
-	"⛷️": {"body", "people", "person", "ski", "skier", "snow", "sport"},
-	"🏂": {"body", "people", "person", "snowboarder", "sport"},
-	"🏂🏻": {"body", "light", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
-	"🏂🏼": {"body", "light", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
-	"🏂🏽": {"body", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
-	"🏂🏾": {"body", "dark", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
-	"🏂🏿": {"body", "dark", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
-	"🏌️": {"ball", "body", "golf", "golfing", "people", "person", "sport"},
-	"🏌🏻": {"ball", "body", "golf", "golfing", "light", "people", "person", "skin", "sport", "tone"},
-	"🏌🏼": {"ball", "body", "golf", "golfing", "light", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏽": {"ball", "body", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏾": {"ball", "body", "dark", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏿": {"ball", "body", "dark", "golf", "golfing", "people", "person", "skin", "sport", "tone"},
-	"🏌️\u200d♂️": {"body", "golf", "golfing", "man", "people", "person", "sport"},
-	"🏌🏻\u200d♂️": {"body", "golf", "golfing", "light", "man", "people", "person", "skin", "sport", "tone"},
-	"🏌🏼\u200d♂️": {"body", "golf", "golfing", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏽\u200d♂️": {"body", "golf", "golfing", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏾\u200d♂️": {"body", "dark", "golf", "golfing", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🏌🏿\u200d♂️": {"body", "dark", "golf", "golfing", "man", "people", "person", "skin", "sport", "tone"},
-	"🏌️\u200d♀️": {"body", "golf", "golfing", "people", "person", "sport", "woman"},
-	"🏌🏻\u200d♀️": {"body", "golf", "golfing", "light", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏌🏼\u200d♀️": {"body", "golf", "golfing", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏌🏽\u200d♀️": {"body", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏌🏾\u200d♀️": {"body", "dark", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏌🏿\u200d♀️": {"body", "dark", "golf", "golfing", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏄": {"body", "people", "person", "sport", "surfing"},
-	"🏄🏻": {"body", "light", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏼": {"body", "light", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏽": {"body", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏾": {"body", "dark", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏿": {"body", "dark", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄\u200d♂️": {"body", "man", "people", "person", "sport", "surfing"},
-	"🏄🏻\u200d♂️": {"body", "light", "man", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏼\u200d♂️": {"body", "light", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏽\u200d♂️": {"body", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏾\u200d♂️": {"body", "dark", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄🏿\u200d♂️": {"body", "dark", "man", "people", "person", "skin", "sport", "surfing", "tone"},
-	"🏄\u200d♀️": {"body", "people", "person", "sport", "surfing", "woman"},
-	"🏄🏻\u200d♀️": {"body", "light", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
-	"🏄🏼\u200d♀️": {"body", "light", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
-	"🏄🏽\u200d♀️": {"body", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
-	"🏄🏾\u200d♀️": {"body", "dark", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
-	"🏄🏿\u200d♀️": {"body", "dark", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
-	"🚣": {"boat", "body", "people", "person", "rowboat", "rowing", "sport"},
-	"🚣🏻": {"boat", "body", "light", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏼": {"boat", "body", "light", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏽": {"boat", "body", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏾": {"boat", "body", "dark", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏿": {"boat", "body", "dark", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣\u200d♂️": {"boat", "body", "man", "people", "person", "rowboat", "rowing", "sport"},
-	"🚣🏻\u200d♂️": {"boat", "body", "light", "man", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏼\u200d♂️": {"boat", "body", "light", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏽\u200d♂️": {"boat", "body", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏾\u200d♂️": {"boat", "body", "dark", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣🏿\u200d♂️": {"boat", "body", "dark", "man", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
-	"🚣\u200d♀️": {"boat", "body", "people", "person", "rowboat", "rowing", "sport", "woman"},
-	"🚣🏻\u200d♀️": {"boat", "body", "light", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
-	"🚣🏼\u200d♀️": {"boat", "body", "light", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
-	"🚣🏽\u200d♀️": {"boat", "body", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
-	"🚣🏾\u200d♀️": {"boat", "body", "dark", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
-	"🚣🏿\u200d♀️": {"boat", "body", "dark", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
-	"🏊": {"body", "people", "person", "sport", "swimming"},
-	"🏊🏻": {"body", "light", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏼": {"body", "light", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏽": {"body", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏾": {"body", "dark", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏿": {"body", "dark", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊\u200d♂️": {"body", "man", "people", "person", "sport", "swim", "swimming"},
-	"🏊🏻\u200d♂️": {"body", "light", "man", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏼\u200d♂️": {"body", "light", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏽\u200d♂️": {"body", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏾\u200d♂️": {"body", "dark", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊🏿\u200d♂️": {"body", "dark", "man", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
-	"🏊\u200d♀️": {"body", "people", "person", "sport", "swim", "swimming", "woman"},
-	"🏊🏻\u200d♀️": {"body", "light", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
-	"🏊🏼\u200d♀️": {"body", "light", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
-	"🏊🏽\u200d♀️": {"body", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
-	"🏊🏾\u200d♀️": {"body", "dark", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
-	"🏊🏿\u200d♀️": {"body", "dark", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
-	"⛹️": {"ball", "body", "bouncing", "people", "person", "sport"},
-	"⛹🏻": {"ball", "body", "bouncing", "light", "people", "person", "skin", "sport", "tone"},
-	"⛹🏼": {"ball", "body", "bouncing", "light", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏽": {"ball", "body", "bouncing", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏾": {"ball", "body", "bouncing", "dark", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏿": {"ball", "body", "bouncing", "dark", "people", "person", "skin", "sport", "tone"},
-	"⛹️\u200d♂️": {"ball", "body", "bouncing", "man", "people", "person", "sport"},
-	"⛹🏻\u200d♂️": {"ball", "body", "bouncing", "light", "man", "people", "person", "skin", "sport", "tone"},
-	"⛹🏼\u200d♂️": {"ball", "body", "bouncing", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏽\u200d♂️": {"ball", "body", "bouncing", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏾\u200d♂️": {"ball", "body", "bouncing", "dark", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"⛹🏿\u200d♂️": {"ball", "body", "bouncing", "dark", "man", "people", "person", "skin", "sport", "tone"},
-	"⛹️\u200d♀️": {"ball", "body", "bouncing", "people", "person", "sport", "woman"},
-	"⛹🏻\u200d♀️": {"ball", "body", "bouncing", "light", "people", "person", "skin", "sport", "tone", "woman"},
-	"⛹🏼\u200d♀️": {"ball", "body", "bouncing", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"⛹🏽\u200d♀️": {"ball", "body", "bouncing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"⛹🏾\u200d♀️": {"ball", "body", "bouncing", "dark", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"⛹🏿\u200d♀️": {"ball", "body", "bouncing", "dark", "people", "person", "skin", "sport", "tone", "woman"},
-	"🏋️": {"body", "lifter", "lifting", "people", "person", "sport", "weight", "weights"},
-	"🏋🏻": {"body", "lifter", "lifting", "light", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏼": {"body", "lifter", "lifting", "light", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏽": {"body", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏾": {"body", "dark", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏿": {"body", "dark", "lifter", "lifting", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋️\u200d♂️": {"body", "lifter", "lifting", "man", "people", "person", "sport", "weight", "weights"},
-	"🏋🏻\u200d♂️": {"body", "lifter", "lifting", "light", "man", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏼\u200d♂️": {"body", "lifter", "lifting", "light", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏽\u200d♂️": {"body", "lifter", "lifting", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏾\u200d♂️": {"body", "dark", "lifter", "lifting", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋🏿\u200d♂️": {"body", "dark", "lifter", "lifting", "man", "people", "person", "skin", "sport", "tone", "weight", "weights"},
-	"🏋️\u200d♀️": {"body", "lifter", "lifting", "people", "person", "sport", "weight", "weights", "woman"},
-	"🏋🏻\u200d♀️": {"body", "lifter", "lifting", "light", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
-	"🏋🏼\u200d♀️": {"body", "lifter", "lifting", "light", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
-	"🏋🏽\u200d♀️": {"body", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
-	"🏋🏾\u200d♀️": {"body", "dark", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
-	"🏋🏿\u200d♀️": {"body", "dark", "lifter", "lifting", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
-	"🚴": {"bicycle", "biking", "body", "cyclist", "people", "person", "sport"},
-	"🚴🏻": {"bicycle", "biking", "body", "cyclist", "light", "people", "person", "skin", "sport", "tone"},
-	"🚴🏼": {"bicycle", "biking", "body", "cyclist", "light", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏽": {"bicycle", "biking", "body", "cyclist", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏾": {"bicycle", "biking", "body", "cyclist", "dark", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏿": {"bicycle", "biking", "body", "cyclist", "dark", "people", "person", "skin", "sport", "tone"},
-	"🚴\u200d♂️": {"bicycle", "biking", "body", "cyclist", "man", "people", "person", "sport"},
-	"🚴🏻\u200d♂️": {"bicycle", "biking", "body", "cyclist", "light", "man", "people", "person", "skin", "sport", "tone"},
-	"🚴🏼\u200d♂️": {"bicycle", "biking", "body", "cyclist", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏽\u200d♂️": {"bicycle", "biking", "body", "cyclist", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏾\u200d♂️": {"bicycle", "biking", "body", "cyclist", "dark", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🚴🏿\u200d♂️": {"bicycle", "biking", "body", "cyclist", "dark", "man", "people", "person", "skin", "sport", "tone"},
-	"🚴\u200d♀️": {"bicycle", "biking", "body", "cyclist", "people", "person", "sport", "woman"},
-	"🚴🏻\u200d♀️": {"bicycle", "biking", "body", "cyclist", "light", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚴🏼\u200d♀️": {"bicycle", "biking", "body", "cyclist", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚴🏽\u200d♀️": {"bicycle", "biking", "body", "cyclist", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚴🏾\u200d♀️": {"bicycle", "biking", "body", "cyclist", "dark", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚴🏿\u200d♀️": {"bicycle", "biking", "body", "cyclist", "dark", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚵": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "mountain", "people", "person", "sport"},
-	"🚵🏻": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "light", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏼": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "light", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏽": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏾": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "dark", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏿": {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "dark", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "man", "mountain", "people", "person", "sport"},
-	"🚵🏻\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "light", "man", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏼\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "light", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏽\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏾\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "dark", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵🏿\u200d♂️": {"bicycle", "bike", "biking", "body", "cyclist", "dark", "man", "mountain", "people", "person", "skin", "sport", "tone"},
-	"🚵\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "mountain", "people", "person", "sport", "woman"},
-	"🚵🏻\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "light", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚵🏼\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "light", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚵🏽\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚵🏾\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "dark", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
-	"🚵🏿\u200d♀️": {"bicycle", "bike", "biking", "body", "cyclist", "dark", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤸": {"body", "cartwheel", "cartwheeling", "gymnastics", "people", "person", "sport"},
-	"🤸🏻": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "people", "person", "skin", "sport", "tone"},
-	"🤸🏼": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏽": {"body", "cartwheel", "cartwheeling", "gymnastics", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏾": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏿": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "people", "person", "skin", "sport", "tone"},
-	"🤸\u200d♂️": {"body", "cartwheel", "cartwheeling", "gymnastics", "man", "people", "person", "sport"},
-	"🤸🏻\u200d♂️": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "man", "people", "person", "skin", "sport", "tone"},
-	"🤸🏼\u200d♂️": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏽\u200d♂️": {"body", "cartwheel", "cartwheeling", "gymnastics", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏾\u200d♂️": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "man", "medium", "people", "person", "skin", "sport", "tone"},
-	"🤸🏿\u200d♂️": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "man", "people", "person", "skin", "sport", "tone"},
-	"🤸\u200d♀️": {"body", "cartwheel", "cartwheeling", "gymnastics", "people", "person", "sport", "woman"},
-	"🤸🏻\u200d♀️": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤸🏼\u200d♀️": {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤸🏽\u200d♀️": {"body", "cartwheel", "cartwheeling", "gymnastics", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤸🏾\u200d♀️": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "medium", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤸🏿\u200d♀️": {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤼": {"body", "people", "person", "sport", "wrestle", "wrestler", "wrestling"},
-	"🤼\u200d♂️": {"body", "men", "people", "person", "sport", "wrestle", "wrestling"},
-	"🤼\u200d♀️": {"body", "people", "person", "sport", "women", "wrestle", "wrestling"},
-	"🤽": {"body", "people", "person", "playing", "polo", "sport", "water"},
-	"🤽🏻": {"body", "light", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏼": {"body", "light", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏽": {"body", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏾": {"body", "dark", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏿": {"body", "dark", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽\u200d♂️": {"body", "man", "people", "person", "playing", "polo", "sport", "water"},
-	"🤽🏻\u200d♂️": {"body", "light", "man", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏼\u200d♂️": {"body", "light", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏽\u200d♂️": {"body", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏾\u200d♂️": {"body", "dark", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽🏿\u200d♂️": {"body", "dark", "man", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
-	"🤽\u200d♀️": {"body", "people", "person", "playing", "polo", "sport", "water", "woman"},
-	"🤽🏻\u200d♀️": {"body", "light", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
-	"🤽🏼\u200d♀️": {"body", "light", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
-	"🤽🏽\u200d♀️": {"body", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
-	"🤽🏾\u200d♀️": {"body", "dark", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
-	"🤽🏿\u200d♀️": {"body", "dark", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
-	"🤾": {"ball", "body", "handball", "people", "person", "playing", "sport"},
-	"🤾🏻": {"ball", "body", "handball", "light", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏼": {"ball", "body", "handball", "light", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏽": {"ball", "body", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏾": {"ball", "body", "dark", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏿": {"ball", "body", "dark", "handball", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾\u200d♂️": {"body", "handball", "man", "people", "person", "playing", "sport"},
-	"🤾🏻\u200d♂️": {"body", "handball", "light", "man", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏼\u200d♂️": {"body", "handball", "light", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏽\u200d♂️": {"body", "handball", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏾\u200d♂️": {"body", "dark", "handball", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾🏿\u200d♂️": {"body", "dark", "handball", "man", "people", "person", "playing", "skin", "sport", "tone"},
-	"🤾\u200d♀️": {"body", "handball", "people", "person", "playing", "sport", "woman"},
-	"🤾🏻\u200d♀️": {"body", "handball", "light", "people", "person", "playing", "skin", "sport", "tone", "woman"},
-	"🤾🏼\u200d♀️": {"body", "handball", "light", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
-	"🤾🏽\u200d♀️": {"body", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
-	"🤾🏾\u200d♀️": {"body", "dark", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
-	"🤾🏿\u200d♀️": {"body", "dark", "handball", "people", "person", "playing", "skin", "sport", "tone", "woman"},
-	"🤹": {"balance", "body", "juggle", "juggling", "multitask", "people", "person", "skill", "sport"},
-	"🤹🏻": {"balance", "body", "juggle", "juggling", "light", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
-	"🤹🏼": {"balance", "body", "juggle", "juggling", "light", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
-	"🤹🏽": {"balance", "body", "juggle", "juggling", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
-	"🤹🏾": {"balance", "body", "dark", "juggle", "juggling", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
-	"🤹🏿": {"balance", "body", "dark", "juggle", "juggling", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
-	"🤹\u200d♂️": {"body", "juggling", "man", "multitask", "people", "person", "sport"},
-	"🤹🏻\u200d♂️": {"body", "juggling", "light", "man", "multitask", "people", "person", "skin", "sport", "tone"},
-	"🤹🏼\u200d♂️": {"body", "juggling", "light", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
-	"🤹🏽\u200d♂️": {"body", "juggling", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
-	"🤹🏾\u200d♂️": {"body", "dark", "juggling", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
-	"🤹🏿\u200d♂️": {"body", "dark", "juggling", "man", "multitask", "people", "person", "skin", "sport", "tone"},
-	"🤹\u200d♀️": {"body", "juggling", "multitask", "people", "person", "sport", "woman"},
-	"🤹🏻\u200d♀️": {"body", "juggling", "light", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤹🏼\u200d♀️": {"body", "juggling", "light", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤹🏽\u200d♀️": {"body", "juggling", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤹🏾\u200d♀️": {"body", "dark", "juggling", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
-	"🤹🏿\u200d♀️": {"body", "dark", "juggling", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
-	"🧘": {"body", "in", "lotus", "meditation", "people", "person", "position", "resting", "yoga"},
-	"🧘🏻": {"body", "in", "light", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏼": {"body", "in", "light", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏽": {"body", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏾": {"body", "dark", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏿": {"body", "dark", "in", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘\u200d♂️": {"body", "in", "lotus", "man", "meditation", "people", "person", "position", "resting", "yoga"},
-	"🧘🏻\u200d♂️": {"body", "in", "light", "lotus", "man", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏼\u200d♂️": {"body", "in", "light", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏽\u200d♂️": {"body", "in", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏾\u200d♂️": {"body", "dark", "in", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘🏿\u200d♂️": {"body", "dark", "in", "lotus", "man", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
-	"🧘\u200d♀️": {"body", "in", "lotus", "meditation", "people", "person", "position", "resting", "woman", "yoga"},
-	"🧘🏻\u200d♀️": {"body", "in", "light", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
-	"🧘🏼\u200d♀️": {"body", "in", "light", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
-	"🧘🏽\u200d♀️": {"body", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
-	"🧘🏾\u200d♀️": {"body", "dark", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
-	"🧘🏿\u200d♀️": {"body", "dark", "in", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
-	"🛀": {"bath", "bathtub", "body", "people", "person", "resting", "taking"},
-	"🛀🏻": {"bath", "bathtub", "body", "light", "people", "person", "resting", "skin", "taking", "tone"},
-	"🛀🏼": {"bath", "bathtub", "body", "light", "medium", "people", "person", "resting", "skin", "taking", "tone"},
-	"🛀🏽": {"bath", "bathtub", "body", "medium", "people", "person", "resting", "skin", "taking", "tone"},
-	"🛀🏾": {"bath", "bathtub", "body", "dark", "medium", "people", "person", "resting", "skin", "taking", "tone"},
-	"🛀🏿": {"bath", "bathtub", "body", "dark", "people", "person", "resting", "skin", "taking", "tone"},
-	"🛌": {"bed", "body", "good", "hotel", "in", "night", "people", "person", "resting", "sleep"},
-	"🛌🏻": {"bed", "body", "good", "hotel", "in", "light", "night", "people", "person", "resting", "skin", "sleep", "tone"},
-	"🛌🏼": {"bed", "body", "good", "hotel", "in", "light", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
-	"🛌🏽": {"bed", "body", "good", "hotel", "in", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
-	"🛌🏾": {"bed", "body", "dark", "good", "hotel", "in", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
-	"🛌🏿": {"bed", "body", "dark", "good", "hotel", "in", "night", "people", "person", "resting", "skin", "sleep", "tone"},
-	"🧑\u200d🤝\u200d🧑": {"body", "couple", "family", "hand", "hands", "hold", "holding", "people", "person"},
+var emojis = map[string][]string{
+	"😀":                 {"emotion", "face", "grin", "grinning", "smileys", "smiling"},
+	"😃":                 {"big", "emotion", "eyes", "face", "grinning", "mouth", "open", "smile", "smileys", "smiling", "with"},
+	"😄":                 {"emotion", "eye", "eyes", "face", "grinning", "mouth", "open", "smile", "smileys", "smiling", "with"},
+	"😁":                 {"beaming", "emotion", "eye", "eyes", "face", "grin", "smile", "smileys", "smiling", "with"},
+	"😆":                 {"emotion", "face", "grinning", "laugh", "mouth", "satisfied", "smile", "smileys", "smiling", "squinting"},
+	"😅":                 {"cold", "emotion", "face", "grinning", "open", "smile", "smileys", "smiling", "sweat", "with"},
+	"🤣":                 {"emotion", "face", "floor", "laugh", "laughing", "on", "rofl", "rolling", "rotfl", "smileys", "smiling", "the"},
+	"😂":                 {"emotion", "face", "joy", "laugh", "of", "smileys", "smiling", "tear", "tears", "with"},
+	"🙂":                 {"emotion", "face", "slightly", "smile", "smileys", "smiling"},
+	"🙃":                 {"down", "emotion", "face", "smileys", "smiling", "upside"},
+	"🫠":                 {"disappear", "dissolve", "emotion", "face", "liquid", "melt", "melting", "smileys", "smiling"},
+	"😉":                 {"emotion", "face", "smileys", "smiling", "wink", "winking"},
+	"😊":                 {"blush", "emotion", "eye", "eyes", "face", "smile", "smileys", "smiling", "with"},
+	"😇":                 {"angel", "emotion", "face", "fantasy", "halo", "innocent", "smileys", "smiling", "with"},
+	"🥰":                 {"adore", "affection", "crush", "emotion", "face", "hearts", "in", "love", "smileys", "smiling", "with"},
+	"😍":                 {"affection", "emotion", "eye", "eyes", "face", "heart", "love", "smile", "smileys", "smiling", "with"},
+	"🤩":                 {"affection", "emotion", "eyes", "face", "grinning", "smileys", "star", "struck"},
+	"😘":                 {"a", "affection", "blowing", "emotion", "face", "kiss", "smileys"},
+	"😗":                 {"affection", "emotion", "face", "kiss", "kissing", "smileys"},
+	"☺️":                {"affection", "emotion", "face", "outlined", "relaxed", "smile", "smileys", "smiling"},
+	"😚":                 {"affection", "closed", "emotion", "eye", "eyes", "face", "kiss", "kissing", "smileys", "with"},
+	"😙":                 {"affection", "emotion", "eye", "eyes", "face", "kiss", "kissing", "smile", "smileys", "smiling", "with"},
+	"🥲":                 {"affection", "emotion", "face", "grateful", "proud", "relieved", "smileys", "smiling", "tear", "touched", "with"},
+	"😋":                 {"delicious", "emotion", "face", "food", "savoring", "savouring", "smile", "smileys", "tongue", "yum"},
+	"😛":                 {"emotion", "face", "smileys", "tongue", "with"},
+	"😜":                 {"emotion", "eye", "face", "joke", "smileys", "tongue", "wink", "winking", "with"},
+	"🤪":                 {"emotion", "eye", "face", "goofy", "large", "small", "smileys", "tongue", "zany"},
+	"😝":                 {"emotion", "eye", "face", "horrible", "smileys", "squinting", "taste", "tongue", "with"},
+	"🤑":                 {"emotion", "face", "money", "mouth", "smileys", "tongue"},
+	"🤗":                 {"emotion", "face", "hand", "hands", "hug", "hugging", "open", "smileys", "smiling", "with"},
+	"🤭":                 {"emotion", "face", "hand", "mouth", "over", "smileys", "whoops", "with"},
+	"🫢":                 {"amazement", "and", "awe", "disbelief", "embarrass", "emotion", "eyes", "face", "hand", "mouth", "open", "over", "scared", "smileys", "surprise", "with"},
+	"🫣":                 {"captivated", "emotion", "eye", "face", "hand", "peeking", "peep", "smileys", "stare", "with"},
+	"🤫":                 {"emotion", "face", "hand", "quiet", "shush", "shushing", "smileys"},
+	"🤔":                 {"emotion", "face", "hand", "smileys", "thinking"},
+	"🫡":                 {"emotion", "face", "hand", "ok", "salute", "saluting", "smileys", "sunny", "troops", "yes"},
+	"🤐":                 {"emotion", "face", "mouth", "neutral", "skeptical", "smileys", "zipper"},
+	"🤨":                 {"distrust", "emotion", "eyebrow", "face", "neutral", "raised", "skeptic", "skeptical", "smileys", "with"},
+	"😐":                 {"emotion", "face", "neutral", "skeptical", "smileys"},
+	"😑":                 {"emotion", "expressionless", "face", "inexpressive", "meh", "neutral", "skeptical", "smileys", "unexpressive"},
+	"😶":                 {"emotion", "face", "mouth", "neutral", "quiet", "silent", "skeptical", "smileys", "without"},
+	"🫥":                 {"depressed", "disappear", "dotted", "emotion", "face", "hide", "introvert", "invisible", "line", "neutral", "skeptical", "smileys"},
+	"😶\u200d🌫️":         {"absentminded", "clouds", "emotion", "face", "fog", "head", "in", "neutral", "skeptical", "smileys", "the"},
+	"😏":                 {"emotion", "face", "neutral", "skeptical", "smileys", "smirk", "smirking"},
+	"😒":                 {"emotion", "face", "neutral", "skeptical", "smileys", "unamused", "unhappy"},
+	"🙄":                 {"emotion", "eyeroll", "eyes", "face", "neutral", "rolling", "skeptical", "smileys", "with"},
+	"😬":                 {"emotion", "face", "grimace", "grimacing", "neutral", "skeptical", "smileys"},
+	"😮\u200d💨":          {"emotion", "exhale", "exhaling", "face", "gasp", "groan", "neutral", "relief", "skeptical", "smileys", "whisper", "whistle"},
+	"🤥":                 {"emotion", "face", "lie", "lying", "neutral", "pinocchio", "skeptical", "smileys"},
+	"🫨":                 {"emotion", "face", "neutral", "shaking", "skeptical", "smileys"},
+	"😌":                 {"emotion", "face", "relieved", "sleepy", "smileys"},
+	"😔":                 {"dejected", "emotion", "face", "pensive", "sleepy", "smileys"},
+	"😪":                 {"emotion", "face", "good", "night", "sleep", "sleepy", "smileys"},
+	"🤤":                 {"drooling", "emotion", "face", "sleepy", "smileys"},
+	"😴":                 {"emotion", "face", "good", "night", "sleep", "sleeping", "sleepy", "smileys", "zzz"},
+	"😷":                 {"cold", "doctor", "emotion", "face", "mask", "medical", "sick", "smileys", "unwell", "with"},
+	"🤒":                 {"emotion", "face", "ill", "sick", "smileys", "thermometer", "unwell", "with"},
+	"🤕":                 {"bandage", "emotion", "face", "head", "hurt", "injury", "smileys", "unwell", "with"},
+	"🤢":                 {"emotion", "face", "nauseated", "smileys", "unwell", "vomit"},
+	"🤮":                 {"emotion", "face", "puke", "sick", "smileys", "unwell", "vomit", "vomiting"},
+	"🤧":                 {"emotion", "face", "gesundheit", "smileys", "sneeze", "sneezing", "unwell"},
+	"🥵":                 {"emotion", "face", "faced", "feverish", "heat", "hot", "red", "smileys", "stroke", "sweating", "unwell"},
+	"🥶":                 {"blue", "cold", "emotion", "face", "faced", "freezing", "frostbite", "icicles", "smileys", "unwell"},
+	"🥴":                 {"dizzy", "emotion", "eyes", "face", "intoxicated", "mouth", "smileys", "tipsy", "uneven", "unwell", "wavy", "woozy"},
+	"😵":                 {"crossed", "dead", "emotion", "eyes", "face", "knocked", "out", "smileys", "unwell", "with"},
+	"😵\u200d💫":          {"dizzy", "emotion", "eyes", "face", "hypnotized", "smileys", "spiral", "trouble", "unwell", "whoa", "with"},
+	"🤯":                 {"blown", "emotion", "exploding", "face", "head", "mind", "shocked", "smileys", "unwell"},
+	"🤠":                 {"cowboy", "cowgirl", "emotion", "face", "hat", "smileys"},
+	"🥳":                 {"celebration", "emotion", "face", "hat", "horn", "party", "partying", "smileys"},
+	"🥸":                 {"disguise", "disguised", "emotion", "face", "glasses", "hat", "incognito", "nose", "smileys"},
+	"😎":                 {"bright", "cool", "emotion", "face", "glasses", "smileys", "smiling", "sun", "sunglasses", "with"},
+	"🤓":                 {"emotion", "face", "geek", "glasses", "nerd", "smileys"},
+	"🧐":                 {"emotion", "face", "glasses", "monocle", "smileys", "stuffy", "with"},
+	"😕":                 {"concerned", "confused", "emotion", "face", "meh", "smileys"},
+	"🫤":                 {"concerned", "diagonal", "disappointed", "emotion", "face", "meh", "mouth", "skeptical", "smileys", "unsure", "with"},
+	"😟":                 {"concerned", "emotion", "face", "smileys", "worried"},
+	"🙁":                 {"concerned", "emotion", "face", "frown", "frowning", "slightly", "smileys"},
+	"☹️":                {"concerned", "emotion", "face", "frown", "frowning", "smileys"},
+	"😮":                 {"concerned", "emotion", "face", "mouth", "open", "smileys", "sympathy", "with"},
+	"😯":                 {"concerned", "emotion", "face", "hushed", "smileys", "stunned", "surprised"},
+	"😲":                 {"astonished", "concerned", "emotion", "face", "shocked", "smileys", "totally"},
+	"😳":                 {"concerned", "dazed", "emotion", "face", "flushed", "smileys"},
+	"🥺":                 {"begging", "concerned", "emotion", "eyes", "face", "mercy", "pleading", "puppy", "smileys"},
+	"🥹":                 {"angry", "back", "concerned", "cry", "emotion", "face", "holding", "proud", "resist", "sad", "smileys", "tears"},
+	"😦":                 {"concerned", "emotion", "face", "frown", "frowning", "mouth", "open", "smileys", "with"},
+	"😧":                 {"anguished", "concerned", "emotion", "face", "smileys"},
+	"😨":                 {"concerned", "emotion", "face", "fear", "fearful", "scared", "smileys"},
+	"😰":                 {"anxious", "blue", "cold", "concerned", "emotion", "face", "rushed", "smileys", "sweat", "with"},
+	"😥":                 {"but", "concerned", "disappointed", "emotion", "face", "relieved", "sad", "smileys", "whew"},
+	"😢":                 {"concerned", "cry", "crying", "emotion", "face", "sad", "smileys", "tear"},
+	"😭":                 {"concerned", "cry", "crying", "emotion", "face", "loudly", "sad", "smileys", "sob", "tear"},
+	"😱":                 {"concerned", "emotion", "face", "fear", "in", "munch", "scared", "scream", "screaming", "smileys"},
+	"😖":                 {"concerned", "confounded", "emotion", "face", "smileys"},
+	"😣":                 {"concerned", "emotion", "face", "persevere", "persevering", "smileys"},
+	"😞":                 {"concerned", "disappointed", "emotion", "face", "smileys"},
+	"😓":                 {"cold", "concerned", "downcast", "emotion", "face", "smileys", "sweat", "with"},
+	"😩":                 {"concerned", "emotion", "face", "smileys", "tired", "weary"},
+	"😫":                 {"concerned", "emotion", "face", "smileys", "tired"},
+	"🥱":                 {"bored", "concerned", "emotion", "face", "smileys", "tired", "yawn", "yawning"},
+	"😤":                 {"emotion", "face", "from", "negative", "nose", "smileys", "steam", "triumph", "with", "won"},
+	"😡":                 {"angry", "emotion", "enraged", "face", "mad", "negative", "pouting", "rage", "red", "smileys"},
+	"😠":                 {"anger", "angry", "emotion", "face", "mad", "negative", "smileys"},
+	"🤬":                 {"emotion", "face", "mouth", "negative", "on", "smileys", "swearing", "symbols", "with"},
+	"😈":                 {"emotion", "face", "fairy", "fantasy", "horns", "negative", "smile", "smileys", "smiling", "tale", "with"},
+	"👿":                 {"angry", "demon", "devil", "emotion", "face", "fantasy", "horns", "imp", "negative", "smileys", "with"},
+	"💀":                 {"death", "emotion", "face", "fairy", "monster", "negative", "skull", "smileys", "tale"},
+	"☠️":                {"and", "crossbones", "death", "emotion", "face", "monster", "negative", "skull", "smileys"},
+	"💩":                 {"costume", "dung", "emotion", "face", "monster", "of", "pile", "poo", "poop", "smileys"},
+	"🤡":                 {"clown", "costume", "emotion", "face", "smileys"},
+	"👹":                 {"costume", "creature", "emotion", "face", "fairy", "fantasy", "monster", "ogre", "smileys", "tale"},
+	"👺":                 {"costume", "creature", "emotion", "face", "fairy", "fantasy", "goblin", "monster", "smileys", "tale"},
+	"👻":                 {"costume", "creature", "emotion", "face", "fairy", "fantasy", "ghost", "monster", "smileys", "tale"},
+	"👽":                 {"alien", "costume", "emotion", "face", "smileys"},
+	"👾":                 {"alien", "costume", "creature", "emotion", "extraterrestrial", "face", "monster", "smileys", "ufo"},
+	"🤖":                 {"costume", "emotion", "face", "monster", "robot", "smileys"},
+	"😺":                 {"cat", "emotion", "face", "grinning", "mouth", "open", "smile", "smileys"},
+	"😸":                 {"cat", "emotion", "eye", "eyes", "face", "grin", "grinning", "smile", "smileys", "smiling", "with"},
+	"😹":                 {"cat", "emotion", "face", "joy", "of", "smileys", "tear", "tears", "with"},
+	"😻":                 {"cat", "emotion", "eye", "eyes", "face", "heart", "love", "smile", "smileys", "smiling", "with"},
+	"😼":                 {"cat", "emotion", "face", "ironic", "smile", "smileys", "with", "wry"},
+	"😽":                 {"cat", "emotion", "eye", "face", "kiss", "kissing", "smileys"},
+	"🙀":                 {"cat", "emotion", "face", "oh", "smileys", "surprised", "weary"},
+	"😿":                 {"cat", "cry", "crying", "emotion", "face", "sad", "smileys", "tear"},
+	"😾":                 {"cat", "emotion", "face", "pouting", "smileys"},
+	"🙈":                 {"emotion", "evil", "face", "forbidden", "monkey", "no", "see", "smileys"},
+	"🙉":                 {"emotion", "evil", "face", "forbidden", "hear", "monkey", "no", "smileys"},
+	"🙊":                 {"emotion", "evil", "face", "forbidden", "monkey", "no", "smileys", "speak"},
+	"💌":                 {"emotion", "heart", "letter", "love", "mail", "smileys"},
+	"💘":                 {"arrow", "cupid", "emotion", "heart", "smileys", "with"},
+	"💝":                 {"emotion", "heart", "ribbon", "smileys", "valentine", "with"},
+	"💖":                 {"emotion", "excited", "heart", "smileys", "sparkle", "sparkling"},
+	"💗":                 {"emotion", "excited", "growing", "heart", "nervous", "pulse", "smileys"},
+	"💓":                 {"beating", "emotion", "heart", "heartbeat", "pulsating", "smileys"},
+	"💞":                 {"emotion", "heart", "hearts", "revolving", "smileys"},
+	"💕":                 {"emotion", "heart", "hearts", "love", "smileys", "two"},
+	"💟":                 {"decoration", "emotion", "heart", "smileys"},
+	"❣️":                {"emotion", "exclamation", "heart", "mark", "punctuation", "smileys"},
+	"💔":                 {"break", "broken", "emotion", "heart", "smileys"},
+	"❤️\u200d🔥":         {"burn", "emotion", "fire", "heart", "love", "lust", "on", "sacred", "smileys"},
+	"❤️\u200d🩹":         {"emotion", "healthier", "heart", "improving", "mending", "recovering", "recuperating", "smileys", "well"},
+	"❤️":                {"emotion", "heart", "red", "smileys"},
+	"🩷":                 {"emotion", "heart", "pink", "smileys"},
+	"🧡":                 {"emotion", "heart", "orange", "smileys"},
+	"💛":                 {"emotion", "heart", "smileys", "yellow"},
+	"💚":                 {"emotion", "green", "heart", "smileys"},
+	"💙":                 {"blue", "emotion", "heart", "smileys"},
+	"🩵":                 {"blue", "emotion", "heart", "light", "smileys"},
+	"💜":                 {"emotion", "heart", "purple", "smileys"},
+	"🤎":                 {"brown", "emotion", "heart", "smileys"},
+	"🖤":                 {"black", "emotion", "evil", "heart", "smileys", "wicked"},
+	"🩶":                 {"emotion", "grey", "heart", "smileys"},
+	"🤍":                 {"emotion", "heart", "smileys", "white"},
+	"💋":                 {"emotion", "kiss", "lips", "mark", "smileys"},
+	"💯":                 {"emotion", "full", "hundred", "points", "score", "smileys"},
+	"💢":                 {"anger", "angry", "comic", "emotion", "mad", "smileys", "symbol"},
+	"💥":                 {"boom", "collision", "comic", "emotion", "smileys"},
+	"💫":                 {"comic", "dizzy", "emotion", "smileys", "star"},
+	"💦":                 {"comic", "droplets", "emotion", "smileys", "splashing", "sweat"},
+	"💨":                 {"away", "comic", "dash", "dashing", "emotion", "running", "smileys"},
+	"🕳️":                {"emotion", "hole", "smileys"},
+	"💬":                 {"balloon", "bubble", "comic", "dialog", "emotion", "smileys", "speech"},
+	"👁️\u200d🗨️":        {"balloon", "bubble", "emotion", "eye", "in", "smileys", "speech", "witness"},
+	"🗨️":                {"balloon", "bubble", "dialog", "emotion", "left", "smileys", "speech"},
+	"🗯️":                {"anger", "angry", "balloon", "bubble", "emotion", "mad", "right", "smileys"},
+	"💭":                 {"balloon", "bubble", "comic", "emotion", "smileys", "thought"},
+	"💤":                 {"comic", "emotion", "good", "night", "sleep", "smileys", "zzz"},
+	"👋":                 {"body", "fingers", "hand", "open", "people", "wave", "waving"},
+	"👋🏻":                {"body", "fingers", "hand", "light", "open", "people", "skin", "tone", "wave", "waving"},
+	"👋🏼":                {"body", "fingers", "hand", "light", "medium", "open", "people", "skin", "tone", "wave", "waving"},
+	"👋🏽":                {"body", "fingers", "hand", "medium", "open", "people", "skin", "tone", "wave", "waving"},
+	"👋🏾":                {"body", "dark", "fingers", "hand", "medium", "open", "people", "skin", "tone", "wave", "waving"},
+	"👋🏿":                {"body", "dark", "fingers", "hand", "open", "people", "skin", "tone", "wave", "waving"},
+	"🤚":                 {"back", "backhand", "body", "fingers", "hand", "of", "open", "people", "raised"},
+	"🤚🏻":                {"back", "backhand", "body", "fingers", "hand", "light", "of", "open", "people", "raised", "skin", "tone"},
+	"🤚🏼":                {"back", "backhand", "body", "fingers", "hand", "light", "medium", "of", "open", "people", "raised", "skin", "tone"},
+	"🤚🏽":                {"back", "backhand", "body", "fingers", "hand", "medium", "of", "open", "people", "raised", "skin", "tone"},
+	"🤚🏾":                {"back", "backhand", "body", "dark", "fingers", "hand", "medium", "of", "open", "people", "raised", "skin", "tone"},
+	"🤚🏿":                {"back", "backhand", "body", "dark", "fingers", "hand", "of", "open", "people", "raised", "skin", "tone"},
+	"🖐️":                {"body", "finger", "fingers", "hand", "open", "people", "splayed", "with"},
+	"🖐🏻":                {"body", "finger", "fingers", "hand", "light", "open", "people", "skin", "splayed", "tone", "with"},
+	"🖐🏼":                {"body", "finger", "fingers", "hand", "light", "medium", "open", "people", "skin", "splayed", "tone", "with"},
+	"🖐🏽":                {"body", "finger", "fingers", "hand", "medium", "open", "people", "skin", "splayed", "tone", "with"},
+	"🖐🏾":                {"body", "dark", "finger", "fingers", "hand", "medium", "open", "people", "skin", "splayed", "tone", "with"},
+	"🖐🏿":                {"body", "dark", "finger", "fingers", "hand", "open", "people", "skin", "splayed", "tone", "with"},
+	"✋":                 {"body", "fingers", "five", "hand", "high", "open", "people", "raised"},
+	"✋🏻":                {"body", "fingers", "five", "hand", "high", "light", "open", "people", "raised", "skin", "tone"},
+	"✋🏼":                {"body", "fingers", "five", "hand", "high", "light", "medium", "open", "people", "raised", "skin", "tone"},
+	"✋🏽":                {"body", "fingers", "five", "hand", "high", "medium", "open", "people", "raised", "skin", "tone"},
+	"✋🏾":                {"body", "dark", "fingers", "five", "hand", "high", "medium", "open", "people", "raised", "skin", "tone"},
+	"✋🏿":                {"body", "dark", "fingers", "five", "hand", "high", "open", "people", "raised", "skin", "tone"},
+	"🖖":                 {"body", "finger", "fingers", "hand", "open", "people", "salute", "spock", "vulcan"},
+	"🖖🏻":                {"body", "finger", "fingers", "hand", "light", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
+	"🖖🏼":                {"body", "finger", "fingers", "hand", "light", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
+	"🖖🏽":                {"body", "finger", "fingers", "hand", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
+	"🖖🏾":                {"body", "dark", "finger", "fingers", "hand", "medium", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
+	"🖖🏿":                {"body", "dark", "finger", "fingers", "hand", "open", "people", "salute", "skin", "spock", "tone", "vulcan"},
+	"🫱":                 {"body", "fingers", "hand", "open", "people", "right", "rightward", "rightwards"},
+	"🫱🏻":                {"body", "fingers", "hand", "light", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
+	"🫱🏼":                {"body", "fingers", "hand", "light", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
+	"🫱🏽":                {"body", "fingers", "hand", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
+	"🫱🏾":                {"body", "dark", "fingers", "hand", "medium", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
+	"🫱🏿":                {"body", "dark", "fingers", "hand", "open", "people", "right", "rightward", "rightwards", "skin", "tone"},
+	"🫲":                 {"body", "fingers", "hand", "left", "leftward", "leftwards", "open", "people"},
+	"🫲🏻":                {"body", "fingers", "hand", "left", "leftward", "leftwards", "light", "open", "people", "skin", "tone"},
+	"🫲🏼":                {"body", "fingers", "hand", "left", "leftward", "leftwards", "light", "medium", "open", "people", "skin", "tone"},
+	"🫲🏽":                {"body", "fingers", "hand", "left", "leftward", "leftwards", "medium", "open", "people", "skin", "tone"},
+	"🫲🏾":                {"body", "dark", "fingers", "hand", "left", "leftward", "leftwards", "medium", "open", "people", "skin", "tone"},
+	"🫲🏿":                {"body", "dark", "fingers", "hand", "left", "leftward", "leftwards", "open", "people", "skin", "tone"},
+	"🫳":                 {"body", "dismiss", "down", "drop", "fingers", "hand", "open", "palm", "people", "shoo"},
+	"🫳🏻":                {"body", "dismiss", "down", "drop", "fingers", "hand", "light", "open", "palm", "people", "shoo", "skin", "tone"},
+	"🫳🏼":                {"body", "dismiss", "down", "drop", "fingers", "hand", "light", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
+	"🫳🏽":                {"body", "dismiss", "down", "drop", "fingers", "hand", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
+	"🫳🏾":                {"body", "dark", "dismiss", "down", "drop", "fingers", "hand", "medium", "open", "palm", "people", "shoo", "skin", "tone"},
+	"🫳🏿":                {"body", "dark", "dismiss", "down", "drop", "fingers", "hand", "open", "palm", "people", "shoo", "skin", "tone"},
+	"🫴":                 {"beckon", "body", "catch", "come", "fingers", "hand", "offer", "open", "palm", "people", "up"},
+	"🫴🏻":                {"beckon", "body", "catch", "come", "fingers", "hand", "light", "offer", "open", "palm", "people", "skin", "tone", "up"},
+	"🫴🏼":                {"beckon", "body", "catch", "come", "fingers", "hand", "light", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
+	"🫴🏽":                {"beckon", "body", "catch", "come", "fingers", "hand", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
+	"🫴🏾":                {"beckon", "body", "catch", "come", "dark", "fingers", "hand", "medium", "offer", "open", "palm", "people", "skin", "tone", "up"},
+	"🫴🏿":                {"beckon", "body", "catch", "come", "dark", "fingers", "hand", "offer", "open", "palm", "people", "skin", "tone", "up"},
+	"🫷":                 {"body", "fingers", "hand", "leftwards", "open", "people", "pushing"},
+	"🫷🏻":                {"body", "fingers", "hand", "leftwards", "light", "open", "people", "pushing", "skin", "tone"},
+	"🫷🏼":                {"body", "fingers", "hand", "leftwards", "light", "medium", "open", "people", "pushing", "skin", "tone"},
+	"🫷🏽":                {"body", "fingers", "hand", "leftwards", "medium", "open", "people", "pushing", "skin", "tone"},
+	"🫷🏾":                {"body", "dark", "fingers", "hand", "leftwards", "medium", "open", "people", "pushing", "skin", "tone"},
+	"🫷🏿":                {"body", "dark", "fingers", "hand", "leftwards", "open", "people", "pushing", "skin", "tone"},
+	"🫸":                 {"body", "fingers", "hand", "open", "people", "pushing", "rightwards"},
+	"🫸🏻":                {"body", "fingers", "hand", "light", "open", "people", "pushing", "rightwards", "skin", "tone"},
+	"🫸🏼":                {"body", "fingers", "hand", "light", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
+	"🫸🏽":                {"body", "fingers", "hand", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
+	"🫸🏾":                {"body", "dark", "fingers", "hand", "medium", "open", "people", "pushing", "rightwards", "skin", "tone"},
+	"🫸🏿":                {"body", "dark", "fingers", "hand", "open", "people", "pushing", "rightwards", "skin", "tone"},
+	"👌":                 {"body", "fingers", "hand", "ok", "partial", "people"},
+	"👌🏻":                {"body", "fingers", "hand", "light", "ok", "partial", "people", "skin", "tone"},
+	"👌🏼":                {"body", "fingers", "hand", "light", "medium", "ok", "partial", "people", "skin", "tone"},
+	"👌🏽":                {"body", "fingers", "hand", "medium", "ok", "partial", "people", "skin", "tone"},
+	"👌🏾":                {"body", "dark", "fingers", "hand", "medium", "ok", "partial", "people", "skin", "tone"},
+	"👌🏿":                {"body", "dark", "fingers", "hand", "ok", "partial", "people", "skin", "tone"},
+	"🤌":                 {"body", "fingers", "gesture", "hand", "interrogation", "partial", "people", "pinched", "sarcastic"},
+	"🤌🏻":                {"body", "fingers", "gesture", "hand", "interrogation", "light", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
+	"🤌🏼":                {"body", "fingers", "gesture", "hand", "interrogation", "light", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
+	"🤌🏽":                {"body", "fingers", "gesture", "hand", "interrogation", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
+	"🤌🏾":                {"body", "dark", "fingers", "gesture", "hand", "interrogation", "medium", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
+	"🤌🏿":                {"body", "dark", "fingers", "gesture", "hand", "interrogation", "partial", "people", "pinched", "sarcastic", "skin", "tone"},
+	"🤏":                 {"amount", "body", "fingers", "hand", "partial", "people", "pinching", "small"},
+	"🤏🏻":                {"amount", "body", "fingers", "hand", "light", "partial", "people", "pinching", "skin", "small", "tone"},
+	"🤏🏼":                {"amount", "body", "fingers", "hand", "light", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
+	"🤏🏽":                {"amount", "body", "fingers", "hand", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
+	"🤏🏾":                {"amount", "body", "dark", "fingers", "hand", "medium", "partial", "people", "pinching", "skin", "small", "tone"},
+	"🤏🏿":                {"amount", "body", "dark", "fingers", "hand", "partial", "people", "pinching", "skin", "small", "tone"},
+	"✌️":                {"body", "fingers", "hand", "partial", "people", "v", "victory"},
+	"✌🏻":                {"body", "fingers", "hand", "light", "partial", "people", "skin", "tone", "v", "victory"},
+	"✌🏼":                {"body", "fingers", "hand", "light", "medium", "partial", "people", "skin", "tone", "v", "victory"},
+	"✌🏽":                {"body", "fingers", "hand", "medium", "partial", "people", "skin", "tone", "v", "victory"},
+	"✌🏾":                {"body", "dark", "fingers", "hand", "medium", "partial", "people", "skin", "tone", "v", "victory"},
+	"✌🏿":                {"body", "dark", "fingers", "hand", "partial", "people", "skin", "tone", "v", "victory"},
+	"🤞":                 {"body", "cross", "crossed", "finger", "fingers", "hand", "luck", "partial", "people"},
+	"🤞🏻":                {"body", "cross", "crossed", "finger", "fingers", "hand", "light", "luck", "partial", "people", "skin", "tone"},
+	"🤞🏼":                {"body", "cross", "crossed", "finger", "fingers", "hand", "light", "luck", "medium", "partial", "people", "skin", "tone"},
+	"🤞🏽":                {"body", "cross", "crossed", "finger", "fingers", "hand", "luck", "medium", "partial", "people", "skin", "tone"},
+	"🤞🏾":                {"body", "cross", "crossed", "dark", "finger", "fingers", "hand", "luck", "medium", "partial", "people", "skin", "tone"},
+	"🤞🏿":                {"body", "cross", "crossed", "dark", "finger", "fingers", "hand", "luck", "partial", "people", "skin", "tone"},
+	"🫰":                 {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "money", "partial", "people", "snap", "thumb", "with"},
+	"🫰🏻":                {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "light", "love", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
+	"🫰🏼":                {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "light", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
+	"🫰🏽":                {"and", "body", "crossed", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
+	"🫰🏾":                {"and", "body", "crossed", "dark", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "medium", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
+	"🫰🏿":                {"and", "body", "crossed", "dark", "expensive", "finger", "fingers", "hand", "heart", "index", "love", "money", "partial", "people", "skin", "snap", "thumb", "tone", "with"},
+	"🤟":                 {"body", "fingers", "gesture", "hand", "ily", "love", "partial", "people", "you"},
+	"🤟🏻":                {"body", "fingers", "gesture", "hand", "ily", "light", "love", "partial", "people", "skin", "tone", "you"},
+	"🤟🏼":                {"body", "fingers", "gesture", "hand", "ily", "light", "love", "medium", "partial", "people", "skin", "tone", "you"},
+	"🤟🏽":                {"body", "fingers", "gesture", "hand", "ily", "love", "medium", "partial", "people", "skin", "tone", "you"},
+	"🤟🏾":                {"body", "dark", "fingers", "gesture", "hand", "ily", "love", "medium", "partial", "people", "skin", "tone", "you"},
+	"🤟🏿":                {"body", "dark", "fingers", "gesture", "hand", "ily", "love", "partial", "people", "skin", "tone", "you"},
+	"🤘":                 {"body", "finger", "fingers", "hand", "horns", "of", "on", "partial", "people", "rock", "sign", "the"},
+	"🤘🏻":                {"body", "finger", "fingers", "hand", "horns", "light", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
+	"🤘🏼":                {"body", "finger", "fingers", "hand", "horns", "light", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
+	"🤘🏽":                {"body", "finger", "fingers", "hand", "horns", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
+	"🤘🏾":                {"body", "dark", "finger", "fingers", "hand", "horns", "medium", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
+	"🤘🏿":                {"body", "dark", "finger", "fingers", "hand", "horns", "of", "on", "partial", "people", "rock", "sign", "skin", "the", "tone"},
+	"🤙":                 {"body", "call", "fingers", "hand", "hang", "loose", "me", "partial", "people", "shaka"},
+	"🤙🏻":                {"body", "call", "fingers", "hand", "hang", "light", "loose", "me", "partial", "people", "shaka", "skin", "tone"},
+	"🤙🏼":                {"body", "call", "fingers", "hand", "hang", "light", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
+	"🤙🏽":                {"body", "call", "fingers", "hand", "hang", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
+	"🤙🏾":                {"body", "call", "dark", "fingers", "hand", "hang", "loose", "me", "medium", "partial", "people", "shaka", "skin", "tone"},
+	"🤙🏿":                {"body", "call", "dark", "fingers", "hand", "hang", "loose", "me", "partial", "people", "shaka", "skin", "tone"},
+	"👈":                 {"backhand", "body", "finger", "hand", "index", "left", "people", "pointing", "single"},
+	"👈🏻":                {"backhand", "body", "finger", "hand", "index", "left", "light", "people", "point", "pointing", "single", "skin", "tone"},
+	"👈🏼":                {"backhand", "body", "finger", "hand", "index", "left", "light", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👈🏽":                {"backhand", "body", "finger", "hand", "index", "left", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👈🏾":                {"backhand", "body", "dark", "finger", "hand", "index", "left", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👈🏿":                {"backhand", "body", "dark", "finger", "hand", "index", "left", "people", "point", "pointing", "single", "skin", "tone"},
+	"👉":                 {"backhand", "body", "finger", "hand", "index", "people", "pointing", "right", "single"},
+	"👉🏻":                {"backhand", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "right", "single", "skin", "tone"},
+	"👉🏼":                {"backhand", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
+	"👉🏽":                {"backhand", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
+	"👉🏾":                {"backhand", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "right", "single", "skin", "tone"},
+	"👉🏿":                {"backhand", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "right", "single", "skin", "tone"},
+	"👆":                 {"backhand", "body", "finger", "hand", "index", "people", "pointing", "single", "up"},
+	"👆🏻":                {"backhand", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"👆🏼":                {"backhand", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"👆🏽":                {"backhand", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"👆🏾":                {"backhand", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"👆🏿":                {"backhand", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"🖕":                 {"body", "finger", "hand", "middle", "people", "single"},
+	"🖕🏻":                {"body", "finger", "hand", "light", "middle", "people", "single", "skin", "tone"},
+	"🖕🏼":                {"body", "finger", "hand", "light", "medium", "middle", "people", "single", "skin", "tone"},
+	"🖕🏽":                {"body", "finger", "hand", "medium", "middle", "people", "single", "skin", "tone"},
+	"🖕🏾":                {"body", "dark", "finger", "hand", "medium", "middle", "people", "single", "skin", "tone"},
+	"🖕🏿":                {"body", "dark", "finger", "hand", "middle", "people", "single", "skin", "tone"},
+	"👇":                 {"backhand", "body", "down", "finger", "hand", "index", "people", "pointing", "single"},
+	"👇🏻":                {"backhand", "body", "down", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone"},
+	"👇🏼":                {"backhand", "body", "down", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👇🏽":                {"backhand", "body", "down", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👇🏾":                {"backhand", "body", "dark", "down", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone"},
+	"👇🏿":                {"backhand", "body", "dark", "down", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone"},
+	"☝️":                {"body", "finger", "hand", "index", "people", "point", "pointing", "single", "up"},
+	"☝🏻":                {"body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"☝🏼":                {"body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"☝🏽":                {"body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"☝🏾":                {"body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"☝🏿":                {"body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "tone", "up"},
+	"🫵":                 {"at", "body", "finger", "hand", "index", "people", "point", "pointing", "single", "the", "viewer", "you"},
+	"🫵🏻":                {"at", "body", "finger", "hand", "index", "light", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
+	"🫵🏼":                {"at", "body", "finger", "hand", "index", "light", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
+	"🫵🏽":                {"at", "body", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
+	"🫵🏾":                {"at", "body", "dark", "finger", "hand", "index", "medium", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
+	"🫵🏿":                {"at", "body", "dark", "finger", "hand", "index", "people", "point", "pointing", "single", "skin", "the", "tone", "viewer", "you"},
+	"👍":                 {"body", "closed", "fingers", "hand", "people", "thumbs", "up"},
+	"👍🏻":                {"body", "closed", "fingers", "hand", "light", "people", "skin", "thumb", "thumbs", "tone", "up"},
+	"👍🏼":                {"body", "closed", "fingers", "hand", "light", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
+	"👍🏽":                {"body", "closed", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
+	"👍🏾":                {"body", "closed", "dark", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone", "up"},
+	"👍🏿":                {"body", "closed", "dark", "fingers", "hand", "people", "skin", "thumb", "thumbs", "tone", "up"},
+	"👎":                 {"body", "closed", "down", "fingers", "hand", "people", "thumbs"},
+	"👎🏻":                {"body", "closed", "down", "fingers", "hand", "light", "people", "skin", "thumb", "thumbs", "tone"},
+	"👎🏼":                {"body", "closed", "down", "fingers", "hand", "light", "medium", "people", "skin", "thumb", "thumbs", "tone"},
+	"👎🏽":                {"body", "closed", "down", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone"},
+	"👎🏾":                {"body", "closed", "dark", "down", "fingers", "hand", "medium", "people", "skin", "thumb", "thumbs", "tone"},
+	"👎🏿":                {"body", "closed", "dark", "down", "fingers", "hand", "people", "skin", "thumb", "thumbs", "tone"},
+	"✊":                 {"body", "clenched", "closed", "fingers", "fist", "hand", "people", "punch", "raised"},
+	"✊🏻":                {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "people", "punch", "raised", "skin", "tone"},
+	"✊🏼":                {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "medium", "people", "punch", "raised", "skin", "tone"},
+	"✊🏽":                {"body", "clenched", "closed", "fingers", "fist", "hand", "medium", "people", "punch", "raised", "skin", "tone"},
+	"✊🏾":                {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "medium", "people", "punch", "raised", "skin", "tone"},
+	"✊🏿":                {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "people", "punch", "raised", "skin", "tone"},
+	"👊":                 {"body", "clenched", "closed", "fingers", "fist", "hand", "oncoming", "people", "punch"},
+	"👊🏻":                {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "oncoming", "people", "punch", "skin", "tone"},
+	"👊🏼":                {"body", "clenched", "closed", "fingers", "fist", "hand", "light", "medium", "oncoming", "people", "punch", "skin", "tone"},
+	"👊🏽":                {"body", "clenched", "closed", "fingers", "fist", "hand", "medium", "oncoming", "people", "punch", "skin", "tone"},
+	"👊🏾":                {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "medium", "oncoming", "people", "punch", "skin", "tone"},
+	"👊🏿":                {"body", "clenched", "closed", "dark", "fingers", "fist", "hand", "oncoming", "people", "punch", "skin", "tone"},
+	"🤛":                 {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "people"},
+	"🤛🏻":                {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "light", "people", "skin", "tone"},
+	"🤛🏼":                {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "light", "medium", "people", "skin", "tone"},
+	"🤛🏽":                {"body", "closed", "facing", "fingers", "fist", "hand", "left", "leftwards", "medium", "people", "skin", "tone"},
+	"🤛🏾":                {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "left", "leftwards", "medium", "people", "skin", "tone"},
+	"🤛🏿":                {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "left", "leftwards", "people", "skin", "tone"},
+	"🤜":                 {"body", "closed", "facing", "fingers", "fist", "hand", "people", "right", "rightwards"},
+	"🤜🏻":                {"body", "closed", "facing", "fingers", "fist", "hand", "light", "people", "right", "rightwards", "skin", "tone"},
+	"🤜🏼":                {"body", "closed", "facing", "fingers", "fist", "hand", "light", "medium", "people", "right", "rightwards", "skin", "tone"},
+	"🤜🏽":                {"body", "closed", "facing", "fingers", "fist", "hand", "medium", "people", "right", "rightwards", "skin", "tone"},
+	"🤜🏾":                {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "medium", "people", "right", "rightwards", "skin", "tone"},
+	"🤜🏿":                {"body", "closed", "dark", "facing", "fingers", "fist", "hand", "people", "right", "rightwards", "skin", "tone"},
+	"👏":                 {"body", "clap", "clapping", "hand", "hands", "people"},
+	"👏🏻":                {"body", "clap", "clapping", "hand", "hands", "light", "people", "skin", "tone"},
+	"👏🏼":                {"body", "clap", "clapping", "hand", "hands", "light", "medium", "people", "skin", "tone"},
+	"👏🏽":                {"body", "clap", "clapping", "hand", "hands", "medium", "people", "skin", "tone"},
+	"👏🏾":                {"body", "clap", "clapping", "dark", "hand", "hands", "medium", "people", "skin", "tone"},
+	"👏🏿":                {"body", "clap", "clapping", "dark", "hand", "hands", "people", "skin", "tone"},
+	"🙌":                 {"body", "celebration", "gesture", "hand", "hands", "hooray", "people", "raised", "raising"},
+	"🙌🏻":                {"body", "celebration", "gesture", "hand", "hands", "hooray", "light", "people", "raised", "raising", "skin", "tone"},
+	"🙌🏼":                {"body", "celebration", "gesture", "hand", "hands", "hooray", "light", "medium", "people", "raised", "raising", "skin", "tone"},
+	"🙌🏽":                {"body", "celebration", "gesture", "hand", "hands", "hooray", "medium", "people", "raised", "raising", "skin", "tone"},
+	"🙌🏾":                {"body", "celebration", "dark", "gesture", "hand", "hands", "hooray", "medium", "people", "raised", "raising", "skin", "tone"},
+	"🙌🏿":                {"body", "celebration", "dark", "gesture", "hand", "hands", "hooray", "people", "raised", "raising", "skin", "tone"},
+	"🫶":                 {"body", "hands", "heart", "love", "people"},
+	"🫶🏻":                {"body", "hands", "heart", "light", "love", "people", "skin", "tone"},
+	"🫶🏼":                {"body", "hands", "heart", "light", "love", "medium", "people", "skin", "tone"},
+	"🫶🏽":                {"body", "hands", "heart", "love", "medium", "people", "skin", "tone"},
+	"🫶🏾":                {"body", "dark", "hands", "heart", "love", "medium", "people", "skin", "tone"},
+	"🫶🏿":                {"body", "dark", "hands", "heart", "love", "people", "skin", "tone"},
+	"👐":                 {"body", "hand", "hands", "open", "people"},
+	"👐🏻":                {"body", "hand", "hands", "light", "open", "people", "skin", "tone"},
+	"👐🏼":                {"body", "hand", "hands", "light", "medium", "open", "people", "skin", "tone"},
+	"👐🏽":                {"body", "hand", "hands", "medium", "open", "people", "skin", "tone"},
+	"👐🏾":                {"body", "dark", "hand", "hands", "medium", "open", "people", "skin", "tone"},
+	"👐🏿":                {"body", "dark", "hand", "hands", "open", "people", "skin", "tone"},
+	"🤲":                 {"body", "hands", "palms", "people", "prayer", "together", "up"},
+	"🤲🏻":                {"body", "hands", "light", "palms", "people", "prayer", "skin", "together", "tone", "up"},
+	"🤲🏼":                {"body", "hands", "light", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
+	"🤲🏽":                {"body", "hands", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
+	"🤲🏾":                {"body", "dark", "hands", "medium", "palms", "people", "prayer", "skin", "together", "tone", "up"},
+	"🤲🏿":                {"body", "dark", "hands", "palms", "people", "prayer", "skin", "together", "tone", "up"},
+	"🤝":                 {"agreement", "body", "hand", "hands", "handshake", "meeting", "people", "shake"},
+	"🤝🏻":                {"agreement", "body", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
+	"🤝🏼":                {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🤝🏽":                {"agreement", "body", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🤝🏾":                {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🤝🏿":                {"agreement", "body", "dark", "hand", "hands", "handshake", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏻\u200d🫲🏼":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏻\u200d🫲🏽":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏻\u200d🫲🏾":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏻\u200d🫲🏿":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏼\u200d🫲🏻":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏼\u200d🫲🏽":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏼\u200d🫲🏾":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏼\u200d🫲🏿":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏽\u200d🫲🏻":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏽\u200d🫲🏼":        {"agreement", "body", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏽\u200d🫲🏾":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏽\u200d🫲🏿":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏾\u200d🫲🏻":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏾\u200d🫲🏼":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏾\u200d🫲🏽":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏾\u200d🫲🏿":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏿\u200d🫲🏻":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏿\u200d🫲🏼":        {"agreement", "body", "dark", "hand", "hands", "handshake", "light", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏿\u200d🫲🏽":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🫱🏿\u200d🫲🏾":        {"agreement", "body", "dark", "hand", "hands", "handshake", "medium", "meeting", "people", "shake", "skin", "tone"},
+	"🙏":                 {"ask", "body", "five", "folded", "hand", "hands", "high", "people", "please", "pray", "thanks"},
+	"🙏🏻":                {"ask", "body", "five", "folded", "hand", "hands", "high", "light", "people", "please", "pray", "skin", "thanks", "tone"},
+	"🙏🏼":                {"ask", "body", "five", "folded", "hand", "hands", "high", "light", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
+	"🙏🏽":                {"ask", "body", "five", "folded", "hand", "hands", "high", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
+	"🙏🏾":                {"ask", "body", "dark", "five", "folded", "hand", "hands", "high", "medium", "people", "please", "pray", "skin", "thanks", "tone"},
+	"🙏🏿":                {"ask", "body", "dark", "five", "folded", "hand", "hands", "high", "people", "please", "pray", "skin", "thanks", "tone"},
+	"✍️":                {"body", "hand", "people", "prop", "write", "writing"},
+	"✍🏻":                {"body", "hand", "light", "people", "prop", "skin", "tone", "write", "writing"},
+	"✍🏼":                {"body", "hand", "light", "medium", "people", "prop", "skin", "tone", "write", "writing"},
+	"✍🏽":                {"body", "hand", "medium", "people", "prop", "skin", "tone", "write", "writing"},
+	"✍🏾":                {"body", "dark", "hand", "medium", "people", "prop", "skin", "tone", "write", "writing"},
+	"✍🏿":                {"body", "dark", "hand", "people", "prop", "skin", "tone", "write", "writing"},
+	"💅":                 {"body", "care", "cosmetics", "hand", "manicure", "nail", "people", "polish", "prop"},
+	"💅🏻":                {"body", "care", "cosmetics", "hand", "light", "manicure", "nail", "people", "polish", "prop", "skin", "tone"},
+	"💅🏼":                {"body", "care", "cosmetics", "hand", "light", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
+	"💅🏽":                {"body", "care", "cosmetics", "hand", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
+	"💅🏾":                {"body", "care", "cosmetics", "dark", "hand", "manicure", "medium", "nail", "people", "polish", "prop", "skin", "tone"},
+	"💅🏿":                {"body", "care", "cosmetics", "dark", "hand", "manicure", "nail", "people", "polish", "prop", "skin", "tone"},
+	"🤳":                 {"body", "camera", "hand", "people", "phone", "prop", "selfie"},
+	"🤳🏻":                {"body", "camera", "hand", "light", "people", "phone", "prop", "selfie", "skin", "tone"},
+	"🤳🏼":                {"body", "camera", "hand", "light", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
+	"🤳🏽":                {"body", "camera", "hand", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
+	"🤳🏾":                {"body", "camera", "dark", "hand", "medium", "people", "phone", "prop", "selfie", "skin", "tone"},
+	"🤳🏿":                {"body", "camera", "dark", "hand", "people", "phone", "prop", "selfie", "skin", "tone"},
+	"💪":                 {"biceps", "body", "comic", "flex", "flexed", "muscle", "parts", "people"},
+	"💪🏻":                {"biceps", "body", "comic", "flex", "flexed", "light", "muscle", "parts", "people", "skin", "tone"},
+	"💪🏼":                {"biceps", "body", "comic", "flex", "flexed", "light", "medium", "muscle", "parts", "people", "skin", "tone"},
+	"💪🏽":                {"biceps", "body", "comic", "flex", "flexed", "medium", "muscle", "parts", "people", "skin", "tone"},
+	"💪🏾":                {"biceps", "body", "comic", "dark", "flex", "flexed", "medium", "muscle", "parts", "people", "skin", "tone"},
+	"💪🏿":                {"biceps", "body", "comic", "dark", "flex", "flexed", "muscle", "parts", "people", "skin", "tone"},
+	"🦾":                 {"accessibility", "arm", "body", "mechanical", "parts", "people", "prosthetic"},
+	"🦿":                 {"accessibility", "body", "leg", "mechanical", "parts", "people", "prosthetic"},
+	"🦵":                 {"body", "kick", "leg", "limb", "parts", "people"},
+	"🦵🏻":                {"body", "kick", "leg", "light", "limb", "parts", "people", "skin", "tone"},
+	"🦵🏼":                {"body", "kick", "leg", "light", "limb", "medium", "parts", "people", "skin", "tone"},
+	"🦵🏽":                {"body", "kick", "leg", "limb", "medium", "parts", "people", "skin", "tone"},
+	"🦵🏾":                {"body", "dark", "kick", "leg", "limb", "medium", "parts", "people", "skin", "tone"},
+	"🦵🏿":                {"body", "dark", "kick", "leg", "limb", "parts", "people", "skin", "tone"},
+	"🦶":                 {"body", "foot", "kick", "parts", "people", "stomp"},
+	"🦶🏻":                {"body", "foot", "kick", "light", "parts", "people", "skin", "stomp", "tone"},
+	"🦶🏼":                {"body", "foot", "kick", "light", "medium", "parts", "people", "skin", "stomp", "tone"},
+	"🦶🏽":                {"body", "foot", "kick", "medium", "parts", "people", "skin", "stomp", "tone"},
+	"🦶🏾":                {"body", "dark", "foot", "kick", "medium", "parts", "people", "skin", "stomp", "tone"},
+	"🦶🏿":                {"body", "dark", "foot", "kick", "parts", "people", "skin", "stomp", "tone"},
+	"👂":                 {"body", "ear", "parts", "people"},
+	"👂🏻":                {"body", "ear", "light", "parts", "people", "skin", "tone"},
+	"👂🏼":                {"body", "ear", "light", "medium", "parts", "people", "skin", "tone"},
+	"👂🏽":                {"body", "ear", "medium", "parts", "people", "skin", "tone"},
+	"👂🏾":                {"body", "dark", "ear", "medium", "parts", "people", "skin", "tone"},
+	"👂🏿":                {"body", "dark", "ear", "parts", "people", "skin", "tone"},
+	"🦻":                 {"accessibility", "aid", "body", "ear", "hard", "hearing", "of", "parts", "people", "with"},
+	"🦻🏻":                {"accessibility", "aid", "body", "ear", "hard", "hearing", "light", "of", "parts", "people", "skin", "tone", "with"},
+	"🦻🏼":                {"accessibility", "aid", "body", "ear", "hard", "hearing", "light", "medium", "of", "parts", "people", "skin", "tone", "with"},
+	"🦻🏽":                {"accessibility", "aid", "body", "ear", "hard", "hearing", "medium", "of", "parts", "people", "skin", "tone", "with"},
+	"🦻🏾":                {"accessibility", "aid", "body", "dark", "ear", "hard", "hearing", "medium", "of", "parts", "people", "skin", "tone", "with"},
+	"🦻🏿":                {"accessibility", "aid", "body", "dark", "ear", "hard", "hearing", "of", "parts", "people", "skin", "tone", "with"},
+	"👃":                 {"body", "nose", "parts", "people"},
+	"👃🏻":                {"body", "light", "nose", "parts", "people", "skin", "tone"},
+	"👃🏼":                {"body", "light", "medium", "nose", "parts", "people", "skin", "tone"},
+	"👃🏽":                {"body", "medium", "nose", "parts", "people", "skin", "tone"},
+	"👃🏾":                {"body", "dark", "medium", "nose", "parts", "people", "skin", "tone"},
+	"👃🏿":                {"body", "dark", "nose", "parts", "people", "skin", "tone"},
+	"🧠":                 {"body", "brain", "intelligent", "parts", "people"},
+	"🫀":                 {"anatomical", "body", "cardiology", "heart", "organ", "parts", "people", "pulse"},
+	"🫁":                 {"body", "breath", "exhalation", "inhalation", "lungs", "organ", "parts", "people", "respiration"},
+	"🦷":                 {"body", "dentist", "parts", "people", "tooth"},
+	"🦴":                 {"body", "bone", "parts", "people", "skeleton"},
+	"👀":                 {"body", "eye", "eyes", "face", "parts", "people"},
+	"👁️":                {"body", "eye", "parts", "people"},
+	"👅":                 {"body", "parts", "people", "tongue"},
+	"👄":                 {"body", "lips", "mouth", "parts", "people"},
+	"🫦":                 {"anxious", "biting", "body", "fear", "flirting", "lip", "nervous", "parts", "people", "uncomfortable", "worried"},
+	"👶":                 {"baby", "body", "people", "person", "young"},
+	"👶🏻":                {"baby", "body", "light", "people", "person", "skin", "tone", "young"},
+	"👶🏼":                {"baby", "body", "light", "medium", "people", "person", "skin", "tone", "young"},
+	"👶🏽":                {"baby", "body", "medium", "people", "person", "skin", "tone", "young"},
+	"👶🏾":                {"baby", "body", "dark", "medium", "people", "person", "skin", "tone", "young"},
+	"👶🏿":                {"baby", "body", "dark", "people", "person", "skin", "tone", "young"},
+	"🧒":                 {"body", "child", "gender", "neutral", "people", "person", "unspecified", "young"},
+	"🧒🏻":                {"body", "child", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
+	"🧒🏼":                {"body", "child", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
+	"🧒🏽":                {"body", "child", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
+	"🧒🏾":                {"body", "child", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
+	"🧒🏿":                {"body", "child", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified", "young"},
+	"👦":                 {"body", "boy", "people", "person", "young"},
+	"👦🏻":                {"body", "boy", "light", "people", "person", "skin", "tone", "young"},
+	"👦🏼":                {"body", "boy", "light", "medium", "people", "person", "skin", "tone", "young"},
+	"👦🏽":                {"body", "boy", "medium", "people", "person", "skin", "tone", "young"},
+	"👦🏾":                {"body", "boy", "dark", "medium", "people", "person", "skin", "tone", "young"},
+	"👦🏿":                {"body", "boy", "dark", "people", "person", "skin", "tone", "young"},
+	"👧":                 {"body", "girl", "people", "person", "virgo", "young", "zodiac"},
+	"👧🏻":                {"body", "girl", "light", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
+	"👧🏼":                {"body", "girl", "light", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
+	"👧🏽":                {"body", "girl", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
+	"👧🏾":                {"body", "dark", "girl", "medium", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
+	"👧🏿":                {"body", "dark", "girl", "people", "person", "skin", "tone", "virgo", "young", "zodiac"},
+	"🧑":                 {"adult", "body", "gender", "neutral", "people", "person", "unspecified"},
+	"🧑🏻":                {"adult", "body", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏼":                {"adult", "body", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏽":                {"adult", "body", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏾":                {"adult", "body", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏿":                {"adult", "body", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"👱":                 {"blond", "body", "hair", "haired", "people", "person"},
+	"👱🏻":                {"blond", "body", "hair", "haired", "light", "people", "person", "skin", "tone"},
+	"👱🏼":                {"blond", "body", "hair", "haired", "light", "medium", "people", "person", "skin", "tone"},
+	"👱🏽":                {"blond", "body", "hair", "haired", "medium", "people", "person", "skin", "tone"},
+	"👱🏾":                {"blond", "body", "dark", "hair", "haired", "medium", "people", "person", "skin", "tone"},
+	"👱🏿":                {"blond", "body", "dark", "hair", "haired", "people", "person", "skin", "tone"},
+	"👨":                 {"adult", "body", "man", "people", "person"},
+	"👨🏻":                {"adult", "body", "light", "man", "people", "person", "skin", "tone"},
+	"👨🏼":                {"adult", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏽":                {"adult", "body", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏾":                {"adult", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏿":                {"adult", "body", "dark", "man", "people", "person", "skin", "tone"},
+	"🧔":                 {"beard", "body", "people", "person"},
+	"🧔🏻":                {"beard", "body", "light", "people", "person", "skin", "tone"},
+	"🧔🏼":                {"beard", "body", "light", "medium", "people", "person", "skin", "tone"},
+	"🧔🏽":                {"beard", "body", "medium", "people", "person", "skin", "tone"},
+	"🧔🏾":                {"beard", "body", "dark", "medium", "people", "person", "skin", "tone"},
+	"🧔🏿":                {"beard", "body", "dark", "people", "person", "skin", "tone"},
+	"🧔\u200d♂️":         {"beard", "body", "man", "people", "person"},
+	"🧔🏻\u200d♂️":        {"beard", "body", "light", "man", "people", "person", "skin", "tone"},
+	"🧔🏼\u200d♂️":        {"beard", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🧔🏽\u200d♂️":        {"beard", "body", "man", "medium", "people", "person", "skin", "tone"},
+	"🧔🏾\u200d♂️":        {"beard", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
+	"🧔🏿\u200d♂️":        {"beard", "body", "dark", "man", "people", "person", "skin", "tone"},
+	"🧔\u200d♀️":         {"beard", "body", "people", "person", "woman"},
+	"🧔🏻\u200d♀️":        {"beard", "body", "light", "people", "person", "skin", "tone", "woman"},
+	"🧔🏼\u200d♀️":        {"beard", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧔🏽\u200d♀️":        {"beard", "body", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧔🏾\u200d♀️":        {"beard", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧔🏿\u200d♀️":        {"beard", "body", "dark", "people", "person", "skin", "tone", "woman"},
+	"👨\u200d🦰":          {"adult", "body", "hair", "man", "people", "person", "red"},
+	"👨🏻\u200d🦰":         {"adult", "body", "hair", "light", "man", "people", "person", "red", "skin", "tone"},
+	"👨🏼\u200d🦰":         {"adult", "body", "hair", "light", "man", "medium", "people", "person", "red", "skin", "tone"},
+	"👨🏽\u200d🦰":         {"adult", "body", "hair", "man", "medium", "people", "person", "red", "skin", "tone"},
+	"👨🏾\u200d🦰":         {"adult", "body", "dark", "hair", "man", "medium", "people", "person", "red", "skin", "tone"},
+	"👨🏿\u200d🦰":         {"adult", "body", "dark", "hair", "man", "people", "person", "red", "skin", "tone"},
+	"👨\u200d🦱":          {"adult", "body", "curly", "hair", "man", "people", "person"},
+	"👨🏻\u200d🦱":         {"adult", "body", "curly", "hair", "light", "man", "people", "person", "skin", "tone"},
+	"👨🏼\u200d🦱":         {"adult", "body", "curly", "hair", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏽\u200d🦱":         {"adult", "body", "curly", "hair", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏾\u200d🦱":         {"adult", "body", "curly", "dark", "hair", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏿\u200d🦱":         {"adult", "body", "curly", "dark", "hair", "man", "people", "person", "skin", "tone"},
+	"👨\u200d🦳":          {"adult", "body", "hair", "man", "people", "person", "white"},
+	"👨🏻\u200d🦳":         {"adult", "body", "hair", "light", "man", "people", "person", "skin", "tone", "white"},
+	"👨🏼\u200d🦳":         {"adult", "body", "hair", "light", "man", "medium", "people", "person", "skin", "tone", "white"},
+	"👨🏽\u200d🦳":         {"adult", "body", "hair", "man", "medium", "people", "person", "skin", "tone", "white"},
+	"👨🏾\u200d🦳":         {"adult", "body", "dark", "hair", "man", "medium", "people", "person", "skin", "tone", "white"},
+	"👨🏿\u200d🦳":         {"adult", "body", "dark", "hair", "man", "people", "person", "skin", "tone", "white"},
+	"👨\u200d🦲":          {"adult", "bald", "body", "man", "people", "person"},
+	"👨🏻\u200d🦲":         {"adult", "bald", "body", "light", "man", "people", "person", "skin", "tone"},
+	"👨🏼\u200d🦲":         {"adult", "bald", "body", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏽\u200d🦲":         {"adult", "bald", "body", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏾\u200d🦲":         {"adult", "bald", "body", "dark", "man", "medium", "people", "person", "skin", "tone"},
+	"👨🏿\u200d🦲":         {"adult", "bald", "body", "dark", "man", "people", "person", "skin", "tone"},
+	"👩":                 {"adult", "body", "people", "person", "woman"},
+	"👩🏻":                {"adult", "body", "light", "people", "person", "skin", "tone", "woman"},
+	"👩🏼":                {"adult", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏽":                {"adult", "body", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏾":                {"adult", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏿":                {"adult", "body", "dark", "people", "person", "skin", "tone", "woman"},
+	"👩\u200d🦰":          {"adult", "body", "hair", "people", "person", "red", "woman"},
+	"👩🏻\u200d🦰":         {"adult", "body", "hair", "light", "people", "person", "red", "skin", "tone", "woman"},
+	"👩🏼\u200d🦰":         {"adult", "body", "hair", "light", "medium", "people", "person", "red", "skin", "tone", "woman"},
+	"👩🏽\u200d🦰":         {"adult", "body", "hair", "medium", "people", "person", "red", "skin", "tone", "woman"},
+	"👩🏾\u200d🦰":         {"adult", "body", "dark", "hair", "medium", "people", "person", "red", "skin", "tone", "woman"},
+	"👩🏿\u200d🦰":         {"adult", "body", "dark", "hair", "people", "person", "red", "skin", "tone", "woman"},
+	"🧑\u200d🦰":          {"adult", "body", "gender", "hair", "neutral", "people", "person", "red", "unspecified"},
+	"🧑🏻\u200d🦰":         {"adult", "body", "gender", "hair", "light", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
+	"🧑🏼\u200d🦰":         {"adult", "body", "gender", "hair", "light", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
+	"🧑🏽\u200d🦰":         {"adult", "body", "gender", "hair", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
+	"🧑🏾\u200d🦰":         {"adult", "body", "dark", "gender", "hair", "medium", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
+	"🧑🏿\u200d🦰":         {"adult", "body", "dark", "gender", "hair", "neutral", "people", "person", "red", "skin", "tone", "unspecified"},
+	"👩\u200d🦱":          {"adult", "body", "curly", "hair", "people", "person", "woman"},
+	"👩🏻\u200d🦱":         {"adult", "body", "curly", "hair", "light", "people", "person", "skin", "tone", "woman"},
+	"👩🏼\u200d🦱":         {"adult", "body", "curly", "hair", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏽\u200d🦱":         {"adult", "body", "curly", "hair", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏾\u200d🦱":         {"adult", "body", "curly", "dark", "hair", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏿\u200d🦱":         {"adult", "body", "curly", "dark", "hair", "people", "person", "skin", "tone", "woman"},
+	"🧑\u200d🦱":          {"adult", "body", "curly", "gender", "hair", "neutral", "people", "person", "unspecified"},
+	"🧑🏻\u200d🦱":         {"adult", "body", "curly", "gender", "hair", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏼\u200d🦱":         {"adult", "body", "curly", "gender", "hair", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏽\u200d🦱":         {"adult", "body", "curly", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏾\u200d🦱":         {"adult", "body", "curly", "dark", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏿\u200d🦱":         {"adult", "body", "curly", "dark", "gender", "hair", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"👩\u200d🦳":          {"adult", "body", "hair", "people", "person", "white", "woman"},
+	"👩🏻\u200d🦳":         {"adult", "body", "hair", "light", "people", "person", "skin", "tone", "white", "woman"},
+	"👩🏼\u200d🦳":         {"adult", "body", "hair", "light", "medium", "people", "person", "skin", "tone", "white", "woman"},
+	"👩🏽\u200d🦳":         {"adult", "body", "hair", "medium", "people", "person", "skin", "tone", "white", "woman"},
+	"👩🏾\u200d🦳":         {"adult", "body", "dark", "hair", "medium", "people", "person", "skin", "tone", "white", "woman"},
+	"👩🏿\u200d🦳":         {"adult", "body", "dark", "hair", "people", "person", "skin", "tone", "white", "woman"},
+	"🧑\u200d🦳":          {"adult", "body", "gender", "hair", "neutral", "people", "person", "unspecified", "white"},
+	"🧑🏻\u200d🦳":         {"adult", "body", "gender", "hair", "light", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
+	"🧑🏼\u200d🦳":         {"adult", "body", "gender", "hair", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
+	"🧑🏽\u200d🦳":         {"adult", "body", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
+	"🧑🏾\u200d🦳":         {"adult", "body", "dark", "gender", "hair", "medium", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
+	"🧑🏿\u200d🦳":         {"adult", "body", "dark", "gender", "hair", "neutral", "people", "person", "skin", "tone", "unspecified", "white"},
+	"👩\u200d🦲":          {"adult", "bald", "body", "people", "person", "woman"},
+	"👩🏻\u200d🦲":         {"adult", "bald", "body", "light", "people", "person", "skin", "tone", "woman"},
+	"👩🏼\u200d🦲":         {"adult", "bald", "body", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏽\u200d🦲":         {"adult", "bald", "body", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏾\u200d🦲":         {"adult", "bald", "body", "dark", "medium", "people", "person", "skin", "tone", "woman"},
+	"👩🏿\u200d🦲":         {"adult", "bald", "body", "dark", "people", "person", "skin", "tone", "woman"},
+	"🧑\u200d🦲":          {"adult", "bald", "body", "gender", "neutral", "people", "person", "unspecified"},
+	"🧑🏻\u200d🦲":         {"adult", "bald", "body", "gender", "light", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏼\u200d🦲":         {"adult", "bald", "body", "gender", "light", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏽\u200d🦲":         {"adult", "bald", "body", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏾\u200d🦲":         {"adult", "bald", "body", "dark", "gender", "medium", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"🧑🏿\u200d🦲":         {"adult", "bald", "body", "dark", "gender", "neutral", "people", "person", "skin", "tone", "unspecified"},
+	"👱\u200d♀️":         {"blond", "blonde", "body", "hair", "haired", "people", "person", "woman"},
+	"👱🏻\u200d♀️":        {"blond", "blonde", "body", "hair", "haired", "light", "people", "person", "skin", "tone", "woman"},
+	"👱🏼\u200d♀️":        {"blond", "blonde", "body", "hair", "haired", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"👱🏽\u200d♀️":        {"blond", "blonde", "body", "hair", "haired", "medium", "people", "person", "skin", "tone", "woman"},
+	"👱🏾\u200d♀️":        {"blond", "blonde", "body", "dark", "hair", "haired", "medium", "people", "person", "skin", "tone", "woman"},
+	"👱🏿\u200d♀️":        {"blond", "blonde", "body", "dark", "hair", "haired", "people", "person", "skin", "tone", "woman"},
+	"👱\u200d♂️":         {"blond", "body", "hair", "haired", "man", "people", "person"},
+	"👱🏻\u200d♂️":        {"blond", "body", "hair", "haired", "light", "man", "people", "person", "skin", "tone"},
+	"👱🏼\u200d♂️":        {"blond", "body", "hair", "haired", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"👱🏽\u200d♂️":        {"blond", "body", "hair", "haired", "man", "medium", "people", "person", "skin", "tone"},
+	"👱🏾\u200d♂️":        {"blond", "body", "dark", "hair", "haired", "man", "medium", "people", "person", "skin", "tone"},
+	"👱🏿\u200d♂️":        {"blond", "body", "dark", "hair", "haired", "man", "people", "person", "skin", "tone"},
+	"🧓":                 {"adult", "body", "gender", "neutral", "old", "older", "people", "person", "unspecified"},
+	"🧓🏻":                {"adult", "body", "gender", "light", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
+	"🧓🏼":                {"adult", "body", "gender", "light", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
+	"🧓🏽":                {"adult", "body", "gender", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
+	"🧓🏾":                {"adult", "body", "dark", "gender", "medium", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
+	"🧓🏿":                {"adult", "body", "dark", "gender", "neutral", "old", "older", "people", "person", "skin", "tone", "unspecified"},
+	"👴":                 {"adult", "body", "man", "old", "people", "person"},
+	"👴🏻":                {"adult", "body", "light", "man", "old", "people", "person", "skin", "tone"},
+	"👴🏼":                {"adult", "body", "light", "man", "medium", "old", "people", "person", "skin", "tone"},
+	"👴🏽":                {"adult", "body", "man", "medium", "old", "people", "person", "skin", "tone"},
+	"👴🏾":                {"adult", "body", "dark", "man", "medium", "old", "people", "person", "skin", "tone"},
+	"👴🏿":                {"adult", "body", "dark", "man", "old", "people", "person", "skin", "tone"},
+	"👵":                 {"adult", "body", "old", "people", "person", "woman"},
+	"👵🏻":                {"adult", "body", "light", "old", "people", "person", "skin", "tone", "woman"},
+	"👵🏼":                {"adult", "body", "light", "medium", "old", "people", "person", "skin", "tone", "woman"},
+	"👵🏽":                {"adult", "body", "medium", "old", "people", "person", "skin", "tone", "woman"},
+	"👵🏾":                {"adult", "body", "dark", "medium", "old", "people", "person", "skin", "tone", "woman"},
+	"👵🏿":                {"adult", "body", "dark", "old", "people", "person", "skin", "tone", "woman"},
+	"🙍":                 {"body", "frown", "frowning", "gesture", "people", "person"},
+	"🙍🏻":                {"body", "frown", "frowning", "gesture", "light", "people", "person", "skin", "tone"},
+	"🙍🏼":                {"body", "frown", "frowning", "gesture", "light", "medium", "people", "person", "skin", "tone"},
+	"🙍🏽":                {"body", "frown", "frowning", "gesture", "medium", "people", "person", "skin", "tone"},
+	"🙍🏾":                {"body", "dark", "frown", "frowning", "gesture", "medium", "people", "person", "skin", "tone"},
+	"🙍🏿":                {"body", "dark", "frown", "frowning", "gesture", "people", "person", "skin", "tone"},
+	"🙍\u200d♂️":         {"body", "frowning", "gesture", "man", "people", "person"},
+	"🙍🏻\u200d♂️":        {"body", "frowning", "gesture", "light", "man", "people", "person", "skin", "tone"},
+	"🙍🏼\u200d♂️":        {"body", "frowning", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🙍🏽\u200d♂️":        {"body", "frowning", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🙍🏾\u200d♂️":        {"body", "dark", "frowning", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🙍🏿\u200d♂️":        {"body", "dark", "frowning", "gesture", "man", "people", "person", "skin", "tone"},
+	"🙍\u200d♀️":         {"body", "frowning", "gesture", "people", "person", "woman"},
+	"🙍🏻\u200d♀️":        {"body", "frowning", "gesture", "light", "people", "person", "skin", "tone", "woman"},
+	"🙍🏼\u200d♀️":        {"body", "frowning", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🙍🏽\u200d♀️":        {"body", "frowning", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🙍🏾\u200d♀️":        {"body", "dark", "frowning", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🙍🏿\u200d♀️":        {"body", "dark", "frowning", "gesture", "people", "person", "skin", "tone", "woman"},
+	"🙎":                 {"body", "gesture", "people", "person", "pouting"},
+	"🙎🏻":                {"body", "gesture", "light", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏼":                {"body", "gesture", "light", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏽":                {"body", "gesture", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏾":                {"body", "dark", "gesture", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏿":                {"body", "dark", "gesture", "people", "person", "pouting", "skin", "tone"},
+	"🙎\u200d♂️":         {"body", "gesture", "man", "people", "person", "pouting"},
+	"🙎🏻\u200d♂️":        {"body", "gesture", "light", "man", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏼\u200d♂️":        {"body", "gesture", "light", "man", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏽\u200d♂️":        {"body", "gesture", "man", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏾\u200d♂️":        {"body", "dark", "gesture", "man", "medium", "people", "person", "pouting", "skin", "tone"},
+	"🙎🏿\u200d♂️":        {"body", "dark", "gesture", "man", "people", "person", "pouting", "skin", "tone"},
+	"🙎\u200d♀️":         {"body", "gesture", "people", "person", "pouting", "woman"},
+	"🙎🏻\u200d♀️":        {"body", "gesture", "light", "people", "person", "pouting", "skin", "tone", "woman"},
+	"🙎🏼\u200d♀️":        {"body", "gesture", "light", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
+	"🙎🏽\u200d♀️":        {"body", "gesture", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
+	"🙎🏾\u200d♀️":        {"body", "dark", "gesture", "medium", "people", "person", "pouting", "skin", "tone", "woman"},
+	"🙎🏿\u200d♀️":        {"body", "dark", "gesture", "people", "person", "pouting", "skin", "tone", "woman"},
+	"🙅":                 {"body", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited"},
+	"🙅🏻":                {"body", "forbidden", "gesture", "gesturing", "hand", "light", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏼":                {"body", "forbidden", "gesture", "gesturing", "hand", "light", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏽":                {"body", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏾":                {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏿":                {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅\u200d♂️":         {"body", "forbidden", "gesture", "gesturing", "hand", "man", "no", "people", "person", "prohibited"},
+	"🙅🏻\u200d♂️":        {"body", "forbidden", "gesture", "gesturing", "hand", "light", "man", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏼\u200d♂️":        {"body", "forbidden", "gesture", "gesturing", "hand", "light", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏽\u200d♂️":        {"body", "forbidden", "gesture", "gesturing", "hand", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏾\u200d♂️":        {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "man", "medium", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅🏿\u200d♂️":        {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "man", "no", "people", "person", "prohibited", "skin", "tone"},
+	"🙅\u200d♀️":         {"body", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "woman"},
+	"🙅🏻\u200d♀️":        {"body", "forbidden", "gesture", "gesturing", "hand", "light", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
+	"🙅🏼\u200d♀️":        {"body", "forbidden", "gesture", "gesturing", "hand", "light", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
+	"🙅🏽\u200d♀️":        {"body", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
+	"🙅🏾\u200d♀️":        {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "medium", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
+	"🙅🏿\u200d♀️":        {"body", "dark", "forbidden", "gesture", "gesturing", "hand", "no", "people", "person", "prohibited", "skin", "tone", "woman"},
+	"🙆":                 {"body", "gesture", "gesturing", "hand", "ok", "people", "person"},
+	"🙆🏻":                {"body", "gesture", "gesturing", "hand", "light", "ok", "people", "person", "skin", "tone"},
+	"🙆🏼":                {"body", "gesture", "gesturing", "hand", "light", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏽":                {"body", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏾":                {"body", "dark", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏿":                {"body", "dark", "gesture", "gesturing", "hand", "ok", "people", "person", "skin", "tone"},
+	"🙆\u200d♂️":         {"body", "gesture", "gesturing", "hand", "man", "ok", "people", "person"},
+	"🙆🏻\u200d♂️":        {"body", "gesture", "gesturing", "hand", "light", "man", "ok", "people", "person", "skin", "tone"},
+	"🙆🏼\u200d♂️":        {"body", "gesture", "gesturing", "hand", "light", "man", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏽\u200d♂️":        {"body", "gesture", "gesturing", "hand", "man", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏾\u200d♂️":        {"body", "dark", "gesture", "gesturing", "hand", "man", "medium", "ok", "people", "person", "skin", "tone"},
+	"🙆🏿\u200d♂️":        {"body", "dark", "gesture", "gesturing", "hand", "man", "ok", "people", "person", "skin", "tone"},
+	"🙆\u200d♀️":         {"body", "gesture", "gesturing", "hand", "ok", "people", "person", "woman"},
+	"🙆🏻\u200d♀️":        {"body", "gesture", "gesturing", "hand", "light", "ok", "people", "person", "skin", "tone", "woman"},
+	"🙆🏼\u200d♀️":        {"body", "gesture", "gesturing", "hand", "light", "medium", "ok", "people", "person", "skin", "tone", "woman"},
+	"🙆🏽\u200d♀️":        {"body", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone", "woman"},
+	"🙆🏾\u200d♀️":        {"body", "dark", "gesture", "gesturing", "hand", "medium", "ok", "people", "person", "skin", "tone", "woman"},
+	"🙆🏿\u200d♀️":        {"body", "dark", "gesture", "gesturing", "hand", "ok", "people", "person", "skin", "tone", "woman"},
+	"💁":                 {"body", "gesture", "hand", "help", "information", "people", "person", "sassy", "tipping"},
+	"💁🏻":                {"body", "gesture", "hand", "help", "information", "light", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏼":                {"body", "gesture", "hand", "help", "information", "light", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏽":                {"body", "gesture", "hand", "help", "information", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏾":                {"body", "dark", "gesture", "hand", "help", "information", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏿":                {"body", "dark", "gesture", "hand", "help", "information", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁\u200d♂️":         {"body", "gesture", "hand", "man", "people", "person", "sassy", "tipping"},
+	"💁🏻\u200d♂️":        {"body", "gesture", "hand", "light", "man", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏼\u200d♂️":        {"body", "gesture", "hand", "light", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏽\u200d♂️":        {"body", "gesture", "hand", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏾\u200d♂️":        {"body", "dark", "gesture", "hand", "man", "medium", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁🏿\u200d♂️":        {"body", "dark", "gesture", "hand", "man", "people", "person", "sassy", "skin", "tipping", "tone"},
+	"💁\u200d♀️":         {"body", "gesture", "hand", "people", "person", "sassy", "tipping", "woman"},
+	"💁🏻\u200d♀️":        {"body", "gesture", "hand", "light", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
+	"💁🏼\u200d♀️":        {"body", "gesture", "hand", "light", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
+	"💁🏽\u200d♀️":        {"body", "gesture", "hand", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
+	"💁🏾\u200d♀️":        {"body", "dark", "gesture", "hand", "medium", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
+	"💁🏿\u200d♀️":        {"body", "dark", "gesture", "hand", "people", "person", "sassy", "skin", "tipping", "tone", "woman"},
+	"🙋":                 {"body", "gesture", "hand", "happy", "people", "person", "raised", "raising"},
+	"🙋🏻":                {"body", "gesture", "hand", "happy", "light", "people", "person", "raised", "raising", "skin", "tone"},
+	"🙋🏼":                {"body", "gesture", "hand", "happy", "light", "medium", "people", "person", "raised", "raising", "skin", "tone"},
+	"🙋🏽":                {"body", "gesture", "hand", "happy", "medium", "people", "person", "raised", "raising", "skin", "tone"},
+	"🙋🏾":                {"body", "dark", "gesture", "hand", "happy", "medium", "people", "person", "raised", "raising", "skin", "tone"},
+	"🙋🏿":                {"body", "dark", "gesture", "hand", "happy", "people", "person", "raised", "raising", "skin", "tone"},
+	"🙋\u200d♂️":         {"body", "gesture", "hand", "man", "people", "person", "raising"},
+	"🙋🏻\u200d♂️":        {"body", "gesture", "hand", "light", "man", "people", "person", "raising", "skin", "tone"},
+	"🙋🏼\u200d♂️":        {"body", "gesture", "hand", "light", "man", "medium", "people", "person", "raising", "skin", "tone"},
+	"🙋🏽\u200d♂️":        {"body", "gesture", "hand", "man", "medium", "people", "person", "raising", "skin", "tone"},
+	"🙋🏾\u200d♂️":        {"body", "dark", "gesture", "hand", "man", "medium", "people", "person", "raising", "skin", "tone"},
+	"🙋🏿\u200d♂️":        {"body", "dark", "gesture", "hand", "man", "people", "person", "raising", "skin", "tone"},
+	"🙋\u200d♀️":         {"body", "gesture", "hand", "people", "person", "raising", "woman"},
+	"🙋🏻\u200d♀️":        {"body", "gesture", "hand", "light", "people", "person", "raising", "skin", "tone", "woman"},
+	"🙋🏼\u200d♀️":        {"body", "gesture", "hand", "light", "medium", "people", "person", "raising", "skin", "tone", "woman"},
+	"🙋🏽\u200d♀️":        {"body", "gesture", "hand", "medium", "people", "person", "raising", "skin", "tone", "woman"},
+	"🙋🏾\u200d♀️":        {"body", "dark", "gesture", "hand", "medium", "people", "person", "raising", "skin", "tone", "woman"},
+	"🙋🏿\u200d♀️":        {"body", "dark", "gesture", "hand", "people", "person", "raising", "skin", "tone", "woman"},
+	"🧏":                 {"accessibility", "body", "deaf", "ear", "gesture", "hear", "people", "person"},
+	"🧏🏻":                {"accessibility", "body", "deaf", "ear", "gesture", "hear", "light", "people", "person", "skin", "tone"},
+	"🧏🏼":                {"accessibility", "body", "deaf", "ear", "gesture", "hear", "light", "medium", "people", "person", "skin", "tone"},
+	"🧏🏽":                {"accessibility", "body", "deaf", "ear", "gesture", "hear", "medium", "people", "person", "skin", "tone"},
+	"🧏🏾":                {"accessibility", "body", "dark", "deaf", "ear", "gesture", "hear", "medium", "people", "person", "skin", "tone"},
+	"🧏🏿":                {"accessibility", "body", "dark", "deaf", "ear", "gesture", "hear", "people", "person", "skin", "tone"},
+	"🧏\u200d♂️":         {"body", "deaf", "gesture", "man", "people", "person"},
+	"🧏🏻\u200d♂️":        {"body", "deaf", "gesture", "light", "man", "people", "person", "skin", "tone"},
+	"🧏🏼\u200d♂️":        {"body", "deaf", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🧏🏽\u200d♂️":        {"body", "deaf", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🧏🏾\u200d♂️":        {"body", "dark", "deaf", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🧏🏿\u200d♂️":        {"body", "dark", "deaf", "gesture", "man", "people", "person", "skin", "tone"},
+	"🧏\u200d♀️":         {"body", "deaf", "gesture", "people", "person", "woman"},
+	"🧏🏻\u200d♀️":        {"body", "deaf", "gesture", "light", "people", "person", "skin", "tone", "woman"},
+	"🧏🏼\u200d♀️":        {"body", "deaf", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧏🏽\u200d♀️":        {"body", "deaf", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧏🏾\u200d♀️":        {"body", "dark", "deaf", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧏🏿\u200d♀️":        {"body", "dark", "deaf", "gesture", "people", "person", "skin", "tone", "woman"},
+	"🙇":                 {"apology", "body", "bow", "bowing", "gesture", "people", "person", "sorry"},
+	"🙇🏻":                {"apology", "body", "bow", "bowing", "gesture", "light", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏼":                {"apology", "body", "bow", "bowing", "gesture", "light", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏽":                {"apology", "body", "bow", "bowing", "gesture", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏾":                {"apology", "body", "bow", "bowing", "dark", "gesture", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏿":                {"apology", "body", "bow", "bowing", "dark", "gesture", "people", "person", "skin", "sorry", "tone"},
+	"🙇\u200d♂️":         {"apology", "body", "bowing", "favor", "gesture", "man", "people", "person", "sorry"},
+	"🙇🏻\u200d♂️":        {"apology", "body", "bowing", "favor", "gesture", "light", "man", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏼\u200d♂️":        {"apology", "body", "bowing", "favor", "gesture", "light", "man", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏽\u200d♂️":        {"apology", "body", "bowing", "favor", "gesture", "man", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏾\u200d♂️":        {"apology", "body", "bowing", "dark", "favor", "gesture", "man", "medium", "people", "person", "skin", "sorry", "tone"},
+	"🙇🏿\u200d♂️":        {"apology", "body", "bowing", "dark", "favor", "gesture", "man", "people", "person", "skin", "sorry", "tone"},
+	"🙇\u200d♀️":         {"apology", "body", "bowing", "favor", "gesture", "people", "person", "sorry", "woman"},
+	"🙇🏻\u200d♀️":        {"apology", "body", "bowing", "favor", "gesture", "light", "people", "person", "skin", "sorry", "tone", "woman"},
+	"🙇🏼\u200d♀️":        {"apology", "body", "bowing", "favor", "gesture", "light", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
+	"🙇🏽\u200d♀️":        {"apology", "body", "bowing", "favor", "gesture", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
+	"🙇🏾\u200d♀️":        {"apology", "body", "bowing", "dark", "favor", "gesture", "medium", "people", "person", "skin", "sorry", "tone", "woman"},
+	"🙇🏿\u200d♀️":        {"apology", "body", "bowing", "dark", "favor", "gesture", "people", "person", "skin", "sorry", "tone", "woman"},
+	"🤦":                 {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "palm", "people", "person"},
+	"🤦🏻":                {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "light", "palm", "people", "person", "skin", "tone"},
+	"🤦🏼":                {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "light", "medium", "palm", "people", "person", "skin", "tone"},
+	"🤦🏽":                {"body", "disbelief", "exasperation", "face", "facepalming", "gesture", "medium", "palm", "people", "person", "skin", "tone"},
+	"🤦🏾":                {"body", "dark", "disbelief", "exasperation", "face", "facepalming", "gesture", "medium", "palm", "people", "person", "skin", "tone"},
+	"🤦🏿":                {"body", "dark", "disbelief", "exasperation", "face", "facepalming", "gesture", "palm", "people", "person", "skin", "tone"},
+	"🤦\u200d♂️":         {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "people", "person"},
+	"🤦🏻\u200d♂️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "man", "people", "person", "skin", "tone"},
+	"🤦🏼\u200d♂️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🤦🏽\u200d♂️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🤦🏾\u200d♂️":        {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "medium", "people", "person", "skin", "tone"},
+	"🤦🏿\u200d♂️":        {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "man", "people", "person", "skin", "tone"},
+	"🤦\u200d♀️":         {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "people", "person", "woman"},
+	"🤦🏻\u200d♀️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "people", "person", "skin", "tone", "woman"},
+	"🤦🏼\u200d♀️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🤦🏽\u200d♀️":        {"body", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🤦🏾\u200d♀️":        {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "medium", "people", "person", "skin", "tone", "woman"},
+	"🤦🏿\u200d♀️":        {"body", "dark", "disbelief", "exasperation", "facepalm", "facepalming", "gesture", "people", "person", "skin", "tone", "woman"},
+	"🤷":                 {"body", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging"},
+	"🤷🏻":                {"body", "doubt", "gesture", "ignorance", "indifference", "light", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏼":                {"body", "doubt", "gesture", "ignorance", "indifference", "light", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏽":                {"body", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏾":                {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏿":                {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷\u200d♂️":         {"body", "doubt", "gesture", "ignorance", "indifference", "man", "people", "person", "shrug", "shrugging"},
+	"🤷🏻\u200d♂️":        {"body", "doubt", "gesture", "ignorance", "indifference", "light", "man", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏼\u200d♂️":        {"body", "doubt", "gesture", "ignorance", "indifference", "light", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏽\u200d♂️":        {"body", "doubt", "gesture", "ignorance", "indifference", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏾\u200d♂️":        {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "man", "medium", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷🏿\u200d♂️":        {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "man", "people", "person", "shrug", "shrugging", "skin", "tone"},
+	"🤷\u200d♀️":         {"body", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "woman"},
+	"🤷🏻\u200d♀️":        {"body", "doubt", "gesture", "ignorance", "indifference", "light", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
+	"🤷🏼\u200d♀️":        {"body", "doubt", "gesture", "ignorance", "indifference", "light", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
+	"🤷🏽\u200d♀️":        {"body", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
+	"🤷🏾\u200d♀️":        {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "medium", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
+	"🤷🏿\u200d♀️":        {"body", "dark", "doubt", "gesture", "ignorance", "indifference", "people", "person", "shrug", "shrugging", "skin", "tone", "woman"},
+	"🧑\u200d⚕️":         {"body", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "therapist", "worker"},
+	"🧑🏻\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"🧑🏼\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"🧑🏽\u200d⚕️":        {"body", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"🧑🏾\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"🧑🏿\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👨\u200d⚕️":         {"body", "doctor", "health", "healthcare", "man", "nurse", "people", "person", "role", "therapist", "worker"},
+	"👨🏻\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "man", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👨🏼\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👨🏽\u200d⚕️":        {"body", "doctor", "health", "healthcare", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👨🏾\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "man", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👨🏿\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "man", "nurse", "people", "person", "role", "skin", "therapist", "tone", "worker"},
+	"👩\u200d⚕️":         {"body", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "therapist", "woman", "worker"},
+	"👩🏻\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
+	"👩🏼\u200d⚕️":        {"body", "doctor", "health", "healthcare", "light", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
+	"👩🏽\u200d⚕️":        {"body", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
+	"👩🏾\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "medium", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
+	"👩🏿\u200d⚕️":        {"body", "dark", "doctor", "health", "healthcare", "nurse", "people", "person", "role", "skin", "therapist", "tone", "woman", "worker"},
+	"🧑\u200d🎓":          {"body", "graduate", "people", "person", "role", "student"},
+	"🧑🏻\u200d🎓":         {"body", "graduate", "light", "people", "person", "role", "skin", "student", "tone"},
+	"🧑🏼\u200d🎓":         {"body", "graduate", "light", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"🧑🏽\u200d🎓":         {"body", "graduate", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"🧑🏾\u200d🎓":         {"body", "dark", "graduate", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"🧑🏿\u200d🎓":         {"body", "dark", "graduate", "people", "person", "role", "skin", "student", "tone"},
+	"👨\u200d🎓":          {"body", "graduate", "man", "people", "person", "role", "student"},
+	"👨🏻\u200d🎓":         {"body", "graduate", "light", "man", "people", "person", "role", "skin", "student", "tone"},
+	"👨🏼\u200d🎓":         {"body", "graduate", "light", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"👨🏽\u200d🎓":         {"body", "graduate", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"👨🏾\u200d🎓":         {"body", "dark", "graduate", "man", "medium", "people", "person", "role", "skin", "student", "tone"},
+	"👨🏿\u200d🎓":         {"body", "dark", "graduate", "man", "people", "person", "role", "skin", "student", "tone"},
+	"👩\u200d🎓":          {"body", "graduate", "people", "person", "role", "student", "woman"},
+	"👩🏻\u200d🎓":         {"body", "graduate", "light", "people", "person", "role", "skin", "student", "tone", "woman"},
+	"👩🏼\u200d🎓":         {"body", "graduate", "light", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
+	"👩🏽\u200d🎓":         {"body", "graduate", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
+	"👩🏾\u200d🎓":         {"body", "dark", "graduate", "medium", "people", "person", "role", "skin", "student", "tone", "woman"},
+	"👩🏿\u200d🎓":         {"body", "dark", "graduate", "people", "person", "role", "skin", "student", "tone", "woman"},
+	"🧑\u200d🏫":          {"body", "instructor", "people", "person", "professor", "role", "teacher"},
+	"🧑🏻\u200d🏫":         {"body", "instructor", "light", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"🧑🏼\u200d🏫":         {"body", "instructor", "light", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"🧑🏽\u200d🏫":         {"body", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"🧑🏾\u200d🏫":         {"body", "dark", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"🧑🏿\u200d🏫":         {"body", "dark", "instructor", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👨\u200d🏫":          {"body", "instructor", "man", "people", "person", "professor", "role", "teacher"},
+	"👨🏻\u200d🏫":         {"body", "instructor", "light", "man", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👨🏼\u200d🏫":         {"body", "instructor", "light", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👨🏽\u200d🏫":         {"body", "instructor", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👨🏾\u200d🏫":         {"body", "dark", "instructor", "man", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👨🏿\u200d🏫":         {"body", "dark", "instructor", "man", "people", "person", "professor", "role", "skin", "teacher", "tone"},
+	"👩\u200d🏫":          {"body", "instructor", "people", "person", "professor", "role", "teacher", "woman"},
+	"👩🏻\u200d🏫":         {"body", "instructor", "light", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
+	"👩🏼\u200d🏫":         {"body", "instructor", "light", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
+	"👩🏽\u200d🏫":         {"body", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
+	"👩🏾\u200d🏫":         {"body", "dark", "instructor", "medium", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
+	"👩🏿\u200d🏫":         {"body", "dark", "instructor", "people", "person", "professor", "role", "skin", "teacher", "tone", "woman"},
+	"🧑\u200d⚖️":         {"body", "judge", "justice", "people", "person", "role", "scales"},
+	"🧑🏻\u200d⚖️":        {"body", "judge", "justice", "light", "people", "person", "role", "scales", "skin", "tone"},
+	"🧑🏼\u200d⚖️":        {"body", "judge", "justice", "light", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"🧑🏽\u200d⚖️":        {"body", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"🧑🏾\u200d⚖️":        {"body", "dark", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"🧑🏿\u200d⚖️":        {"body", "dark", "judge", "justice", "people", "person", "role", "scales", "skin", "tone"},
+	"👨\u200d⚖️":         {"body", "judge", "justice", "man", "people", "person", "role", "scales"},
+	"👨🏻\u200d⚖️":        {"body", "judge", "justice", "light", "man", "people", "person", "role", "scales", "skin", "tone"},
+	"👨🏼\u200d⚖️":        {"body", "judge", "justice", "light", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"👨🏽\u200d⚖️":        {"body", "judge", "justice", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"👨🏾\u200d⚖️":        {"body", "dark", "judge", "justice", "man", "medium", "people", "person", "role", "scales", "skin", "tone"},
+	"👨🏿\u200d⚖️":        {"body", "dark", "judge", "justice", "man", "people", "person", "role", "scales", "skin", "tone"},
+	"👩\u200d⚖️":         {"body", "judge", "justice", "people", "person", "role", "scales", "woman"},
+	"👩🏻\u200d⚖️":        {"body", "judge", "justice", "light", "people", "person", "role", "scales", "skin", "tone", "woman"},
+	"👩🏼\u200d⚖️":        {"body", "judge", "justice", "light", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
+	"👩🏽\u200d⚖️":        {"body", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
+	"👩🏾\u200d⚖️":        {"body", "dark", "judge", "justice", "medium", "people", "person", "role", "scales", "skin", "tone", "woman"},
+	"👩🏿\u200d⚖️":        {"body", "dark", "judge", "justice", "people", "person", "role", "scales", "skin", "tone", "woman"},
+	"🧑\u200d🌾":          {"body", "farmer", "gardener", "people", "person", "rancher", "role"},
+	"🧑🏻\u200d🌾":         {"body", "farmer", "gardener", "light", "people", "person", "rancher", "role", "skin", "tone"},
+	"🧑🏼\u200d🌾":         {"body", "farmer", "gardener", "light", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"🧑🏽\u200d🌾":         {"body", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"🧑🏾\u200d🌾":         {"body", "dark", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"🧑🏿\u200d🌾":         {"body", "dark", "farmer", "gardener", "people", "person", "rancher", "role", "skin", "tone"},
+	"👨\u200d🌾":          {"body", "farmer", "gardener", "man", "people", "person", "rancher", "role"},
+	"👨🏻\u200d🌾":         {"body", "farmer", "gardener", "light", "man", "people", "person", "rancher", "role", "skin", "tone"},
+	"👨🏼\u200d🌾":         {"body", "farmer", "gardener", "light", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"👨🏽\u200d🌾":         {"body", "farmer", "gardener", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"👨🏾\u200d🌾":         {"body", "dark", "farmer", "gardener", "man", "medium", "people", "person", "rancher", "role", "skin", "tone"},
+	"👨🏿\u200d🌾":         {"body", "dark", "farmer", "gardener", "man", "people", "person", "rancher", "role", "skin", "tone"},
+	"👩\u200d🌾":          {"body", "farmer", "gardener", "people", "person", "rancher", "role", "woman"},
+	"👩🏻\u200d🌾":         {"body", "farmer", "gardener", "light", "people", "person", "rancher", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🌾":         {"body", "farmer", "gardener", "light", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🌾":         {"body", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🌾":         {"body", "dark", "farmer", "gardener", "medium", "people", "person", "rancher", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🌾":         {"body", "dark", "farmer", "gardener", "people", "person", "rancher", "role", "skin", "tone", "woman"},
+	"🧑\u200d🍳":          {"body", "chef", "cook", "people", "person", "role"},
+	"🧑🏻\u200d🍳":         {"body", "chef", "cook", "light", "people", "person", "role", "skin", "tone"},
+	"🧑🏼\u200d🍳":         {"body", "chef", "cook", "light", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏽\u200d🍳":         {"body", "chef", "cook", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏾\u200d🍳":         {"body", "chef", "cook", "dark", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏿\u200d🍳":         {"body", "chef", "cook", "dark", "people", "person", "role", "skin", "tone"},
+	"👨\u200d🍳":          {"body", "chef", "cook", "man", "people", "person", "role"},
+	"👨🏻\u200d🍳":         {"body", "chef", "cook", "light", "man", "people", "person", "role", "skin", "tone"},
+	"👨🏼\u200d🍳":         {"body", "chef", "cook", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏽\u200d🍳":         {"body", "chef", "cook", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏾\u200d🍳":         {"body", "chef", "cook", "dark", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏿\u200d🍳":         {"body", "chef", "cook", "dark", "man", "people", "person", "role", "skin", "tone"},
+	"👩\u200d🍳":          {"body", "chef", "cook", "people", "person", "role", "woman"},
+	"👩🏻\u200d🍳":         {"body", "chef", "cook", "light", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🍳":         {"body", "chef", "cook", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🍳":         {"body", "chef", "cook", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🍳":         {"body", "chef", "cook", "dark", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🍳":         {"body", "chef", "cook", "dark", "people", "person", "role", "skin", "tone", "woman"},
+	"🧑\u200d🔧":          {"body", "electrician", "mechanic", "people", "person", "plumber", "role", "tradesperson"},
+	"🧑🏻\u200d🔧":         {"body", "electrician", "light", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"🧑🏼\u200d🔧":         {"body", "electrician", "light", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"🧑🏽\u200d🔧":         {"body", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"🧑🏾\u200d🔧":         {"body", "dark", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"🧑🏿\u200d🔧":         {"body", "dark", "electrician", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👨\u200d🔧":          {"body", "electrician", "man", "mechanic", "people", "person", "plumber", "role", "tradesperson"},
+	"👨🏻\u200d🔧":         {"body", "electrician", "light", "man", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👨🏼\u200d🔧":         {"body", "electrician", "light", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👨🏽\u200d🔧":         {"body", "electrician", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👨🏾\u200d🔧":         {"body", "dark", "electrician", "man", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👨🏿\u200d🔧":         {"body", "dark", "electrician", "man", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson"},
+	"👩\u200d🔧":          {"body", "electrician", "mechanic", "people", "person", "plumber", "role", "tradesperson", "woman"},
+	"👩🏻\u200d🔧":         {"body", "electrician", "light", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
+	"👩🏼\u200d🔧":         {"body", "electrician", "light", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
+	"👩🏽\u200d🔧":         {"body", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
+	"👩🏾\u200d🔧":         {"body", "dark", "electrician", "mechanic", "medium", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
+	"👩🏿\u200d🔧":         {"body", "dark", "electrician", "mechanic", "people", "person", "plumber", "role", "skin", "tone", "tradesperson", "woman"},
+	"🧑\u200d🏭":          {"assembly", "body", "factory", "industrial", "people", "person", "role", "worker"},
+	"🧑🏻\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "people", "person", "role", "skin", "tone", "worker"},
+	"🧑🏼\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"🧑🏽\u200d🏭":         {"assembly", "body", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"🧑🏾\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"🧑🏿\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "people", "person", "role", "skin", "tone", "worker"},
+	"👨\u200d🏭":          {"assembly", "body", "factory", "industrial", "man", "people", "person", "role", "worker"},
+	"👨🏻\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "man", "people", "person", "role", "skin", "tone", "worker"},
+	"👨🏼\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👨🏽\u200d🏭":         {"assembly", "body", "factory", "industrial", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👨🏾\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👨🏿\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "man", "people", "person", "role", "skin", "tone", "worker"},
+	"👩\u200d🏭":          {"assembly", "body", "factory", "industrial", "people", "person", "role", "woman", "worker"},
+	"👩🏻\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👩🏼\u200d🏭":         {"assembly", "body", "factory", "industrial", "light", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👩🏽\u200d🏭":         {"assembly", "body", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👩🏾\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👩🏿\u200d🏭":         {"assembly", "body", "dark", "factory", "industrial", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"🧑\u200d💼":          {"architect", "body", "business", "collar", "manager", "office", "people", "person", "role", "white", "worker"},
+	"🧑🏻\u200d💼":         {"architect", "body", "business", "collar", "light", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"🧑🏼\u200d💼":         {"architect", "body", "business", "collar", "light", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"🧑🏽\u200d💼":         {"architect", "body", "business", "collar", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"🧑🏾\u200d💼":         {"architect", "body", "business", "collar", "dark", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"🧑🏿\u200d💼":         {"architect", "body", "business", "collar", "dark", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👨\u200d💼":          {"architect", "body", "business", "collar", "man", "manager", "office", "people", "person", "role", "white", "worker"},
+	"👨🏻\u200d💼":         {"architect", "body", "business", "collar", "light", "man", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👨🏼\u200d💼":         {"architect", "body", "business", "collar", "light", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👨🏽\u200d💼":         {"architect", "body", "business", "collar", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👨🏾\u200d💼":         {"architect", "body", "business", "collar", "dark", "man", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👨🏿\u200d💼":         {"architect", "body", "business", "collar", "dark", "man", "manager", "office", "people", "person", "role", "skin", "tone", "white", "worker"},
+	"👩\u200d💼":          {"architect", "body", "business", "collar", "manager", "office", "people", "person", "role", "white", "woman", "worker"},
+	"👩🏻\u200d💼":         {"architect", "body", "business", "collar", "light", "manager", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
+	"👩🏼\u200d💼":         {"architect", "body", "business", "collar", "light", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
+	"👩🏽\u200d💼":         {"architect", "body", "business", "collar", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
+	"👩🏾\u200d💼":         {"architect", "body", "business", "collar", "dark", "manager", "medium", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
+	"👩🏿\u200d💼":         {"architect", "body", "business", "collar", "dark", "manager", "office", "people", "person", "role", "skin", "tone", "white", "woman", "worker"},
+	"🧑\u200d🔬":          {"biologist", "body", "chemist", "engineer", "people", "person", "physicist", "role", "scientist"},
+	"🧑🏻\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"🧑🏼\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"🧑🏽\u200d🔬":         {"biologist", "body", "chemist", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"🧑🏾\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"🧑🏿\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👨\u200d🔬":          {"biologist", "body", "chemist", "engineer", "man", "people", "person", "physicist", "role", "scientist"},
+	"👨🏻\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "man", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👨🏼\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👨🏽\u200d🔬":         {"biologist", "body", "chemist", "engineer", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👨🏾\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "man", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👨🏿\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "man", "people", "person", "physicist", "role", "scientist", "skin", "tone"},
+	"👩\u200d🔬":          {"biologist", "body", "chemist", "engineer", "people", "person", "physicist", "role", "scientist", "woman"},
+	"👩🏻\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
+	"👩🏼\u200d🔬":         {"biologist", "body", "chemist", "engineer", "light", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
+	"👩🏽\u200d🔬":         {"biologist", "body", "chemist", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
+	"👩🏾\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "medium", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
+	"👩🏿\u200d🔬":         {"biologist", "body", "chemist", "dark", "engineer", "people", "person", "physicist", "role", "scientist", "skin", "tone", "woman"},
+	"🧑\u200d💻":          {"body", "coder", "developer", "inventor", "people", "person", "role", "software", "technologist"},
+	"🧑🏻\u200d💻":         {"body", "coder", "developer", "inventor", "light", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"🧑🏼\u200d💻":         {"body", "coder", "developer", "inventor", "light", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"🧑🏽\u200d💻":         {"body", "coder", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"🧑🏾\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"🧑🏿\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👨\u200d💻":          {"body", "coder", "developer", "inventor", "man", "people", "person", "role", "software", "technologist"},
+	"👨🏻\u200d💻":         {"body", "coder", "developer", "inventor", "light", "man", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👨🏼\u200d💻":         {"body", "coder", "developer", "inventor", "light", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👨🏽\u200d💻":         {"body", "coder", "developer", "inventor", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👨🏾\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "man", "medium", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👨🏿\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "man", "people", "person", "role", "skin", "software", "technologist", "tone"},
+	"👩\u200d💻":          {"body", "coder", "developer", "inventor", "people", "person", "role", "software", "technologist", "woman"},
+	"👩🏻\u200d💻":         {"body", "coder", "developer", "inventor", "light", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
+	"👩🏼\u200d💻":         {"body", "coder", "developer", "inventor", "light", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
+	"👩🏽\u200d💻":         {"body", "coder", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
+	"👩🏾\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "medium", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
+	"👩🏿\u200d💻":         {"body", "coder", "dark", "developer", "inventor", "people", "person", "role", "skin", "software", "technologist", "tone", "woman"},
+	"🧑\u200d🎤":          {"actor", "body", "entertainer", "people", "person", "rock", "role", "singer", "star"},
+	"🧑🏻\u200d🎤":         {"actor", "body", "entertainer", "light", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"🧑🏼\u200d🎤":         {"actor", "body", "entertainer", "light", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"🧑🏽\u200d🎤":         {"actor", "body", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"🧑🏾\u200d🎤":         {"actor", "body", "dark", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"🧑🏿\u200d🎤":         {"actor", "body", "dark", "entertainer", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👨\u200d🎤":          {"actor", "body", "entertainer", "man", "people", "person", "rock", "role", "singer", "star"},
+	"👨🏻\u200d🎤":         {"actor", "body", "entertainer", "light", "man", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👨🏼\u200d🎤":         {"actor", "body", "entertainer", "light", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👨🏽\u200d🎤":         {"actor", "body", "entertainer", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👨🏾\u200d🎤":         {"actor", "body", "dark", "entertainer", "man", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👨🏿\u200d🎤":         {"actor", "body", "dark", "entertainer", "man", "people", "person", "rock", "role", "singer", "skin", "star", "tone"},
+	"👩\u200d🎤":          {"actor", "body", "entertainer", "people", "person", "rock", "role", "singer", "star", "woman"},
+	"👩🏻\u200d🎤":         {"actor", "body", "entertainer", "light", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
+	"👩🏼\u200d🎤":         {"actor", "body", "entertainer", "light", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
+	"👩🏽\u200d🎤":         {"actor", "body", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
+	"👩🏾\u200d🎤":         {"actor", "body", "dark", "entertainer", "medium", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
+	"👩🏿\u200d🎤":         {"actor", "body", "dark", "entertainer", "people", "person", "rock", "role", "singer", "skin", "star", "tone", "woman"},
+	"🧑\u200d🎨":          {"artist", "body", "palette", "people", "person", "role"},
+	"🧑🏻\u200d🎨":         {"artist", "body", "light", "palette", "people", "person", "role", "skin", "tone"},
+	"🧑🏼\u200d🎨":         {"artist", "body", "light", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"🧑🏽\u200d🎨":         {"artist", "body", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"🧑🏾\u200d🎨":         {"artist", "body", "dark", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"🧑🏿\u200d🎨":         {"artist", "body", "dark", "palette", "people", "person", "role", "skin", "tone"},
+	"👨\u200d🎨":          {"artist", "body", "man", "palette", "people", "person", "role"},
+	"👨🏻\u200d🎨":         {"artist", "body", "light", "man", "palette", "people", "person", "role", "skin", "tone"},
+	"👨🏼\u200d🎨":         {"artist", "body", "light", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"👨🏽\u200d🎨":         {"artist", "body", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"👨🏾\u200d🎨":         {"artist", "body", "dark", "man", "medium", "palette", "people", "person", "role", "skin", "tone"},
+	"👨🏿\u200d🎨":         {"artist", "body", "dark", "man", "palette", "people", "person", "role", "skin", "tone"},
+	"👩\u200d🎨":          {"artist", "body", "palette", "people", "person", "role", "woman"},
+	"👩🏻\u200d🎨":         {"artist", "body", "light", "palette", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🎨":         {"artist", "body", "light", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🎨":         {"artist", "body", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🎨":         {"artist", "body", "dark", "medium", "palette", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🎨":         {"artist", "body", "dark", "palette", "people", "person", "role", "skin", "tone", "woman"},
+	"🧑\u200d✈️":         {"body", "people", "person", "pilot", "plane", "role"},
+	"🧑🏻\u200d✈️":        {"body", "light", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"🧑🏼\u200d✈️":        {"body", "light", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"🧑🏽\u200d✈️":        {"body", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"🧑🏾\u200d✈️":        {"body", "dark", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"🧑🏿\u200d✈️":        {"body", "dark", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👨\u200d✈️":         {"body", "man", "people", "person", "pilot", "plane", "role"},
+	"👨🏻\u200d✈️":        {"body", "light", "man", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👨🏼\u200d✈️":        {"body", "light", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👨🏽\u200d✈️":        {"body", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👨🏾\u200d✈️":        {"body", "dark", "man", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👨🏿\u200d✈️":        {"body", "dark", "man", "people", "person", "pilot", "plane", "role", "skin", "tone"},
+	"👩\u200d✈️":         {"body", "people", "person", "pilot", "plane", "role", "woman"},
+	"👩🏻\u200d✈️":        {"body", "light", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d✈️":        {"body", "light", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d✈️":        {"body", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d✈️":        {"body", "dark", "medium", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d✈️":        {"body", "dark", "people", "person", "pilot", "plane", "role", "skin", "tone", "woman"},
+	"🧑\u200d🚀":          {"astronaut", "body", "people", "person", "rocket", "role"},
+	"🧑🏻\u200d🚀":         {"astronaut", "body", "light", "people", "person", "rocket", "role", "skin", "tone"},
+	"🧑🏼\u200d🚀":         {"astronaut", "body", "light", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"🧑🏽\u200d🚀":         {"astronaut", "body", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"🧑🏾\u200d🚀":         {"astronaut", "body", "dark", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"🧑🏿\u200d🚀":         {"astronaut", "body", "dark", "people", "person", "rocket", "role", "skin", "tone"},
+	"👨\u200d🚀":          {"astronaut", "body", "man", "people", "person", "rocket", "role"},
+	"👨🏻\u200d🚀":         {"astronaut", "body", "light", "man", "people", "person", "rocket", "role", "skin", "tone"},
+	"👨🏼\u200d🚀":         {"astronaut", "body", "light", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"👨🏽\u200d🚀":         {"astronaut", "body", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"👨🏾\u200d🚀":         {"astronaut", "body", "dark", "man", "medium", "people", "person", "rocket", "role", "skin", "tone"},
+	"👨🏿\u200d🚀":         {"astronaut", "body", "dark", "man", "people", "person", "rocket", "role", "skin", "tone"},
+	"👩\u200d🚀":          {"astronaut", "body", "people", "person", "rocket", "role", "woman"},
+	"👩🏻\u200d🚀":         {"astronaut", "body", "light", "people", "person", "rocket", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🚀":         {"astronaut", "body", "light", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🚀":         {"astronaut", "body", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🚀":         {"astronaut", "body", "dark", "medium", "people", "person", "rocket", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🚀":         {"astronaut", "body", "dark", "people", "person", "rocket", "role", "skin", "tone", "woman"},
+	"🧑\u200d🚒":          {"body", "firefighter", "firetruck", "people", "person", "role"},
+	"🧑🏻\u200d🚒":         {"body", "firefighter", "firetruck", "light", "people", "person", "role", "skin", "tone"},
+	"🧑🏼\u200d🚒":         {"body", "firefighter", "firetruck", "light", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏽\u200d🚒":         {"body", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏾\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone"},
+	"🧑🏿\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "people", "person", "role", "skin", "tone"},
+	"👨\u200d🚒":          {"body", "firefighter", "firetruck", "man", "people", "person", "role"},
+	"👨🏻\u200d🚒":         {"body", "firefighter", "firetruck", "light", "man", "people", "person", "role", "skin", "tone"},
+	"👨🏼\u200d🚒":         {"body", "firefighter", "firetruck", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏽\u200d🚒":         {"body", "firefighter", "firetruck", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏾\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"👨🏿\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "man", "people", "person", "role", "skin", "tone"},
+	"👩\u200d🚒":          {"body", "firefighter", "firetruck", "people", "person", "role", "woman"},
+	"👩🏻\u200d🚒":         {"body", "firefighter", "firetruck", "light", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🚒":         {"body", "firefighter", "firetruck", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🚒":         {"body", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🚒":         {"body", "dark", "firefighter", "firetruck", "people", "person", "role", "skin", "tone", "woman"},
+	"👮":                 {"body", "cop", "officer", "people", "person", "police", "role"},
+	"👮🏻":                {"body", "cop", "light", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏼":                {"body", "cop", "light", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏽":                {"body", "cop", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏾":                {"body", "cop", "dark", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏿":                {"body", "cop", "dark", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮\u200d♂️":         {"body", "cop", "man", "officer", "people", "person", "police", "role"},
+	"👮🏻\u200d♂️":        {"body", "cop", "light", "man", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏼\u200d♂️":        {"body", "cop", "light", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏽\u200d♂️":        {"body", "cop", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏾\u200d♂️":        {"body", "cop", "dark", "man", "medium", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮🏿\u200d♂️":        {"body", "cop", "dark", "man", "officer", "people", "person", "police", "role", "skin", "tone"},
+	"👮\u200d♀️":         {"body", "cop", "officer", "people", "person", "police", "role", "woman"},
+	"👮🏻\u200d♀️":        {"body", "cop", "light", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
+	"👮🏼\u200d♀️":        {"body", "cop", "light", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
+	"👮🏽\u200d♀️":        {"body", "cop", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
+	"👮🏾\u200d♀️":        {"body", "cop", "dark", "medium", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
+	"👮🏿\u200d♀️":        {"body", "cop", "dark", "officer", "people", "person", "police", "role", "skin", "tone", "woman"},
+	"🕵️":                {"body", "detective", "people", "person", "role", "sleuth", "spy"},
+	"🕵🏻":                {"body", "detective", "light", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏼":                {"body", "detective", "light", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏽":                {"body", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏾":                {"body", "dark", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏿":                {"body", "dark", "detective", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵️\u200d♂️":        {"body", "detective", "man", "people", "person", "role", "sleuth", "spy"},
+	"🕵🏻\u200d♂️":        {"body", "detective", "light", "man", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏼\u200d♂️":        {"body", "detective", "light", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏽\u200d♂️":        {"body", "detective", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏾\u200d♂️":        {"body", "dark", "detective", "man", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵🏿\u200d♂️":        {"body", "dark", "detective", "man", "people", "person", "role", "skin", "sleuth", "spy", "tone"},
+	"🕵️\u200d♀️":        {"body", "detective", "people", "person", "role", "sleuth", "spy", "woman"},
+	"🕵🏻\u200d♀️":        {"body", "detective", "light", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
+	"🕵🏼\u200d♀️":        {"body", "detective", "light", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
+	"🕵🏽\u200d♀️":        {"body", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
+	"🕵🏾\u200d♀️":        {"body", "dark", "detective", "medium", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
+	"🕵🏿\u200d♀️":        {"body", "dark", "detective", "people", "person", "role", "skin", "sleuth", "spy", "tone", "woman"},
+	"💂":                 {"body", "guard", "people", "person", "role"},
+	"💂🏻":                {"body", "guard", "light", "people", "person", "role", "skin", "tone"},
+	"💂🏼":                {"body", "guard", "light", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏽":                {"body", "guard", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏾":                {"body", "dark", "guard", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏿":                {"body", "dark", "guard", "people", "person", "role", "skin", "tone"},
+	"💂\u200d♂️":         {"body", "guard", "man", "people", "person", "role"},
+	"💂🏻\u200d♂️":        {"body", "guard", "light", "man", "people", "person", "role", "skin", "tone"},
+	"💂🏼\u200d♂️":        {"body", "guard", "light", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏽\u200d♂️":        {"body", "guard", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏾\u200d♂️":        {"body", "dark", "guard", "man", "medium", "people", "person", "role", "skin", "tone"},
+	"💂🏿\u200d♂️":        {"body", "dark", "guard", "man", "people", "person", "role", "skin", "tone"},
+	"💂\u200d♀️":         {"body", "guard", "people", "person", "role", "woman"},
+	"💂🏻\u200d♀️":        {"body", "guard", "light", "people", "person", "role", "skin", "tone", "woman"},
+	"💂🏼\u200d♀️":        {"body", "guard", "light", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"💂🏽\u200d♀️":        {"body", "guard", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"💂🏾\u200d♀️":        {"body", "dark", "guard", "medium", "people", "person", "role", "skin", "tone", "woman"},
+	"💂🏿\u200d♀️":        {"body", "dark", "guard", "people", "person", "role", "skin", "tone", "woman"},
+	"🥷":                 {"body", "fighter", "hidden", "ninja", "people", "person", "role", "stealth"},
+	"🥷🏻":                {"body", "fighter", "hidden", "light", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
+	"🥷🏼":                {"body", "fighter", "hidden", "light", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
+	"🥷🏽":                {"body", "fighter", "hidden", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
+	"🥷🏾":                {"body", "dark", "fighter", "hidden", "medium", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
+	"🥷🏿":                {"body", "dark", "fighter", "hidden", "ninja", "people", "person", "role", "skin", "stealth", "tone"},
+	"👷":                 {"body", "construction", "hat", "people", "person", "role", "worker"},
+	"👷🏻":                {"body", "construction", "hat", "light", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏼":                {"body", "construction", "hat", "light", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏽":                {"body", "construction", "hat", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏾":                {"body", "construction", "dark", "hat", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏿":                {"body", "construction", "dark", "hat", "people", "person", "role", "skin", "tone", "worker"},
+	"👷\u200d♂️":         {"body", "construction", "man", "people", "person", "role", "worker"},
+	"👷🏻\u200d♂️":        {"body", "construction", "light", "man", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏼\u200d♂️":        {"body", "construction", "light", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏽\u200d♂️":        {"body", "construction", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏾\u200d♂️":        {"body", "construction", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "worker"},
+	"👷🏿\u200d♂️":        {"body", "construction", "dark", "man", "people", "person", "role", "skin", "tone", "worker"},
+	"👷\u200d♀️":         {"body", "construction", "people", "person", "role", "woman", "worker"},
+	"👷🏻\u200d♀️":        {"body", "construction", "light", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👷🏼\u200d♀️":        {"body", "construction", "light", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👷🏽\u200d♀️":        {"body", "construction", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👷🏾\u200d♀️":        {"body", "construction", "dark", "medium", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"👷🏿\u200d♀️":        {"body", "construction", "dark", "people", "person", "role", "skin", "tone", "woman", "worker"},
+	"🫅":                 {"body", "crown", "monarch", "noble", "people", "person", "regal", "role", "royalty", "with"},
+	"🫅🏻":                {"body", "crown", "light", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
+	"🫅🏼":                {"body", "crown", "light", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
+	"🫅🏽":                {"body", "crown", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
+	"🫅🏾":                {"body", "crown", "dark", "medium", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
+	"🫅🏿":                {"body", "crown", "dark", "monarch", "noble", "people", "person", "regal", "role", "royalty", "skin", "tone", "with"},
+	"🤴":                 {"body", "people", "person", "prince", "role"},
+	"🤴🏻":                {"body", "light", "people", "person", "prince", "role", "skin", "tone"},
+	"🤴🏼":                {"body", "light", "medium", "people", "person", "prince", "role", "skin", "tone"},
+	"🤴🏽":                {"body", "medium", "people", "person", "prince", "role", "skin", "tone"},
+	"🤴🏾":                {"body", "dark", "medium", "people", "person", "prince", "role", "skin", "tone"},
+	"🤴🏿":                {"body", "dark", "people", "person", "prince", "role", "skin", "tone"},
+	"👸":                 {"body", "fairy", "fantasy", "people", "person", "princess", "role", "tale"},
+	"👸🏻":                {"body", "fairy", "fantasy", "light", "people", "person", "princess", "role", "skin", "tale", "tone"},
+	"👸🏼":                {"body", "fairy", "fantasy", "light", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
+	"👸🏽":                {"body", "fairy", "fantasy", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
+	"👸🏾":                {"body", "dark", "fairy", "fantasy", "medium", "people", "person", "princess", "role", "skin", "tale", "tone"},
+	"👸🏿":                {"body", "dark", "fairy", "fantasy", "people", "person", "princess", "role", "skin", "tale", "tone"},
+	"👳":                 {"body", "people", "person", "role", "turban", "wearing"},
+	"👳🏻":                {"body", "light", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏼":                {"body", "light", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏽":                {"body", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏾":                {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏿":                {"body", "dark", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳\u200d♂️":         {"body", "man", "people", "person", "role", "turban", "wearing"},
+	"👳🏻\u200d♂️":        {"body", "light", "man", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏼\u200d♂️":        {"body", "light", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏽\u200d♂️":        {"body", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏾\u200d♂️":        {"body", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳🏿\u200d♂️":        {"body", "dark", "man", "people", "person", "role", "skin", "tone", "turban", "wearing"},
+	"👳\u200d♀️":         {"body", "people", "person", "role", "turban", "wearing", "woman"},
+	"👳🏻\u200d♀️":        {"body", "light", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
+	"👳🏼\u200d♀️":        {"body", "light", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
+	"👳🏽\u200d♀️":        {"body", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
+	"👳🏾\u200d♀️":        {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
+	"👳🏿\u200d♀️":        {"body", "dark", "people", "person", "role", "skin", "tone", "turban", "wearing", "woman"},
+	"👲":                 {"body", "cap", "gua", "hat", "mao", "people", "person", "pi", "role", "skullcap", "with"},
+	"👲🏻":                {"body", "cap", "gua", "hat", "light", "mao", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
+	"👲🏼":                {"body", "cap", "gua", "hat", "light", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
+	"👲🏽":                {"body", "cap", "gua", "hat", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
+	"👲🏾":                {"body", "cap", "dark", "gua", "hat", "mao", "medium", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
+	"👲🏿":                {"body", "cap", "dark", "gua", "hat", "mao", "people", "person", "pi", "role", "skin", "skullcap", "tone", "with"},
+	"🧕":                 {"body", "headscarf", "hijab", "mantilla", "people", "person", "role", "tichel", "with", "woman"},
+	"🧕🏻":                {"body", "headscarf", "hijab", "light", "mantilla", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
+	"🧕🏼":                {"body", "headscarf", "hijab", "light", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
+	"🧕🏽":                {"body", "headscarf", "hijab", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
+	"🧕🏾":                {"body", "dark", "headscarf", "hijab", "mantilla", "medium", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
+	"🧕🏿":                {"body", "dark", "headscarf", "hijab", "mantilla", "people", "person", "role", "skin", "tichel", "tone", "with", "woman"},
+	"🤵":                 {"body", "groom", "in", "people", "person", "role", "tuxedo"},
+	"🤵🏻":                {"body", "groom", "in", "light", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏼":                {"body", "groom", "in", "light", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏽":                {"body", "groom", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏾":                {"body", "dark", "groom", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏿":                {"body", "dark", "groom", "in", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵\u200d♂️":         {"body", "in", "man", "people", "person", "role", "tuxedo"},
+	"🤵🏻\u200d♂️":        {"body", "in", "light", "man", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏼\u200d♂️":        {"body", "in", "light", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏽\u200d♂️":        {"body", "in", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏾\u200d♂️":        {"body", "dark", "in", "man", "medium", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵🏿\u200d♂️":        {"body", "dark", "in", "man", "people", "person", "role", "skin", "tone", "tuxedo"},
+	"🤵\u200d♀️":         {"body", "in", "people", "person", "role", "tuxedo", "woman"},
+	"🤵🏻\u200d♀️":        {"body", "in", "light", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
+	"🤵🏼\u200d♀️":        {"body", "in", "light", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
+	"🤵🏽\u200d♀️":        {"body", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
+	"🤵🏾\u200d♀️":        {"body", "dark", "in", "medium", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
+	"🤵🏿\u200d♀️":        {"body", "dark", "in", "people", "person", "role", "skin", "tone", "tuxedo", "woman"},
+	"👰":                 {"body", "bride", "people", "person", "role", "veil", "wedding", "with"},
+	"👰🏻":                {"body", "bride", "light", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
+	"👰🏼":                {"body", "bride", "light", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
+	"👰🏽":                {"body", "bride", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
+	"👰🏾":                {"body", "bride", "dark", "medium", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
+	"👰🏿":                {"body", "bride", "dark", "people", "person", "role", "skin", "tone", "veil", "wedding", "with"},
+	"👰\u200d♂️":         {"body", "man", "people", "person", "role", "veil", "with"},
+	"👰🏻\u200d♂️":        {"body", "light", "man", "people", "person", "role", "skin", "tone", "veil", "with"},
+	"👰🏼\u200d♂️":        {"body", "light", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
+	"👰🏽\u200d♂️":        {"body", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
+	"👰🏾\u200d♂️":        {"body", "dark", "man", "medium", "people", "person", "role", "skin", "tone", "veil", "with"},
+	"👰🏿\u200d♂️":        {"body", "dark", "man", "people", "person", "role", "skin", "tone", "veil", "with"},
+	"👰\u200d♀️":         {"body", "people", "person", "role", "veil", "with", "woman"},
+	"👰🏻\u200d♀️":        {"body", "light", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
+	"👰🏼\u200d♀️":        {"body", "light", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
+	"👰🏽\u200d♀️":        {"body", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
+	"👰🏾\u200d♀️":        {"body", "dark", "medium", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
+	"👰🏿\u200d♀️":        {"body", "dark", "people", "person", "role", "skin", "tone", "veil", "with", "woman"},
+	"🤰":                 {"body", "people", "person", "pregnant", "role", "woman"},
+	"🤰🏻":                {"body", "light", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
+	"🤰🏼":                {"body", "light", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
+	"🤰🏽":                {"body", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
+	"🤰🏾":                {"body", "dark", "medium", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
+	"🤰🏿":                {"body", "dark", "people", "person", "pregnant", "role", "skin", "tone", "woman"},
+	"🫃":                 {"belly", "bloated", "body", "full", "man", "people", "person", "pregnant", "role"},
+	"🫃🏻":                {"belly", "bloated", "body", "full", "light", "man", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫃🏼":                {"belly", "bloated", "body", "full", "light", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫃🏽":                {"belly", "bloated", "body", "full", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫃🏾":                {"belly", "bloated", "body", "dark", "full", "man", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫃🏿":                {"belly", "bloated", "body", "dark", "full", "man", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫄":                 {"belly", "bloated", "body", "full", "people", "person", "pregnant", "role"},
+	"🫄🏻":                {"belly", "bloated", "body", "full", "light", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫄🏼":                {"belly", "bloated", "body", "full", "light", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫄🏽":                {"belly", "bloated", "body", "full", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫄🏾":                {"belly", "bloated", "body", "dark", "full", "medium", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🫄🏿":                {"belly", "bloated", "body", "dark", "full", "people", "person", "pregnant", "role", "skin", "tone"},
+	"🤱":                 {"baby", "body", "breast", "feeding", "nursing", "people", "person", "role"},
+	"🤱🏻":                {"baby", "body", "breast", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone"},
+	"🤱🏼":                {"baby", "body", "breast", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🤱🏽":                {"baby", "body", "breast", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🤱🏾":                {"baby", "body", "breast", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🤱🏿":                {"baby", "body", "breast", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone"},
+	"👩\u200d🍼":          {"baby", "body", "feeding", "nursing", "people", "person", "role", "woman"},
+	"👩🏻\u200d🍼":         {"baby", "body", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏼\u200d🍼":         {"baby", "body", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏽\u200d🍼":         {"baby", "body", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏾\u200d🍼":         {"baby", "body", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone", "woman"},
+	"👩🏿\u200d🍼":         {"baby", "body", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone", "woman"},
+	"👨\u200d🍼":          {"baby", "body", "feeding", "man", "nursing", "people", "person", "role"},
+	"👨🏻\u200d🍼":         {"baby", "body", "feeding", "light", "man", "nursing", "people", "person", "role", "skin", "tone"},
+	"👨🏼\u200d🍼":         {"baby", "body", "feeding", "light", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"👨🏽\u200d🍼":         {"baby", "body", "feeding", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"👨🏾\u200d🍼":         {"baby", "body", "dark", "feeding", "man", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"👨🏿\u200d🍼":         {"baby", "body", "dark", "feeding", "man", "nursing", "people", "person", "role", "skin", "tone"},
+	"🧑\u200d🍼":          {"baby", "body", "feeding", "nursing", "people", "person", "role"},
+	"🧑🏻\u200d🍼":         {"baby", "body", "feeding", "light", "nursing", "people", "person", "role", "skin", "tone"},
+	"🧑🏼\u200d🍼":         {"baby", "body", "feeding", "light", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🧑🏽\u200d🍼":         {"baby", "body", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🧑🏾\u200d🍼":         {"baby", "body", "dark", "feeding", "medium", "nursing", "people", "person", "role", "skin", "tone"},
+	"🧑🏿\u200d🍼":         {"baby", "body", "dark", "feeding", "nursing", "people", "person", "role", "skin", "tone"},
+	"👼":                 {"angel", "baby", "body", "face", "fairy", "fantasy", "people", "person", "tale"},
+	"👼🏻":                {"angel", "baby", "body", "face", "fairy", "fantasy", "light", "people", "person", "skin", "tale", "tone"},
+	"👼🏼":                {"angel", "baby", "body", "face", "fairy", "fantasy", "light", "medium", "people", "person", "skin", "tale", "tone"},
+	"👼🏽":                {"angel", "baby", "body", "face", "fairy", "fantasy", "medium", "people", "person", "skin", "tale", "tone"},
+	"👼🏾":                {"angel", "baby", "body", "dark", "face", "fairy", "fantasy", "medium", "people", "person", "skin", "tale", "tone"},
+	"👼🏿":                {"angel", "baby", "body", "dark", "face", "fairy", "fantasy", "people", "person", "skin", "tale", "tone"},
+	"🎅":                 {"body", "celebration", "christmas", "claus", "fantasy", "father", "people", "person", "santa"},
+	"🎅🏻":                {"body", "celebration", "christmas", "claus", "fantasy", "father", "light", "people", "person", "santa", "skin", "tone"},
+	"🎅🏼":                {"body", "celebration", "christmas", "claus", "fantasy", "father", "light", "medium", "people", "person", "santa", "skin", "tone"},
+	"🎅🏽":                {"body", "celebration", "christmas", "claus", "fantasy", "father", "medium", "people", "person", "santa", "skin", "tone"},
+	"🎅🏾":                {"body", "celebration", "christmas", "claus", "dark", "fantasy", "father", "medium", "people", "person", "santa", "skin", "tone"},
+	"🎅🏿":                {"body", "celebration", "christmas", "claus", "dark", "fantasy", "father", "people", "person", "santa", "skin", "tone"},
+	"🤶":                 {"body", "celebration", "christmas", "claus", "fantasy", "mother", "mrs", "people", "person"},
+	"🤶🏻":                {"body", "celebration", "christmas", "claus", "fantasy", "light", "mother", "mrs", "people", "person", "skin", "tone"},
+	"🤶🏼":                {"body", "celebration", "christmas", "claus", "fantasy", "light", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
+	"🤶🏽":                {"body", "celebration", "christmas", "claus", "fantasy", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
+	"🤶🏾":                {"body", "celebration", "christmas", "claus", "dark", "fantasy", "medium", "mother", "mrs", "people", "person", "skin", "tone"},
+	"🤶🏿":                {"body", "celebration", "christmas", "claus", "dark", "fantasy", "mother", "mrs", "people", "person", "skin", "tone"},
+	"🧑\u200d🎄":          {"body", "christmas", "claus", "fantasy", "mx", "people", "person"},
+	"🧑🏻\u200d🎄":         {"body", "christmas", "claus", "fantasy", "light", "mx", "people", "person", "skin", "tone"},
+	"🧑🏼\u200d🎄":         {"body", "christmas", "claus", "fantasy", "light", "medium", "mx", "people", "person", "skin", "tone"},
+	"🧑🏽\u200d🎄":         {"body", "christmas", "claus", "fantasy", "medium", "mx", "people", "person", "skin", "tone"},
+	"🧑🏾\u200d🎄":         {"body", "christmas", "claus", "dark", "fantasy", "medium", "mx", "people", "person", "skin", "tone"},
+	"🧑🏿\u200d🎄":         {"body", "christmas", "claus", "dark", "fantasy", "mx", "people", "person", "skin", "tone"},
+	"🦸":                 {"body", "fantasy", "good", "hero", "heroine", "people", "person", "superhero", "superpower"},
+	"🦸🏻":                {"body", "fantasy", "good", "hero", "heroine", "light", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏼":                {"body", "fantasy", "good", "hero", "heroine", "light", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏽":                {"body", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏾":                {"body", "dark", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏿":                {"body", "dark", "fantasy", "good", "hero", "heroine", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸\u200d♂️":         {"body", "fantasy", "good", "hero", "man", "people", "person", "superhero", "superpower"},
+	"🦸🏻\u200d♂️":        {"body", "fantasy", "good", "hero", "light", "man", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏼\u200d♂️":        {"body", "fantasy", "good", "hero", "light", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏽\u200d♂️":        {"body", "fantasy", "good", "hero", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏾\u200d♂️":        {"body", "dark", "fantasy", "good", "hero", "man", "medium", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸🏿\u200d♂️":        {"body", "dark", "fantasy", "good", "hero", "man", "people", "person", "skin", "superhero", "superpower", "tone"},
+	"🦸\u200d♀️":         {"body", "fantasy", "good", "hero", "heroine", "people", "person", "superhero", "superpower", "woman"},
+	"🦸🏻\u200d♀️":        {"body", "fantasy", "good", "hero", "heroine", "light", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
+	"🦸🏼\u200d♀️":        {"body", "fantasy", "good", "hero", "heroine", "light", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
+	"🦸🏽\u200d♀️":        {"body", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
+	"🦸🏾\u200d♀️":        {"body", "dark", "fantasy", "good", "hero", "heroine", "medium", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
+	"🦸🏿\u200d♀️":        {"body", "dark", "fantasy", "good", "hero", "heroine", "people", "person", "skin", "superhero", "superpower", "tone", "woman"},
+	"🦹":                 {"body", "criminal", "evil", "fantasy", "people", "person", "superpower", "supervillain", "villain"},
+	"🦹🏻":                {"body", "criminal", "evil", "fantasy", "light", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏼":                {"body", "criminal", "evil", "fantasy", "light", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏽":                {"body", "criminal", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏾":                {"body", "criminal", "dark", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏿":                {"body", "criminal", "dark", "evil", "fantasy", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹\u200d♂️":         {"body", "criminal", "evil", "fantasy", "man", "people", "person", "superpower", "supervillain", "villain"},
+	"🦹🏻\u200d♂️":        {"body", "criminal", "evil", "fantasy", "light", "man", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏼\u200d♂️":        {"body", "criminal", "evil", "fantasy", "light", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏽\u200d♂️":        {"body", "criminal", "evil", "fantasy", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏾\u200d♂️":        {"body", "criminal", "dark", "evil", "fantasy", "man", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹🏿\u200d♂️":        {"body", "criminal", "dark", "evil", "fantasy", "man", "people", "person", "skin", "superpower", "supervillain", "tone", "villain"},
+	"🦹\u200d♀️":         {"body", "criminal", "evil", "fantasy", "people", "person", "superpower", "supervillain", "villain", "woman"},
+	"🦹🏻\u200d♀️":        {"body", "criminal", "evil", "fantasy", "light", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
+	"🦹🏼\u200d♀️":        {"body", "criminal", "evil", "fantasy", "light", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
+	"🦹🏽\u200d♀️":        {"body", "criminal", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
+	"🦹🏾\u200d♀️":        {"body", "criminal", "dark", "evil", "fantasy", "medium", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
+	"🦹🏿\u200d♀️":        {"body", "criminal", "dark", "evil", "fantasy", "people", "person", "skin", "superpower", "supervillain", "tone", "villain", "woman"},
+	"🧙":                 {"body", "fantasy", "mage", "people", "person", "sorcerer", "sorceress", "witch", "wizard"},
+	"🧙🏻":                {"body", "fantasy", "light", "mage", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
+	"🧙🏼":                {"body", "fantasy", "light", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
+	"🧙🏽":                {"body", "fantasy", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
+	"🧙🏾":                {"body", "dark", "fantasy", "mage", "medium", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
+	"🧙🏿":                {"body", "dark", "fantasy", "mage", "people", "person", "skin", "sorcerer", "sorceress", "tone", "witch", "wizard"},
+	"🧙\u200d♂️":         {"body", "fantasy", "mage", "man", "people", "person", "sorcerer", "wizard"},
+	"🧙🏻\u200d♂️":        {"body", "fantasy", "light", "mage", "man", "people", "person", "skin", "sorcerer", "tone", "wizard"},
+	"🧙🏼\u200d♂️":        {"body", "fantasy", "light", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
+	"🧙🏽\u200d♂️":        {"body", "fantasy", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
+	"🧙🏾\u200d♂️":        {"body", "dark", "fantasy", "mage", "man", "medium", "people", "person", "skin", "sorcerer", "tone", "wizard"},
+	"🧙🏿\u200d♂️":        {"body", "dark", "fantasy", "mage", "man", "people", "person", "skin", "sorcerer", "tone", "wizard"},
+	"🧙\u200d♀️":         {"body", "fantasy", "mage", "people", "person", "sorceress", "witch", "woman"},
+	"🧙🏻\u200d♀️":        {"body", "fantasy", "light", "mage", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
+	"🧙🏼\u200d♀️":        {"body", "fantasy", "light", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
+	"🧙🏽\u200d♀️":        {"body", "fantasy", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
+	"🧙🏾\u200d♀️":        {"body", "dark", "fantasy", "mage", "medium", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
+	"🧙🏿\u200d♀️":        {"body", "dark", "fantasy", "mage", "people", "person", "skin", "sorceress", "tone", "witch", "woman"},
+	"🧚":                 {"body", "fairy", "fantasy", "oberon", "people", "person", "puck", "titania"},
+	"🧚🏻":                {"body", "fairy", "fantasy", "light", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
+	"🧚🏼":                {"body", "fairy", "fantasy", "light", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
+	"🧚🏽":                {"body", "fairy", "fantasy", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
+	"🧚🏾":                {"body", "dark", "fairy", "fantasy", "medium", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
+	"🧚🏿":                {"body", "dark", "fairy", "fantasy", "oberon", "people", "person", "puck", "skin", "titania", "tone"},
+	"🧚\u200d♂️":         {"body", "fairy", "fantasy", "man", "oberon", "people", "person", "puck"},
+	"🧚🏻\u200d♂️":        {"body", "fairy", "fantasy", "light", "man", "oberon", "people", "person", "puck", "skin", "tone"},
+	"🧚🏼\u200d♂️":        {"body", "fairy", "fantasy", "light", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
+	"🧚🏽\u200d♂️":        {"body", "fairy", "fantasy", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
+	"🧚🏾\u200d♂️":        {"body", "dark", "fairy", "fantasy", "man", "medium", "oberon", "people", "person", "puck", "skin", "tone"},
+	"🧚🏿\u200d♂️":        {"body", "dark", "fairy", "fantasy", "man", "oberon", "people", "person", "puck", "skin", "tone"},
+	"🧚\u200d♀️":         {"body", "fairy", "fantasy", "people", "person", "titania", "woman"},
+	"🧚🏻\u200d♀️":        {"body", "fairy", "fantasy", "light", "people", "person", "skin", "titania", "tone", "woman"},
+	"🧚🏼\u200d♀️":        {"body", "fairy", "fantasy", "light", "medium", "people", "person", "skin", "titania", "tone", "woman"},
+	"🧚🏽\u200d♀️":        {"body", "fairy", "fantasy", "medium", "people", "person", "skin", "titania", "tone", "woman"},
+	"🧚🏾\u200d♀️":        {"body", "dark", "fairy", "fantasy", "medium", "people", "person", "skin", "titania", "tone", "woman"},
+	"🧚🏿\u200d♀️":        {"body", "dark", "fairy", "fantasy", "people", "person", "skin", "titania", "tone", "woman"},
+	"🧛":                 {"body", "dracula", "fantasy", "people", "person", "undead", "vampire"},
+	"🧛🏻":                {"body", "dracula", "fantasy", "light", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏼":                {"body", "dracula", "fantasy", "light", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏽":                {"body", "dracula", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏾":                {"body", "dark", "dracula", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏿":                {"body", "dark", "dracula", "fantasy", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛\u200d♂️":         {"body", "dracula", "fantasy", "man", "people", "person", "undead", "vampire"},
+	"🧛🏻\u200d♂️":        {"body", "dracula", "fantasy", "light", "man", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏼\u200d♂️":        {"body", "dracula", "fantasy", "light", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏽\u200d♂️":        {"body", "dracula", "fantasy", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏾\u200d♂️":        {"body", "dark", "dracula", "fantasy", "man", "medium", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛🏿\u200d♂️":        {"body", "dark", "dracula", "fantasy", "man", "people", "person", "skin", "tone", "undead", "vampire"},
+	"🧛\u200d♀️":         {"body", "fantasy", "people", "person", "undead", "vampire", "woman"},
+	"🧛🏻\u200d♀️":        {"body", "fantasy", "light", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
+	"🧛🏼\u200d♀️":        {"body", "fantasy", "light", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
+	"🧛🏽\u200d♀️":        {"body", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
+	"🧛🏾\u200d♀️":        {"body", "dark", "fantasy", "medium", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
+	"🧛🏿\u200d♀️":        {"body", "dark", "fantasy", "people", "person", "skin", "tone", "undead", "vampire", "woman"},
+	"🧜":                 {"body", "fantasy", "mermaid", "merman", "merperson", "merwoman", "people", "person"},
+	"🧜🏻":                {"body", "fantasy", "light", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏼":                {"body", "fantasy", "light", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏽":                {"body", "fantasy", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏾":                {"body", "dark", "fantasy", "medium", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏿":                {"body", "dark", "fantasy", "mermaid", "merman", "merperson", "merwoman", "people", "person", "skin", "tone"},
+	"🧜\u200d♂️":         {"body", "fantasy", "merman", "people", "person", "triton"},
+	"🧜🏻\u200d♂️":        {"body", "fantasy", "light", "merman", "people", "person", "skin", "tone", "triton"},
+	"🧜🏼\u200d♂️":        {"body", "fantasy", "light", "medium", "merman", "people", "person", "skin", "tone", "triton"},
+	"🧜🏽\u200d♂️":        {"body", "fantasy", "medium", "merman", "people", "person", "skin", "tone", "triton"},
+	"🧜🏾\u200d♂️":        {"body", "dark", "fantasy", "medium", "merman", "people", "person", "skin", "tone", "triton"},
+	"🧜🏿\u200d♂️":        {"body", "dark", "fantasy", "merman", "people", "person", "skin", "tone", "triton"},
+	"🧜\u200d♀️":         {"body", "fantasy", "mermaid", "merwoman", "people", "person"},
+	"🧜🏻\u200d♀️":        {"body", "fantasy", "light", "mermaid", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏼\u200d♀️":        {"body", "fantasy", "light", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏽\u200d♀️":        {"body", "fantasy", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏾\u200d♀️":        {"body", "dark", "fantasy", "medium", "mermaid", "merwoman", "people", "person", "skin", "tone"},
+	"🧜🏿\u200d♀️":        {"body", "dark", "fantasy", "mermaid", "merwoman", "people", "person", "skin", "tone"},
+	"🧝":                 {"body", "elf", "fantasy", "magical", "people", "person"},
+	"🧝🏻":                {"body", "elf", "fantasy", "light", "magical", "people", "person", "skin", "tone"},
+	"🧝🏼":                {"body", "elf", "fantasy", "light", "magical", "medium", "people", "person", "skin", "tone"},
+	"🧝🏽":                {"body", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone"},
+	"🧝🏾":                {"body", "dark", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone"},
+	"🧝🏿":                {"body", "dark", "elf", "fantasy", "magical", "people", "person", "skin", "tone"},
+	"🧝\u200d♂️":         {"body", "elf", "fantasy", "magical", "man", "people", "person"},
+	"🧝🏻\u200d♂️":        {"body", "elf", "fantasy", "light", "magical", "man", "people", "person", "skin", "tone"},
+	"🧝🏼\u200d♂️":        {"body", "elf", "fantasy", "light", "magical", "man", "medium", "people", "person", "skin", "tone"},
+	"🧝🏽\u200d♂️":        {"body", "elf", "fantasy", "magical", "man", "medium", "people", "person", "skin", "tone"},
+	"🧝🏾\u200d♂️":        {"body", "dark", "elf", "fantasy", "magical", "man", "medium", "people", "person", "skin", "tone"},
+	"🧝🏿\u200d♂️":        {"body", "dark", "elf", "fantasy", "magical", "man", "people", "person", "skin", "tone"},
+	"🧝\u200d♀️":         {"body", "elf", "fantasy", "magical", "people", "person", "woman"},
+	"🧝🏻\u200d♀️":        {"body", "elf", "fantasy", "light", "magical", "people", "person", "skin", "tone", "woman"},
+	"🧝🏼\u200d♀️":        {"body", "elf", "fantasy", "light", "magical", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧝🏽\u200d♀️":        {"body", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧝🏾\u200d♀️":        {"body", "dark", "elf", "fantasy", "magical", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧝🏿\u200d♀️":        {"body", "dark", "elf", "fantasy", "magical", "people", "person", "skin", "tone", "woman"},
+	"🧞":                 {"body", "djinn", "fantasy", "genie", "people", "person"},
+	"🧞\u200d♂️":         {"body", "djinn", "fantasy", "genie", "man", "people", "person"},
+	"🧞\u200d♀️":         {"body", "djinn", "fantasy", "genie", "people", "person", "woman"},
+	"🧟":                 {"body", "dead", "fantasy", "people", "person", "undead", "walking", "zombie"},
+	"🧟\u200d♂️":         {"body", "dead", "fantasy", "man", "people", "person", "undead", "walking", "zombie"},
+	"🧟\u200d♀️":         {"body", "dead", "fantasy", "people", "person", "undead", "walking", "woman", "zombie"},
+	"🧌":                 {"body", "fairy", "fantasy", "monster", "people", "person", "tale", "troll"},
+	"💆":                 {"activity", "body", "face", "getting", "massage", "people", "person", "salon"},
+	"💆🏻":                {"activity", "body", "face", "getting", "light", "massage", "people", "person", "salon", "skin", "tone"},
+	"💆🏼":                {"activity", "body", "face", "getting", "light", "massage", "medium", "people", "person", "salon", "skin", "tone"},
+	"💆🏽":                {"activity", "body", "face", "getting", "massage", "medium", "people", "person", "salon", "skin", "tone"},
+	"💆🏾":                {"activity", "body", "dark", "face", "getting", "massage", "medium", "people", "person", "salon", "skin", "tone"},
+	"💆🏿":                {"activity", "body", "dark", "face", "getting", "massage", "people", "person", "salon", "skin", "tone"},
+	"💆\u200d♂️":         {"activity", "body", "face", "getting", "man", "massage", "people", "person"},
+	"💆🏻\u200d♂️":        {"activity", "body", "face", "getting", "light", "man", "massage", "people", "person", "skin", "tone"},
+	"💆🏼\u200d♂️":        {"activity", "body", "face", "getting", "light", "man", "massage", "medium", "people", "person", "skin", "tone"},
+	"💆🏽\u200d♂️":        {"activity", "body", "face", "getting", "man", "massage", "medium", "people", "person", "skin", "tone"},
+	"💆🏾\u200d♂️":        {"activity", "body", "dark", "face", "getting", "man", "massage", "medium", "people", "person", "skin", "tone"},
+	"💆🏿\u200d♂️":        {"activity", "body", "dark", "face", "getting", "man", "massage", "people", "person", "skin", "tone"},
+	"💆\u200d♀️":         {"activity", "body", "face", "getting", "massage", "people", "person", "woman"},
+	"💆🏻\u200d♀️":        {"activity", "body", "face", "getting", "light", "massage", "people", "person", "skin", "tone", "woman"},
+	"💆🏼\u200d♀️":        {"activity", "body", "face", "getting", "light", "massage", "medium", "people", "person", "skin", "tone", "woman"},
+	"💆🏽\u200d♀️":        {"activity", "body", "face", "getting", "massage", "medium", "people", "person", "skin", "tone", "woman"},
+	"💆🏾\u200d♀️":        {"activity", "body", "dark", "face", "getting", "massage", "medium", "people", "person", "skin", "tone", "woman"},
+	"💆🏿\u200d♀️":        {"activity", "body", "dark", "face", "getting", "massage", "people", "person", "skin", "tone", "woman"},
+	"💇":                 {"activity", "barber", "beauty", "body", "getting", "haircut", "parlor", "people", "person"},
+	"💇🏻":                {"activity", "barber", "beauty", "body", "getting", "haircut", "light", "parlor", "people", "person", "skin", "tone"},
+	"💇🏼":                {"activity", "barber", "beauty", "body", "getting", "haircut", "light", "medium", "parlor", "people", "person", "skin", "tone"},
+	"💇🏽":                {"activity", "barber", "beauty", "body", "getting", "haircut", "medium", "parlor", "people", "person", "skin", "tone"},
+	"💇🏾":                {"activity", "barber", "beauty", "body", "dark", "getting", "haircut", "medium", "parlor", "people", "person", "skin", "tone"},
+	"💇🏿":                {"activity", "barber", "beauty", "body", "dark", "getting", "haircut", "parlor", "people", "person", "skin", "tone"},
+	"💇\u200d♂️":         {"activity", "body", "getting", "haircut", "man", "people", "person"},
+	"💇🏻\u200d♂️":        {"activity", "body", "getting", "haircut", "light", "man", "people", "person", "skin", "tone"},
+	"💇🏼\u200d♂️":        {"activity", "body", "getting", "haircut", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"💇🏽\u200d♂️":        {"activity", "body", "getting", "haircut", "man", "medium", "people", "person", "skin", "tone"},
+	"💇🏾\u200d♂️":        {"activity", "body", "dark", "getting", "haircut", "man", "medium", "people", "person", "skin", "tone"},
+	"💇🏿\u200d♂️":        {"activity", "body", "dark", "getting", "haircut", "man", "people", "person", "skin", "tone"},
+	"💇\u200d♀️":         {"activity", "body", "getting", "haircut", "people", "person", "woman"},
+	"💇🏻\u200d♀️":        {"activity", "body", "getting", "haircut", "light", "people", "person", "skin", "tone", "woman"},
+	"💇🏼\u200d♀️":        {"activity", "body", "getting", "haircut", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"💇🏽\u200d♀️":        {"activity", "body", "getting", "haircut", "medium", "people", "person", "skin", "tone", "woman"},
+	"💇🏾\u200d♀️":        {"activity", "body", "dark", "getting", "haircut", "medium", "people", "person", "skin", "tone", "woman"},
+	"💇🏿\u200d♀️":        {"activity", "body", "dark", "getting", "haircut", "people", "person", "skin", "tone", "woman"},
+	"🚶":                 {"activity", "body", "hike", "people", "person", "walk", "walking"},
+	"🚶🏻":                {"activity", "body", "hike", "light", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏼":                {"activity", "body", "hike", "light", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏽":                {"activity", "body", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏾":                {"activity", "body", "dark", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏿":                {"activity", "body", "dark", "hike", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶\u200d♂️":         {"activity", "body", "hike", "man", "people", "person", "walk", "walking"},
+	"🚶🏻\u200d♂️":        {"activity", "body", "hike", "light", "man", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏼\u200d♂️":        {"activity", "body", "hike", "light", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏽\u200d♂️":        {"activity", "body", "hike", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏾\u200d♂️":        {"activity", "body", "dark", "hike", "man", "medium", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶🏿\u200d♂️":        {"activity", "body", "dark", "hike", "man", "people", "person", "skin", "tone", "walk", "walking"},
+	"🚶\u200d♀️":         {"activity", "body", "hike", "people", "person", "walk", "walking", "woman"},
+	"🚶🏻\u200d♀️":        {"activity", "body", "hike", "light", "people", "person", "skin", "tone", "walk", "walking", "woman"},
+	"🚶🏼\u200d♀️":        {"activity", "body", "hike", "light", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
+	"🚶🏽\u200d♀️":        {"activity", "body", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
+	"🚶🏾\u200d♀️":        {"activity", "body", "dark", "hike", "medium", "people", "person", "skin", "tone", "walk", "walking", "woman"},
+	"🚶🏿\u200d♀️":        {"activity", "body", "dark", "hike", "people", "person", "skin", "tone", "walk", "walking", "woman"},
+	"🧍":                 {"activity", "body", "people", "person", "stand", "standing"},
+	"🧍🏻":                {"activity", "body", "light", "people", "person", "skin", "stand", "standing", "tone"},
+	"🧍🏼":                {"activity", "body", "light", "medium", "people", "person", "skin", "stand", "standing", "tone"},
+	"🧍🏽":                {"activity", "body", "medium", "people", "person", "skin", "stand", "standing", "tone"},
+	"🧍🏾":                {"activity", "body", "dark", "medium", "people", "person", "skin", "stand", "standing", "tone"},
+	"🧍🏿":                {"activity", "body", "dark", "people", "person", "skin", "stand", "standing", "tone"},
+	"🧍\u200d♂️":         {"activity", "body", "man", "people", "person", "standing"},
+	"🧍🏻\u200d♂️":        {"activity", "body", "light", "man", "people", "person", "skin", "standing", "tone"},
+	"🧍🏼\u200d♂️":        {"activity", "body", "light", "man", "medium", "people", "person", "skin", "standing", "tone"},
+	"🧍🏽\u200d♂️":        {"activity", "body", "man", "medium", "people", "person", "skin", "standing", "tone"},
+	"🧍🏾\u200d♂️":        {"activity", "body", "dark", "man", "medium", "people", "person", "skin", "standing", "tone"},
+	"🧍🏿\u200d♂️":        {"activity", "body", "dark", "man", "people", "person", "skin", "standing", "tone"},
+	"🧍\u200d♀️":         {"activity", "body", "people", "person", "standing", "woman"},
+	"🧍🏻\u200d♀️":        {"activity", "body", "light", "people", "person", "skin", "standing", "tone", "woman"},
+	"🧍🏼\u200d♀️":        {"activity", "body", "light", "medium", "people", "person", "skin", "standing", "tone", "woman"},
+	"🧍🏽\u200d♀️":        {"activity", "body", "medium", "people", "person", "skin", "standing", "tone", "woman"},
+	"🧍🏾\u200d♀️":        {"activity", "body", "dark", "medium", "people", "person", "skin", "standing", "tone", "woman"},
+	"🧍🏿\u200d♀️":        {"activity", "body", "dark", "people", "person", "skin", "standing", "tone", "woman"},
+	"🧎":                 {"activity", "body", "kneel", "kneeling", "people", "person"},
+	"🧎🏻":                {"activity", "body", "kneel", "kneeling", "light", "people", "person", "skin", "tone"},
+	"🧎🏼":                {"activity", "body", "kneel", "kneeling", "light", "medium", "people", "person", "skin", "tone"},
+	"🧎🏽":                {"activity", "body", "kneel", "kneeling", "medium", "people", "person", "skin", "tone"},
+	"🧎🏾":                {"activity", "body", "dark", "kneel", "kneeling", "medium", "people", "person", "skin", "tone"},
+	"🧎🏿":                {"activity", "body", "dark", "kneel", "kneeling", "people", "person", "skin", "tone"},
+	"🧎\u200d♂️":         {"activity", "body", "kneeling", "man", "people", "person"},
+	"🧎🏻\u200d♂️":        {"activity", "body", "kneeling", "light", "man", "people", "person", "skin", "tone"},
+	"🧎🏼\u200d♂️":        {"activity", "body", "kneeling", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🧎🏽\u200d♂️":        {"activity", "body", "kneeling", "man", "medium", "people", "person", "skin", "tone"},
+	"🧎🏾\u200d♂️":        {"activity", "body", "dark", "kneeling", "man", "medium", "people", "person", "skin", "tone"},
+	"🧎🏿\u200d♂️":        {"activity", "body", "dark", "kneeling", "man", "people", "person", "skin", "tone"},
+	"🧎\u200d♀️":         {"activity", "body", "kneeling", "people", "person", "woman"},
+	"🧎🏻\u200d♀️":        {"activity", "body", "kneeling", "light", "people", "person", "skin", "tone", "woman"},
+	"🧎🏼\u200d♀️":        {"activity", "body", "kneeling", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧎🏽\u200d♀️":        {"activity", "body", "kneeling", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧎🏾\u200d♀️":        {"activity", "body", "dark", "kneeling", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧎🏿\u200d♀️":        {"activity", "body", "dark", "kneeling", "people", "person", "skin", "tone", "woman"},
+	"🧑\u200d🦯":          {"accessibility", "activity", "blind", "body", "cane", "people", "person", "white", "with"},
+	"🧑🏻\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "people", "person", "skin", "tone", "white", "with"},
+	"🧑🏼\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"🧑🏽\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"🧑🏾\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"🧑🏿\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "people", "person", "skin", "tone", "white", "with"},
+	"👨\u200d🦯":          {"accessibility", "activity", "blind", "body", "cane", "man", "people", "person", "white", "with"},
+	"👨🏻\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "man", "people", "person", "skin", "tone", "white", "with"},
+	"👨🏼\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"👨🏽\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"👨🏾\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "man", "medium", "people", "person", "skin", "tone", "white", "with"},
+	"👨🏿\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "man", "people", "person", "skin", "tone", "white", "with"},
+	"👩\u200d🦯":          {"accessibility", "activity", "blind", "body", "cane", "people", "person", "white", "with", "woman"},
+	"👩🏻\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "people", "person", "skin", "tone", "white", "with", "woman"},
+	"👩🏼\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "light", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
+	"👩🏽\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
+	"👩🏾\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "medium", "people", "person", "skin", "tone", "white", "with", "woman"},
+	"👩🏿\u200d🦯":         {"accessibility", "activity", "blind", "body", "cane", "dark", "people", "person", "skin", "tone", "white", "with", "woman"},
+	"🧑\u200d🦼":          {"accessibility", "activity", "body", "in", "motorized", "people", "person", "wheelchair"},
+	"🧑🏻\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏼\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏽\u200d🦼":         {"accessibility", "activity", "body", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏾\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏿\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👨\u200d🦼":          {"accessibility", "activity", "body", "in", "man", "motorized", "people", "person", "wheelchair"},
+	"👨🏻\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "man", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏼\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏽\u200d🦼":         {"accessibility", "activity", "body", "in", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏾\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "man", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏿\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "man", "motorized", "people", "person", "skin", "tone", "wheelchair"},
+	"👩\u200d🦼":          {"accessibility", "activity", "body", "in", "motorized", "people", "person", "wheelchair", "woman"},
+	"👩🏻\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏼\u200d🦼":         {"accessibility", "activity", "body", "in", "light", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏽\u200d🦼":         {"accessibility", "activity", "body", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏾\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "medium", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏿\u200d🦼":         {"accessibility", "activity", "body", "dark", "in", "motorized", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"🧑\u200d🦽":          {"accessibility", "activity", "body", "in", "manual", "people", "person", "wheelchair"},
+	"🧑🏻\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "manual", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏼\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏽\u200d🦽":         {"accessibility", "activity", "body", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏾\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"🧑🏿\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "manual", "people", "person", "skin", "tone", "wheelchair"},
+	"👨\u200d🦽":          {"accessibility", "activity", "body", "in", "man", "manual", "people", "person", "wheelchair"},
+	"👨🏻\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "man", "manual", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏼\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏽\u200d🦽":         {"accessibility", "activity", "body", "in", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏾\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "man", "manual", "medium", "people", "person", "skin", "tone", "wheelchair"},
+	"👨🏿\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "man", "manual", "people", "person", "skin", "tone", "wheelchair"},
+	"👩\u200d🦽":          {"accessibility", "activity", "body", "in", "manual", "people", "person", "wheelchair", "woman"},
+	"👩🏻\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "manual", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏼\u200d🦽":         {"accessibility", "activity", "body", "in", "light", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏽\u200d🦽":         {"accessibility", "activity", "body", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏾\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "manual", "medium", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"👩🏿\u200d🦽":         {"accessibility", "activity", "body", "dark", "in", "manual", "people", "person", "skin", "tone", "wheelchair", "woman"},
+	"🏃":                 {"activity", "body", "marathon", "people", "person", "running"},
+	"🏃🏻":                {"activity", "body", "light", "marathon", "people", "person", "running", "skin", "tone"},
+	"🏃🏼":                {"activity", "body", "light", "marathon", "medium", "people", "person", "running", "skin", "tone"},
+	"🏃🏽":                {"activity", "body", "marathon", "medium", "people", "person", "running", "skin", "tone"},
+	"🏃🏾":                {"activity", "body", "dark", "marathon", "medium", "people", "person", "running", "skin", "tone"},
+	"🏃🏿":                {"activity", "body", "dark", "marathon", "people", "person", "running", "skin", "tone"},
+	"🏃\u200d♂️":         {"activity", "body", "man", "marathon", "people", "person", "racing", "running"},
+	"🏃🏻\u200d♂️":        {"activity", "body", "light", "man", "marathon", "people", "person", "racing", "running", "skin", "tone"},
+	"🏃🏼\u200d♂️":        {"activity", "body", "light", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
+	"🏃🏽\u200d♂️":        {"activity", "body", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
+	"🏃🏾\u200d♂️":        {"activity", "body", "dark", "man", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone"},
+	"🏃🏿\u200d♂️":        {"activity", "body", "dark", "man", "marathon", "people", "person", "racing", "running", "skin", "tone"},
+	"🏃\u200d♀️":         {"activity", "body", "marathon", "people", "person", "racing", "running", "woman"},
+	"🏃🏻\u200d♀️":        {"activity", "body", "light", "marathon", "people", "person", "racing", "running", "skin", "tone", "woman"},
+	"🏃🏼\u200d♀️":        {"activity", "body", "light", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
+	"🏃🏽\u200d♀️":        {"activity", "body", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
+	"🏃🏾\u200d♀️":        {"activity", "body", "dark", "marathon", "medium", "people", "person", "racing", "running", "skin", "tone", "woman"},
+	"🏃🏿\u200d♀️":        {"activity", "body", "dark", "marathon", "people", "person", "racing", "running", "skin", "tone", "woman"},
+	"💃":                 {"activity", "body", "dance", "dancing", "people", "person", "woman"},
+	"💃🏻":                {"activity", "body", "dance", "dancing", "light", "people", "person", "skin", "tone", "woman"},
+	"💃🏼":                {"activity", "body", "dance", "dancing", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"💃🏽":                {"activity", "body", "dance", "dancing", "medium", "people", "person", "skin", "tone", "woman"},
+	"💃🏾":                {"activity", "body", "dance", "dancing", "dark", "medium", "people", "person", "skin", "tone", "woman"},
+	"💃🏿":                {"activity", "body", "dance", "dancing", "dark", "people", "person", "skin", "tone", "woman"},
+	"🕺":                 {"activity", "body", "dance", "dancing", "man", "people", "person"},
+	"🕺🏻":                {"activity", "body", "dance", "dancing", "light", "man", "people", "person", "skin", "tone"},
+	"🕺🏼":                {"activity", "body", "dance", "dancing", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🕺🏽":                {"activity", "body", "dance", "dancing", "man", "medium", "people", "person", "skin", "tone"},
+	"🕺🏾":                {"activity", "body", "dance", "dancing", "dark", "man", "medium", "people", "person", "skin", "tone"},
+	"🕺🏿":                {"activity", "body", "dance", "dancing", "dark", "man", "people", "person", "skin", "tone"},
+	"🕴️":                {"activity", "body", "business", "in", "levitating", "people", "person", "suit"},
+	"🕴🏻":                {"activity", "body", "business", "in", "levitating", "light", "people", "person", "skin", "suit", "tone"},
+	"🕴🏼":                {"activity", "body", "business", "in", "levitating", "light", "medium", "people", "person", "skin", "suit", "tone"},
+	"🕴🏽":                {"activity", "body", "business", "in", "levitating", "medium", "people", "person", "skin", "suit", "tone"},
+	"🕴🏾":                {"activity", "body", "business", "dark", "in", "levitating", "medium", "people", "person", "skin", "suit", "tone"},
+	"🕴🏿":                {"activity", "body", "business", "dark", "in", "levitating", "people", "person", "skin", "suit", "tone"},
+	"👯":                 {"activity", "body", "bunny", "dancer", "ear", "ears", "partying", "people", "person", "with"},
+	"👯\u200d♂️":         {"activity", "body", "bunny", "dancer", "ear", "ears", "men", "partying", "people", "person", "with"},
+	"👯\u200d♀️":         {"activity", "body", "bunny", "dancer", "ear", "ears", "partying", "people", "person", "with", "women"},
+	"🧖":                 {"activity", "body", "in", "people", "person", "room", "sauna", "steam", "steamy"},
+	"🧖🏻":                {"activity", "body", "in", "light", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏼":                {"activity", "body", "in", "light", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏽":                {"activity", "body", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏾":                {"activity", "body", "dark", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏿":                {"activity", "body", "dark", "in", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖\u200d♂️":         {"activity", "body", "in", "man", "people", "person", "room", "sauna", "steam", "steamy"},
+	"🧖🏻\u200d♂️":        {"activity", "body", "in", "light", "man", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏼\u200d♂️":        {"activity", "body", "in", "light", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏽\u200d♂️":        {"activity", "body", "in", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏾\u200d♂️":        {"activity", "body", "dark", "in", "man", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖🏿\u200d♂️":        {"activity", "body", "dark", "in", "man", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone"},
+	"🧖\u200d♀️":         {"activity", "body", "in", "people", "person", "room", "sauna", "steam", "steamy", "woman"},
+	"🧖🏻\u200d♀️":        {"activity", "body", "in", "light", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
+	"🧖🏼\u200d♀️":        {"activity", "body", "in", "light", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
+	"🧖🏽\u200d♀️":        {"activity", "body", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
+	"🧖🏾\u200d♀️":        {"activity", "body", "dark", "in", "medium", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
+	"🧖🏿\u200d♀️":        {"activity", "body", "dark", "in", "people", "person", "room", "sauna", "skin", "steam", "steamy", "tone", "woman"},
+	"🧗":                 {"activity", "body", "climber", "climbing", "people", "person"},
+	"🧗🏻":                {"activity", "body", "climber", "climbing", "light", "people", "person", "skin", "tone"},
+	"🧗🏼":                {"activity", "body", "climber", "climbing", "light", "medium", "people", "person", "skin", "tone"},
+	"🧗🏽":                {"activity", "body", "climber", "climbing", "medium", "people", "person", "skin", "tone"},
+	"🧗🏾":                {"activity", "body", "climber", "climbing", "dark", "medium", "people", "person", "skin", "tone"},
+	"🧗🏿":                {"activity", "body", "climber", "climbing", "dark", "people", "person", "skin", "tone"},
+	"🧗\u200d♂️":         {"activity", "body", "climber", "climbing", "man", "people", "person"},
+	"🧗🏻\u200d♂️":        {"activity", "body", "climber", "climbing", "light", "man", "people", "person", "skin", "tone"},
+	"🧗🏼\u200d♂️":        {"activity", "body", "climber", "climbing", "light", "man", "medium", "people", "person", "skin", "tone"},
+	"🧗🏽\u200d♂️":        {"activity", "body", "climber", "climbing", "man", "medium", "people", "person", "skin", "tone"},
+	"🧗🏾\u200d♂️":        {"activity", "body", "climber", "climbing", "dark", "man", "medium", "people", "person", "skin", "tone"},
+	"🧗🏿\u200d♂️":        {"activity", "body", "climber", "climbing", "dark", "man", "people", "person", "skin", "tone"},
+	"🧗\u200d♀️":         {"activity", "body", "climber", "climbing", "people", "person", "woman"},
+	"🧗🏻\u200d♀️":        {"activity", "body", "climber", "climbing", "light", "people", "person", "skin", "tone", "woman"},
+	"🧗🏼\u200d♀️":        {"activity", "body", "climber", "climbing", "light", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧗🏽\u200d♀️":        {"activity", "body", "climber", "climbing", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧗🏾\u200d♀️":        {"activity", "body", "climber", "climbing", "dark", "medium", "people", "person", "skin", "tone", "woman"},
+	"🧗🏿\u200d♀️":        {"activity", "body", "climber", "climbing", "dark", "people", "person", "skin", "tone", "woman"},
+	"🤺":                 {"body", "fencer", "fencing", "people", "person", "sport", "sword"},
+	"🏇":                 {"body", "horse", "jockey", "people", "person", "racehorse", "racing", "sport"},
+	"🏇🏻":                {"body", "horse", "jockey", "light", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
+	"🏇🏼":                {"body", "horse", "jockey", "light", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
+	"🏇🏽":                {"body", "horse", "jockey", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
+	"🏇🏾":                {"body", "dark", "horse", "jockey", "medium", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
+	"🏇🏿":                {"body", "dark", "horse", "jockey", "people", "person", "racehorse", "racing", "skin", "sport", "tone"},
+	"⛷️":                {"body", "people", "person", "ski", "skier", "snow", "sport"},
+	"🏂":                 {"body", "people", "person", "snowboarder", "sport"},
+	"🏂🏻":                {"body", "light", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
+	"🏂🏼":                {"body", "light", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
+	"🏂🏽":                {"body", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
+	"🏂🏾":                {"body", "dark", "medium", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
+	"🏂🏿":                {"body", "dark", "people", "person", "ski", "skin", "snow", "snowboard", "snowboarder", "sport", "tone"},
+	"🏌️":                {"ball", "body", "golf", "golfing", "people", "person", "sport"},
+	"🏌🏻":                {"ball", "body", "golf", "golfing", "light", "people", "person", "skin", "sport", "tone"},
+	"🏌🏼":                {"ball", "body", "golf", "golfing", "light", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏽":                {"ball", "body", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏾":                {"ball", "body", "dark", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏿":                {"ball", "body", "dark", "golf", "golfing", "people", "person", "skin", "sport", "tone"},
+	"🏌️\u200d♂️":        {"body", "golf", "golfing", "man", "people", "person", "sport"},
+	"🏌🏻\u200d♂️":        {"body", "golf", "golfing", "light", "man", "people", "person", "skin", "sport", "tone"},
+	"🏌🏼\u200d♂️":        {"body", "golf", "golfing", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏽\u200d♂️":        {"body", "golf", "golfing", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏾\u200d♂️":        {"body", "dark", "golf", "golfing", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🏌🏿\u200d♂️":        {"body", "dark", "golf", "golfing", "man", "people", "person", "skin", "sport", "tone"},
+	"🏌️\u200d♀️":        {"body", "golf", "golfing", "people", "person", "sport", "woman"},
+	"🏌🏻\u200d♀️":        {"body", "golf", "golfing", "light", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏌🏼\u200d♀️":        {"body", "golf", "golfing", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏌🏽\u200d♀️":        {"body", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏌🏾\u200d♀️":        {"body", "dark", "golf", "golfing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏌🏿\u200d♀️":        {"body", "dark", "golf", "golfing", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏄":                 {"body", "people", "person", "sport", "surfing"},
+	"🏄🏻":                {"body", "light", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏼":                {"body", "light", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏽":                {"body", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏾":                {"body", "dark", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏿":                {"body", "dark", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄\u200d♂️":         {"body", "man", "people", "person", "sport", "surfing"},
+	"🏄🏻\u200d♂️":        {"body", "light", "man", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏼\u200d♂️":        {"body", "light", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏽\u200d♂️":        {"body", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏾\u200d♂️":        {"body", "dark", "man", "medium", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄🏿\u200d♂️":        {"body", "dark", "man", "people", "person", "skin", "sport", "surfing", "tone"},
+	"🏄\u200d♀️":         {"body", "people", "person", "sport", "surfing", "woman"},
+	"🏄🏻\u200d♀️":        {"body", "light", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
+	"🏄🏼\u200d♀️":        {"body", "light", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
+	"🏄🏽\u200d♀️":        {"body", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
+	"🏄🏾\u200d♀️":        {"body", "dark", "medium", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
+	"🏄🏿\u200d♀️":        {"body", "dark", "people", "person", "skin", "sport", "surfing", "tone", "woman"},
+	"🚣":                 {"boat", "body", "people", "person", "rowboat", "rowing", "sport"},
+	"🚣🏻":                {"boat", "body", "light", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏼":                {"boat", "body", "light", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏽":                {"boat", "body", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏾":                {"boat", "body", "dark", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏿":                {"boat", "body", "dark", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣\u200d♂️":         {"boat", "body", "man", "people", "person", "rowboat", "rowing", "sport"},
+	"🚣🏻\u200d♂️":        {"boat", "body", "light", "man", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏼\u200d♂️":        {"boat", "body", "light", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏽\u200d♂️":        {"boat", "body", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏾\u200d♂️":        {"boat", "body", "dark", "man", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣🏿\u200d♂️":        {"boat", "body", "dark", "man", "people", "person", "rowboat", "rowing", "skin", "sport", "tone"},
+	"🚣\u200d♀️":         {"boat", "body", "people", "person", "rowboat", "rowing", "sport", "woman"},
+	"🚣🏻\u200d♀️":        {"boat", "body", "light", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
+	"🚣🏼\u200d♀️":        {"boat", "body", "light", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
+	"🚣🏽\u200d♀️":        {"boat", "body", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
+	"🚣🏾\u200d♀️":        {"boat", "body", "dark", "medium", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
+	"🚣🏿\u200d♀️":        {"boat", "body", "dark", "people", "person", "rowboat", "rowing", "skin", "sport", "tone", "woman"},
+	"🏊":                 {"body", "people", "person", "sport", "swimming"},
+	"🏊🏻":                {"body", "light", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏼":                {"body", "light", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏽":                {"body", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏾":                {"body", "dark", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏿":                {"body", "dark", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊\u200d♂️":         {"body", "man", "people", "person", "sport", "swim", "swimming"},
+	"🏊🏻\u200d♂️":        {"body", "light", "man", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏼\u200d♂️":        {"body", "light", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏽\u200d♂️":        {"body", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏾\u200d♂️":        {"body", "dark", "man", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊🏿\u200d♂️":        {"body", "dark", "man", "people", "person", "skin", "sport", "swim", "swimming", "tone"},
+	"🏊\u200d♀️":         {"body", "people", "person", "sport", "swim", "swimming", "woman"},
+	"🏊🏻\u200d♀️":        {"body", "light", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
+	"🏊🏼\u200d♀️":        {"body", "light", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
+	"🏊🏽\u200d♀️":        {"body", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
+	"🏊🏾\u200d♀️":        {"body", "dark", "medium", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
+	"🏊🏿\u200d♀️":        {"body", "dark", "people", "person", "skin", "sport", "swim", "swimming", "tone", "woman"},
+	"⛹️":                {"ball", "body", "bouncing", "people", "person", "sport"},
+	"⛹🏻":                {"ball", "body", "bouncing", "light", "people", "person", "skin", "sport", "tone"},
+	"⛹🏼":                {"ball", "body", "bouncing", "light", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏽":                {"ball", "body", "bouncing", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏾":                {"ball", "body", "bouncing", "dark", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏿":                {"ball", "body", "bouncing", "dark", "people", "person", "skin", "sport", "tone"},
+	"⛹️\u200d♂️":        {"ball", "body", "bouncing", "man", "people", "person", "sport"},
+	"⛹🏻\u200d♂️":        {"ball", "body", "bouncing", "light", "man", "people", "person", "skin", "sport", "tone"},
+	"⛹🏼\u200d♂️":        {"ball", "body", "bouncing", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏽\u200d♂️":        {"ball", "body", "bouncing", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏾\u200d♂️":        {"ball", "body", "bouncing", "dark", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"⛹🏿\u200d♂️":        {"ball", "body", "bouncing", "dark", "man", "people", "person", "skin", "sport", "tone"},
+	"⛹️\u200d♀️":        {"ball", "body", "bouncing", "people", "person", "sport", "woman"},
+	"⛹🏻\u200d♀️":        {"ball", "body", "bouncing", "light", "people", "person", "skin", "sport", "tone", "woman"},
+	"⛹🏼\u200d♀️":        {"ball", "body", "bouncing", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"⛹🏽\u200d♀️":        {"ball", "body", "bouncing", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"⛹🏾\u200d♀️":        {"ball", "body", "bouncing", "dark", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"⛹🏿\u200d♀️":        {"ball", "body", "bouncing", "dark", "people", "person", "skin", "sport", "tone", "woman"},
+	"🏋️":                {"body", "lifter", "lifting", "people", "person", "sport", "weight", "weights"},
+	"🏋🏻":                {"body", "lifter", "lifting", "light", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏼":                {"body", "lifter", "lifting", "light", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏽":                {"body", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏾":                {"body", "dark", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏿":                {"body", "dark", "lifter", "lifting", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋️\u200d♂️":        {"body", "lifter", "lifting", "man", "people", "person", "sport", "weight", "weights"},
+	"🏋🏻\u200d♂️":        {"body", "lifter", "lifting", "light", "man", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏼\u200d♂️":        {"body", "lifter", "lifting", "light", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏽\u200d♂️":        {"body", "lifter", "lifting", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏾\u200d♂️":        {"body", "dark", "lifter", "lifting", "man", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋🏿\u200d♂️":        {"body", "dark", "lifter", "lifting", "man", "people", "person", "skin", "sport", "tone", "weight", "weights"},
+	"🏋️\u200d♀️":        {"body", "lifter", "lifting", "people", "person", "sport", "weight", "weights", "woman"},
+	"🏋🏻\u200d♀️":        {"body", "lifter", "lifting", "light", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
+	"🏋🏼\u200d♀️":        {"body", "lifter", "lifting", "light", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
+	"🏋🏽\u200d♀️":        {"body", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
+	"🏋🏾\u200d♀️":        {"body", "dark", "lifter", "lifting", "medium", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
+	"🏋🏿\u200d♀️":        {"body", "dark", "lifter", "lifting", "people", "person", "skin", "sport", "tone", "weight", "weights", "woman"},
+	"🚴":                 {"bicycle", "biking", "body", "cyclist", "people", "person", "sport"},
+	"🚴🏻":                {"bicycle", "biking", "body", "cyclist", "light", "people", "person", "skin", "sport", "tone"},
+	"🚴🏼":                {"bicycle", "biking", "body", "cyclist", "light", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏽":                {"bicycle", "biking", "body", "cyclist", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏾":                {"bicycle", "biking", "body", "cyclist", "dark", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏿":                {"bicycle", "biking", "body", "cyclist", "dark", "people", "person", "skin", "sport", "tone"},
+	"🚴\u200d♂️":         {"bicycle", "biking", "body", "cyclist", "man", "people", "person", "sport"},
+	"🚴🏻\u200d♂️":        {"bicycle", "biking", "body", "cyclist", "light", "man", "people", "person", "skin", "sport", "tone"},
+	"🚴🏼\u200d♂️":        {"bicycle", "biking", "body", "cyclist", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏽\u200d♂️":        {"bicycle", "biking", "body", "cyclist", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏾\u200d♂️":        {"bicycle", "biking", "body", "cyclist", "dark", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🚴🏿\u200d♂️":        {"bicycle", "biking", "body", "cyclist", "dark", "man", "people", "person", "skin", "sport", "tone"},
+	"🚴\u200d♀️":         {"bicycle", "biking", "body", "cyclist", "people", "person", "sport", "woman"},
+	"🚴🏻\u200d♀️":        {"bicycle", "biking", "body", "cyclist", "light", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚴🏼\u200d♀️":        {"bicycle", "biking", "body", "cyclist", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚴🏽\u200d♀️":        {"bicycle", "biking", "body", "cyclist", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚴🏾\u200d♀️":        {"bicycle", "biking", "body", "cyclist", "dark", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚴🏿\u200d♀️":        {"bicycle", "biking", "body", "cyclist", "dark", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚵":                 {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "mountain", "people", "person", "sport"},
+	"🚵🏻":                {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "light", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏼":                {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "light", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏽":                {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏾":                {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "dark", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏿":                {"bicycle", "bicyclist", "bike", "biking", "body", "cyclist", "dark", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵\u200d♂️":         {"bicycle", "bike", "biking", "body", "cyclist", "man", "mountain", "people", "person", "sport"},
+	"🚵🏻\u200d♂️":        {"bicycle", "bike", "biking", "body", "cyclist", "light", "man", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏼\u200d♂️":        {"bicycle", "bike", "biking", "body", "cyclist", "light", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏽\u200d♂️":        {"bicycle", "bike", "biking", "body", "cyclist", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏾\u200d♂️":        {"bicycle", "bike", "biking", "body", "cyclist", "dark", "man", "medium", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵🏿\u200d♂️":        {"bicycle", "bike", "biking", "body", "cyclist", "dark", "man", "mountain", "people", "person", "skin", "sport", "tone"},
+	"🚵\u200d♀️":         {"bicycle", "bike", "biking", "body", "cyclist", "mountain", "people", "person", "sport", "woman"},
+	"🚵🏻\u200d♀️":        {"bicycle", "bike", "biking", "body", "cyclist", "light", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚵🏼\u200d♀️":        {"bicycle", "bike", "biking", "body", "cyclist", "light", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚵🏽\u200d♀️":        {"bicycle", "bike", "biking", "body", "cyclist", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚵🏾\u200d♀️":        {"bicycle", "bike", "biking", "body", "cyclist", "dark", "medium", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
+	"🚵🏿\u200d♀️":        {"bicycle", "bike", "biking", "body", "cyclist", "dark", "mountain", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤸":                 {"body", "cartwheel", "cartwheeling", "gymnastics", "people", "person", "sport"},
+	"🤸🏻":                {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "people", "person", "skin", "sport", "tone"},
+	"🤸🏼":                {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏽":                {"body", "cartwheel", "cartwheeling", "gymnastics", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏾":                {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏿":                {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "people", "person", "skin", "sport", "tone"},
+	"🤸\u200d♂️":         {"body", "cartwheel", "cartwheeling", "gymnastics", "man", "people", "person", "sport"},
+	"🤸🏻\u200d♂️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "man", "people", "person", "skin", "sport", "tone"},
+	"🤸🏼\u200d♂️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏽\u200d♂️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏾\u200d♂️":        {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "man", "medium", "people", "person", "skin", "sport", "tone"},
+	"🤸🏿\u200d♂️":        {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "man", "people", "person", "skin", "sport", "tone"},
+	"🤸\u200d♀️":         {"body", "cartwheel", "cartwheeling", "gymnastics", "people", "person", "sport", "woman"},
+	"🤸🏻\u200d♀️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤸🏼\u200d♀️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "light", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤸🏽\u200d♀️":        {"body", "cartwheel", "cartwheeling", "gymnastics", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤸🏾\u200d♀️":        {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "medium", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤸🏿\u200d♀️":        {"body", "cartwheel", "cartwheeling", "dark", "gymnastics", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤼":                 {"body", "people", "person", "sport", "wrestle", "wrestler", "wrestling"},
+	"🤼\u200d♂️":         {"body", "men", "people", "person", "sport", "wrestle", "wrestling"},
+	"🤼\u200d♀️":         {"body", "people", "person", "sport", "women", "wrestle", "wrestling"},
+	"🤽":                 {"body", "people", "person", "playing", "polo", "sport", "water"},
+	"🤽🏻":                {"body", "light", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏼":                {"body", "light", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏽":                {"body", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏾":                {"body", "dark", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏿":                {"body", "dark", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽\u200d♂️":         {"body", "man", "people", "person", "playing", "polo", "sport", "water"},
+	"🤽🏻\u200d♂️":        {"body", "light", "man", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏼\u200d♂️":        {"body", "light", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏽\u200d♂️":        {"body", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏾\u200d♂️":        {"body", "dark", "man", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽🏿\u200d♂️":        {"body", "dark", "man", "people", "person", "playing", "polo", "skin", "sport", "tone", "water"},
+	"🤽\u200d♀️":         {"body", "people", "person", "playing", "polo", "sport", "water", "woman"},
+	"🤽🏻\u200d♀️":        {"body", "light", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
+	"🤽🏼\u200d♀️":        {"body", "light", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
+	"🤽🏽\u200d♀️":        {"body", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
+	"🤽🏾\u200d♀️":        {"body", "dark", "medium", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
+	"🤽🏿\u200d♀️":        {"body", "dark", "people", "person", "playing", "polo", "skin", "sport", "tone", "water", "woman"},
+	"🤾":                 {"ball", "body", "handball", "people", "person", "playing", "sport"},
+	"🤾🏻":                {"ball", "body", "handball", "light", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏼":                {"ball", "body", "handball", "light", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏽":                {"ball", "body", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏾":                {"ball", "body", "dark", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏿":                {"ball", "body", "dark", "handball", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾\u200d♂️":         {"body", "handball", "man", "people", "person", "playing", "sport"},
+	"🤾🏻\u200d♂️":        {"body", "handball", "light", "man", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏼\u200d♂️":        {"body", "handball", "light", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏽\u200d♂️":        {"body", "handball", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏾\u200d♂️":        {"body", "dark", "handball", "man", "medium", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾🏿\u200d♂️":        {"body", "dark", "handball", "man", "people", "person", "playing", "skin", "sport", "tone"},
+	"🤾\u200d♀️":         {"body", "handball", "people", "person", "playing", "sport", "woman"},
+	"🤾🏻\u200d♀️":        {"body", "handball", "light", "people", "person", "playing", "skin", "sport", "tone", "woman"},
+	"🤾🏼\u200d♀️":        {"body", "handball", "light", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
+	"🤾🏽\u200d♀️":        {"body", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
+	"🤾🏾\u200d♀️":        {"body", "dark", "handball", "medium", "people", "person", "playing", "skin", "sport", "tone", "woman"},
+	"🤾🏿\u200d♀️":        {"body", "dark", "handball", "people", "person", "playing", "skin", "sport", "tone", "woman"},
+	"🤹":                 {"balance", "body", "juggle", "juggling", "multitask", "people", "person", "skill", "sport"},
+	"🤹🏻":                {"balance", "body", "juggle", "juggling", "light", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
+	"🤹🏼":                {"balance", "body", "juggle", "juggling", "light", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
+	"🤹🏽":                {"balance", "body", "juggle", "juggling", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
+	"🤹🏾":                {"balance", "body", "dark", "juggle", "juggling", "medium", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
+	"🤹🏿":                {"balance", "body", "dark", "juggle", "juggling", "multitask", "people", "person", "skill", "skin", "sport", "tone"},
+	"🤹\u200d♂️":         {"body", "juggling", "man", "multitask", "people", "person", "sport"},
+	"🤹🏻\u200d♂️":        {"body", "juggling", "light", "man", "multitask", "people", "person", "skin", "sport", "tone"},
+	"🤹🏼\u200d♂️":        {"body", "juggling", "light", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
+	"🤹🏽\u200d♂️":        {"body", "juggling", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
+	"🤹🏾\u200d♂️":        {"body", "dark", "juggling", "man", "medium", "multitask", "people", "person", "skin", "sport", "tone"},
+	"🤹🏿\u200d♂️":        {"body", "dark", "juggling", "man", "multitask", "people", "person", "skin", "sport", "tone"},
+	"🤹\u200d♀️":         {"body", "juggling", "multitask", "people", "person", "sport", "woman"},
+	"🤹🏻\u200d♀️":        {"body", "juggling", "light", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤹🏼\u200d♀️":        {"body", "juggling", "light", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤹🏽\u200d♀️":        {"body", "juggling", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤹🏾\u200d♀️":        {"body", "dark", "juggling", "medium", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
+	"🤹🏿\u200d♀️":        {"body", "dark", "juggling", "multitask", "people", "person", "skin", "sport", "tone", "woman"},
+	"🧘":                 {"body", "in", "lotus", "meditation", "people", "person", "position", "resting", "yoga"},
+	"🧘🏻":                {"body", "in", "light", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏼":                {"body", "in", "light", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏽":                {"body", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏾":                {"body", "dark", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏿":                {"body", "dark", "in", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘\u200d♂️":         {"body", "in", "lotus", "man", "meditation", "people", "person", "position", "resting", "yoga"},
+	"🧘🏻\u200d♂️":        {"body", "in", "light", "lotus", "man", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏼\u200d♂️":        {"body", "in", "light", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏽\u200d♂️":        {"body", "in", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏾\u200d♂️":        {"body", "dark", "in", "lotus", "man", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘🏿\u200d♂️":        {"body", "dark", "in", "lotus", "man", "meditation", "people", "person", "position", "resting", "skin", "tone", "yoga"},
+	"🧘\u200d♀️":         {"body", "in", "lotus", "meditation", "people", "person", "position", "resting", "woman", "yoga"},
+	"🧘🏻\u200d♀️":        {"body", "in", "light", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
+	"🧘🏼\u200d♀️":        {"body", "in", "light", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
+	"🧘🏽\u200d♀️":        {"body", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
+	"🧘🏾\u200d♀️":        {"body", "dark", "in", "lotus", "meditation", "medium", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
+	"🧘🏿\u200d♀️":        {"body", "dark", "in", "lotus", "meditation", "people", "person", "position", "resting", "skin", "tone", "woman", "yoga"},
+	"🛀":                 {"bath", "bathtub", "body", "people", "person", "resting", "taking"},
+	"🛀🏻":                {"bath", "bathtub", "body", "light", "people", "person", "resting", "skin", "taking", "tone"},
+	"🛀🏼":                {"bath", "bathtub", "body", "light", "medium", "people", "person", "resting", "skin", "taking", "tone"},
+	"🛀🏽":                {"bath", "bathtub", "body", "medium", "people", "person", "resting", "skin", "taking", "tone"},
+	"🛀🏾":                {"bath", "bathtub", "body", "dark", "medium", "people", "person", "resting", "skin", "taking", "tone"},
+	"🛀🏿":                {"bath", "bathtub", "body", "dark", "people", "person", "resting", "skin", "taking", "tone"},
+	"🛌":                 {"bed", "body", "good", "hotel", "in", "night", "people", "person", "resting", "sleep"},
+	"🛌🏻":                {"bed", "body", "good", "hotel", "in", "light", "night", "people", "person", "resting", "skin", "sleep", "tone"},
+	"🛌🏼":                {"bed", "body", "good", "hotel", "in", "light", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
+	"🛌🏽":                {"bed", "body", "good", "hotel", "in", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
+	"🛌🏾":                {"bed", "body", "dark", "good", "hotel", "in", "medium", "night", "people", "person", "resting", "skin", "sleep", "tone"},
+	"🛌🏿":                {"bed", "body", "dark", "good", "hotel", "in", "night", "people", "person", "resting", "skin", "sleep", "tone"},
+	"🧑\u200d🤝\u200d🧑":   {"body", "couple", "family", "hand", "hands", "hold", "holding", "people", "person"},
 	"🧑🏻\u200d🤝\u200d🧑🏻": {"body", "couple", "family", "hand", "hands", "hold", "holding", "light", "people", "person", "skin", "tone"},
 	"🧑🏻\u200d🤝\u200d🧑🏼": {"body", "couple", "family", "hand", "hands", "hold", "holding", "light", "medium", "people", "person", "skin", "tone"},
 	"🧑🏻\u200d🤝\u200d🧑🏽": {"body", "couple", "family", "hand", "hands", "hold", "holding", "light", "medium", "people", "person", "skin", "tone"},
@@ -1999,90 +1999,90 @@ var emojis = map[string][]string {
 	"🧑🏿\u200d🤝\u200d🧑🏽": {"body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "medium", "people", "person", "skin", "tone"},
 	"🧑🏿\u200d🤝\u200d🧑🏾": {"body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "medium", "people", "person", "skin", "tone"},
 	"🧑🏿\u200d🤝\u200d🧑🏿": {"body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "people", "person", "skin", "tone"},
-	"👭": {"body", "couple", "family", "hand", "hands", "holding", "people", "women"},
-	"👭🏻": {"body", "couple", "family", "hand", "hands", "holding", "light", "people", "skin", "tone", "women"},
+	"👭":                 {"body", "couple", "family", "hand", "hands", "holding", "people", "women"},
+	"👭🏻":                {"body", "couple", "family", "hand", "hands", "holding", "light", "people", "skin", "tone", "women"},
 	"👩🏻\u200d🤝\u200d👩🏼": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏻\u200d🤝\u200d👩🏽": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏻\u200d🤝\u200d👩🏾": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏻\u200d🤝\u200d👩🏿": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "people", "skin", "tone", "women"},
 	"👩🏼\u200d🤝\u200d👩🏻": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
-	"👭🏼": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
+	"👭🏼":                {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏼\u200d🤝\u200d👩🏽": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏼\u200d🤝\u200d👩🏾": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏼\u200d🤝\u200d👩🏿": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏽\u200d🤝\u200d👩🏻": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏽\u200d🤝\u200d👩🏼": {"body", "couple", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
-	"👭🏽": {"body", "couple", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
+	"👭🏽":                {"body", "couple", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏽\u200d🤝\u200d👩🏾": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏽\u200d🤝\u200d👩🏿": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏾\u200d🤝\u200d👩🏻": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏾\u200d🤝\u200d👩🏼": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏾\u200d🤝\u200d👩🏽": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
-	"👭🏾": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
+	"👭🏾":                {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏾\u200d🤝\u200d👩🏿": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏿\u200d🤝\u200d👩🏻": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "people", "skin", "tone", "women"},
 	"👩🏿\u200d🤝\u200d👩🏼": {"body", "couple", "dark", "family", "hand", "hands", "holding", "light", "medium", "people", "skin", "tone", "women"},
 	"👩🏿\u200d🤝\u200d👩🏽": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
 	"👩🏿\u200d🤝\u200d👩🏾": {"body", "couple", "dark", "family", "hand", "hands", "holding", "medium", "people", "skin", "tone", "women"},
-	"👭🏿": {"body", "couple", "dark", "family", "hand", "hands", "holding", "people", "skin", "tone", "women"},
-	"👫": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "man", "people", "woman"},
-	"👫🏻": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "people", "skin", "tone", "woman"},
+	"👭🏿":                {"body", "couple", "dark", "family", "hand", "hands", "holding", "people", "skin", "tone", "women"},
+	"👫":                 {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "man", "people", "woman"},
+	"👫🏻":                {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d🤝\u200d👨🏼": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d🤝\u200d👨🏽": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d🤝\u200d👨🏾": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d🤝\u200d👨🏿": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "people", "skin", "tone", "woman"},
 	"👩🏼\u200d🤝\u200d👨🏻": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
-	"👫🏼": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
+	"👫🏼":                {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏼\u200d🤝\u200d👨🏽": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏼\u200d🤝\u200d👨🏾": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏼\u200d🤝\u200d👨🏿": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏽\u200d🤝\u200d👨🏻": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏽\u200d🤝\u200d👨🏼": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
-	"👫🏽": {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
+	"👫🏽":                {"and", "body", "couple", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏽\u200d🤝\u200d👨🏾": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏽\u200d🤝\u200d👨🏿": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏾\u200d🤝\u200d👨🏻": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏾\u200d🤝\u200d👨🏼": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏾\u200d🤝\u200d👨🏽": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
-	"👫🏾": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
+	"👫🏾":                {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏾\u200d🤝\u200d👨🏿": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d🤝\u200d👨🏻": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d🤝\u200d👨🏼": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d🤝\u200d👨🏽": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d🤝\u200d👨🏾": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "medium", "people", "skin", "tone", "woman"},
-	"👫🏿": {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "people", "skin", "tone", "woman"},
-	"👬": {"body", "couple", "family", "gemini", "hands", "holding", "man", "men", "people", "twins", "zodiac"},
-	"👬🏻": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
+	"👫🏿":                {"and", "body", "couple", "dark", "family", "hand", "hands", "hold", "holding", "man", "people", "skin", "tone", "woman"},
+	"👬":                 {"body", "couple", "family", "gemini", "hands", "holding", "man", "men", "people", "twins", "zodiac"},
+	"👬🏻":                {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏻\u200d🤝\u200d👨🏼": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏻\u200d🤝\u200d👨🏽": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏻\u200d🤝\u200d👨🏾": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏻\u200d🤝\u200d👨🏿": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏼\u200d🤝\u200d👨🏻": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
-	"👬🏼": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
+	"👬🏼":                {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏼\u200d🤝\u200d👨🏽": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏼\u200d🤝\u200d👨🏾": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏼\u200d🤝\u200d👨🏿": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏽\u200d🤝\u200d👨🏻": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏽\u200d🤝\u200d👨🏼": {"body", "couple", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
-	"👬🏽": {"body", "couple", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
+	"👬🏽":                {"body", "couple", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏽\u200d🤝\u200d👨🏾": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏽\u200d🤝\u200d👨🏿": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏾\u200d🤝\u200d👨🏻": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏾\u200d🤝\u200d👨🏼": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏾\u200d🤝\u200d👨🏽": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
-	"👬🏾": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
+	"👬🏾":                {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏾\u200d🤝\u200d👨🏿": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏿\u200d🤝\u200d👨🏻": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏿\u200d🤝\u200d👨🏼": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "light", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏿\u200d🤝\u200d👨🏽": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
 	"👨🏿\u200d🤝\u200d👨🏾": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "medium", "men", "people", "skin", "tone", "twins", "zodiac"},
-	"👬🏿": {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
-	"💏": {"body", "couple", "family", "kiss", "people"},
-	"💏🏻": {"body", "couple", "family", "kiss", "light", "people", "skin", "tone"},
-	"💏🏼": {"body", "couple", "family", "kiss", "light", "medium", "people", "skin", "tone"},
-	"💏🏽": {"body", "couple", "family", "kiss", "medium", "people", "skin", "tone"},
-	"💏🏾": {"body", "couple", "dark", "family", "kiss", "medium", "people", "skin", "tone"},
-	"💏🏿": {"body", "couple", "dark", "family", "kiss", "people", "skin", "tone"},
+	"👬🏿":                {"body", "couple", "dark", "family", "gemini", "hands", "holding", "man", "men", "people", "skin", "tone", "twins", "zodiac"},
+	"💏":                 {"body", "couple", "family", "kiss", "people"},
+	"💏🏻":                {"body", "couple", "family", "kiss", "light", "people", "skin", "tone"},
+	"💏🏼":                {"body", "couple", "family", "kiss", "light", "medium", "people", "skin", "tone"},
+	"💏🏽":                {"body", "couple", "family", "kiss", "medium", "people", "skin", "tone"},
+	"💏🏾":                {"body", "couple", "dark", "family", "kiss", "medium", "people", "skin", "tone"},
+	"💏🏿":                {"body", "couple", "dark", "family", "kiss", "people", "skin", "tone"},
 	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼": {"body", "couple", "family", "kiss", "light", "medium", "people", "person", "skin", "tone"},
 	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽": {"body", "couple", "family", "kiss", "light", "medium", "people", "person", "skin", "tone"},
 	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾": {"body", "couple", "dark", "family", "kiss", "light", "medium", "people", "person", "skin", "tone"},
@@ -2103,7 +2103,7 @@ var emojis = map[string][]string {
 	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼": {"body", "couple", "dark", "family", "kiss", "light", "medium", "people", "person", "skin", "tone"},
 	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽": {"body", "couple", "dark", "family", "kiss", "medium", "people", "person", "skin", "tone"},
 	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾": {"body", "couple", "dark", "family", "kiss", "medium", "people", "person", "skin", "tone"},
-	"👩\u200d❤️\u200d💋\u200d👨": {"body", "couple", "family", "kiss", "man", "people", "woman"},
+	"👩\u200d❤️\u200d💋\u200d👨":   {"body", "couple", "family", "kiss", "man", "people", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👨🏻": {"body", "couple", "family", "kiss", "light", "man", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👨🏼": {"body", "couple", "family", "kiss", "light", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👨🏽": {"body", "couple", "family", "kiss", "light", "man", "medium", "people", "skin", "tone", "woman"},
@@ -2129,7 +2129,7 @@ var emojis = map[string][]string {
 	"👩🏿\u200d❤️\u200d💋\u200d👨🏽": {"body", "couple", "dark", "family", "kiss", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d❤️\u200d💋\u200d👨🏾": {"body", "couple", "dark", "family", "kiss", "man", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d❤️\u200d💋\u200d👨🏿": {"body", "couple", "dark", "family", "kiss", "man", "people", "skin", "tone", "woman"},
-	"👨\u200d❤️\u200d💋\u200d👨": {"body", "couple", "family", "kiss", "man", "people"},
+	"👨\u200d❤️\u200d💋\u200d👨":   {"body", "couple", "family", "kiss", "man", "people"},
 	"👨🏻\u200d❤️\u200d💋\u200d👨🏻": {"body", "couple", "family", "kiss", "light", "man", "people", "skin", "tone"},
 	"👨🏻\u200d❤️\u200d💋\u200d👨🏼": {"body", "couple", "family", "kiss", "light", "man", "medium", "people", "skin", "tone"},
 	"👨🏻\u200d❤️\u200d💋\u200d👨🏽": {"body", "couple", "family", "kiss", "light", "man", "medium", "people", "skin", "tone"},
@@ -2155,7 +2155,7 @@ var emojis = map[string][]string {
 	"👨🏿\u200d❤️\u200d💋\u200d👨🏽": {"body", "couple", "dark", "family", "kiss", "man", "medium", "people", "skin", "tone"},
 	"👨🏿\u200d❤️\u200d💋\u200d👨🏾": {"body", "couple", "dark", "family", "kiss", "man", "medium", "people", "skin", "tone"},
 	"👨🏿\u200d❤️\u200d💋\u200d👨🏿": {"body", "couple", "dark", "family", "kiss", "man", "people", "skin", "tone"},
-	"👩\u200d❤️\u200d💋\u200d👩": {"body", "couple", "family", "kiss", "people", "woman"},
+	"👩\u200d❤️\u200d💋\u200d👩":   {"body", "couple", "family", "kiss", "people", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👩🏻": {"body", "couple", "family", "kiss", "light", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👩🏼": {"body", "couple", "family", "kiss", "light", "medium", "people", "skin", "tone", "woman"},
 	"👩🏻\u200d❤️\u200d💋\u200d👩🏽": {"body", "couple", "family", "kiss", "light", "medium", "people", "skin", "tone", "woman"},
@@ -2181,1479 +2181,1479 @@ var emojis = map[string][]string {
 	"👩🏿\u200d❤️\u200d💋\u200d👩🏽": {"body", "couple", "dark", "family", "kiss", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d❤️\u200d💋\u200d👩🏾": {"body", "couple", "dark", "family", "kiss", "medium", "people", "skin", "tone", "woman"},
 	"👩🏿\u200d❤️\u200d💋\u200d👩🏿": {"body", "couple", "dark", "family", "kiss", "people", "skin", "tone", "woman"},
-	"💑": {"body", "couple", "family", "heart", "love", "people", "with"},
-	"💑🏻": {"body", "couple", "family", "heart", "light", "love", "people", "skin", "tone", "with"},
-	"💑🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with"},
-	"💑🏽": {"body", "couple", "family", "heart", "love", "medium", "people", "skin", "tone", "with"},
-	"💑🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with"},
-	"💑🏿": {"body", "couple", "dark", "family", "heart", "love", "people", "skin", "tone", "with"},
-	"🧑🏻\u200d❤️\u200d🧑🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏻\u200d❤️\u200d🧑🏽": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏻\u200d❤️\u200d🧑🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏻\u200d❤️\u200d🧑🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "people", "person", "skin", "tone", "with"},
-	"🧑🏼\u200d❤️\u200d🧑🏻": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏼\u200d❤️\u200d🧑🏽": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏼\u200d❤️\u200d🧑🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏼\u200d❤️\u200d🧑🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏽\u200d❤️\u200d🧑🏻": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏽\u200d❤️\u200d🧑🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏽\u200d❤️\u200d🧑🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏽\u200d❤️\u200d🧑🏿": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏾\u200d❤️\u200d🧑🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏾\u200d❤️\u200d🧑🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏾\u200d❤️\u200d🧑🏽": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏾\u200d❤️\u200d🧑🏿": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏿\u200d❤️\u200d🧑🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "people", "person", "skin", "tone", "with"},
-	"🧑🏿\u200d❤️\u200d🧑🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏿\u200d❤️\u200d🧑🏽": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"🧑🏿\u200d❤️\u200d🧑🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
-	"👩\u200d❤️\u200d👨": {"body", "couple", "family", "heart", "love", "man", "people", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👨🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👨🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👨🏽": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👨🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👨🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👨🏽": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "people", "skin", "tone", "with", "woman"},
-	"👨\u200d❤️\u200d👨": {"body", "couple", "family", "heart", "love", "man", "people", "with"},
-	"👨🏻\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
-	"👨🏻\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏻\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏻\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏻\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
-	"👨🏼\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏼\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏼\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏼\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏼\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏽\u200d❤️\u200d👨🏻": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏽\u200d❤️\u200d👨🏼": {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏽\u200d❤️\u200d👨🏽": {"body", "couple", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏽\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏽\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏾\u200d❤️\u200d👨🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏾\u200d❤️\u200d👨🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏾\u200d❤️\u200d👨🏽": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏾\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏾\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏿\u200d❤️\u200d👨🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
-	"👨🏿\u200d❤️\u200d👨🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏿\u200d❤️\u200d👨🏽": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏿\u200d❤️\u200d👨🏾": {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
-	"👨🏿\u200d❤️\u200d👨🏿": {"body", "couple", "dark", "family", "heart", "love", "man", "people", "skin", "tone", "with"},
-	"👩\u200d❤️\u200d👩": {"body", "couple", "family", "heart", "love", "people", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👩🏻": {"body", "couple", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👩🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👩🏽": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👩🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏻\u200d❤️\u200d👩🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👩🏻": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👩🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👩🏽": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👩🏾": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏼\u200d❤️\u200d👩🏿": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👩🏻": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👩🏼": {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👩🏽": {"body", "couple", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👩🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏽\u200d❤️\u200d👩🏿": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👩🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👩🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👩🏽": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👩🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏾\u200d❤️\u200d👩🏿": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👩🏻": {"body", "couple", "dark", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👩🏼": {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👩🏽": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👩🏾": {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
-	"👩🏿\u200d❤️\u200d👩🏿": {"body", "couple", "dark", "family", "heart", "love", "people", "skin", "tone", "with", "woman"},
-	"👪": {"body", "family", "people"},
-	"👨\u200d👩\u200d👦": {"body", "boy", "family", "man", "people", "woman"},
-	"👨\u200d👩\u200d👧": {"body", "family", "girl", "man", "people", "woman"},
+	"💑":                      {"body", "couple", "family", "heart", "love", "people", "with"},
+	"💑🏻":                     {"body", "couple", "family", "heart", "light", "love", "people", "skin", "tone", "with"},
+	"💑🏼":                     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with"},
+	"💑🏽":                     {"body", "couple", "family", "heart", "love", "medium", "people", "skin", "tone", "with"},
+	"💑🏾":                     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with"},
+	"💑🏿":                     {"body", "couple", "dark", "family", "heart", "love", "people", "skin", "tone", "with"},
+	"🧑🏻\u200d❤️\u200d🧑🏼":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏻\u200d❤️\u200d🧑🏽":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏻\u200d❤️\u200d🧑🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏻\u200d❤️\u200d🧑🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "people", "person", "skin", "tone", "with"},
+	"🧑🏼\u200d❤️\u200d🧑🏻":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏼\u200d❤️\u200d🧑🏽":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏼\u200d❤️\u200d🧑🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏼\u200d❤️\u200d🧑🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏽\u200d❤️\u200d🧑🏻":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏽\u200d❤️\u200d🧑🏼":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏽\u200d❤️\u200d🧑🏾":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏽\u200d❤️\u200d🧑🏿":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏾\u200d❤️\u200d🧑🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏾\u200d❤️\u200d🧑🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏾\u200d❤️\u200d🧑🏽":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏾\u200d❤️\u200d🧑🏿":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏿\u200d❤️\u200d🧑🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "people", "person", "skin", "tone", "with"},
+	"🧑🏿\u200d❤️\u200d🧑🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏿\u200d❤️\u200d🧑🏽":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"🧑🏿\u200d❤️\u200d🧑🏾":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "person", "skin", "tone", "with"},
+	"👩\u200d❤️\u200d👨":       {"body", "couple", "family", "heart", "love", "man", "people", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👨🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👨🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👨🏽":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👨🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👨🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👨🏽":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "people", "skin", "tone", "with", "woman"},
+	"👨\u200d❤️\u200d👨":       {"body", "couple", "family", "heart", "love", "man", "people", "with"},
+	"👨🏻\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
+	"👨🏻\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏻\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏻\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏻\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
+	"👨🏼\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏼\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏼\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏼\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏼\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏽\u200d❤️\u200d👨🏻":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏽\u200d❤️\u200d👨🏼":     {"body", "couple", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏽\u200d❤️\u200d👨🏽":     {"body", "couple", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏽\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏽\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏾\u200d❤️\u200d👨🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏾\u200d❤️\u200d👨🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏾\u200d❤️\u200d👨🏽":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏾\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏾\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏿\u200d❤️\u200d👨🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "people", "skin", "tone", "with"},
+	"👨🏿\u200d❤️\u200d👨🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏿\u200d❤️\u200d👨🏽":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏿\u200d❤️\u200d👨🏾":     {"body", "couple", "dark", "family", "heart", "love", "man", "medium", "people", "skin", "tone", "with"},
+	"👨🏿\u200d❤️\u200d👨🏿":     {"body", "couple", "dark", "family", "heart", "love", "man", "people", "skin", "tone", "with"},
+	"👩\u200d❤️\u200d👩":       {"body", "couple", "family", "heart", "love", "people", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👩🏻":     {"body", "couple", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👩🏼":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👩🏽":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👩🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏻\u200d❤️\u200d👩🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👩🏻":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👩🏼":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👩🏽":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👩🏾":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏼\u200d❤️\u200d👩🏿":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👩🏻":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👩🏼":     {"body", "couple", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👩🏽":     {"body", "couple", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👩🏾":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏽\u200d❤️\u200d👩🏿":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👩🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👩🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👩🏽":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👩🏾":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏾\u200d❤️\u200d👩🏿":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👩🏻":     {"body", "couple", "dark", "family", "heart", "light", "love", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👩🏼":     {"body", "couple", "dark", "family", "heart", "light", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👩🏽":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👩🏾":     {"body", "couple", "dark", "family", "heart", "love", "medium", "people", "skin", "tone", "with", "woman"},
+	"👩🏿\u200d❤️\u200d👩🏿":     {"body", "couple", "dark", "family", "heart", "love", "people", "skin", "tone", "with", "woman"},
+	"👪":                      {"body", "family", "people"},
+	"👨\u200d👩\u200d👦":        {"body", "boy", "family", "man", "people", "woman"},
+	"👨\u200d👩\u200d👧":        {"body", "family", "girl", "man", "people", "woman"},
 	"👨\u200d👩\u200d👧\u200d👦": {"body", "boy", "family", "girl", "man", "people", "woman"},
 	"👨\u200d👩\u200d👦\u200d👦": {"body", "boy", "family", "man", "people", "woman"},
 	"👨\u200d👩\u200d👧\u200d👧": {"body", "family", "girl", "man", "people", "woman"},
-	"👨\u200d👨\u200d👦": {"body", "boy", "family", "man", "people"},
-	"👨\u200d👨\u200d👧": {"body", "family", "girl", "man", "people"},
+	"👨\u200d👨\u200d👦":        {"body", "boy", "family", "man", "people"},
+	"👨\u200d👨\u200d👧":        {"body", "family", "girl", "man", "people"},
 	"👨\u200d👨\u200d👧\u200d👦": {"body", "boy", "family", "girl", "man", "people"},
 	"👨\u200d👨\u200d👦\u200d👦": {"body", "boy", "family", "man", "people"},
 	"👨\u200d👨\u200d👧\u200d👧": {"body", "family", "girl", "man", "people"},
-	"👩\u200d👩\u200d👦": {"body", "boy", "family", "people", "woman"},
-	"👩\u200d👩\u200d👧": {"body", "family", "girl", "people", "woman"},
+	"👩\u200d👩\u200d👦":        {"body", "boy", "family", "people", "woman"},
+	"👩\u200d👩\u200d👧":        {"body", "family", "girl", "people", "woman"},
 	"👩\u200d👩\u200d👧\u200d👦": {"body", "boy", "family", "girl", "people", "woman"},
 	"👩\u200d👩\u200d👦\u200d👦": {"body", "boy", "family", "people", "woman"},
 	"👩\u200d👩\u200d👧\u200d👧": {"body", "family", "girl", "people", "woman"},
-	"👨\u200d👦": {"body", "boy", "family", "man", "people"},
-	"👨\u200d👦\u200d👦": {"body", "boy", "family", "man", "people"},
-	"👨\u200d👧": {"body", "family", "girl", "man", "people"},
-	"👨\u200d👧\u200d👦": {"body", "boy", "family", "girl", "man", "people"},
-	"👨\u200d👧\u200d👧": {"body", "family", "girl", "man", "people"},
-	"👩\u200d👦": {"body", "boy", "family", "people", "woman"},
-	"👩\u200d👦\u200d👦": {"body", "boy", "family", "people", "woman"},
-	"👩\u200d👧": {"body", "family", "girl", "people", "woman"},
-	"👩\u200d👧\u200d👦": {"body", "boy", "family", "girl", "people", "woman"},
-	"👩\u200d👧\u200d👧": {"body", "family", "girl", "people", "woman"},
-	"🗣️": {"body", "face", "head", "people", "person", "silhouette", "speak", "speaking", "symbol"},
-	"👤": {"body", "bust", "in", "people", "person", "silhouette", "symbol"},
-	"👥": {"body", "bust", "busts", "in", "people", "person", "silhouette", "symbol"},
-	"🫂": {"body", "goodbye", "hello", "hug", "hugging", "people", "person", "symbol", "thanks"},
-	"👣": {"body", "clothing", "footprint", "footprints", "people", "person", "print", "symbol"},
-	"🐵": {"animal", "animals", "face", "mammal", "monkey", "nature"},
-	"🐒": {"animal", "animals", "mammal", "monkey", "nature"},
-	"🦍": {"animal", "animals", "gorilla", "mammal", "nature"},
-	"🦧": {"animal", "animals", "ape", "mammal", "nature", "orangutan"},
-	"🐶": {"animal", "animals", "dog", "face", "mammal", "nature", "pet"},
-	"🐕": {"animal", "animals", "dog", "mammal", "nature"},
-	"🦮": {"accessibility", "animal", "animals", "blind", "dog", "guide", "mammal", "nature"},
-	"🐕\u200d🦺": {"accessibility", "animal", "animals", "assistance", "dog", "mammal", "nature", "service"},
-	"🐩": {"animal", "animals", "dog", "mammal", "nature", "poodle"},
-	"🐺": {"animal", "animals", "face", "mammal", "nature", "wolf"},
-	"🦊": {"animal", "animals", "face", "fox", "mammal", "nature"},
-	"🦝": {"animal", "animals", "curious", "mammal", "nature", "raccoon", "sly"},
-	"🐱": {"animal", "animals", "cat", "face", "mammal", "nature", "pet"},
-	"🐈": {"animal", "animals", "cat", "mammal", "nature"},
-	"🐈\u200d⬛": {"animal", "animals", "black", "cat", "mammal", "nature", "unlucky"},
-	"🦁": {"animal", "animals", "face", "leo", "lion", "mammal", "nature", "zodiac"},
-	"🐯": {"animal", "animals", "face", "mammal", "nature", "tiger"},
-	"🐅": {"animal", "animals", "mammal", "nature", "tiger"},
-	"🐆": {"animal", "animals", "leopard", "mammal", "nature"},
-	"🐴": {"animal", "animals", "face", "horse", "mammal", "nature"},
-	"🫎": {"animal", "animals", "mammal", "moose", "nature"},
-	"🫏": {"animal", "animals", "donkey", "mammal", "nature"},
-	"🐎": {"animal", "animals", "equestrian", "horse", "mammal", "nature", "racehorse", "racing"},
-	"🦄": {"animal", "animals", "face", "mammal", "nature", "unicorn"},
-	"🦓": {"animal", "animals", "mammal", "nature", "stripe", "zebra"},
-	"🦌": {"animal", "animals", "deer", "mammal", "nature"},
-	"🦬": {"animal", "animals", "bison", "buffalo", "herd", "mammal", "nature", "wisent"},
-	"🐮": {"animal", "animals", "cow", "face", "mammal", "nature"},
-	"🐂": {"animal", "animals", "bull", "mammal", "nature", "ox", "taurus", "zodiac"},
-	"🐃": {"animal", "animals", "buffalo", "mammal", "nature", "water"},
-	"🐄": {"animal", "animals", "cow", "mammal", "nature"},
-	"🐷": {"animal", "animals", "face", "mammal", "nature", "pig"},
-	"🐖": {"animal", "animals", "mammal", "nature", "pig", "sow"},
-	"🐗": {"animal", "animals", "boar", "mammal", "nature", "pig"},
-	"🐽": {"animal", "animals", "face", "mammal", "nature", "nose", "pig"},
-	"🐏": {"animal", "animals", "aries", "male", "mammal", "nature", "ram", "sheep", "zodiac"},
-	"🐑": {"animal", "animals", "ewe", "female", "mammal", "nature", "sheep"},
-	"🐐": {"animal", "animals", "capricorn", "goat", "mammal", "nature", "zodiac"},
-	"🐪": {"animal", "animals", "camel", "dromedary", "hump", "mammal", "nature"},
-	"🐫": {"animal", "animals", "bactrian", "camel", "hump", "mammal", "nature", "two"},
-	"🦙": {"a", "alpaca", "animal", "animals", "guanaco", "llama", "mammal", "nature", "vicu", "wool"},
-	"🦒": {"animal", "animals", "giraffe", "mammal", "nature", "spots"},
-	"🐘": {"animal", "animals", "elephant", "mammal", "nature"},
-	"🦣": {"animal", "animals", "extinction", "large", "mammal", "mammoth", "nature", "tusk", "woolly"},
-	"🦏": {"animal", "animals", "mammal", "nature", "rhinoceros"},
-	"🦛": {"animal", "animals", "hippo", "hippopotamus", "mammal", "nature"},
-	"🐭": {"animal", "animals", "face", "mammal", "mouse", "nature"},
-	"🐁": {"animal", "animals", "mammal", "mouse", "nature"},
-	"🐀": {"animal", "animals", "mammal", "nature", "rat"},
-	"🐹": {"animal", "animals", "face", "hamster", "mammal", "nature", "pet"},
-	"🐰": {"animal", "animals", "bunny", "face", "mammal", "nature", "pet", "rabbit"},
-	"🐇": {"animal", "animals", "bunny", "mammal", "nature", "pet", "rabbit"},
-	"🐿️": {"animal", "animals", "chipmunk", "mammal", "nature", "squirrel"},
-	"🦫": {"animal", "animals", "beaver", "dam", "mammal", "nature"},
-	"🦔": {"animal", "animals", "hedgehog", "mammal", "nature", "spiny"},
-	"🦇": {"animal", "animals", "bat", "mammal", "nature", "vampire"},
-	"🐻": {"animal", "animals", "bear", "face", "mammal", "nature"},
-	"🐻\u200d❄️": {"animal", "animals", "arctic", "bear", "mammal", "nature", "polar", "white"},
-	"🐨": {"animal", "animals", "face", "koala", "mammal", "marsupial", "nature"},
-	"🐼": {"animal", "animals", "face", "mammal", "nature", "panda"},
-	"🦥": {"animal", "animals", "lazy", "mammal", "nature", "sloth", "slow"},
-	"🦦": {"animal", "animals", "fishing", "mammal", "nature", "otter", "playful"},
-	"🦨": {"animal", "animals", "mammal", "nature", "skunk", "stink"},
-	"🦘": {"animal", "animals", "australia", "joey", "jump", "kangaroo", "mammal", "marsupial", "nature"},
-	"🦡": {"animal", "animals", "badger", "honey", "mammal", "nature", "pester"},
-	"🐾": {"animal", "animals", "feet", "mammal", "nature", "paw", "print", "prints"},
-	"🦃": {"animal", "animals", "bird", "nature", "turkey"},
-	"🐔": {"animal", "animals", "bird", "chicken", "nature"},
-	"🐓": {"animal", "animals", "bird", "nature", "rooster"},
-	"🐣": {"animal", "animals", "baby", "bird", "chick", "hatching", "nature"},
-	"🐤": {"animal", "animals", "baby", "bird", "chick", "nature"},
-	"🐥": {"animal", "animals", "baby", "bird", "chick", "facing", "front", "nature"},
-	"🐦": {"animal", "animals", "bird", "nature"},
-	"🐧": {"animal", "animals", "bird", "nature", "penguin"},
-	"🕊️": {"animal", "animals", "bird", "dove", "fly", "nature", "peace"},
-	"🦅": {"animal", "animals", "bird", "eagle", "nature"},
-	"🦆": {"animal", "animals", "bird", "duck", "nature"},
-	"🦢": {"animal", "animals", "bird", "cygnet", "duckling", "nature", "swan", "ugly"},
-	"🦉": {"animal", "animals", "bird", "nature", "owl", "wise"},
-	"🦤": {"animal", "animals", "bird", "dodo", "extinction", "large", "mauritius", "nature"},
-	"🪶": {"animal", "animals", "bird", "feather", "flight", "light", "nature", "plumage"},
-	"🦩": {"animal", "animals", "bird", "flamboyant", "flamingo", "nature", "tropical"},
-	"🦚": {"animal", "animals", "bird", "nature", "ostentatious", "peacock", "peahen", "proud"},
-	"🦜": {"animal", "animals", "bird", "nature", "parrot", "pirate", "talk"},
-	"🪽": {"animal", "animals", "bird", "nature", "wing"},
-	"🐦\u200d⬛": {"animal", "animals", "bird", "black", "nature"},
-	"🪿": {"animal", "animals", "bird", "goose", "nature"},
-	"🐸": {"amphibian", "animal", "animals", "face", "frog", "nature"},
-	"🐊": {"animal", "animals", "crocodile", "nature", "reptile"},
-	"🐢": {"animal", "animals", "nature", "reptile", "terrapin", "tortoise", "turtle"},
-	"🦎": {"animal", "animals", "lizard", "nature", "reptile"},
-	"🐍": {"animal", "animals", "bearer", "nature", "ophiuchus", "reptile", "serpent", "snake", "zodiac"},
-	"🐲": {"animal", "animals", "dragon", "face", "fairy", "nature", "reptile", "tale"},
-	"🐉": {"animal", "animals", "dragon", "fairy", "nature", "reptile", "tale"},
-	"🦕": {"animal", "animals", "brachiosaurus", "brontosaurus", "diplodocus", "nature", "reptile", "sauropod"},
-	"🦖": {"animal", "animals", "nature", "reptile", "rex", "t", "tyrannosaurus"},
-	"🐳": {"animal", "animals", "face", "marine", "nature", "spouting", "whale"},
-	"🐋": {"animal", "animals", "marine", "nature", "whale"},
-	"🐬": {"animal", "animals", "dolphin", "flipper", "marine", "nature"},
-	"🦭": {"animal", "animals", "lion", "marine", "nature", "sea", "seal"},
-	"🐟": {"animal", "animals", "fish", "marine", "nature"},
-	"🐠": {"animal", "animals", "fish", "marine", "nature", "tropical"},
-	"🐡": {"animal", "animals", "blowfish", "fish", "marine", "nature"},
-	"🦈": {"animal", "animals", "fish", "marine", "nature", "shark"},
-	"🐙": {"animal", "animals", "marine", "nature", "octopus"},
-	"🐚": {"animal", "animals", "marine", "nature", "shell", "spiral"},
-	"🪸": {"animal", "animals", "coral", "marine", "nature", "ocean", "reef"},
-	"🪼": {"animal", "animals", "jellyfish", "marine", "nature"},
-	"🐌": {"animal", "animals", "bug", "nature", "snail"},
-	"🦋": {"animal", "animals", "bug", "butterfly", "insect", "nature", "pretty"},
-	"🐛": {"animal", "animals", "bug", "insect", "nature"},
-	"🐜": {"animal", "animals", "ant", "bug", "insect", "nature"},
-	"🐝": {"animal", "animals", "bee", "bug", "honeybee", "insect", "nature"},
-	"🪲": {"animal", "animals", "beetle", "bug", "insect", "nature"},
-	"🐞": {"animal", "animals", "beetle", "bug", "insect", "lady", "ladybird", "ladybug", "nature"},
-	"🦗": {"animal", "animals", "bug", "cricket", "grasshopper", "nature"},
-	"🪳": {"animal", "animals", "bug", "cockroach", "insect", "nature", "pest", "roach"},
-	"🕷️": {"animal", "animals", "bug", "insect", "nature", "spider"},
-	"🕸️": {"animal", "animals", "bug", "nature", "spider", "web"},
-	"🦂": {"animal", "animals", "bug", "nature", "scorpio", "scorpion", "zodiac"},
-	"🦟": {"animal", "animals", "bug", "disease", "fever", "malaria", "mosquito", "nature", "pest", "virus"},
-	"🪰": {"animal", "animals", "bug", "disease", "fly", "maggot", "nature", "pest", "rotting"},
-	"🪱": {"animal", "animals", "annelid", "bug", "earthworm", "nature", "parasite", "worm"},
-	"🦠": {"amoeba", "animal", "animals", "bacteria", "bug", "microbe", "nature", "virus"},
-	"💐": {"animals", "bouquet", "flower", "nature", "plant"},
-	"🌸": {"animals", "blossom", "cherry", "flower", "nature", "plant"},
-	"💮": {"animals", "flower", "nature", "plant", "white"},
-	"🪷": {"animals", "buddhism", "flower", "hinduism", "india", "lotus", "nature", "plant", "purity", "vietnam"},
-	"🏵️": {"animals", "flower", "nature", "plant", "rosette"},
-	"🌹": {"animals", "flower", "nature", "plant", "rose"},
-	"🥀": {"animals", "flower", "nature", "plant", "wilted"},
-	"🌺": {"animals", "flower", "hibiscus", "nature", "plant"},
-	"🌻": {"animals", "flower", "nature", "plant", "sun", "sunflower"},
-	"🌼": {"animals", "blossom", "flower", "nature", "plant"},
-	"🌷": {"animals", "flower", "nature", "plant", "tulip"},
-	"🪻": {"animals", "flower", "hyacinth", "nature", "plant"},
-	"🌱": {"animals", "nature", "other", "plant", "seedling", "young"},
-	"🪴": {"animals", "boring", "grow", "house", "nature", "nurturing", "other", "plant", "potted", "useless"},
-	"🌲": {"animals", "evergreen", "nature", "other", "plant", "tree"},
-	"🌳": {"animals", "deciduous", "nature", "other", "plant", "shedding", "tree"},
-	"🌴": {"animals", "nature", "other", "palm", "plant", "tree"},
-	"🌵": {"animals", "cactus", "nature", "other", "plant"},
-	"🌾": {"animals", "ear", "grain", "nature", "of", "other", "plant", "rice", "sheaf"},
-	"🌿": {"animals", "herb", "leaf", "nature", "other", "plant"},
-	"☘️": {"animals", "nature", "other", "plant", "shamrock"},
-	"🍀": {"animals", "clover", "four", "leaf", "nature", "other", "plant"},
-	"🍁": {"animals", "falling", "leaf", "maple", "nature", "other", "plant"},
-	"🍂": {"animals", "fallen", "falling", "leaf", "nature", "other", "plant"},
-	"🍃": {"animals", "blow", "flutter", "fluttering", "in", "leaf", "nature", "other", "plant", "wind"},
-	"🪹": {"animals", "empty", "nature", "nest", "nesting", "other", "plant"},
-	"🪺": {"animals", "eggs", "nature", "nest", "nesting", "other", "plant", "with"},
-	"🍄": {"animals", "mushroom", "nature", "other", "plant", "toadstool"},
-	"🍇": {"drink", "food", "fruit", "grape", "grapes"},
-	"🍈": {"drink", "food", "fruit", "melon"},
-	"🍉": {"drink", "food", "fruit", "watermelon"},
-	"🍊": {"drink", "food", "fruit", "orange", "tangerine"},
-	"🍋": {"citrus", "drink", "food", "fruit", "lemon"},
-	"🍌": {"banana", "drink", "food", "fruit"},
-	"🍍": {"drink", "food", "fruit", "pineapple"},
-	"🥭": {"drink", "food", "fruit", "mango", "tropical"},
-	"🍎": {"apple", "drink", "food", "fruit", "red"},
-	"🍏": {"apple", "drink", "food", "fruit", "green"},
-	"🍐": {"drink", "food", "fruit", "pear"},
-	"🍑": {"drink", "food", "fruit", "peach"},
-	"🍒": {"berries", "cherries", "cherry", "drink", "food", "fruit", "red"},
-	"🍓": {"berry", "drink", "food", "fruit", "strawberry"},
-	"🫐": {"berry", "bilberry", "blue", "blueberries", "blueberry", "drink", "food", "fruit"},
-	"🥝": {"drink", "food", "fruit", "kiwi"},
-	"🍅": {"drink", "food", "fruit", "tomato", "vegetable"},
-	"🫒": {"drink", "food", "fruit", "olive"},
-	"🥥": {"a", "coconut", "colada", "drink", "food", "fruit", "palm", "pi"},
-	"🥑": {"avocado", "drink", "food", "fruit", "vegetable"},
-	"🍆": {"aubergine", "drink", "eggplant", "food", "vegetable"},
-	"🥔": {"drink", "food", "potato", "vegetable"},
-	"🥕": {"carrot", "drink", "food", "vegetable"},
-	"🌽": {"corn", "drink", "ear", "food", "maize", "maze", "of", "vegetable"},
-	"🌶️": {"drink", "food", "hot", "pepper", "vegetable"},
-	"🫑": {"bell", "capsicum", "drink", "food", "pepper", "vegetable"},
-	"🥒": {"cucumber", "drink", "food", "pickle", "vegetable"},
-	"🥬": {"bok", "cabbage", "choy", "drink", "food", "green", "kale", "leafy", "lettuce", "vegetable"},
-	"🥦": {"broccoli", "cabbage", "drink", "food", "vegetable", "wild"},
-	"🧄": {"drink", "flavoring", "food", "garlic", "vegetable"},
-	"🧅": {"drink", "flavoring", "food", "onion", "vegetable"},
-	"🥜": {"drink", "food", "nut", "peanut", "peanuts", "vegetable"},
-	"🫘": {"beans", "drink", "food", "kidney", "legume", "vegetable"},
-	"🌰": {"chestnut", "drink", "food", "plant", "vegetable"},
-	"🫚": {"drink", "food", "ginger", "root", "vegetable"},
-	"🫛": {"drink", "food", "pea", "pod", "vegetable"},
-	"🍞": {"bread", "drink", "food", "loaf", "prepared"},
-	"🥐": {"bread", "breakfast", "croissant", "drink", "food", "french", "prepared", "roll"},
-	"🥖": {"baguette", "bread", "drink", "food", "french", "prepared"},
-	"🫓": {"arepa", "drink", "flatbread", "food", "lavash", "naan", "pita", "prepared"},
-	"🥨": {"drink", "food", "prepared", "pretzel", "twisted"},
-	"🥯": {"bagel", "bakery", "breakfast", "drink", "food", "prepared", "schmear"},
-	"🥞": {"breakfast", "cr", "drink", "food", "hotcake", "pancake", "pancakes", "pe", "prepared"},
-	"🧇": {"breakfast", "drink", "food", "indecisive", "iron", "prepared", "waffle"},
-	"🧀": {"cheese", "drink", "food", "prepared", "wedge"},
-	"🍖": {"bone", "drink", "food", "meat", "on", "prepared"},
-	"🍗": {"bone", "chicken", "drink", "drumstick", "food", "leg", "poultry", "prepared"},
-	"🥩": {"chop", "cut", "drink", "food", "lambchop", "meat", "of", "porkchop", "prepared", "steak"},
-	"🥓": {"bacon", "breakfast", "drink", "food", "meat", "prepared"},
-	"🍔": {"burger", "drink", "food", "hamburger", "prepared"},
-	"🍟": {"drink", "food", "french", "fries", "prepared"},
-	"🍕": {"cheese", "drink", "food", "pizza", "prepared", "slice"},
-	"🌭": {"dog", "drink", "food", "frankfurter", "hot", "hotdog", "prepared", "sausage"},
-	"🥪": {"bread", "drink", "food", "prepared", "sandwich"},
-	"🌮": {"drink", "food", "mexican", "prepared", "taco"},
-	"🌯": {"burrito", "drink", "food", "mexican", "prepared", "wrap"},
-	"🫔": {"drink", "food", "mexican", "prepared", "tamale", "wrapped"},
-	"🥙": {"drink", "falafel", "flatbread", "food", "gyro", "kebab", "prepared", "stuffed"},
-	"🧆": {"chickpea", "drink", "falafel", "food", "meatball", "prepared"},
-	"🥚": {"breakfast", "drink", "egg", "food", "prepared"},
-	"🍳": {"breakfast", "cooking", "drink", "egg", "food", "frying", "pan", "prepared"},
-	"🥘": {"casserole", "drink", "food", "of", "paella", "pan", "prepared", "shallow"},
-	"🍲": {"drink", "food", "of", "pot", "prepared", "stew"},
-	"🫕": {"cheese", "chocolate", "drink", "fondue", "food", "melted", "pot", "prepared", "swiss"},
-	"🥣": {"bowl", "breakfast", "cereal", "congee", "drink", "food", "prepared", "spoon", "with"},
-	"🥗": {"drink", "food", "green", "prepared", "salad"},
-	"🍿": {"drink", "food", "popcorn", "prepared"},
-	"🧈": {"butter", "dairy", "drink", "food", "prepared"},
-	"🧂": {"condiment", "drink", "food", "prepared", "salt", "shaker"},
-	"🥫": {"can", "canned", "drink", "food", "prepared"},
-	"🍱": {"asian", "bento", "box", "drink", "food"},
-	"🍘": {"asian", "cracker", "drink", "food", "rice"},
-	"🍙": {"asian", "ball", "drink", "food", "japanese", "rice"},
-	"🍚": {"asian", "cooked", "drink", "food", "rice"},
-	"🍛": {"asian", "curry", "drink", "food", "rice"},
-	"🍜": {"asian", "bowl", "drink", "food", "noodle", "ramen", "steaming"},
-	"🍝": {"asian", "drink", "food", "pasta", "spaghetti"},
-	"🍠": {"asian", "drink", "food", "potato", "roasted", "sweet"},
-	"🍢": {"asian", "drink", "food", "kebab", "oden", "seafood", "skewer", "stick"},
-	"🍣": {"asian", "drink", "food", "sushi"},
-	"🍤": {"asian", "drink", "food", "fried", "prawn", "shrimp", "tempura"},
-	"🍥": {"asian", "cake", "drink", "fish", "food", "pastry", "swirl", "with"},
-	"🥮": {"asian", "autumn", "b", "cake", "drink", "festival", "food", "moon", "ng", "yu"},
-	"🍡": {"asian", "dango", "dessert", "drink", "food", "japanese", "skewer", "stick", "sweet"},
-	"🥟": {"asian", "drink", "dumpling", "empanada", "food", "gy", "jiaozi", "pierogi", "potsticker", "za"},
-	"🥠": {"asian", "cookie", "drink", "food", "fortune", "prophecy"},
-	"🥡": {"asian", "box", "drink", "food", "oyster", "pail", "takeout"},
-	"🦀": {"cancer", "crab", "drink", "food", "marine", "zodiac"},
-	"🦞": {"bisque", "claws", "drink", "food", "lobster", "marine", "seafood"},
-	"🦐": {"drink", "food", "marine", "shellfish", "shrimp", "small"},
-	"🦑": {"drink", "food", "marine", "molusc", "squid"},
-	"🦪": {"diving", "drink", "food", "marine", "oyster", "pearl"},
-	"🍦": {"cream", "dessert", "drink", "food", "ice", "icecream", "soft", "sweet"},
-	"🍧": {"dessert", "drink", "food", "ice", "shaved", "sweet"},
-	"🍨": {"cream", "dessert", "drink", "food", "ice", "sweet"},
-	"🍩": {"breakfast", "dessert", "donut", "doughnut", "drink", "food", "sweet"},
-	"🍪": {"cookie", "dessert", "drink", "food", "sweet"},
-	"🎂": {"birthday", "cake", "celebration", "dessert", "drink", "food", "pastry", "sweet"},
-	"🍰": {"cake", "dessert", "drink", "food", "pastry", "shortcake", "slice", "sweet"},
-	"🧁": {"bakery", "cupcake", "drink", "food", "sweet"},
-	"🥧": {"drink", "filling", "food", "pastry", "pie", "sweet"},
-	"🍫": {"bar", "chocolate", "dessert", "drink", "food", "sweet"},
-	"🍬": {"candy", "dessert", "drink", "food", "sweet"},
-	"🍭": {"candy", "dessert", "drink", "food", "lollipop", "sweet"},
-	"🍮": {"custard", "dessert", "drink", "food", "pudding", "sweet"},
-	"🍯": {"drink", "food", "honey", "honeypot", "pot", "sweet"},
-	"🍼": {"baby", "bottle", "drink", "food", "milk"},
-	"🥛": {"drink", "food", "glass", "milk", "of"},
-	"☕": {"beverage", "drink", "food", "hot"},
-	"🫖": {"drink", "food", "pot", "tea", "teapot"},
-	"🍵": {"beverage", "cup", "drink", "food", "handle", "tea", "teacup", "without"},
-	"🍶": {"bar", "beverage", "bottle", "cup", "drink", "food", "sake"},
-	"🍾": {"bar", "bottle", "cork", "drink", "food", "popping", "with"},
-	"🍷": {"bar", "beverage", "drink", "food", "glass", "wine"},
-	"🍸": {"cocktail", "drink", "food", "glass"},
-	"🍹": {"bar", "drink", "food", "tropical"},
-	"🍺": {"bar", "beer", "drink", "food", "mug"},
-	"🍻": {"bar", "beer", "clink", "clinking", "drink", "food", "mug", "mugs"},
-	"🥂": {"celebrate", "clink", "clinking", "drink", "food", "glass", "glasses"},
-	"🥃": {"drink", "food", "glass", "liquor", "shot", "tumbler", "whisky"},
-	"🫗": {"drink", "empty", "food", "glass", "liquid", "pouring", "spill"},
-	"🥤": {"cup", "drink", "food", "juice", "soda", "straw", "with"},
-	"🧋": {"bubble", "drink", "food", "milk", "pearl", "tea"},
-	"🧃": {"beverage", "box", "drink", "food", "juice", "straw", "sweet"},
-	"🧉": {"drink", "food", "mate"},
-	"🧊": {"cold", "cube", "drink", "food", "ice", "iceberg"},
-	"🥢": {"chopsticks", "dishware", "drink", "food", "hashi"},
-	"🍽️": {"and", "cooking", "dishware", "drink", "food", "fork", "knife", "plate", "with"},
-	"🍴": {"and", "cooking", "cutlery", "dishware", "drink", "food", "fork", "knife"},
-	"🥄": {"dishware", "drink", "food", "spoon", "tableware"},
-	"🔪": {"cooking", "dishware", "drink", "food", "hocho", "kitchen", "knife", "tool", "weapon"},
-	"🫙": {"condiment", "container", "dishware", "drink", "empty", "food", "jar", "sauce", "store"},
-	"🏺": {"amphora", "aquarius", "cooking", "dishware", "drink", "food", "jug", "zodiac"},
-	"🌍": {"africa", "europe", "globe", "map", "place", "places", "showing", "travel"},
-	"🌎": {"americas", "globe", "map", "place", "places", "showing", "travel"},
-	"🌏": {"asia", "australia", "globe", "map", "place", "places", "showing", "travel"},
-	"🌐": {"earth", "globe", "map", "meridians", "place", "places", "travel", "with", "world"},
-	"🗺️": {"map", "place", "places", "travel", "world"},
-	"🗾": {"japan", "map", "of", "place", "places", "travel"},
-	"🧭": {"compass", "magnetic", "map", "navigation", "orienteering", "place", "places", "travel"},
-	"🏔️": {"capped", "cold", "geographic", "mountain", "place", "places", "snow", "travel"},
-	"⛰️": {"geographic", "mountain", "place", "places", "travel"},
-	"🌋": {"eruption", "geographic", "mountain", "place", "places", "travel", "volcano"},
-	"🗻": {"fuji", "geographic", "mount", "mountain", "place", "places", "travel"},
-	"🏕️": {"camping", "geographic", "place", "places", "travel"},
-	"🏖️": {"beach", "geographic", "place", "places", "travel", "umbrella", "with"},
-	"🏜️": {"desert", "geographic", "place", "places", "travel"},
-	"🏝️": {"desert", "geographic", "island", "place", "places", "travel"},
-	"🏞️": {"geographic", "national", "park", "place", "places", "travel"},
-	"🏟️": {"building", "place", "places", "stadium", "travel"},
-	"🏛️": {"building", "classical", "place", "places", "travel"},
-	"🏗️": {"building", "construction", "place", "places", "travel"},
-	"🧱": {"brick", "bricks", "building", "clay", "mortar", "place", "places", "travel", "wall"},
-	"🪨": {"boulder", "building", "heavy", "place", "places", "rock", "solid", "stone", "travel"},
-	"🪵": {"building", "log", "lumber", "place", "places", "timber", "travel", "wood"},
-	"🛖": {"building", "house", "hut", "place", "places", "roundhouse", "travel", "yurt"},
-	"🏘️": {"building", "houses", "place", "places", "travel"},
-	"🏚️": {"building", "derelict", "house", "place", "places", "travel"},
-	"🏠": {"building", "house", "place", "places", "travel"},
-	"🏡": {"building", "garden", "home", "house", "place", "places", "travel", "with"},
-	"🏢": {"building", "office", "place", "places", "travel"},
-	"🏣": {"building", "japanese", "office", "place", "places", "post", "travel"},
-	"🏤": {"building", "european", "office", "place", "places", "post", "travel"},
-	"🏥": {"building", "doctor", "hospital", "medicine", "place", "places", "travel"},
-	"🏦": {"bank", "building", "place", "places", "travel"},
-	"🏨": {"building", "hotel", "place", "places", "travel"},
-	"🏩": {"building", "hotel", "love", "place", "places", "travel"},
-	"🏪": {"building", "convenience", "place", "places", "store", "travel"},
-	"🏫": {"building", "place", "places", "school", "travel"},
-	"🏬": {"building", "department", "place", "places", "store", "travel"},
-	"🏭": {"building", "factory", "place", "places", "travel"},
-	"🏯": {"building", "castle", "japanese", "place", "places", "travel"},
-	"🏰": {"building", "castle", "european", "place", "places", "travel"},
-	"💒": {"building", "chapel", "place", "places", "romance", "travel", "wedding"},
-	"🗼": {"building", "place", "places", "tokyo", "tower", "travel"},
-	"🗽": {"building", "liberty", "of", "place", "places", "statue", "travel"},
-	"⛪": {"church", "place", "places", "religious", "travel"},
-	"🕌": {"islam", "mosque", "muslim", "place", "places", "religion", "religious", "travel"},
-	"🛕": {"hindu", "place", "places", "religious", "temple", "travel"},
-	"🕍": {"jew", "jewish", "place", "places", "religion", "religious", "synagogue", "temple", "travel"},
-	"⛩️": {"place", "places", "religion", "religious", "shinto", "shrine", "travel"},
-	"🕋": {"islam", "kaaba", "muslim", "place", "places", "religion", "religious", "travel"},
-	"⛲": {"fountain", "other", "place", "places", "travel"},
-	"⛺": {"other", "place", "places", "tent", "travel"},
-	"🌁": {"fog", "foggy", "other", "place", "places", "travel"},
-	"🌃": {"night", "other", "place", "places", "star", "stars", "travel", "with"},
-	"🏙️": {"city", "cityscape", "other", "place", "places", "travel"},
-	"🌄": {"morning", "mountain", "mountains", "other", "over", "place", "places", "sun", "sunrise", "travel"},
-	"🌅": {"morning", "other", "place", "places", "sun", "sunrise", "travel"},
-	"🌆": {"at", "city", "cityscape", "dusk", "evening", "landscape", "other", "place", "places", "sunset", "travel"},
-	"🌇": {"dusk", "other", "place", "places", "sun", "sunset", "travel"},
-	"🌉": {"at", "bridge", "night", "other", "place", "places", "travel"},
-	"♨️": {"hot", "hotsprings", "other", "place", "places", "springs", "steaming", "travel"},
-	"🎠": {"carousel", "horse", "other", "place", "places", "travel"},
-	"🛝": {"amusement", "other", "park", "place", "places", "play", "playground", "slide", "travel"},
-	"🎡": {"amusement", "ferris", "other", "park", "place", "places", "travel", "wheel"},
-	"🎢": {"amusement", "coaster", "other", "park", "place", "places", "roller", "travel"},
-	"💈": {"barber", "haircut", "other", "place", "places", "pole", "travel"},
-	"🎪": {"circus", "other", "place", "places", "tent", "travel"},
-	"🚂": {"engine", "ground", "locomotive", "places", "railway", "steam", "train", "transport", "travel"},
-	"🚃": {"car", "electric", "ground", "places", "railway", "train", "tram", "transport", "travel", "trolleybus"},
-	"🚄": {"ground", "high", "places", "railway", "shinkansen", "speed", "train", "transport", "travel"},
-	"🚅": {"bullet", "ground", "places", "railway", "shinkansen", "speed", "train", "transport", "travel"},
-	"🚆": {"ground", "places", "railway", "train", "transport", "travel"},
-	"🚇": {"ground", "metro", "places", "transport", "travel"},
-	"🚈": {"ground", "light", "places", "rail", "railway", "transport", "travel"},
-	"🚉": {"ground", "places", "railway", "station", "train", "transport", "travel"},
-	"🚊": {"ground", "places", "tram", "transport", "travel", "trolleybus"},
-	"🚝": {"ground", "monorail", "places", "transport", "travel", "vehicle"},
-	"🚞": {"car", "ground", "mountain", "places", "railway", "transport", "travel"},
-	"🚋": {"car", "ground", "places", "tram", "transport", "travel", "trolleybus"},
-	"🚌": {"bus", "ground", "places", "transport", "travel", "vehicle"},
-	"🚍": {"bus", "ground", "oncoming", "places", "transport", "travel"},
-	"🚎": {"bus", "ground", "places", "tram", "transport", "travel", "trolley", "trolleybus"},
-	"🚐": {"bus", "ground", "minibus", "places", "transport", "travel"},
-	"🚑": {"ambulance", "ground", "places", "transport", "travel"},
-	"🚒": {"engine", "fire", "ground", "places", "transport", "travel", "truck"},
-	"🚓": {"car", "ground", "patrol", "places", "police", "transport", "travel"},
-	"🚔": {"car", "ground", "oncoming", "places", "police", "transport", "travel"},
-	"🚕": {"ground", "places", "taxi", "transport", "travel", "vehicle"},
-	"🚖": {"ground", "oncoming", "places", "taxi", "transport", "travel"},
-	"🚗": {"automobile", "car", "ground", "places", "transport", "travel"},
-	"🚘": {"automobile", "ground", "oncoming", "places", "transport", "travel"},
-	"🚙": {"ground", "places", "recreational", "sport", "transport", "travel", "utility", "vehicle"},
-	"🛻": {"ground", "pick", "pickup", "places", "transport", "travel", "truck", "up"},
-	"🚚": {"delivery", "ground", "places", "transport", "travel", "truck"},
-	"🚛": {"articulated", "ground", "lorry", "places", "semi", "transport", "travel", "truck"},
-	"🚜": {"ground", "places", "tractor", "transport", "travel", "vehicle"},
-	"🏎️": {"car", "ground", "places", "racing", "transport", "travel"},
-	"🏍️": {"ground", "motorcycle", "places", "racing", "transport", "travel"},
-	"🛵": {"ground", "motor", "places", "scooter", "transport", "travel"},
-	"🦽": {"accessibility", "ground", "manual", "places", "transport", "travel", "wheelchair"},
-	"🦼": {"accessibility", "ground", "motorized", "places", "transport", "travel", "wheelchair"},
-	"🛺": {"auto", "ground", "places", "rickshaw", "transport", "travel", "tuk"},
-	"🚲": {"bicycle", "ground", "places", "transport", "travel"},
-	"🛴": {"ground", "kick", "places", "scooter", "transport", "travel"},
-	"🛹": {"board", "ground", "places", "skateboard", "transport", "travel"},
-	"🛼": {"ground", "places", "roller", "skate", "transport", "travel"},
-	"🚏": {"bus", "ground", "places", "stop", "transport", "travel"},
-	"🛣️": {"ground", "highway", "motorway", "places", "road", "transport", "travel"},
-	"🛤️": {"ground", "places", "railway", "track", "train", "transport", "travel"},
-	"🛢️": {"drum", "ground", "oil", "places", "transport", "travel"},
-	"⛽": {"fuel", "ground", "places", "pump", "transport", "travel"},
-	"🛞": {"circle", "ground", "places", "tire", "transport", "travel", "turn", "wheel"},
-	"🚨": {"beacon", "car", "ground", "light", "places", "police", "revolving", "transport", "travel"},
-	"🚥": {"ground", "horizontal", "light", "places", "signal", "traffic", "transport", "travel"},
-	"🚦": {"ground", "light", "places", "signal", "traffic", "transport", "travel", "vertical"},
-	"🛑": {"ground", "octagonal", "places", "sign", "stop", "transport", "travel"},
-	"🚧": {"barrier", "construction", "ground", "places", "transport", "travel"},
-	"⚓": {"anchor", "places", "transport", "travel", "water"},
-	"🛟": {"buoy", "float", "life", "places", "preserver", "rescue", "ring", "safety", "saver", "transport", "travel", "water"},
-	"⛵": {"places", "sailboat", "transport", "travel", "water"},
-	"🛶": {"boat", "canoe", "places", "transport", "travel", "water"},
-	"🚤": {"boat", "places", "speedboat", "transport", "travel", "water"},
-	"🛳️": {"passenger", "places", "ship", "transport", "travel", "water"},
-	"⛴️": {"boat", "ferry", "passenger", "places", "transport", "travel", "water"},
-	"🛥️": {"boat", "motor", "motorboat", "places", "transport", "travel", "water"},
-	"🚢": {"boat", "passenger", "places", "ship", "transport", "travel", "water"},
-	"✈️": {"aeroplane", "air", "airplane", "places", "transport", "travel"},
-	"🛩️": {"aeroplane", "air", "airplane", "places", "small", "transport", "travel"},
-	"🛫": {"aeroplane", "air", "airplane", "check", "departure", "departures", "in", "places", "transport", "travel"},
-	"🛬": {"aeroplane", "air", "airplane", "arrival", "arrivals", "arriving", "landing", "places", "transport", "travel"},
-	"🪂": {"air", "glide", "hang", "parachute", "parasail", "places", "skydive", "transport", "travel"},
-	"💺": {"air", "chair", "places", "seat", "transport", "travel"},
-	"🚁": {"air", "helicopter", "places", "transport", "travel", "vehicle"},
-	"🚟": {"air", "places", "railway", "suspension", "transport", "travel"},
-	"🚠": {"air", "cable", "cableway", "gondola", "mountain", "places", "transport", "travel"},
-	"🚡": {"aerial", "air", "cable", "car", "gondola", "places", "tramway", "transport", "travel"},
-	"🛰️": {"air", "places", "satellite", "space", "transport", "travel"},
-	"🚀": {"air", "places", "rocket", "space", "transport", "travel"},
-	"🛸": {"air", "flying", "places", "saucer", "transport", "travel", "ufo"},
-	"🛎️": {"bell", "bellhop", "hotel", "places", "travel"},
-	"🧳": {"hotel", "luggage", "packing", "places", "travel"},
-	"⌛": {"done", "hourglass", "places", "time", "travel"},
-	"⏳": {"done", "hourglass", "not", "places", "time", "travel"},
-	"⌚": {"places", "time", "travel", "watch"},
-	"⏰": {"alarm", "clock", "places", "time", "travel"},
-	"⏱️": {"clock", "places", "stopwatch", "time", "travel"},
-	"⏲️": {"clock", "places", "time", "timer", "travel"},
-	"🕰️": {"clock", "mantelpiece", "places", "time", "travel"},
-	"🕛": {"clock", "o", "places", "time", "travel", "twelve"},
-	"🕧": {"places", "thirty", "time", "travel", "twelve"},
-	"🕐": {"clock", "o", "one", "places", "time", "travel"},
-	"🕜": {"one", "places", "thirty", "time", "travel"},
-	"🕑": {"clock", "o", "places", "time", "travel", "two"},
-	"🕝": {"places", "thirty", "time", "travel", "two"},
-	"🕒": {"clock", "o", "places", "three", "time", "travel"},
-	"🕞": {"places", "thirty", "three", "time", "travel"},
-	"🕓": {"clock", "four", "o", "places", "time", "travel"},
-	"🕟": {"four", "places", "thirty", "time", "travel"},
-	"🕔": {"clock", "five", "o", "places", "time", "travel"},
-	"🕠": {"five", "places", "thirty", "time", "travel"},
-	"🕕": {"clock", "o", "places", "six", "time", "travel"},
-	"🕡": {"places", "six", "thirty", "time", "travel"},
-	"🕖": {"clock", "o", "places", "seven", "time", "travel"},
-	"🕢": {"places", "seven", "thirty", "time", "travel"},
-	"🕗": {"clock", "eight", "o", "places", "time", "travel"},
-	"🕣": {"eight", "places", "thirty", "time", "travel"},
-	"🕘": {"clock", "nine", "o", "places", "time", "travel"},
-	"🕤": {"nine", "places", "thirty", "time", "travel"},
-	"🕙": {"clock", "o", "places", "ten", "time", "travel"},
-	"🕥": {"places", "ten", "thirty", "time", "travel"},
-	"🕚": {"clock", "eleven", "o", "places", "time", "travel"},
-	"🕦": {"eleven", "places", "thirty", "time", "travel"},
-	"🌑": {"dark", "moon", "new", "places", "sky", "travel", "weather"},
-	"🌒": {"crescent", "moon", "places", "sky", "travel", "waxing", "weather"},
-	"🌓": {"first", "moon", "places", "quarter", "sky", "travel", "weather"},
-	"🌔": {"gibbous", "moon", "places", "sky", "travel", "waxing", "weather"},
-	"🌕": {"full", "moon", "places", "sky", "travel", "weather"},
-	"🌖": {"gibbous", "moon", "places", "sky", "travel", "waning", "weather"},
-	"🌗": {"last", "moon", "places", "quarter", "sky", "travel", "weather"},
-	"🌘": {"crescent", "moon", "places", "sky", "travel", "waning", "weather"},
-	"🌙": {"crescent", "moon", "places", "sky", "travel", "weather"},
-	"🌚": {"face", "moon", "new", "places", "sky", "travel", "weather"},
-	"🌛": {"face", "first", "moon", "places", "quarter", "sky", "travel", "weather"},
-	"🌜": {"face", "last", "moon", "places", "quarter", "sky", "travel", "weather"},
-	"🌡️": {"places", "sky", "thermometer", "travel", "weather"},
-	"☀️": {"bright", "places", "rays", "sky", "sun", "sunny", "travel", "weather"},
-	"🌝": {"bright", "face", "full", "moon", "places", "sky", "travel", "weather"},
-	"🌞": {"bright", "face", "places", "sky", "sun", "travel", "weather", "with"},
-	"🪐": {"places", "planet", "ringed", "saturn", "saturnine", "sky", "travel", "weather"},
-	"⭐": {"places", "sky", "star", "travel", "weather"},
-	"🌟": {"glittery", "glow", "glowing", "places", "shining", "sky", "sparkle", "star", "travel", "weather"},
-	"🌠": {"falling", "places", "shooting", "sky", "star", "travel", "weather"},
-	"🌌": {"milky", "places", "sky", "space", "travel", "way", "weather"},
-	"☁️": {"cloud", "places", "sky", "travel", "weather"},
-	"⛅": {"behind", "cloud", "places", "sky", "sun", "travel", "weather"},
-	"⛈️": {"and", "cloud", "lightning", "places", "rain", "sky", "thunder", "travel", "weather", "with"},
-	"🌤️": {"behind", "cloud", "places", "sky", "small", "sun", "travel", "weather"},
-	"🌥️": {"behind", "cloud", "large", "places", "sky", "sun", "travel", "weather"},
-	"🌦️": {"behind", "cloud", "places", "rain", "sky", "sun", "travel", "weather"},
-	"🌧️": {"cloud", "places", "rain", "sky", "travel", "weather", "with"},
-	"🌨️": {"cloud", "cold", "places", "sky", "snow", "travel", "weather", "with"},
-	"🌩️": {"cloud", "lightning", "places", "sky", "travel", "weather", "with"},
-	"🌪️": {"cloud", "places", "sky", "tornado", "travel", "weather", "whirlwind"},
-	"🌫️": {"cloud", "fog", "places", "sky", "travel", "weather"},
-	"🌬️": {"blow", "cloud", "face", "places", "sky", "travel", "weather", "wind"},
-	"🌀": {"cyclone", "dizzy", "hurricane", "places", "sky", "travel", "twister", "typhoon", "weather"},
-	"🌈": {"places", "rain", "rainbow", "sky", "travel", "weather"},
-	"🌂": {"closed", "clothing", "places", "rain", "sky", "travel", "umbrella", "weather"},
-	"☂️": {"clothing", "places", "rain", "sky", "travel", "umbrella", "weather"},
-	"☔": {"drops", "places", "rain", "sky", "travel", "umbrella", "weather", "with"},
-	"⛱️": {"ground", "on", "places", "rain", "sky", "sun", "travel", "umbrella", "weather"},
-	"⚡": {"high", "places", "sky", "travel", "voltage", "weather"},
-	"❄️": {"cold", "places", "sky", "snow", "snowflake", "travel", "weather"},
-	"☃️": {"cold", "places", "sky", "snow", "snowman", "travel", "weather"},
-	"⛄": {"places", "sky", "snow", "snowman", "travel", "weather", "without"},
-	"☄️": {"comet", "places", "sky", "space", "travel", "weather"},
-	"🔥": {"fire", "flame", "places", "sky", "tool", "travel", "weather"},
-	"💧": {"cold", "comic", "drop", "droplet", "places", "sky", "sweat", "travel", "weather"},
-	"🌊": {"ocean", "places", "sky", "travel", "water", "wave", "weather"},
-	"🎃": {"activities", "celebration", "event", "halloween", "jack", "lantern", "o"},
-	"🎄": {"activities", "celebration", "christmas", "event", "tree"},
-	"🎆": {"activities", "celebration", "event", "fireworks"},
-	"🎇": {"activities", "celebration", "event", "fireworks", "sparkle", "sparkler"},
-	"🧨": {"activities", "dynamite", "event", "explosive", "firecracker", "fireworks"},
-	"✨": {"activities", "event", "sparkle", "sparkles", "star"},
-	"🎈": {"activities", "balloon", "celebration", "event"},
-	"🎉": {"activities", "celebration", "event", "party", "popper", "tada"},
-	"🎊": {"activities", "ball", "celebration", "confetti", "event"},
-	"🎋": {"activities", "banner", "celebration", "event", "japanese", "tanabata", "tree"},
-	"🎍": {"activities", "bamboo", "celebration", "decoration", "event", "japanese", "pine"},
-	"🎎": {"activities", "celebration", "doll", "dolls", "event", "festival", "japanese"},
-	"🎏": {"activities", "carp", "celebration", "event", "streamer"},
-	"🎐": {"activities", "bell", "celebration", "chime", "event", "wind"},
-	"🎑": {"activities", "celebration", "ceremony", "event", "moon", "viewing"},
-	"🧧": {"activities", "envelope", "event", "gift", "good", "h", "lai", "luck", "money", "ngb", "o", "red", "see"},
-	"🎀": {"activities", "celebration", "event", "ribbon"},
-	"🎁": {"activities", "box", "celebration", "event", "gift", "present", "wrapped"},
-	"🎗️": {"activities", "celebration", "event", "reminder", "ribbon"},
-	"🎟️": {"activities", "admission", "event", "ticket", "tickets"},
-	"🎫": {"activities", "admission", "event", "ticket"},
-	"🎖️": {"activities", "award", "celebration", "medal", "military"},
-	"🏆": {"activities", "award", "medal", "trophy"},
-	"🏅": {"activities", "award", "medal", "sports"},
-	"🥇": {"activities", "award", "first", "gold", "medal", "place", "st"},
-	"🥈": {"activities", "award", "medal", "nd", "place", "second", "silver"},
-	"🥉": {"activities", "award", "bronze", "medal", "place", "rd", "third"},
-	"⚽": {"activities", "ball", "soccer", "sport"},
-	"⚾": {"activities", "baseball", "sport"},
-	"🥎": {"activities", "ball", "glove", "softball", "sport", "underarm"},
-	"🏀": {"activities", "ball", "basketball", "hoop", "sport"},
-	"🏐": {"activities", "ball", "game", "sport", "volleyball"},
-	"🏈": {"activities", "american", "ball", "football", "sport"},
-	"🏉": {"activities", "ball", "football", "rugby", "sport"},
-	"🎾": {"activities", "ball", "racquet", "sport", "tennis"},
-	"🥏": {"activities", "disc", "flying", "sport", "ultimate"},
-	"🎳": {"activities", "ball", "bowling", "game", "sport"},
-	"🏏": {"activities", "ball", "bat", "cricket", "game", "sport"},
-	"🏑": {"activities", "ball", "field", "game", "hockey", "sport", "stick"},
-	"🏒": {"activities", "game", "hockey", "ice", "puck", "sport", "stick"},
-	"🥍": {"activities", "ball", "goal", "lacrosse", "sport", "stick"},
-	"🏓": {"activities", "ball", "bat", "game", "paddle", "ping", "pong", "sport", "table", "tennis"},
-	"🏸": {"activities", "badminton", "birdie", "game", "racquet", "shuttlecock", "sport"},
-	"🥊": {"activities", "boxing", "glove", "sport"},
-	"🥋": {"activities", "arts", "judo", "karate", "martial", "sport", "taekwondo", "uniform"},
-	"🥅": {"activities", "goal", "net", "sport"},
-	"⛳": {"activities", "flag", "hole", "in", "sport"},
-	"⛸️": {"activities", "ice", "skate", "sport"},
-	"🎣": {"activities", "fish", "fishing", "pole", "sport"},
-	"🤿": {"activities", "diving", "mask", "scuba", "snorkeling", "sport"},
-	"🎽": {"activities", "athletics", "running", "sash", "shirt", "sport"},
-	"🎿": {"activities", "ski", "skis", "snow", "sport"},
-	"🛷": {"activities", "sled", "sledge", "sleigh", "sport"},
-	"🥌": {"activities", "curling", "game", "rock", "sport", "stone"},
-	"🎯": {"activities", "bullseye", "dart", "direct", "game", "hit", "target"},
-	"🪀": {"activities", "fluctuate", "game", "toy", "yo"},
-	"🪁": {"activities", "fly", "game", "kite", "soar"},
-	"🔫": {"activities", "game", "gun", "handgun", "pistol", "revolver", "tool", "water", "weapon"},
-	"🎱": {"activities", "ball", "billiard", "eight", "game", "pool"},
-	"🔮": {"activities", "ball", "crystal", "fairy", "fantasy", "fortune", "game", "tale", "tool"},
-	"🪄": {"activities", "game", "magic", "wand", "witch", "wizard"},
-	"🎮": {"activities", "game", "video"},
-	"🕹️": {"activities", "game", "joystick", "video"},
-	"🎰": {"activities", "game", "machine", "slot"},
-	"🎲": {"activities", "dice", "die", "game"},
-	"🧩": {"activities", "clue", "game", "interlocking", "jigsaw", "piece", "puzzle"},
-	"🧸": {"activities", "bear", "game", "plaything", "plush", "stuffed", "teddy", "toy"},
-	"🪅": {"activities", "ata", "celebration", "game", "party", "pi"},
-	"🪩": {"activities", "ball", "dance", "disco", "game", "glitter", "mirror", "party"},
-	"🪆": {"activities", "doll", "dolls", "game", "nesting", "russia"},
-	"♠️": {"activities", "card", "game", "spade", "suit"},
-	"♥️": {"activities", "card", "game", "heart", "suit"},
-	"♦️": {"activities", "card", "diamond", "game", "suit"},
-	"♣️": {"activities", "card", "club", "game", "suit"},
-	"♟️": {"activities", "chess", "dupe", "expendable", "game", "pawn"},
-	"🃏": {"activities", "card", "game", "joker", "wildcard"},
-	"🀄": {"activities", "dragon", "game", "mahjong", "red"},
-	"🎴": {"activities", "card", "cards", "flower", "game", "japanese", "playing"},
-	"🎭": {"activities", "arts", "crafts", "performing"},
-	"🖼️": {"activities", "art", "arts", "crafts", "frame", "framed", "museum", "painting", "picture"},
-	"🎨": {"activities", "art", "artist", "arts", "crafts", "museum", "painting", "palette"},
-	"🧵": {"activities", "arts", "crafts", "needle", "sewing", "spool", "string", "thread"},
-	"🪡": {"activities", "arts", "crafts", "embroidery", "needle", "sewing", "stitches", "sutures", "tailoring"},
-	"🧶": {"activities", "arts", "ball", "crafts", "crochet", "knit", "yarn"},
-	"🪢": {"activities", "arts", "crafts", "knot", "rope", "tangled", "tie", "twine", "twist"},
-	"👓": {"clothing", "glasses", "objects"},
-	"🕶️": {"clothing", "dark", "eye", "eyewear", "glasses", "objects", "sunglasses"},
-	"🥽": {"clothing", "eye", "goggles", "objects", "protection", "swimming", "welding"},
-	"🥼": {"clothing", "coat", "doctor", "experiment", "lab", "objects", "scientist"},
-	"🦺": {"clothing", "emergency", "objects", "safety", "vest"},
-	"👔": {"clothing", "necktie", "objects", "tie"},
-	"👕": {"clothing", "objects", "shirt", "t", "tshirt"},
-	"👖": {"clothing", "jeans", "objects", "pants", "trousers"},
-	"🧣": {"clothing", "neck", "objects", "scarf"},
-	"🧤": {"clothing", "gloves", "hand", "objects"},
-	"🧥": {"clothing", "coat", "jacket", "objects"},
-	"🧦": {"clothing", "objects", "socks", "stocking"},
-	"👗": {"clothing", "dress", "objects"},
-	"👘": {"clothing", "kimono", "objects"},
-	"🥻": {"clothing", "dress", "objects", "sari"},
-	"🩱": {"bathing", "clothing", "objects", "one", "piece", "suit", "swimsuit"},
-	"🩲": {"bathing", "briefs", "clothing", "objects", "one", "piece", "suit", "swimsuit", "underwear"},
-	"🩳": {"bathing", "clothing", "objects", "pants", "shorts", "suit", "underwear"},
-	"👙": {"bikini", "clothing", "objects", "swim"},
-	"👚": {"clothes", "clothing", "objects", "s", "woman"},
-	"🪭": {"clothing", "fan", "folding", "hand", "objects"},
-	"👛": {"clothing", "coin", "objects", "purse"},
-	"👜": {"bag", "clothing", "handbag", "objects", "purse"},
-	"👝": {"bag", "clothing", "clutch", "objects", "pouch"},
-	"🛍️": {"bag", "bags", "clothing", "hotel", "objects", "shopping"},
-	"🎒": {"backpack", "bag", "clothing", "objects", "rucksack", "satchel", "school"},
-	"🩴": {"beach", "clothing", "objects", "ri", "sandal", "sandals", "thong", "thongs", "z"},
-	"👞": {"clothing", "man", "objects", "s", "shoe"},
-	"👟": {"athletic", "clothing", "objects", "running", "shoe", "sneaker"},
-	"🥾": {"backpacking", "boot", "camping", "clothing", "hiking", "objects"},
-	"🥿": {"ballet", "clothing", "flat", "objects", "on", "shoe", "slip", "slipper"},
-	"👠": {"clothing", "heel", "heeled", "high", "objects", "shoe", "woman"},
-	"👡": {"clothing", "objects", "s", "sandal", "shoe", "woman"},
-	"🩰": {"ballet", "clothing", "dance", "objects", "shoes"},
-	"👢": {"boot", "clothing", "objects", "s", "shoe", "woman"},
-	"🪮": {"clothing", "hair", "objects", "pick"},
-	"👑": {"clothing", "crown", "king", "objects", "queen"},
-	"👒": {"clothing", "hat", "objects", "s", "woman"},
-	"🎩": {"clothing", "hat", "objects", "top", "tophat"},
-	"🎓": {"cap", "clothing", "graduation", "objects"},
-	"🧢": {"baseball", "billed", "cap", "clothing", "objects"},
-	"🪖": {"army", "clothing", "helmet", "military", "objects", "soldier", "warrior"},
-	"⛑️": {"aid", "clothing", "cross", "face", "hat", "helmet", "objects", "rescue", "s", "worker"},
-	"📿": {"beads", "clothing", "necklace", "objects", "prayer", "religion"},
-	"💄": {"clothing", "cosmetics", "lipstick", "makeup", "objects"},
-	"💍": {"clothing", "diamond", "objects", "ring"},
-	"💎": {"clothing", "diamond", "gem", "jewel", "objects", "stone"},
-	"🔇": {"mute", "muted", "objects", "quiet", "silent", "sound", "speaker"},
-	"🔈": {"low", "objects", "sound", "speaker", "volume"},
-	"🔉": {"medium", "objects", "sound", "speaker", "volume"},
-	"🔊": {"high", "loud", "objects", "sound", "speaker", "volume"},
-	"📢": {"address", "loud", "loudspeaker", "objects", "public", "sound"},
-	"📣": {"cheering", "megaphone", "objects", "sound"},
-	"📯": {"horn", "objects", "post", "postal", "sound"},
-	"🔔": {"bell", "objects", "sound"},
-	"🔕": {"bell", "forbidden", "mute", "objects", "quiet", "silent", "slash", "sound", "with"},
-	"🎼": {"music", "musical", "objects", "score"},
-	"🎵": {"music", "musical", "note", "objects"},
-	"🎶": {"music", "musical", "note", "notes", "objects"},
-	"🎙️": {"mic", "microphone", "music", "objects", "studio"},
-	"🎚️": {"level", "music", "objects", "slider"},
-	"🎛️": {"control", "knobs", "music", "objects"},
-	"🎤": {"karaoke", "mic", "microphone", "music", "objects"},
-	"🎧": {"headphone", "music", "objects"},
-	"📻": {"music", "objects", "radio"},
-	"🎷": {"instrument", "music", "musical", "objects", "sax", "saxophone"},
-	"🪗": {"accordion", "box", "concertina", "instrument", "musical", "objects", "squeeze"},
-	"🎸": {"guitar", "instrument", "music", "musical", "objects"},
-	"🎹": {"instrument", "keyboard", "music", "musical", "objects", "piano"},
-	"🎺": {"instrument", "music", "musical", "objects", "trumpet"},
-	"🎻": {"instrument", "music", "musical", "objects", "violin"},
-	"🪕": {"banjo", "instrument", "music", "musical", "objects", "stringed"},
-	"🥁": {"drum", "drumsticks", "instrument", "music", "musical", "objects"},
-	"🪘": {"beat", "conga", "drum", "instrument", "long", "musical", "objects", "rhythm"},
-	"🪇": {"instrument", "maracas", "musical", "objects"},
-	"🪈": {"flute", "instrument", "musical", "objects"},
-	"📱": {"cell", "mobile", "objects", "phone", "telephone"},
-	"📲": {"arrow", "cell", "mobile", "objects", "phone", "receive", "with"},
-	"☎️": {"objects", "phone", "telephone"},
-	"📞": {"objects", "phone", "receiver", "telephone"},
-	"📟": {"objects", "pager", "phone"},
-	"📠": {"fax", "machine", "objects", "phone"},
-	"🔋": {"battery", "computer", "objects"},
-	"🪫": {"battery", "computer", "electronic", "energy", "low", "objects"},
-	"🔌": {"computer", "electric", "electricity", "objects", "plug"},
-	"💻": {"computer", "laptop", "objects"},
-	"🖥️": {"computer", "desktop", "objects"},
-	"🖨️": {"computer", "objects", "printer"},
-	"⌨️": {"computer", "keyboard", "objects"},
-	"🖱️": {"computer", "mouse", "objects"},
-	"🖲️": {"computer", "objects", "trackball"},
-	"💽": {"computer", "disk", "minidisk", "objects", "optical"},
-	"💾": {"computer", "disk", "floppy", "objects"},
-	"💿": {"computer", "disk", "objects", "optical"},
-	"📀": {"blu", "computer", "disk", "dvd", "objects", "optical", "ray"},
-	"🧮": {"abacus", "calculation", "computer", "objects"},
-	"🎥": {"camera", "cinema", "light", "movie", "objects", "video"},
-	"🎞️": {"cinema", "film", "frames", "light", "movie", "objects", "video"},
-	"📽️": {"cinema", "film", "light", "movie", "objects", "projector", "video"},
-	"🎬": {"board", "clapper", "light", "objects", "video"},
-	"📺": {"light", "objects", "television", "video"},
-	"📷": {"camera", "light", "objects", "video"},
-	"📸": {"camera", "flash", "light", "objects", "video", "with"},
-	"📹": {"camera", "light", "objects", "video"},
-	"📼": {"light", "objects", "tape", "vhs", "video", "videocassette"},
-	"🔍": {"glass", "left", "light", "magnifying", "objects", "tilted", "video"},
-	"🔎": {"glass", "light", "magnifying", "objects", "right", "search", "tilted", "tool", "video"},
-	"🕯️": {"candle", "light", "objects", "video"},
-	"💡": {"bulb", "comic", "electric", "idea", "light", "objects", "video"},
-	"🔦": {"electric", "flashlight", "light", "objects", "tool", "torch", "video"},
-	"🏮": {"bar", "lantern", "light", "objects", "paper", "red", "video"},
-	"🪔": {"diya", "lamp", "light", "objects", "oil", "video"},
-	"📔": {"book", "cover", "decorated", "decorative", "notebook", "objects", "paper", "with"},
-	"📕": {"book", "closed", "objects", "paper"},
-	"📖": {"book", "objects", "open", "paper"},
-	"📗": {"book", "green", "objects", "paper"},
-	"📘": {"blue", "book", "objects", "paper"},
-	"📙": {"book", "objects", "orange", "paper"},
-	"📚": {"book", "books", "objects", "paper"},
-	"📓": {"book", "notebook", "objects", "paper"},
-	"📒": {"book", "ledger", "notebook", "objects", "paper"},
-	"📃": {"book", "curl", "document", "objects", "page", "paper", "with"},
-	"📜": {"book", "objects", "paper", "scroll"},
-	"📄": {"book", "document", "facing", "objects", "page", "paper", "up"},
-	"📰": {"book", "news", "newspaper", "objects", "paper"},
-	"🗞️": {"book", "news", "newspaper", "objects", "paper", "rolled", "up"},
-	"📑": {"book", "bookmark", "mark", "marker", "objects", "paper", "tabs"},
-	"🔖": {"book", "bookmark", "mark", "objects", "paper"},
-	"🏷️": {"book", "label", "objects", "paper"},
-	"💰": {"bag", "money", "objects"},
-	"🪙": {"coin", "gold", "metal", "money", "objects", "silver", "treasure"},
-	"💴": {"banknote", "bill", "currency", "money", "note", "objects", "yen"},
-	"💵": {"banknote", "bill", "currency", "dollar", "money", "note", "objects"},
-	"💶": {"banknote", "bill", "currency", "euro", "money", "note", "objects"},
-	"💷": {"banknote", "bill", "currency", "money", "note", "objects", "pound"},
-	"💸": {"banknote", "bill", "fly", "money", "objects", "wings", "with"},
-	"💳": {"card", "credit", "money", "objects"},
-	"🧾": {"accounting", "bookkeeping", "evidence", "money", "objects", "proof", "receipt"},
-	"💹": {"chart", "graph", "growth", "increasing", "money", "objects", "with", "yen"},
-	"✉️": {"email", "envelope", "letter", "mail", "objects"},
-	"📧": {"e", "email", "letter", "mail", "objects"},
-	"📨": {"e", "email", "envelope", "incoming", "letter", "mail", "objects", "receive"},
-	"📩": {"arrow", "e", "email", "envelope", "mail", "objects", "outgoing", "with"},
-	"📤": {"mail", "objects", "outbox", "tray"},
-	"📥": {"inbox", "mail", "objects", "tray"},
-	"📦": {"mail", "objects", "package"},
-	"📫": {"closed", "flag", "mail", "mailbox", "objects", "raised", "with"},
-	"📪": {"closed", "flag", "lowered", "mail", "mailbox", "objects", "with"},
-	"📬": {"flag", "mail", "mailbox", "objects", "open", "raised", "with"},
-	"📭": {"flag", "lowered", "mail", "mailbox", "objects", "open", "with"},
-	"📮": {"mail", "mailbox", "objects", "postbox"},
-	"🗳️": {"ballot", "box", "mail", "objects", "with"},
-	"✏️": {"objects", "pencil", "writing"},
-	"✒️": {"black", "nib", "objects", "pen", "writing"},
-	"🖋️": {"fountain", "objects", "pen", "writing"},
-	"🖊️": {"ballpoint", "objects", "pen", "writing"},
-	"🖌️": {"objects", "paintbrush", "painting", "writing"},
-	"🖍️": {"crayon", "objects", "writing"},
-	"📝": {"memo", "objects", "pencil", "writing"},
-	"💼": {"briefcase", "objects", "office"},
-	"📁": {"file", "folder", "objects", "office"},
-	"📂": {"file", "folder", "objects", "office", "open"},
-	"🗂️": {"card", "dividers", "index", "objects", "office"},
-	"📅": {"calendar", "date", "objects", "office"},
-	"📆": {"calendar", "objects", "off", "office", "tear"},
-	"🗒️": {"note", "notepad", "objects", "office", "pad", "spiral"},
-	"🗓️": {"calendar", "objects", "office", "pad", "spiral"},
-	"📇": {"card", "index", "objects", "office", "rolodex"},
-	"📈": {"chart", "graph", "growth", "increasing", "objects", "office", "trend", "upward"},
-	"📉": {"chart", "decreasing", "down", "graph", "objects", "office", "trend"},
-	"📊": {"bar", "chart", "graph", "objects", "office"},
-	"📋": {"clipboard", "objects", "office"},
-	"📌": {"objects", "office", "pin", "pushpin"},
-	"📍": {"objects", "office", "pin", "pushpin", "round"},
-	"📎": {"objects", "office", "paperclip"},
-	"🖇️": {"link", "linked", "objects", "office", "paperclip", "paperclips"},
-	"📏": {"edge", "objects", "office", "ruler", "straight"},
-	"📐": {"objects", "office", "ruler", "set", "triangle", "triangular"},
-	"✂️": {"cutting", "objects", "office", "scissors", "tool"},
-	"🗃️": {"box", "card", "file", "objects", "office"},
-	"🗄️": {"cabinet", "file", "filing", "objects", "office"},
-	"🗑️": {"objects", "office", "wastebasket"},
-	"🔒": {"lock", "locked", "objects"},
-	"🔓": {"lock", "objects", "unlocked"},
-	"🔏": {"ink", "lock", "locked", "nib", "objects", "pen", "privacy", "with"},
-	"🔐": {"closed", "key", "lock", "locked", "objects", "secure", "with"},
-	"🔑": {"key", "lock", "objects", "password"},
-	"🗝️": {"clue", "key", "lock", "objects", "old"},
-	"🔨": {"hammer", "objects", "tool"},
-	"🪓": {"axe", "chop", "hatchet", "objects", "split", "tool", "wood"},
-	"⛏️": {"mining", "objects", "pick", "tool"},
-	"⚒️": {"and", "hammer", "objects", "pick", "tool"},
-	"🛠️": {"and", "hammer", "objects", "spanner", "tool", "wrench"},
-	"🗡️": {"dagger", "knife", "objects", "tool", "weapon"},
-	"⚔️": {"crossed", "objects", "swords", "tool", "weapon"},
-	"💣": {"bomb", "objects", "tool"},
-	"🪃": {"australia", "boomerang", "objects", "rebound", "repercussion", "tool"},
-	"🏹": {"and", "archer", "arrow", "bow", "objects", "sagittarius", "tool", "zodiac"},
-	"🛡️": {"objects", "shield", "tool", "weapon"},
-	"🪚": {"carpenter", "carpentry", "lumber", "objects", "saw", "tool"},
-	"🔧": {"objects", "spanner", "tool", "wrench"},
-	"🪛": {"objects", "screw", "screwdriver", "tool"},
-	"🔩": {"and", "bolt", "nut", "objects", "tool"},
-	"⚙️": {"cog", "cogwheel", "gear", "objects", "tool"},
-	"🗜️": {"clamp", "compress", "objects", "tool", "vice"},
-	"⚖️": {"balance", "justice", "libra", "objects", "scale", "tool", "zodiac"},
-	"🦯": {"accessibility", "blind", "cane", "objects", "tool", "white"},
-	"🔗": {"link", "objects", "tool"},
-	"⛓️": {"chain", "chains", "objects", "tool"},
-	"🪝": {"catch", "crook", "curve", "ensnare", "hook", "objects", "point", "selling", "tool"},
-	"🧰": {"chest", "mechanic", "objects", "tool", "toolbox"},
-	"🧲": {"attraction", "horseshoe", "magnet", "magnetic", "objects", "tool"},
-	"🪜": {"climb", "ladder", "objects", "rung", "step", "tool"},
-	"⚗️": {"alembic", "chemistry", "objects", "science", "tool"},
-	"🧪": {"chemist", "chemistry", "experiment", "lab", "objects", "science", "test", "tube"},
-	"🧫": {"bacteria", "biologist", "biology", "culture", "dish", "lab", "objects", "petri", "science"},
-	"🧬": {"biologist", "dna", "evolution", "gene", "genetics", "life", "objects", "science"},
-	"🔬": {"microscope", "objects", "science", "tool"},
-	"🔭": {"objects", "science", "telescope", "tool"},
-	"📡": {"antenna", "dish", "objects", "satellite", "science"},
-	"💉": {"medical", "medicine", "needle", "objects", "shot", "sick", "syringe"},
-	"🩸": {"bleed", "blood", "donation", "drop", "injury", "medical", "medicine", "menstruation", "objects", "of"},
-	"💊": {"doctor", "medical", "medicine", "objects", "pill", "sick"},
-	"🩹": {"adhesive", "bandage", "medical", "objects"},
-	"🩼": {"aid", "cane", "crutch", "disability", "hurt", "medical", "mobility", "objects", "stick"},
-	"🩺": {"doctor", "heart", "medical", "medicine", "objects", "stethoscope"},
-	"🩻": {"bones", "doctor", "medical", "objects", "ray", "skeleton", "x"},
-	"🚪": {"door", "household", "objects"},
-	"🛗": {"accessibility", "elevator", "hoist", "household", "lift", "objects"},
-	"🪞": {"household", "mirror", "objects", "reflection", "reflector", "speculum"},
-	"🪟": {"air", "frame", "fresh", "household", "objects", "opening", "transparent", "view", "window"},
-	"🛏️": {"bed", "hotel", "household", "objects", "sleep"},
-	"🛋️": {"and", "couch", "hotel", "household", "lamp", "objects"},
-	"🪑": {"chair", "household", "objects", "seat", "sit"},
-	"🚽": {"household", "objects", "toilet"},
-	"🪠": {"cup", "force", "household", "objects", "plumber", "plunger", "suction", "toilet"},
-	"🚿": {"household", "objects", "shower", "water"},
-	"🛁": {"bath", "bathtub", "household", "objects"},
-	"🪤": {"bait", "household", "mouse", "mousetrap", "objects", "snare", "trap"},
-	"🪒": {"household", "objects", "razor", "sharp", "shave"},
-	"🧴": {"bottle", "household", "lotion", "moisturizer", "objects", "shampoo", "sunscreen"},
-	"🧷": {"diaper", "household", "objects", "pin", "punk", "rock", "safety"},
-	"🧹": {"broom", "cleaning", "household", "objects", "sweeping", "witch"},
-	"🧺": {"basket", "farming", "household", "laundry", "objects", "picnic"},
-	"🧻": {"household", "objects", "of", "paper", "roll", "toilet", "towels"},
-	"🪣": {"bucket", "cask", "household", "objects", "pail", "vat"},
-	"🧼": {"bar", "bathing", "cleaning", "household", "lather", "objects", "soap", "soapdish"},
-	"🫧": {"bubbles", "burp", "clean", "household", "objects", "soap", "underwater"},
-	"🪥": {"bathroom", "brush", "clean", "dental", "household", "hygiene", "objects", "teeth", "toothbrush"},
-	"🧽": {"absorbing", "cleaning", "household", "objects", "porous", "sponge"},
-	"🧯": {"extinguish", "extinguisher", "fire", "household", "objects", "quench"},
-	"🛒": {"cart", "household", "objects", "shopping", "trolley"},
-	"🚬": {"cigarette", "object", "objects", "other", "smoking"},
-	"⚰️": {"coffin", "death", "object", "objects", "other"},
-	"🪦": {"cemetery", "grave", "graveyard", "headstone", "object", "objects", "other", "tombstone"},
-	"⚱️": {"ashes", "death", "funeral", "object", "objects", "other", "urn"},
-	"🧿": {"amulet", "bead", "charm", "evil", "eye", "nazar", "object", "objects", "other", "talisman"},
-	"🪬": {"amulet", "fatima", "hamsa", "hand", "mary", "miriam", "object", "objects", "other", "protection"},
-	"🗿": {"face", "moai", "moyai", "object", "objects", "other", "statue"},
-	"🪧": {"demonstration", "object", "objects", "other", "picket", "placard", "protest", "sign"},
-	"🪪": {"card", "credentials", "id", "identification", "license", "object", "objects", "other", "security"},
-	"🏧": {"atm", "automated", "bank", "sign", "symbols", "teller", "transport"},
-	"🚮": {"bin", "in", "litter", "sign", "symbols", "transport"},
-	"🚰": {"drinking", "potable", "sign", "symbols", "transport", "water"},
-	"♿": {"sign", "symbol", "symbols", "transport", "wheelchair"},
-	"🚹": {"men", "room", "s", "sign", "symbols", "transport"},
-	"🚺": {"room", "s", "sign", "symbols", "transport", "women"},
-	"🚻": {"bathroom", "lavatory", "restroom", "sign", "symbols", "toilet", "transport", "wc"},
-	"🚼": {"baby", "sign", "symbol", "symbols", "transport"},
-	"🚾": {"bathroom", "closet", "lavatory", "restroom", "sign", "symbols", "toilet", "transport", "water", "wc"},
-	"🛂": {"control", "passport", "sign", "symbols", "transport"},
-	"🛃": {"customs", "sign", "symbols", "transport"},
-	"🛄": {"baggage", "claim", "sign", "symbols", "transport"},
-	"🛅": {"baggage", "left", "locker", "luggage", "sign", "symbols", "transport"},
-	"⚠️": {"symbols", "warning"},
-	"🚸": {"child", "children", "crossing", "pedestrian", "symbols", "traffic", "warning"},
-	"⛔": {"entry", "no", "symbols", "warning"},
-	"🚫": {"entry", "forbidden", "no", "not", "prohibited", "symbols", "warning"},
-	"🚳": {"bicycle", "bicycles", "bike", "forbidden", "no", "prohibited", "symbols", "warning"},
-	"🚭": {"no", "smoking", "symbols", "warning"},
-	"🚯": {"forbidden", "litter", "littering", "no", "not", "prohibited", "symbols", "warning"},
-	"🚱": {"drinking", "non", "potable", "symbols", "warning", "water"},
-	"🚷": {"forbidden", "no", "not", "pedestrian", "pedestrians", "prohibited", "symbols", "warning"},
-	"📵": {"cell", "forbidden", "mobile", "no", "phone", "phones", "symbols", "warning"},
-	"🔞": {"age", "eighteen", "no", "one", "prohibited", "restriction", "symbols", "under", "underage", "warning"},
-	"☢️": {"radioactive", "sign", "symbols", "warning"},
-	"☣️": {"biohazard", "sign", "symbols", "warning"},
-	"⬆️": {"arrow", "cardinal", "direction", "north", "symbols", "up"},
-	"↗️": {"arrow", "direction", "intercardinal", "northeast", "right", "symbols", "up"},
-	"➡️": {"arrow", "cardinal", "direction", "east", "right", "symbols"},
-	"↘️": {"arrow", "direction", "down", "intercardinal", "right", "southeast", "symbols"},
-	"⬇️": {"arrow", "cardinal", "direction", "down", "south", "symbols"},
-	"↙️": {"arrow", "direction", "down", "intercardinal", "left", "southwest", "symbols"},
-	"⬅️": {"arrow", "cardinal", "direction", "left", "symbols", "west"},
-	"↖️": {"arrow", "direction", "intercardinal", "left", "northwest", "symbols", "up"},
-	"↕️": {"arrow", "down", "symbols", "up"},
-	"↔️": {"arrow", "left", "right", "symbols"},
-	"↩️": {"arrow", "curving", "left", "right", "symbols"},
-	"↪️": {"arrow", "curving", "left", "right", "symbols"},
-	"⤴️": {"arrow", "curving", "right", "symbols", "up"},
-	"⤵️": {"arrow", "curving", "down", "right", "symbols"},
-	"🔃": {"arrow", "arrows", "clockwise", "reload", "symbols", "vertical"},
-	"🔄": {"anticlockwise", "arrow", "arrows", "button", "counterclockwise", "symbols", "withershins"},
-	"🔙": {"arrow", "back", "symbols"},
-	"🔚": {"arrow", "end", "symbols"},
-	"🔛": {"arrow", "mark", "on", "symbols"},
-	"🔜": {"arrow", "soon", "symbols"},
-	"🔝": {"arrow", "symbols", "top", "up"},
-	"🛐": {"of", "place", "religion", "symbols", "worship"},
-	"⚛️": {"atheist", "atom", "religion", "symbol", "symbols"},
-	"🕉️": {"hindu", "om", "religion", "symbols"},
-	"✡️": {"david", "jew", "jewish", "of", "religion", "star", "symbols"},
-	"☸️": {"buddhist", "dharma", "of", "religion", "symbols", "wheel"},
-	"☯️": {"religion", "symbols", "tao", "taoist", "yang", "yin"},
-	"✝️": {"christian", "cross", "latin", "religion", "symbols"},
-	"☦️": {"christian", "cross", "orthodox", "religion", "symbols"},
-	"☪️": {"and", "crescent", "islam", "muslim", "religion", "star", "symbols"},
-	"☮️": {"peace", "religion", "symbol", "symbols"},
-	"🕎": {"candelabrum", "candlestick", "menorah", "religion", "symbols"},
-	"🔯": {"dotted", "fortune", "pointed", "religion", "six", "star", "symbols"},
-	"🪯": {"khanda", "religion", "symbols"},
-	"♈": {"aries", "symbols", "zodiac"},
-	"♉": {"symbols", "taurus", "zodiac"},
-	"♊": {"gemini", "symbols", "zodiac"},
-	"♋": {"cancer", "symbols", "zodiac"},
-	"♌": {"leo", "symbols", "zodiac"},
-	"♍": {"symbols", "virgo", "zodiac"},
-	"♎": {"libra", "symbols", "zodiac"},
-	"♏": {"scorpio", "symbols", "zodiac"},
-	"♐": {"sagittarius", "symbols", "zodiac"},
-	"♑": {"capricorn", "symbols", "zodiac"},
-	"♒": {"aquarius", "symbols", "zodiac"},
-	"♓": {"pisces", "symbols", "zodiac"},
-	"⛎": {"bearer", "ophiuchus", "serpent", "snake", "symbols", "zodiac"},
-	"🔀": {"arrow", "av", "button", "crossed", "shuffle", "symbol", "symbols", "tracks"},
-	"🔁": {"arrow", "av", "button", "clockwise", "repeat", "symbol", "symbols"},
-	"🔂": {"arrow", "av", "button", "clockwise", "once", "repeat", "single", "symbol", "symbols"},
-	"▶️": {"arrow", "av", "button", "play", "right", "symbol", "symbols", "triangle"},
-	"⏩": {"av", "button", "fast", "forward", "symbol", "symbols"},
-	"⏭️": {"arrow", "av", "button", "next", "scene", "symbol", "symbols", "track", "triangle"},
-	"⏯️": {"arrow", "av", "button", "or", "pause", "play", "right", "symbol", "symbols", "triangle"},
-	"◀️": {"arrow", "av", "button", "left", "reverse", "symbol", "symbols", "triangle"},
-	"⏪": {"av", "button", "fast", "reverse", "symbol", "symbols"},
-	"⏮️": {"arrow", "av", "button", "last", "previous", "scene", "symbol", "symbols", "track", "triangle"},
-	"🔼": {"arrow", "av", "button", "red", "symbol", "symbols", "upwards"},
-	"⏫": {"arrow", "av", "button", "double", "fast", "symbol", "symbols", "up"},
-	"🔽": {"arrow", "av", "button", "down", "downwards", "red", "symbol", "symbols"},
-	"⏬": {"arrow", "av", "button", "double", "down", "fast", "symbol", "symbols"},
-	"⏸️": {"av", "bar", "button", "double", "pause", "symbol", "symbols", "vertical"},
-	"⏹️": {"av", "button", "square", "stop", "symbol", "symbols"},
-	"⏺️": {"av", "button", "circle", "record", "symbol", "symbols"},
-	"⏏️": {"av", "button", "eject", "symbol", "symbols"},
-	"🎦": {"av", "camera", "cinema", "film", "movie", "symbol", "symbols"},
-	"🔅": {"av", "brightness", "button", "dim", "low", "symbol", "symbols"},
-	"🔆": {"av", "bright", "brightness", "button", "symbol", "symbols"},
-	"📶": {"antenna", "av", "bar", "bars", "cell", "mobile", "phone", "symbol", "symbols"},
-	"🛜": {"av", "symbol", "symbols", "wireless"},
-	"📳": {"av", "cell", "mobile", "mode", "phone", "symbol", "symbols", "telephone", "vibration"},
-	"📴": {"av", "cell", "mobile", "off", "phone", "symbol", "symbols", "telephone"},
-	"♀️": {"female", "gender", "sign", "symbols", "woman"},
-	"♂️": {"gender", "male", "man", "sign", "symbols"},
-	"⚧️": {"gender", "symbol", "symbols", "transgender"},
-	"✖️": {"cancel", "math", "multiplication", "multiply", "sign", "symbols", "x"},
-	"➕": {"math", "plus", "sign", "symbols"},
-	"➖": {"math", "minus", "sign", "symbols"},
-	"➗": {"divide", "division", "math", "sign", "symbols"},
-	"🟰": {"equality", "equals", "heavy", "math", "sign", "symbols"},
-	"♾️": {"forever", "infinity", "math", "symbols", "unbounded", "universal"},
-	"‼️": {"bangbang", "double", "exclamation", "mark", "punctuation", "symbols"},
-	"⁉️": {"exclamation", "interrobang", "mark", "punctuation", "question", "symbols"},
-	"❓": {"mark", "punctuation", "question", "red", "symbols"},
-	"❔": {"mark", "outlined", "punctuation", "question", "symbols", "white"},
-	"❕": {"exclamation", "mark", "outlined", "punctuation", "symbols", "white"},
-	"❗": {"exclamation", "mark", "punctuation", "red", "symbols"},
-	"〰️": {"dash", "punctuation", "symbols", "wavy"},
-	"💱": {"bank", "currency", "exchange", "money", "symbols"},
-	"💲": {"currency", "dollar", "heavy", "money", "sign", "symbols"},
-	"⚕️": {"aesculapius", "medical", "medicine", "other", "staff", "symbol", "symbols"},
-	"♻️": {"other", "recycle", "recycling", "symbol", "symbols"},
-	"⚜️": {"de", "fleur", "lis", "other", "symbol", "symbols"},
-	"🔱": {"anchor", "emblem", "other", "ship", "symbol", "symbols", "tool", "trident"},
-	"📛": {"badge", "name", "other", "symbol", "symbols"},
-	"🔰": {"beginner", "chevron", "for", "japanese", "leaf", "other", "symbol", "symbols"},
-	"⭕": {"circle", "hollow", "other", "red", "symbol", "symbols"},
-	"✅": {"button", "check", "mark", "other", "symbol", "symbols"},
-	"☑️": {"box", "check", "other", "symbol", "symbols", "with"},
-	"✔️": {"check", "mark", "other", "symbol", "symbols"},
-	"❌": {"cancel", "cross", "mark", "multiplication", "multiply", "other", "symbol", "symbols", "x"},
-	"❎": {"button", "cross", "mark", "other", "square", "symbol", "symbols", "x"},
-	"➰": {"curl", "curly", "loop", "other", "symbol", "symbols"},
-	"➿": {"curl", "curly", "double", "loop", "other", "symbol", "symbols"},
-	"〽️": {"alternation", "mark", "other", "part", "symbol", "symbols"},
-	"✳️": {"asterisk", "eight", "other", "spoked", "symbol", "symbols"},
-	"✴️": {"eight", "other", "pointed", "star", "symbol", "symbols"},
-	"❇️": {"other", "sparkle", "symbol", "symbols"},
-	"©️": {"c", "copyright", "other", "symbol", "symbols"},
-	"®️": {"other", "r", "registered", "symbol", "symbols"},
-	"™️": {"mark", "other", "symbol", "symbols", "tm", "trade", "trademark"},
-	"#️⃣": {"keycap", "symbols"},
-	"*️⃣": {"keycap", "symbols"},
-	"0️⃣": {"keycap", "symbols"},
-	"1️⃣": {"keycap", "symbols"},
-	"2️⃣": {"keycap", "symbols"},
-	"3️⃣": {"keycap", "symbols"},
-	"4️⃣": {"keycap", "symbols"},
-	"5️⃣": {"keycap", "symbols"},
-	"6️⃣": {"keycap", "symbols"},
-	"7️⃣": {"keycap", "symbols"},
-	"8️⃣": {"keycap", "symbols"},
-	"9️⃣": {"keycap", "symbols"},
-	"🔟": {"keycap", "symbols"},
-	"🔠": {"abcd", "alphanum", "input", "latin", "letters", "symbols", "uppercase"},
-	"🔡": {"abcd", "alphanum", "input", "latin", "letters", "lowercase", "symbols"},
-	"🔢": {"alphanum", "input", "numbers", "symbols"},
-	"🔣": {"alphanum", "input", "symbols"},
-	"🔤": {"abc", "alphabet", "alphanum", "input", "latin", "letters", "symbols"},
-	"🅰️": {"a", "alphanum", "blood", "button", "symbols", "type"},
-	"🆎": {"ab", "alphanum", "blood", "button", "symbols", "type"},
-	"🅱️": {"alphanum", "b", "blood", "button", "symbols", "type"},
-	"🆑": {"alphanum", "button", "cl", "symbols"},
-	"🆒": {"alphanum", "button", "cool", "symbols"},
-	"🆓": {"alphanum", "button", "free", "symbols"},
-	"ℹ️": {"alphanum", "i", "information", "symbols"},
-	"🆔": {"alphanum", "button", "id", "identity", "symbols"},
-	"Ⓜ️": {"alphanum", "circle", "circled", "m", "symbols"},
-	"🆕": {"alphanum", "button", "new", "symbols"},
-	"🆖": {"alphanum", "button", "ng", "symbols"},
-	"🅾️": {"alphanum", "blood", "button", "o", "symbols", "type"},
-	"🆗": {"alphanum", "button", "ok", "symbols"},
-	"🅿️": {"alphanum", "button", "p", "parking", "symbols"},
-	"🆘": {"alphanum", "button", "help", "sos", "symbols"},
-	"🆙": {"alphanum", "button", "mark", "symbols", "up"},
-	"🆚": {"alphanum", "button", "symbols", "versus", "vs"},
-	"🈁": {"alphanum", "button", "here", "japanese", "katakana", "symbols"},
-	"🈂️": {"alphanum", "button", "charge", "japanese", "katakana", "service", "symbols"},
-	"🈷️": {"alphanum", "amount", "button", "ideograph", "japanese", "monthly", "symbols"},
-	"🈶": {"alphanum", "button", "charge", "free", "ideograph", "japanese", "not", "of", "symbols"},
-	"🈯": {"alphanum", "button", "japanese", "reserved", "symbols"},
-	"🉐": {"alphanum", "bargain", "button", "ideograph", "japanese", "symbols"},
-	"🈹": {"alphanum", "button", "discount", "ideograph", "japanese", "symbols"},
-	"🈚": {"alphanum", "button", "charge", "free", "japanese", "of", "symbols"},
-	"🈲": {"alphanum", "button", "ideograph", "japanese", "prohibited", "symbols"},
-	"🉑": {"acceptable", "alphanum", "button", "ideograph", "japanese", "symbols"},
-	"🈸": {"alphanum", "application", "button", "ideograph", "japanese", "symbols"},
-	"🈴": {"alphanum", "button", "grade", "ideograph", "japanese", "passing", "symbols"},
-	"🈳": {"alphanum", "button", "ideograph", "japanese", "symbols", "vacancy"},
-	"㊗️": {"alphanum", "button", "congratulations", "ideograph", "japanese", "symbols"},
-	"㊙️": {"alphanum", "button", "ideograph", "japanese", "secret", "symbols"},
-	"🈺": {"alphanum", "business", "button", "for", "ideograph", "japanese", "open", "symbols"},
-	"🈵": {"alphanum", "button", "ideograph", "japanese", "no", "symbols", "vacancy"},
-	"🔴": {"circle", "geometric", "red", "symbols"},
-	"🟠": {"circle", "geometric", "orange", "symbols"},
-	"🟡": {"circle", "geometric", "symbols", "yellow"},
-	"🟢": {"circle", "geometric", "green", "symbols"},
-	"🔵": {"blue", "circle", "geometric", "symbols"},
-	"🟣": {"circle", "geometric", "purple", "symbols"},
-	"🟤": {"brown", "circle", "geometric", "symbols"},
-	"⚫": {"black", "circle", "geometric", "symbols"},
-	"⚪": {"circle", "geometric", "symbols", "white"},
-	"🟥": {"geometric", "red", "square", "symbols"},
-	"🟧": {"geometric", "orange", "square", "symbols"},
-	"🟨": {"geometric", "square", "symbols", "yellow"},
-	"🟩": {"geometric", "green", "square", "symbols"},
-	"🟦": {"blue", "geometric", "square", "symbols"},
-	"🟪": {"geometric", "purple", "square", "symbols"},
-	"🟫": {"brown", "geometric", "square", "symbols"},
-	"⬛": {"black", "geometric", "large", "square", "symbols"},
-	"⬜": {"geometric", "large", "square", "symbols", "white"},
-	"◼️": {"black", "geometric", "medium", "square", "symbols"},
-	"◻️": {"geometric", "medium", "square", "symbols", "white"},
-	"◾": {"black", "geometric", "medium", "small", "square", "symbols"},
-	"◽": {"geometric", "medium", "small", "square", "symbols", "white"},
-	"▪️": {"black", "geometric", "small", "square", "symbols"},
-	"▫️": {"geometric", "small", "square", "symbols", "white"},
-	"🔶": {"diamond", "geometric", "large", "orange", "symbols"},
-	"🔷": {"blue", "diamond", "geometric", "large", "symbols"},
-	"🔸": {"diamond", "geometric", "orange", "small", "symbols"},
-	"🔹": {"blue", "diamond", "geometric", "small", "symbols"},
-	"🔺": {"geometric", "pointed", "red", "symbols", "triangle", "up"},
-	"🔻": {"down", "geometric", "pointed", "red", "symbols", "triangle"},
-	"💠": {"a", "comic", "diamond", "dot", "geometric", "inside", "symbols", "with"},
-	"🔘": {"button", "geometric", "radio", "symbols"},
-	"🔳": {"button", "geometric", "outlined", "square", "symbols", "white"},
-	"🔲": {"black", "button", "geometric", "square", "symbols"},
-	"🏁": {"checkered", "chequered", "flag", "flags", "racing"},
-	"🚩": {"flag", "flags", "post", "triangular"},
-	"🎌": {"celebration", "cross", "crossed", "flag", "flags", "japanese"},
-	"🏴": {"black", "flag", "flags", "waving"},
-	"🏳️": {"flag", "flags", "waving", "white"},
-	"🏳️\u200d🌈": {"flag", "flags", "pride", "rainbow"},
-	"🏳️\u200d⚧️": {"blue", "flag", "flags", "light", "pink", "transgender", "white"},
-	"🏴\u200d☠️": {"flag", "flags", "jolly", "pirate", "plunder", "roger", "treasure"},
-	"🇦🇨": {"ac", "ascension", "country", "flag", "flags", "island"},
-	"🇦🇩": {"ad", "andorra", "country", "flag", "flags"},
-	"🇦🇪": {"ae", "arab", "country", "emirates", "flag", "flags", "united"},
-	"🇦🇫": {"af", "afghanistan", "country", "flag", "flags"},
-	"🇦🇬": {"ag", "antigua", "barbuda", "country", "flag", "flags"},
-	"🇦🇮": {"ai", "anguilla", "country", "flag", "flags"},
-	"🇦🇱": {"al", "albania", "country", "flag", "flags"},
-	"🇦🇲": {"am", "armenia", "country", "flag", "flags"},
-	"🇦🇴": {"angola", "ao", "country", "flag", "flags"},
-	"🇦🇶": {"antarctica", "aq", "country", "flag", "flags"},
-	"🇦🇷": {"ar", "argentina", "country", "flag", "flags"},
-	"🇦🇸": {"american", "as", "country", "flag", "flags", "samoa"},
-	"🇦🇹": {"at", "austria", "country", "flag", "flags"},
-	"🇦🇺": {"au", "australia", "country", "flag", "flags"},
-	"🇦🇼": {"aruba", "aw", "country", "flag", "flags"},
-	"🇦🇽": {"ax", "country", "flag", "flags", "islands", "land"},
-	"🇦🇿": {"az", "azerbaijan", "country", "flag", "flags"},
-	"🇧🇦": {"ba", "bosnia", "country", "flag", "flags", "herzegovina"},
-	"🇧🇧": {"barbados", "bb", "country", "flag", "flags"},
-	"🇧🇩": {"bangladesh", "bd", "country", "flag", "flags"},
-	"🇧🇪": {"be", "belgium", "country", "flag", "flags"},
-	"🇧🇫": {"bf", "burkina", "country", "faso", "flag", "flags"},
-	"🇧🇬": {"bg", "bulgaria", "country", "flag", "flags"},
-	"🇧🇭": {"bahrain", "bh", "country", "flag", "flags"},
-	"🇧🇮": {"bi", "burundi", "country", "flag", "flags"},
-	"🇧🇯": {"benin", "bj", "country", "flag", "flags"},
-	"🇧🇱": {"barth", "bl", "country", "flag", "flags", "lemy", "st"},
-	"🇧🇲": {"bermuda", "bm", "country", "flag", "flags"},
-	"🇧🇳": {"bn", "brunei", "country", "flag", "flags"},
-	"🇧🇴": {"bo", "bolivia", "country", "flag", "flags"},
-	"🇧🇶": {"bq", "caribbean", "country", "flag", "flags", "netherlands"},
-	"🇧🇷": {"br", "brazil", "country", "flag", "flags"},
-	"🇧🇸": {"bahamas", "bs", "country", "flag", "flags"},
-	"🇧🇹": {"bhutan", "bt", "country", "flag", "flags"},
-	"🇧🇻": {"bouvet", "bv", "country", "flag", "flags", "island"},
-	"🇧🇼": {"botswana", "bw", "country", "flag", "flags"},
-	"🇧🇾": {"belarus", "by", "country", "flag", "flags"},
-	"🇧🇿": {"belize", "bz", "country", "flag", "flags"},
-	"🇨🇦": {"ca", "canada", "country", "flag", "flags"},
-	"🇨🇨": {"cc", "cocos", "country", "flag", "flags", "islands", "keeling"},
-	"🇨🇩": {"cd", "congo", "country", "flag", "flags", "kinshasa"},
-	"🇨🇫": {"african", "central", "cf", "country", "flag", "flags", "republic"},
-	"🇨🇬": {"brazzaville", "cg", "congo", "country", "flag", "flags"},
-	"🇨🇭": {"ch", "country", "flag", "flags", "switzerland"},
-	"🇨🇮": {"c", "ci", "country", "d", "flag", "flags", "ivoire", "te"},
-	"🇨🇰": {"ck", "cook", "country", "flag", "flags", "islands"},
-	"🇨🇱": {"chile", "cl", "country", "flag", "flags"},
-	"🇨🇲": {"cameroon", "cm", "country", "flag", "flags"},
-	"🇨🇳": {"china", "cn", "country", "flag", "flags"},
-	"🇨🇴": {"co", "colombia", "country", "flag", "flags"},
-	"🇨🇵": {"clipperton", "country", "cp", "flag", "flags", "island"},
-	"🇨🇷": {"costa", "country", "cr", "flag", "flags", "rica"},
-	"🇨🇺": {"country", "cu", "cuba", "flag", "flags"},
-	"🇨🇻": {"cape", "country", "cv", "flag", "flags", "verde"},
-	"🇨🇼": {"ao", "country", "cura", "cw", "flag", "flags"},
-	"🇨🇽": {"christmas", "country", "cx", "flag", "flags", "island"},
-	"🇨🇾": {"country", "cy", "cyprus", "flag", "flags"},
-	"🇨🇿": {"country", "cz", "czechia", "flag", "flags"},
-	"🇩🇪": {"country", "de", "flag", "flags", "germany"},
-	"🇩🇬": {"country", "dg", "diego", "flag", "flags", "garcia"},
-	"🇩🇯": {"country", "dj", "djibouti", "flag", "flags"},
-	"🇩🇰": {"country", "denmark", "dk", "flag", "flags"},
-	"🇩🇲": {"country", "dm", "dominica", "flag", "flags"},
-	"🇩🇴": {"country", "do", "dominican", "flag", "flags", "republic"},
-	"🇩🇿": {"algeria", "country", "dz", "flag", "flags"},
-	"🇪🇦": {"ceuta", "country", "ea", "flag", "flags", "melilla"},
-	"🇪🇨": {"country", "ec", "ecuador", "flag", "flags"},
-	"🇪🇪": {"country", "ee", "estonia", "flag", "flags"},
-	"🇪🇬": {"country", "eg", "egypt", "flag", "flags"},
-	"🇪🇭": {"country", "eh", "flag", "flags", "sahara", "western"},
-	"🇪🇷": {"country", "er", "eritrea", "flag", "flags"},
-	"🇪🇸": {"country", "es", "flag", "flags", "spain"},
-	"🇪🇹": {"country", "et", "ethiopia", "flag", "flags"},
-	"🇪🇺": {"country", "eu", "european", "flag", "flags", "union"},
-	"🇫🇮": {"country", "fi", "finland", "flag", "flags"},
-	"🇫🇯": {"country", "fiji", "fj", "flag", "flags"},
-	"🇫🇰": {"country", "falkland", "fk", "flag", "flags", "islands"},
-	"🇫🇲": {"country", "flag", "flags", "fm", "micronesia"},
-	"🇫🇴": {"country", "faroe", "flag", "flags", "fo", "islands"},
-	"🇫🇷": {"country", "flag", "flags", "fr", "france"},
-	"🇬🇦": {"country", "flag", "flags", "ga", "gabon"},
-	"🇬🇧": {"country", "flag", "flags", "gb", "kingdom", "united"},
-	"🇬🇩": {"country", "flag", "flags", "gd", "grenada"},
-	"🇬🇪": {"country", "flag", "flags", "ge", "georgia"},
-	"🇬🇫": {"country", "flag", "flags", "french", "gf", "guiana"},
-	"🇬🇬": {"country", "flag", "flags", "gg", "guernsey"},
-	"🇬🇭": {"country", "flag", "flags", "gh", "ghana"},
-	"🇬🇮": {"country", "flag", "flags", "gi", "gibraltar"},
-	"🇬🇱": {"country", "flag", "flags", "gl", "greenland"},
-	"🇬🇲": {"country", "flag", "flags", "gambia", "gm"},
-	"🇬🇳": {"country", "flag", "flags", "gn", "guinea"},
-	"🇬🇵": {"country", "flag", "flags", "gp", "guadeloupe"},
-	"🇬🇶": {"country", "equatorial", "flag", "flags", "gq", "guinea"},
-	"🇬🇷": {"country", "flag", "flags", "gr", "greece"},
-	"🇬🇸": {"country", "flag", "flags", "georgia", "gs", "islands", "sandwich", "south"},
-	"🇬🇹": {"country", "flag", "flags", "gt", "guatemala"},
-	"🇬🇺": {"country", "flag", "flags", "gu", "guam"},
-	"🇬🇼": {"bissau", "country", "flag", "flags", "guinea", "gw"},
-	"🇬🇾": {"country", "flag", "flags", "guyana", "gy"},
-	"🇭🇰": {"china", "country", "flag", "flags", "hk", "hong", "kong", "sar"},
-	"🇭🇲": {"country", "flag", "flags", "heard", "hm", "islands", "mcdonald"},
-	"🇭🇳": {"country", "flag", "flags", "hn", "honduras"},
-	"🇭🇷": {"country", "croatia", "flag", "flags", "hr"},
-	"🇭🇹": {"country", "flag", "flags", "haiti", "ht"},
-	"🇭🇺": {"country", "flag", "flags", "hu", "hungary"},
-	"🇮🇨": {"canary", "country", "flag", "flags", "ic", "islands"},
-	"🇮🇩": {"country", "flag", "flags", "id", "indonesia"},
-	"🇮🇪": {"country", "flag", "flags", "ie", "ireland"},
-	"🇮🇱": {"country", "flag", "flags", "il", "israel"},
-	"🇮🇲": {"country", "flag", "flags", "im", "isle", "man", "of"},
-	"🇮🇳": {"country", "flag", "flags", "in", "india"},
-	"🇮🇴": {"british", "country", "flag", "flags", "indian", "io", "ocean", "territory"},
-	"🇮🇶": {"country", "flag", "flags", "iq", "iraq"},
-	"🇮🇷": {"country", "flag", "flags", "ir", "iran"},
-	"🇮🇸": {"country", "flag", "flags", "iceland", "is"},
-	"🇮🇹": {"country", "flag", "flags", "it", "italy"},
-	"🇯🇪": {"country", "flag", "flags", "je", "jersey"},
-	"🇯🇲": {"country", "flag", "flags", "jamaica", "jm"},
-	"🇯🇴": {"country", "flag", "flags", "jo", "jordan"},
-	"🇯🇵": {"country", "flag", "flags", "japan", "jp"},
-	"🇰🇪": {"country", "flag", "flags", "ke", "kenya"},
-	"🇰🇬": {"country", "flag", "flags", "kg", "kyrgyzstan"},
-	"🇰🇭": {"cambodia", "country", "flag", "flags", "kh"},
-	"🇰🇮": {"country", "flag", "flags", "ki", "kiribati"},
-	"🇰🇲": {"comoros", "country", "flag", "flags", "km"},
-	"🇰🇳": {"country", "flag", "flags", "kitts", "kn", "nevis", "st"},
-	"🇰🇵": {"country", "flag", "flags", "korea", "kp", "north"},
-	"🇰🇷": {"country", "flag", "flags", "korea", "kr", "south"},
-	"🇰🇼": {"country", "flag", "flags", "kuwait", "kw"},
-	"🇰🇾": {"cayman", "country", "flag", "flags", "islands", "ky"},
-	"🇰🇿": {"country", "flag", "flags", "kazakhstan", "kz"},
-	"🇱🇦": {"country", "flag", "flags", "la", "laos"},
-	"🇱🇧": {"country", "flag", "flags", "lb", "lebanon"},
-	"🇱🇨": {"country", "flag", "flags", "lc", "lucia", "st"},
-	"🇱🇮": {"country", "flag", "flags", "li", "liechtenstein"},
-	"🇱🇰": {"country", "flag", "flags", "lanka", "lk", "sri"},
-	"🇱🇷": {"country", "flag", "flags", "liberia", "lr"},
-	"🇱🇸": {"country", "flag", "flags", "lesotho", "ls"},
-	"🇱🇹": {"country", "flag", "flags", "lithuania", "lt"},
-	"🇱🇺": {"country", "flag", "flags", "lu", "luxembourg"},
-	"🇱🇻": {"country", "flag", "flags", "latvia", "lv"},
-	"🇱🇾": {"country", "flag", "flags", "libya", "ly"},
-	"🇲🇦": {"country", "flag", "flags", "ma", "morocco"},
-	"🇲🇨": {"country", "flag", "flags", "mc", "monaco"},
-	"🇲🇩": {"country", "flag", "flags", "md", "moldova"},
-	"🇲🇪": {"country", "flag", "flags", "me", "montenegro"},
-	"🇲🇫": {"country", "flag", "flags", "martin", "mf", "st"},
-	"🇲🇬": {"country", "flag", "flags", "madagascar", "mg"},
-	"🇲🇭": {"country", "flag", "flags", "islands", "marshall", "mh"},
-	"🇲🇰": {"country", "flag", "flags", "macedonia", "mk", "north"},
-	"🇲🇱": {"country", "flag", "flags", "mali", "ml"},
-	"🇲🇲": {"burma", "country", "flag", "flags", "mm", "myanmar"},
-	"🇲🇳": {"country", "flag", "flags", "mn", "mongolia"},
-	"🇲🇴": {"china", "country", "flag", "flags", "macao", "mo", "sar"},
-	"🇲🇵": {"country", "flag", "flags", "islands", "mariana", "mp", "northern"},
-	"🇲🇶": {"country", "flag", "flags", "martinique", "mq"},
-	"🇲🇷": {"country", "flag", "flags", "mauritania", "mr"},
-	"🇲🇸": {"country", "flag", "flags", "montserrat", "ms"},
-	"🇲🇹": {"country", "flag", "flags", "malta", "mt"},
-	"🇲🇺": {"country", "flag", "flags", "mauritius", "mu"},
-	"🇲🇻": {"country", "flag", "flags", "maldives", "mv"},
-	"🇲🇼": {"country", "flag", "flags", "malawi", "mw"},
-	"🇲🇽": {"country", "flag", "flags", "mexico", "mx"},
-	"🇲🇾": {"country", "flag", "flags", "malaysia", "my"},
-	"🇲🇿": {"country", "flag", "flags", "mozambique", "mz"},
-	"🇳🇦": {"country", "flag", "flags", "na", "namibia"},
-	"🇳🇨": {"caledonia", "country", "flag", "flags", "nc", "new"},
-	"🇳🇪": {"country", "flag", "flags", "ne", "niger"},
-	"🇳🇫": {"country", "flag", "flags", "island", "nf", "norfolk"},
-	"🇳🇬": {"country", "flag", "flags", "ng", "nigeria"},
-	"🇳🇮": {"country", "flag", "flags", "ni", "nicaragua"},
-	"🇳🇱": {"country", "flag", "flags", "netherlands", "nl"},
-	"🇳🇴": {"country", "flag", "flags", "no", "norway"},
-	"🇳🇵": {"country", "flag", "flags", "nepal", "np"},
-	"🇳🇷": {"country", "flag", "flags", "nauru", "nr"},
-	"🇳🇺": {"country", "flag", "flags", "niue", "nu"},
-	"🇳🇿": {"country", "flag", "flags", "new", "nz", "zealand"},
-	"🇴🇲": {"country", "flag", "flags", "om", "oman"},
-	"🇵🇦": {"country", "flag", "flags", "pa", "panama"},
-	"🇵🇪": {"country", "flag", "flags", "pe", "peru"},
-	"🇵🇫": {"country", "flag", "flags", "french", "pf", "polynesia"},
-	"🇵🇬": {"country", "flag", "flags", "guinea", "new", "papua", "pg"},
-	"🇵🇭": {"country", "flag", "flags", "ph", "philippines"},
-	"🇵🇰": {"country", "flag", "flags", "pakistan", "pk"},
-	"🇵🇱": {"country", "flag", "flags", "pl", "poland"},
-	"🇵🇲": {"country", "flag", "flags", "miquelon", "pierre", "pm", "st"},
-	"🇵🇳": {"country", "flag", "flags", "islands", "pitcairn", "pn"},
-	"🇵🇷": {"country", "flag", "flags", "pr", "puerto", "rico"},
-	"🇵🇸": {"country", "flag", "flags", "palestinian", "ps", "territories"},
-	"🇵🇹": {"country", "flag", "flags", "portugal", "pt"},
-	"🇵🇼": {"country", "flag", "flags", "palau", "pw"},
-	"🇵🇾": {"country", "flag", "flags", "paraguay", "py"},
-	"🇶🇦": {"country", "flag", "flags", "qa", "qatar"},
-	"🇷🇪": {"country", "flag", "flags", "r", "re", "union"},
-	"🇷🇴": {"country", "flag", "flags", "ro", "romania"},
-	"🇷🇸": {"country", "flag", "flags", "rs", "serbia"},
-	"🇷🇺": {"country", "flag", "flags", "ru", "russia"},
-	"🇷🇼": {"country", "flag", "flags", "rw", "rwanda"},
-	"🇸🇦": {"arabia", "country", "flag", "flags", "sa", "saudi"},
-	"🇸🇧": {"country", "flag", "flags", "islands", "sb", "solomon"},
-	"🇸🇨": {"country", "flag", "flags", "sc", "seychelles"},
-	"🇸🇩": {"country", "flag", "flags", "sd", "sudan"},
-	"🇸🇪": {"country", "flag", "flags", "se", "sweden"},
-	"🇸🇬": {"country", "flag", "flags", "sg", "singapore"},
-	"🇸🇭": {"country", "flag", "flags", "helena", "sh", "st"},
-	"🇸🇮": {"country", "flag", "flags", "si", "slovenia"},
-	"🇸🇯": {"country", "flag", "flags", "jan", "mayen", "sj", "svalbard"},
-	"🇸🇰": {"country", "flag", "flags", "sk", "slovakia"},
-	"🇸🇱": {"country", "flag", "flags", "leone", "sierra", "sl"},
-	"🇸🇲": {"country", "flag", "flags", "marino", "san", "sm"},
-	"🇸🇳": {"country", "flag", "flags", "senegal", "sn"},
-	"🇸🇴": {"country", "flag", "flags", "so", "somalia"},
-	"🇸🇷": {"country", "flag", "flags", "sr", "suriname"},
-	"🇸🇸": {"country", "flag", "flags", "south", "ss", "sudan"},
-	"🇸🇹": {"country", "flag", "flags", "ncipe", "o", "pr", "s", "st", "tom"},
-	"🇸🇻": {"country", "el", "flag", "flags", "salvador", "sv"},
-	"🇸🇽": {"country", "flag", "flags", "maarten", "sint", "sx"},
-	"🇸🇾": {"country", "flag", "flags", "sy", "syria"},
-	"🇸🇿": {"country", "eswatini", "flag", "flags", "sz"},
-	"🇹🇦": {"country", "cunha", "da", "flag", "flags", "ta", "tristan"},
-	"🇹🇨": {"caicos", "country", "flag", "flags", "islands", "tc", "turks"},
-	"🇹🇩": {"chad", "country", "flag", "flags", "td"},
-	"🇹🇫": {"country", "flag", "flags", "french", "southern", "territories", "tf"},
-	"🇹🇬": {"country", "flag", "flags", "tg", "togo"},
-	"🇹🇭": {"country", "flag", "flags", "th", "thailand"},
-	"🇹🇯": {"country", "flag", "flags", "tajikistan", "tj"},
-	"🇹🇰": {"country", "flag", "flags", "tk", "tokelau"},
-	"🇹🇱": {"country", "flag", "flags", "leste", "timor", "tl"},
-	"🇹🇲": {"country", "flag", "flags", "tm", "turkmenistan"},
-	"🇹🇳": {"country", "flag", "flags", "tn", "tunisia"},
-	"🇹🇴": {"country", "flag", "flags", "to", "tonga"},
-	"🇹🇷": {"country", "flag", "flags", "tr", "turkey"},
-	"🇹🇹": {"country", "flag", "flags", "tobago", "trinidad", "tt"},
-	"🇹🇻": {"country", "flag", "flags", "tuvalu", "tv"},
-	"🇹🇼": {"country", "flag", "flags", "taiwan", "tw"},
-	"🇹🇿": {"country", "flag", "flags", "tanzania", "tz"},
-	"🇺🇦": {"country", "flag", "flags", "ua", "ukraine"},
-	"🇺🇬": {"country", "flag", "flags", "ug", "uganda"},
-	"🇺🇲": {"country", "flag", "flags", "islands", "outlying", "um", "us"},
-	"🇺🇳": {"country", "flag", "flags", "nations", "un", "united"},
-	"🇺🇸": {"country", "flag", "flags", "states", "united", "us"},
-	"🇺🇾": {"country", "flag", "flags", "uruguay", "uy"},
-	"🇺🇿": {"country", "flag", "flags", "uz", "uzbekistan"},
-	"🇻🇦": {"city", "country", "flag", "flags", "va", "vatican"},
-	"🇻🇨": {"country", "flag", "flags", "grenadines", "st", "vc", "vincent"},
-	"🇻🇪": {"country", "flag", "flags", "ve", "venezuela"},
-	"🇻🇬": {"british", "country", "flag", "flags", "islands", "vg", "virgin"},
-	"🇻🇮": {"country", "flag", "flags", "islands", "us", "vi", "virgin"},
-	"🇻🇳": {"country", "flag", "flags", "vietnam", "vn"},
-	"🇻🇺": {"country", "flag", "flags", "vanuatu", "vu"},
-	"🇼🇫": {"country", "flag", "flags", "futuna", "wallis", "wf"},
-	"🇼🇸": {"country", "flag", "flags", "samoa", "ws"},
-	"🇽🇰": {"country", "flag", "flags", "kosovo", "xk"},
-	"🇾🇪": {"country", "flag", "flags", "ye", "yemen"},
-	"🇾🇹": {"country", "flag", "flags", "mayotte", "yt"},
-	"🇿🇦": {"africa", "country", "flag", "flags", "south", "za"},
-	"🇿🇲": {"country", "flag", "flags", "zambia", "zm"},
-	"🇿🇼": {"country", "flag", "flags", "zimbabwe", "zw"},
+	"👨\u200d👦":               {"body", "boy", "family", "man", "people"},
+	"👨\u200d👦\u200d👦":        {"body", "boy", "family", "man", "people"},
+	"👨\u200d👧":               {"body", "family", "girl", "man", "people"},
+	"👨\u200d👧\u200d👦":        {"body", "boy", "family", "girl", "man", "people"},
+	"👨\u200d👧\u200d👧":        {"body", "family", "girl", "man", "people"},
+	"👩\u200d👦":               {"body", "boy", "family", "people", "woman"},
+	"👩\u200d👦\u200d👦":        {"body", "boy", "family", "people", "woman"},
+	"👩\u200d👧":               {"body", "family", "girl", "people", "woman"},
+	"👩\u200d👧\u200d👦":        {"body", "boy", "family", "girl", "people", "woman"},
+	"👩\u200d👧\u200d👧":        {"body", "family", "girl", "people", "woman"},
+	"🗣️":                     {"body", "face", "head", "people", "person", "silhouette", "speak", "speaking", "symbol"},
+	"👤":                      {"body", "bust", "in", "people", "person", "silhouette", "symbol"},
+	"👥":                      {"body", "bust", "busts", "in", "people", "person", "silhouette", "symbol"},
+	"🫂":                      {"body", "goodbye", "hello", "hug", "hugging", "people", "person", "symbol", "thanks"},
+	"👣":                      {"body", "clothing", "footprint", "footprints", "people", "person", "print", "symbol"},
+	"🐵":                      {"animal", "animals", "face", "mammal", "monkey", "nature"},
+	"🐒":                      {"animal", "animals", "mammal", "monkey", "nature"},
+	"🦍":                      {"animal", "animals", "gorilla", "mammal", "nature"},
+	"🦧":                      {"animal", "animals", "ape", "mammal", "nature", "orangutan"},
+	"🐶":                      {"animal", "animals", "dog", "face", "mammal", "nature", "pet"},
+	"🐕":                      {"animal", "animals", "dog", "mammal", "nature"},
+	"🦮":                      {"accessibility", "animal", "animals", "blind", "dog", "guide", "mammal", "nature"},
+	"🐕\u200d🦺":               {"accessibility", "animal", "animals", "assistance", "dog", "mammal", "nature", "service"},
+	"🐩":                      {"animal", "animals", "dog", "mammal", "nature", "poodle"},
+	"🐺":                      {"animal", "animals", "face", "mammal", "nature", "wolf"},
+	"🦊":                      {"animal", "animals", "face", "fox", "mammal", "nature"},
+	"🦝":                      {"animal", "animals", "curious", "mammal", "nature", "raccoon", "sly"},
+	"🐱":                      {"animal", "animals", "cat", "face", "mammal", "nature", "pet"},
+	"🐈":                      {"animal", "animals", "cat", "mammal", "nature"},
+	"🐈\u200d⬛":               {"animal", "animals", "black", "cat", "mammal", "nature", "unlucky"},
+	"🦁":                      {"animal", "animals", "face", "leo", "lion", "mammal", "nature", "zodiac"},
+	"🐯":                      {"animal", "animals", "face", "mammal", "nature", "tiger"},
+	"🐅":                      {"animal", "animals", "mammal", "nature", "tiger"},
+	"🐆":                      {"animal", "animals", "leopard", "mammal", "nature"},
+	"🐴":                      {"animal", "animals", "face", "horse", "mammal", "nature"},
+	"🫎":                      {"animal", "animals", "mammal", "moose", "nature"},
+	"🫏":                      {"animal", "animals", "donkey", "mammal", "nature"},
+	"🐎":                      {"animal", "animals", "equestrian", "horse", "mammal", "nature", "racehorse", "racing"},
+	"🦄":                      {"animal", "animals", "face", "mammal", "nature", "unicorn"},
+	"🦓":                      {"animal", "animals", "mammal", "nature", "stripe", "zebra"},
+	"🦌":                      {"animal", "animals", "deer", "mammal", "nature"},
+	"🦬":                      {"animal", "animals", "bison", "buffalo", "herd", "mammal", "nature", "wisent"},
+	"🐮":                      {"animal", "animals", "cow", "face", "mammal", "nature"},
+	"🐂":                      {"animal", "animals", "bull", "mammal", "nature", "ox", "taurus", "zodiac"},
+	"🐃":                      {"animal", "animals", "buffalo", "mammal", "nature", "water"},
+	"🐄":                      {"animal", "animals", "cow", "mammal", "nature"},
+	"🐷":                      {"animal", "animals", "face", "mammal", "nature", "pig"},
+	"🐖":                      {"animal", "animals", "mammal", "nature", "pig", "sow"},
+	"🐗":                      {"animal", "animals", "boar", "mammal", "nature", "pig"},
+	"🐽":                      {"animal", "animals", "face", "mammal", "nature", "nose", "pig"},
+	"🐏":                      {"animal", "animals", "aries", "male", "mammal", "nature", "ram", "sheep", "zodiac"},
+	"🐑":                      {"animal", "animals", "ewe", "female", "mammal", "nature", "sheep"},
+	"🐐":                      {"animal", "animals", "capricorn", "goat", "mammal", "nature", "zodiac"},
+	"🐪":                      {"animal", "animals", "camel", "dromedary", "hump", "mammal", "nature"},
+	"🐫":                      {"animal", "animals", "bactrian", "camel", "hump", "mammal", "nature", "two"},
+	"🦙":                      {"alpaca", "animal", "animals", "guanaco", "llama", "mammal", "nature", "vicuña", "wool"},
+	"🦒":                      {"animal", "animals", "giraffe", "mammal", "nature", "spots"},
+	"🐘":                      {"animal", "animals", "elephant", "mammal", "nature"},
+	"🦣":                      {"animal", "animals", "extinction", "large", "mammal", "mammoth", "nature", "tusk", "woolly"},
+	"🦏":                      {"animal", "animals", "mammal", "nature", "rhinoceros"},
+	"🦛":                      {"animal", "animals", "hippo", "hippopotamus", "mammal", "nature"},
+	"🐭":                      {"animal", "animals", "face", "mammal", "mouse", "nature"},
+	"🐁":                      {"animal", "animals", "mammal", "mouse", "nature"},
+	"🐀":                      {"animal", "animals", "mammal", "nature", "rat"},
+	"🐹":                      {"animal", "animals", "face", "hamster", "mammal", "nature", "pet"},
+	"🐰":                      {"animal", "animals", "bunny", "face", "mammal", "nature", "pet", "rabbit"},
+	"🐇":                      {"animal", "animals", "bunny", "mammal", "nature", "pet", "rabbit"},
+	"🐿️":                     {"animal", "animals", "chipmunk", "mammal", "nature", "squirrel"},
+	"🦫":                      {"animal", "animals", "beaver", "dam", "mammal", "nature"},
+	"🦔":                      {"animal", "animals", "hedgehog", "mammal", "nature", "spiny"},
+	"🦇":                      {"animal", "animals", "bat", "mammal", "nature", "vampire"},
+	"🐻":                      {"animal", "animals", "bear", "face", "mammal", "nature"},
+	"🐻\u200d❄️":              {"animal", "animals", "arctic", "bear", "mammal", "nature", "polar", "white"},
+	"🐨":                      {"animal", "animals", "face", "koala", "mammal", "marsupial", "nature"},
+	"🐼":                      {"animal", "animals", "face", "mammal", "nature", "panda"},
+	"🦥":                      {"animal", "animals", "lazy", "mammal", "nature", "sloth", "slow"},
+	"🦦":                      {"animal", "animals", "fishing", "mammal", "nature", "otter", "playful"},
+	"🦨":                      {"animal", "animals", "mammal", "nature", "skunk", "stink"},
+	"🦘":                      {"animal", "animals", "australia", "joey", "jump", "kangaroo", "mammal", "marsupial", "nature"},
+	"🦡":                      {"animal", "animals", "badger", "honey", "mammal", "nature", "pester"},
+	"🐾":                      {"animal", "animals", "feet", "mammal", "nature", "paw", "print", "prints"},
+	"🦃":                      {"animal", "animals", "bird", "nature", "turkey"},
+	"🐔":                      {"animal", "animals", "bird", "chicken", "nature"},
+	"🐓":                      {"animal", "animals", "bird", "nature", "rooster"},
+	"🐣":                      {"animal", "animals", "baby", "bird", "chick", "hatching", "nature"},
+	"🐤":                      {"animal", "animals", "baby", "bird", "chick", "nature"},
+	"🐥":                      {"animal", "animals", "baby", "bird", "chick", "facing", "front", "nature"},
+	"🐦":                      {"animal", "animals", "bird", "nature"},
+	"🐧":                      {"animal", "animals", "bird", "nature", "penguin"},
+	"🕊️":                     {"animal", "animals", "bird", "dove", "fly", "nature", "peace"},
+	"🦅":                      {"animal", "animals", "bird", "eagle", "nature"},
+	"🦆":                      {"animal", "animals", "bird", "duck", "nature"},
+	"🦢":                      {"animal", "animals", "bird", "cygnet", "duckling", "nature", "swan", "ugly"},
+	"🦉":                      {"animal", "animals", "bird", "nature", "owl", "wise"},
+	"🦤":                      {"animal", "animals", "bird", "dodo", "extinction", "large", "mauritius", "nature"},
+	"🪶":                      {"animal", "animals", "bird", "feather", "flight", "light", "nature", "plumage"},
+	"🦩":                      {"animal", "animals", "bird", "flamboyant", "flamingo", "nature", "tropical"},
+	"🦚":                      {"animal", "animals", "bird", "nature", "ostentatious", "peacock", "peahen", "proud"},
+	"🦜":                      {"animal", "animals", "bird", "nature", "parrot", "pirate", "talk"},
+	"🪽":                      {"animal", "animals", "bird", "nature", "wing"},
+	"🐦\u200d⬛":               {"animal", "animals", "bird", "black", "nature"},
+	"🪿":                      {"animal", "animals", "bird", "goose", "nature"},
+	"🐸":                      {"amphibian", "animal", "animals", "face", "frog", "nature"},
+	"🐊":                      {"animal", "animals", "crocodile", "nature", "reptile"},
+	"🐢":                      {"animal", "animals", "nature", "reptile", "terrapin", "tortoise", "turtle"},
+	"🦎":                      {"animal", "animals", "lizard", "nature", "reptile"},
+	"🐍":                      {"animal", "animals", "bearer", "nature", "ophiuchus", "reptile", "serpent", "snake", "zodiac"},
+	"🐲":                      {"animal", "animals", "dragon", "face", "fairy", "nature", "reptile", "tale"},
+	"🐉":                      {"animal", "animals", "dragon", "fairy", "nature", "reptile", "tale"},
+	"🦕":                      {"animal", "animals", "brachiosaurus", "brontosaurus", "diplodocus", "nature", "reptile", "sauropod"},
+	"🦖":                      {"animal", "animals", "nature", "reptile", "rex", "t", "tyrannosaurus"},
+	"🐳":                      {"animal", "animals", "face", "marine", "nature", "spouting", "whale"},
+	"🐋":                      {"animal", "animals", "marine", "nature", "whale"},
+	"🐬":                      {"animal", "animals", "dolphin", "flipper", "marine", "nature"},
+	"🦭":                      {"animal", "animals", "lion", "marine", "nature", "sea", "seal"},
+	"🐟":                      {"animal", "animals", "fish", "marine", "nature"},
+	"🐠":                      {"animal", "animals", "fish", "marine", "nature", "tropical"},
+	"🐡":                      {"animal", "animals", "blowfish", "fish", "marine", "nature"},
+	"🦈":                      {"animal", "animals", "fish", "marine", "nature", "shark"},
+	"🐙":                      {"animal", "animals", "marine", "nature", "octopus"},
+	"🐚":                      {"animal", "animals", "marine", "nature", "shell", "spiral"},
+	"🪸":                      {"animal", "animals", "coral", "marine", "nature", "ocean", "reef"},
+	"🪼":                      {"animal", "animals", "jellyfish", "marine", "nature"},
+	"🐌":                      {"animal", "animals", "bug", "nature", "snail"},
+	"🦋":                      {"animal", "animals", "bug", "butterfly", "insect", "nature", "pretty"},
+	"🐛":                      {"animal", "animals", "bug", "insect", "nature"},
+	"🐜":                      {"animal", "animals", "ant", "bug", "insect", "nature"},
+	"🐝":                      {"animal", "animals", "bee", "bug", "honeybee", "insect", "nature"},
+	"🪲":                      {"animal", "animals", "beetle", "bug", "insect", "nature"},
+	"🐞":                      {"animal", "animals", "beetle", "bug", "insect", "lady", "ladybird", "ladybug", "nature"},
+	"🦗":                      {"animal", "animals", "bug", "cricket", "grasshopper", "nature"},
+	"🪳":                      {"animal", "animals", "bug", "cockroach", "insect", "nature", "pest", "roach"},
+	"🕷️":                     {"animal", "animals", "bug", "insect", "nature", "spider"},
+	"🕸️":                     {"animal", "animals", "bug", "nature", "spider", "web"},
+	"🦂":                      {"animal", "animals", "bug", "nature", "scorpio", "scorpion", "zodiac"},
+	"🦟":                      {"animal", "animals", "bug", "disease", "fever", "malaria", "mosquito", "nature", "pest", "virus"},
+	"🪰":                      {"animal", "animals", "bug", "disease", "fly", "maggot", "nature", "pest", "rotting"},
+	"🪱":                      {"animal", "animals", "annelid", "bug", "earthworm", "nature", "parasite", "worm"},
+	"🦠":                      {"amoeba", "animal", "animals", "bacteria", "bug", "microbe", "nature", "virus"},
+	"💐":                      {"animals", "bouquet", "flower", "nature", "plant"},
+	"🌸":                      {"animals", "blossom", "cherry", "flower", "nature", "plant"},
+	"💮":                      {"animals", "flower", "nature", "plant", "white"},
+	"🪷":                      {"animals", "buddhism", "flower", "hinduism", "india", "lotus", "nature", "plant", "purity", "vietnam"},
+	"🏵️":                     {"animals", "flower", "nature", "plant", "rosette"},
+	"🌹":                      {"animals", "flower", "nature", "plant", "rose"},
+	"🥀":                      {"animals", "flower", "nature", "plant", "wilted"},
+	"🌺":                      {"animals", "flower", "hibiscus", "nature", "plant"},
+	"🌻":                      {"animals", "flower", "nature", "plant", "sun", "sunflower"},
+	"🌼":                      {"animals", "blossom", "flower", "nature", "plant"},
+	"🌷":                      {"animals", "flower", "nature", "plant", "tulip"},
+	"🪻":                      {"animals", "flower", "hyacinth", "nature", "plant"},
+	"🌱":                      {"animals", "nature", "other", "plant", "seedling", "young"},
+	"🪴":                      {"animals", "boring", "grow", "house", "nature", "nurturing", "other", "plant", "potted", "useless"},
+	"🌲":                      {"animals", "evergreen", "nature", "other", "plant", "tree"},
+	"🌳":                      {"animals", "deciduous", "nature", "other", "plant", "shedding", "tree"},
+	"🌴":                      {"animals", "nature", "other", "palm", "plant", "tree"},
+	"🌵":                      {"animals", "cactus", "nature", "other", "plant"},
+	"🌾":                      {"animals", "ear", "grain", "nature", "of", "other", "plant", "rice", "sheaf"},
+	"🌿":                      {"animals", "herb", "leaf", "nature", "other", "plant"},
+	"☘️":                     {"animals", "nature", "other", "plant", "shamrock"},
+	"🍀":                      {"animals", "clover", "four", "leaf", "nature", "other", "plant"},
+	"🍁":                      {"animals", "falling", "leaf", "maple", "nature", "other", "plant"},
+	"🍂":                      {"animals", "fallen", "falling", "leaf", "nature", "other", "plant"},
+	"🍃":                      {"animals", "blow", "flutter", "fluttering", "in", "leaf", "nature", "other", "plant", "wind"},
+	"🪹":                      {"animals", "empty", "nature", "nest", "nesting", "other", "plant"},
+	"🪺":                      {"animals", "eggs", "nature", "nest", "nesting", "other", "plant", "with"},
+	"🍄":                      {"animals", "mushroom", "nature", "other", "plant", "toadstool"},
+	"🍇":                      {"drink", "food", "fruit", "grape", "grapes"},
+	"🍈":                      {"drink", "food", "fruit", "melon"},
+	"🍉":                      {"drink", "food", "fruit", "watermelon"},
+	"🍊":                      {"drink", "food", "fruit", "orange", "tangerine"},
+	"🍋":                      {"citrus", "drink", "food", "fruit", "lemon"},
+	"🍌":                      {"banana", "drink", "food", "fruit"},
+	"🍍":                      {"drink", "food", "fruit", "pineapple"},
+	"🥭":                      {"drink", "food", "fruit", "mango", "tropical"},
+	"🍎":                      {"apple", "drink", "food", "fruit", "red"},
+	"🍏":                      {"apple", "drink", "food", "fruit", "green"},
+	"🍐":                      {"drink", "food", "fruit", "pear"},
+	"🍑":                      {"drink", "food", "fruit", "peach"},
+	"🍒":                      {"berries", "cherries", "cherry", "drink", "food", "fruit", "red"},
+	"🍓":                      {"berry", "drink", "food", "fruit", "strawberry"},
+	"🫐":                      {"berry", "bilberry", "blue", "blueberries", "blueberry", "drink", "food", "fruit"},
+	"🥝":                      {"drink", "food", "fruit", "kiwi"},
+	"🍅":                      {"drink", "food", "fruit", "tomato", "vegetable"},
+	"🫒":                      {"drink", "food", "fruit", "olive"},
+	"🥥":                      {"coconut", "colada", "drink", "food", "fruit", "palm", "piña"},
+	"🥑":                      {"avocado", "drink", "food", "fruit", "vegetable"},
+	"🍆":                      {"aubergine", "drink", "eggplant", "food", "vegetable"},
+	"🥔":                      {"drink", "food", "potato", "vegetable"},
+	"🥕":                      {"carrot", "drink", "food", "vegetable"},
+	"🌽":                      {"corn", "drink", "ear", "food", "maize", "maze", "of", "vegetable"},
+	"🌶️":                     {"drink", "food", "hot", "pepper", "vegetable"},
+	"🫑":                      {"bell", "capsicum", "drink", "food", "pepper", "vegetable"},
+	"🥒":                      {"cucumber", "drink", "food", "pickle", "vegetable"},
+	"🥬":                      {"bok", "cabbage", "choy", "drink", "food", "green", "kale", "leafy", "lettuce", "vegetable"},
+	"🥦":                      {"broccoli", "cabbage", "drink", "food", "vegetable", "wild"},
+	"🧄":                      {"drink", "flavoring", "food", "garlic", "vegetable"},
+	"🧅":                      {"drink", "flavoring", "food", "onion", "vegetable"},
+	"🥜":                      {"drink", "food", "nut", "peanut", "peanuts", "vegetable"},
+	"🫘":                      {"beans", "drink", "food", "kidney", "legume", "vegetable"},
+	"🌰":                      {"chestnut", "drink", "food", "plant", "vegetable"},
+	"🫚":                      {"drink", "food", "ginger", "root", "vegetable"},
+	"🫛":                      {"drink", "food", "pea", "pod", "vegetable"},
+	"🍞":                      {"bread", "drink", "food", "loaf", "prepared"},
+	"🥐":                      {"bread", "breakfast", "croissant", "drink", "food", "french", "prepared", "roll"},
+	"🥖":                      {"baguette", "bread", "drink", "food", "french", "prepared"},
+	"🫓":                      {"arepa", "drink", "flatbread", "food", "lavash", "naan", "pita", "prepared"},
+	"🥨":                      {"drink", "food", "prepared", "pretzel", "twisted"},
+	"🥯":                      {"bagel", "bakery", "breakfast", "drink", "food", "prepared", "schmear"},
+	"🥞":                      {"breakfast", "crêpe", "drink", "food", "hotcake", "pancake", "pancakes", "prepared"},
+	"🧇":                      {"breakfast", "drink", "food", "indecisive", "iron", "prepared", "waffle"},
+	"🧀":                      {"cheese", "drink", "food", "prepared", "wedge"},
+	"🍖":                      {"bone", "drink", "food", "meat", "on", "prepared"},
+	"🍗":                      {"bone", "chicken", "drink", "drumstick", "food", "leg", "poultry", "prepared"},
+	"🥩":                      {"chop", "cut", "drink", "food", "lambchop", "meat", "of", "porkchop", "prepared", "steak"},
+	"🥓":                      {"bacon", "breakfast", "drink", "food", "meat", "prepared"},
+	"🍔":                      {"burger", "drink", "food", "hamburger", "prepared"},
+	"🍟":                      {"drink", "food", "french", "fries", "prepared"},
+	"🍕":                      {"cheese", "drink", "food", "pizza", "prepared", "slice"},
+	"🌭":                      {"dog", "drink", "food", "frankfurter", "hot", "hotdog", "prepared", "sausage"},
+	"🥪":                      {"bread", "drink", "food", "prepared", "sandwich"},
+	"🌮":                      {"drink", "food", "mexican", "prepared", "taco"},
+	"🌯":                      {"burrito", "drink", "food", "mexican", "prepared", "wrap"},
+	"🫔":                      {"drink", "food", "mexican", "prepared", "tamale", "wrapped"},
+	"🥙":                      {"drink", "falafel", "flatbread", "food", "gyro", "kebab", "prepared", "stuffed"},
+	"🧆":                      {"chickpea", "drink", "falafel", "food", "meatball", "prepared"},
+	"🥚":                      {"breakfast", "drink", "egg", "food", "prepared"},
+	"🍳":                      {"breakfast", "cooking", "drink", "egg", "food", "frying", "pan", "prepared"},
+	"🥘":                      {"casserole", "drink", "food", "of", "paella", "pan", "prepared", "shallow"},
+	"🍲":                      {"drink", "food", "of", "pot", "prepared", "stew"},
+	"🫕":                      {"cheese", "chocolate", "drink", "fondue", "food", "melted", "pot", "prepared", "swiss"},
+	"🥣":                      {"bowl", "breakfast", "cereal", "congee", "drink", "food", "prepared", "spoon", "with"},
+	"🥗":                      {"drink", "food", "green", "prepared", "salad"},
+	"🍿":                      {"drink", "food", "popcorn", "prepared"},
+	"🧈":                      {"butter", "dairy", "drink", "food", "prepared"},
+	"🧂":                      {"condiment", "drink", "food", "prepared", "salt", "shaker"},
+	"🥫":                      {"can", "canned", "drink", "food", "prepared"},
+	"🍱":                      {"asian", "bento", "box", "drink", "food"},
+	"🍘":                      {"asian", "cracker", "drink", "food", "rice"},
+	"🍙":                      {"asian", "ball", "drink", "food", "japanese", "rice"},
+	"🍚":                      {"asian", "cooked", "drink", "food", "rice"},
+	"🍛":                      {"asian", "curry", "drink", "food", "rice"},
+	"🍜":                      {"asian", "bowl", "drink", "food", "noodle", "ramen", "steaming"},
+	"🍝":                      {"asian", "drink", "food", "pasta", "spaghetti"},
+	"🍠":                      {"asian", "drink", "food", "potato", "roasted", "sweet"},
+	"🍢":                      {"asian", "drink", "food", "kebab", "oden", "seafood", "skewer", "stick"},
+	"🍣":                      {"asian", "drink", "food", "sushi"},
+	"🍤":                      {"asian", "drink", "food", "fried", "prawn", "shrimp", "tempura"},
+	"🍥":                      {"asian", "cake", "drink", "fish", "food", "pastry", "swirl", "with"},
+	"🥮":                      {"asian", "autumn", "cake", "drink", "festival", "food", "moon", "yuèbǐng"},
+	"🍡":                      {"asian", "dango", "dessert", "drink", "food", "japanese", "skewer", "stick", "sweet"},
+	"🥟":                      {"asian", "drink", "dumpling", "empanada", "food", "gyōza", "jiaozi", "pierogi", "potsticker"},
+	"🥠":                      {"asian", "cookie", "drink", "food", "fortune", "prophecy"},
+	"🥡":                      {"asian", "box", "drink", "food", "oyster", "pail", "takeout"},
+	"🦀":                      {"cancer", "crab", "drink", "food", "marine", "zodiac"},
+	"🦞":                      {"bisque", "claws", "drink", "food", "lobster", "marine", "seafood"},
+	"🦐":                      {"drink", "food", "marine", "shellfish", "shrimp", "small"},
+	"🦑":                      {"drink", "food", "marine", "molusc", "squid"},
+	"🦪":                      {"diving", "drink", "food", "marine", "oyster", "pearl"},
+	"🍦":                      {"cream", "dessert", "drink", "food", "ice", "icecream", "soft", "sweet"},
+	"🍧":                      {"dessert", "drink", "food", "ice", "shaved", "sweet"},
+	"🍨":                      {"cream", "dessert", "drink", "food", "ice", "sweet"},
+	"🍩":                      {"breakfast", "dessert", "donut", "doughnut", "drink", "food", "sweet"},
+	"🍪":                      {"cookie", "dessert", "drink", "food", "sweet"},
+	"🎂":                      {"birthday", "cake", "celebration", "dessert", "drink", "food", "pastry", "sweet"},
+	"🍰":                      {"cake", "dessert", "drink", "food", "pastry", "shortcake", "slice", "sweet"},
+	"🧁":                      {"bakery", "cupcake", "drink", "food", "sweet"},
+	"🥧":                      {"drink", "filling", "food", "pastry", "pie", "sweet"},
+	"🍫":                      {"bar", "chocolate", "dessert", "drink", "food", "sweet"},
+	"🍬":                      {"candy", "dessert", "drink", "food", "sweet"},
+	"🍭":                      {"candy", "dessert", "drink", "food", "lollipop", "sweet"},
+	"🍮":                      {"custard", "dessert", "drink", "food", "pudding", "sweet"},
+	"🍯":                      {"drink", "food", "honey", "honeypot", "pot", "sweet"},
+	"🍼":                      {"baby", "bottle", "drink", "food", "milk"},
+	"🥛":                      {"drink", "food", "glass", "milk", "of"},
+	"☕":                      {"beverage", "drink", "food", "hot"},
+	"🫖":                      {"drink", "food", "pot", "tea", "teapot"},
+	"🍵":                      {"beverage", "cup", "drink", "food", "handle", "tea", "teacup", "without"},
+	"🍶":                      {"bar", "beverage", "bottle", "cup", "drink", "food", "sake"},
+	"🍾":                      {"bar", "bottle", "cork", "drink", "food", "popping", "with"},
+	"🍷":                      {"bar", "beverage", "drink", "food", "glass", "wine"},
+	"🍸":                      {"cocktail", "drink", "food", "glass"},
+	"🍹":                      {"bar", "drink", "food", "tropical"},
+	"🍺":                      {"bar", "beer", "drink", "food", "mug"},
+	"🍻":                      {"bar", "beer", "clink", "clinking", "drink", "food", "mug", "mugs"},
+	"🥂":                      {"celebrate", "clink", "clinking", "drink", "food", "glass", "glasses"},
+	"🥃":                      {"drink", "food", "glass", "liquor", "shot", "tumbler", "whisky"},
+	"🫗":                      {"drink", "empty", "food", "glass", "liquid", "pouring", "spill"},
+	"🥤":                      {"cup", "drink", "food", "juice", "soda", "straw", "with"},
+	"🧋":                      {"bubble", "drink", "food", "milk", "pearl", "tea"},
+	"🧃":                      {"beverage", "box", "drink", "food", "juice", "straw", "sweet"},
+	"🧉":                      {"drink", "food", "mate"},
+	"🧊":                      {"cold", "cube", "drink", "food", "ice", "iceberg"},
+	"🥢":                      {"chopsticks", "dishware", "drink", "food", "hashi"},
+	"🍽️":                     {"and", "cooking", "dishware", "drink", "food", "fork", "knife", "plate", "with"},
+	"🍴":                      {"and", "cooking", "cutlery", "dishware", "drink", "food", "fork", "knife"},
+	"🥄":                      {"dishware", "drink", "food", "spoon", "tableware"},
+	"🔪":                      {"cooking", "dishware", "drink", "food", "hocho", "kitchen", "knife", "tool", "weapon"},
+	"🫙":                      {"condiment", "container", "dishware", "drink", "empty", "food", "jar", "sauce", "store"},
+	"🏺":                      {"amphora", "aquarius", "cooking", "dishware", "drink", "food", "jug", "zodiac"},
+	"🌍":                      {"africa", "europe", "globe", "map", "place", "places", "showing", "travel"},
+	"🌎":                      {"americas", "globe", "map", "place", "places", "showing", "travel"},
+	"🌏":                      {"asia", "australia", "globe", "map", "place", "places", "showing", "travel"},
+	"🌐":                      {"earth", "globe", "map", "meridians", "place", "places", "travel", "with", "world"},
+	"🗺️":                     {"map", "place", "places", "travel", "world"},
+	"🗾":                      {"japan", "map", "of", "place", "places", "travel"},
+	"🧭":                      {"compass", "magnetic", "map", "navigation", "orienteering", "place", "places", "travel"},
+	"🏔️":                     {"capped", "cold", "geographic", "mountain", "place", "places", "snow", "travel"},
+	"⛰️":                     {"geographic", "mountain", "place", "places", "travel"},
+	"🌋":                      {"eruption", "geographic", "mountain", "place", "places", "travel", "volcano"},
+	"🗻":                      {"fuji", "geographic", "mount", "mountain", "place", "places", "travel"},
+	"🏕️":                     {"camping", "geographic", "place", "places", "travel"},
+	"🏖️":                     {"beach", "geographic", "place", "places", "travel", "umbrella", "with"},
+	"🏜️":                     {"desert", "geographic", "place", "places", "travel"},
+	"🏝️":                     {"desert", "geographic", "island", "place", "places", "travel"},
+	"🏞️":                     {"geographic", "national", "park", "place", "places", "travel"},
+	"🏟️":                     {"building", "place", "places", "stadium", "travel"},
+	"🏛️":                     {"building", "classical", "place", "places", "travel"},
+	"🏗️":                     {"building", "construction", "place", "places", "travel"},
+	"🧱":                      {"brick", "bricks", "building", "clay", "mortar", "place", "places", "travel", "wall"},
+	"🪨":                      {"boulder", "building", "heavy", "place", "places", "rock", "solid", "stone", "travel"},
+	"🪵":                      {"building", "log", "lumber", "place", "places", "timber", "travel", "wood"},
+	"🛖":                      {"building", "house", "hut", "place", "places", "roundhouse", "travel", "yurt"},
+	"🏘️":                     {"building", "houses", "place", "places", "travel"},
+	"🏚️":                     {"building", "derelict", "house", "place", "places", "travel"},
+	"🏠":                      {"building", "house", "place", "places", "travel"},
+	"🏡":                      {"building", "garden", "home", "house", "place", "places", "travel", "with"},
+	"🏢":                      {"building", "office", "place", "places", "travel"},
+	"🏣":                      {"building", "japanese", "office", "place", "places", "post", "travel"},
+	"🏤":                      {"building", "european", "office", "place", "places", "post", "travel"},
+	"🏥":                      {"building", "doctor", "hospital", "medicine", "place", "places", "travel"},
+	"🏦":                      {"bank", "building", "place", "places", "travel"},
+	"🏨":                      {"building", "hotel", "place", "places", "travel"},
+	"🏩":                      {"building", "hotel", "love", "place", "places", "travel"},
+	"🏪":                      {"building", "convenience", "place", "places", "store", "travel"},
+	"🏫":                      {"building", "place", "places", "school", "travel"},
+	"🏬":                      {"building", "department", "place", "places", "store", "travel"},
+	"🏭":                      {"building", "factory", "place", "places", "travel"},
+	"🏯":                      {"building", "castle", "japanese", "place", "places", "travel"},
+	"🏰":                      {"building", "castle", "european", "place", "places", "travel"},
+	"💒":                      {"building", "chapel", "place", "places", "romance", "travel", "wedding"},
+	"🗼":                      {"building", "place", "places", "tokyo", "tower", "travel"},
+	"🗽":                      {"building", "liberty", "of", "place", "places", "statue", "travel"},
+	"⛪":                      {"church", "place", "places", "religious", "travel"},
+	"🕌":                      {"islam", "mosque", "muslim", "place", "places", "religion", "religious", "travel"},
+	"🛕":                      {"hindu", "place", "places", "religious", "temple", "travel"},
+	"🕍":                      {"jew", "jewish", "place", "places", "religion", "religious", "synagogue", "temple", "travel"},
+	"⛩️":                     {"place", "places", "religion", "religious", "shinto", "shrine", "travel"},
+	"🕋":                      {"islam", "kaaba", "muslim", "place", "places", "religion", "religious", "travel"},
+	"⛲":                      {"fountain", "other", "place", "places", "travel"},
+	"⛺":                      {"other", "place", "places", "tent", "travel"},
+	"🌁":                      {"fog", "foggy", "other", "place", "places", "travel"},
+	"🌃":                      {"night", "other", "place", "places", "star", "stars", "travel", "with"},
+	"🏙️":                     {"city", "cityscape", "other", "place", "places", "travel"},
+	"🌄":                      {"morning", "mountain", "mountains", "other", "over", "place", "places", "sun", "sunrise", "travel"},
+	"🌅":                      {"morning", "other", "place", "places", "sun", "sunrise", "travel"},
+	"🌆":                      {"at", "city", "cityscape", "dusk", "evening", "landscape", "other", "place", "places", "sunset", "travel"},
+	"🌇":                      {"dusk", "other", "place", "places", "sun", "sunset", "travel"},
+	"🌉":                      {"at", "bridge", "night", "other", "place", "places", "travel"},
+	"♨️":                     {"hot", "hotsprings", "other", "place", "places", "springs", "steaming", "travel"},
+	"🎠":                      {"carousel", "horse", "other", "place", "places", "travel"},
+	"🛝":                      {"amusement", "other", "park", "place", "places", "play", "playground", "slide", "travel"},
+	"🎡":                      {"amusement", "ferris", "other", "park", "place", "places", "travel", "wheel"},
+	"🎢":                      {"amusement", "coaster", "other", "park", "place", "places", "roller", "travel"},
+	"💈":                      {"barber", "haircut", "other", "place", "places", "pole", "travel"},
+	"🎪":                      {"circus", "other", "place", "places", "tent", "travel"},
+	"🚂":                      {"engine", "ground", "locomotive", "places", "railway", "steam", "train", "transport", "travel"},
+	"🚃":                      {"car", "electric", "ground", "places", "railway", "train", "tram", "transport", "travel", "trolleybus"},
+	"🚄":                      {"ground", "high", "places", "railway", "shinkansen", "speed", "train", "transport", "travel"},
+	"🚅":                      {"bullet", "ground", "places", "railway", "shinkansen", "speed", "train", "transport", "travel"},
+	"🚆":                      {"ground", "places", "railway", "train", "transport", "travel"},
+	"🚇":                      {"ground", "metro", "places", "transport", "travel"},
+	"🚈":                      {"ground", "light", "places", "rail", "railway", "transport", "travel"},
+	"🚉":                      {"ground", "places", "railway", "station", "train", "transport", "travel"},
+	"🚊":                      {"ground", "places", "tram", "transport", "travel", "trolleybus"},
+	"🚝":                      {"ground", "monorail", "places", "transport", "travel", "vehicle"},
+	"🚞":                      {"car", "ground", "mountain", "places", "railway", "transport", "travel"},
+	"🚋":                      {"car", "ground", "places", "tram", "transport", "travel", "trolleybus"},
+	"🚌":                      {"bus", "ground", "places", "transport", "travel", "vehicle"},
+	"🚍":                      {"bus", "ground", "oncoming", "places", "transport", "travel"},
+	"🚎":                      {"bus", "ground", "places", "tram", "transport", "travel", "trolley", "trolleybus"},
+	"🚐":                      {"bus", "ground", "minibus", "places", "transport", "travel"},
+	"🚑":                      {"ambulance", "ground", "places", "transport", "travel"},
+	"🚒":                      {"engine", "fire", "ground", "places", "transport", "travel", "truck"},
+	"🚓":                      {"car", "ground", "patrol", "places", "police", "transport", "travel"},
+	"🚔":                      {"car", "ground", "oncoming", "places", "police", "transport", "travel"},
+	"🚕":                      {"ground", "places", "taxi", "transport", "travel", "vehicle"},
+	"🚖":                      {"ground", "oncoming", "places", "taxi", "transport", "travel"},
+	"🚗":                      {"automobile", "car", "ground", "places", "transport", "travel"},
+	"🚘":                      {"automobile", "ground", "oncoming", "places", "transport", "travel"},
+	"🚙":                      {"ground", "places", "recreational", "sport", "transport", "travel", "utility", "vehicle"},
+	"🛻":                      {"ground", "pick", "pickup", "places", "transport", "travel", "truck", "up"},
+	"🚚":                      {"delivery", "ground", "places", "transport", "travel", "truck"},
+	"🚛":                      {"articulated", "ground", "lorry", "places", "semi", "transport", "travel", "truck"},
+	"🚜":                      {"ground", "places", "tractor", "transport", "travel", "vehicle"},
+	"🏎️":                     {"car", "ground", "places", "racing", "transport", "travel"},
+	"🏍️":                     {"ground", "motorcycle", "places", "racing", "transport", "travel"},
+	"🛵":                      {"ground", "motor", "places", "scooter", "transport", "travel"},
+	"🦽":                      {"accessibility", "ground", "manual", "places", "transport", "travel", "wheelchair"},
+	"🦼":                      {"accessibility", "ground", "motorized", "places", "transport", "travel", "wheelchair"},
+	"🛺":                      {"auto", "ground", "places", "rickshaw", "transport", "travel", "tuk"},
+	"🚲":                      {"bicycle", "ground", "places", "transport", "travel"},
+	"🛴":                      {"ground", "kick", "places", "scooter", "transport", "travel"},
+	"🛹":                      {"board", "ground", "places", "skateboard", "transport", "travel"},
+	"🛼":                      {"ground", "places", "roller", "skate", "transport", "travel"},
+	"🚏":                      {"bus", "ground", "places", "stop", "transport", "travel"},
+	"🛣️":                     {"ground", "highway", "motorway", "places", "road", "transport", "travel"},
+	"🛤️":                     {"ground", "places", "railway", "track", "train", "transport", "travel"},
+	"🛢️":                     {"drum", "ground", "oil", "places", "transport", "travel"},
+	"⛽":                      {"fuel", "ground", "places", "pump", "transport", "travel"},
+	"🛞":                      {"circle", "ground", "places", "tire", "transport", "travel", "turn", "wheel"},
+	"🚨":                      {"beacon", "car", "ground", "light", "places", "police", "revolving", "transport", "travel"},
+	"🚥":                      {"ground", "horizontal", "light", "places", "signal", "traffic", "transport", "travel"},
+	"🚦":                      {"ground", "light", "places", "signal", "traffic", "transport", "travel", "vertical"},
+	"🛑":                      {"ground", "octagonal", "places", "sign", "stop", "transport", "travel"},
+	"🚧":                      {"barrier", "construction", "ground", "places", "transport", "travel"},
+	"⚓":                      {"anchor", "places", "transport", "travel", "water"},
+	"🛟":                      {"buoy", "float", "life", "places", "preserver", "rescue", "ring", "safety", "saver", "transport", "travel", "water"},
+	"⛵":                      {"places", "sailboat", "transport", "travel", "water"},
+	"🛶":                      {"boat", "canoe", "places", "transport", "travel", "water"},
+	"🚤":                      {"boat", "places", "speedboat", "transport", "travel", "water"},
+	"🛳️":                     {"passenger", "places", "ship", "transport", "travel", "water"},
+	"⛴️":                     {"boat", "ferry", "passenger", "places", "transport", "travel", "water"},
+	"🛥️":                     {"boat", "motor", "motorboat", "places", "transport", "travel", "water"},
+	"🚢":                      {"boat", "passenger", "places", "ship", "transport", "travel", "water"},
+	"✈️":                     {"aeroplane", "air", "airplane", "places", "transport", "travel"},
+	"🛩️":                     {"aeroplane", "air", "airplane", "places", "small", "transport", "travel"},
+	"🛫":                      {"aeroplane", "air", "airplane", "check", "departure", "departures", "in", "places", "transport", "travel"},
+	"🛬":                      {"aeroplane", "air", "airplane", "arrival", "arrivals", "arriving", "landing", "places", "transport", "travel"},
+	"🪂":                      {"air", "glide", "hang", "parachute", "parasail", "places", "skydive", "transport", "travel"},
+	"💺":                      {"air", "chair", "places", "seat", "transport", "travel"},
+	"🚁":                      {"air", "helicopter", "places", "transport", "travel", "vehicle"},
+	"🚟":                      {"air", "places", "railway", "suspension", "transport", "travel"},
+	"🚠":                      {"air", "cable", "cableway", "gondola", "mountain", "places", "transport", "travel"},
+	"🚡":                      {"aerial", "air", "cable", "car", "gondola", "places", "tramway", "transport", "travel"},
+	"🛰️":                     {"air", "places", "satellite", "space", "transport", "travel"},
+	"🚀":                      {"air", "places", "rocket", "space", "transport", "travel"},
+	"🛸":                      {"air", "flying", "places", "saucer", "transport", "travel", "ufo"},
+	"🛎️":                     {"bell", "bellhop", "hotel", "places", "travel"},
+	"🧳":                      {"hotel", "luggage", "packing", "places", "travel"},
+	"⌛":                      {"done", "hourglass", "places", "time", "travel"},
+	"⏳":                      {"done", "hourglass", "not", "places", "time", "travel"},
+	"⌚":                      {"places", "time", "travel", "watch"},
+	"⏰":                      {"alarm", "clock", "places", "time", "travel"},
+	"⏱️":                     {"clock", "places", "stopwatch", "time", "travel"},
+	"⏲️":                     {"clock", "places", "time", "timer", "travel"},
+	"🕰️":                     {"clock", "mantelpiece", "places", "time", "travel"},
+	"🕛":                      {"clock", "o", "places", "time", "travel", "twelve"},
+	"🕧":                      {"places", "thirty", "time", "travel", "twelve"},
+	"🕐":                      {"clock", "o", "one", "places", "time", "travel"},
+	"🕜":                      {"one", "places", "thirty", "time", "travel"},
+	"🕑":                      {"clock", "o", "places", "time", "travel", "two"},
+	"🕝":                      {"places", "thirty", "time", "travel", "two"},
+	"🕒":                      {"clock", "o", "places", "three", "time", "travel"},
+	"🕞":                      {"places", "thirty", "three", "time", "travel"},
+	"🕓":                      {"clock", "four", "o", "places", "time", "travel"},
+	"🕟":                      {"four", "places", "thirty", "time", "travel"},
+	"🕔":                      {"clock", "five", "o", "places", "time", "travel"},
+	"🕠":                      {"five", "places", "thirty", "time", "travel"},
+	"🕕":                      {"clock", "o", "places", "six", "time", "travel"},
+	"🕡":                      {"places", "six", "thirty", "time", "travel"},
+	"🕖":                      {"clock", "o", "places", "seven", "time", "travel"},
+	"🕢":                      {"places", "seven", "thirty", "time", "travel"},
+	"🕗":                      {"clock", "eight", "o", "places", "time", "travel"},
+	"🕣":                      {"eight", "places", "thirty", "time", "travel"},
+	"🕘":                      {"clock", "nine", "o", "places", "time", "travel"},
+	"🕤":                      {"nine", "places", "thirty", "time", "travel"},
+	"🕙":                      {"clock", "o", "places", "ten", "time", "travel"},
+	"🕥":                      {"places", "ten", "thirty", "time", "travel"},
+	"🕚":                      {"clock", "eleven", "o", "places", "time", "travel"},
+	"🕦":                      {"eleven", "places", "thirty", "time", "travel"},
+	"🌑":                      {"dark", "moon", "new", "places", "sky", "travel", "weather"},
+	"🌒":                      {"crescent", "moon", "places", "sky", "travel", "waxing", "weather"},
+	"🌓":                      {"first", "moon", "places", "quarter", "sky", "travel", "weather"},
+	"🌔":                      {"gibbous", "moon", "places", "sky", "travel", "waxing", "weather"},
+	"🌕":                      {"full", "moon", "places", "sky", "travel", "weather"},
+	"🌖":                      {"gibbous", "moon", "places", "sky", "travel", "waning", "weather"},
+	"🌗":                      {"last", "moon", "places", "quarter", "sky", "travel", "weather"},
+	"🌘":                      {"crescent", "moon", "places", "sky", "travel", "waning", "weather"},
+	"🌙":                      {"crescent", "moon", "places", "sky", "travel", "weather"},
+	"🌚":                      {"face", "moon", "new", "places", "sky", "travel", "weather"},
+	"🌛":                      {"face", "first", "moon", "places", "quarter", "sky", "travel", "weather"},
+	"🌜":                      {"face", "last", "moon", "places", "quarter", "sky", "travel", "weather"},
+	"🌡️":                     {"places", "sky", "thermometer", "travel", "weather"},
+	"☀️":                     {"bright", "places", "rays", "sky", "sun", "sunny", "travel", "weather"},
+	"🌝":                      {"bright", "face", "full", "moon", "places", "sky", "travel", "weather"},
+	"🌞":                      {"bright", "face", "places", "sky", "sun", "travel", "weather", "with"},
+	"🪐":                      {"places", "planet", "ringed", "saturn", "saturnine", "sky", "travel", "weather"},
+	"⭐":                      {"places", "sky", "star", "travel", "weather"},
+	"🌟":                      {"glittery", "glow", "glowing", "places", "shining", "sky", "sparkle", "star", "travel", "weather"},
+	"🌠":                      {"falling", "places", "shooting", "sky", "star", "travel", "weather"},
+	"🌌":                      {"milky", "places", "sky", "space", "travel", "way", "weather"},
+	"☁️":                     {"cloud", "places", "sky", "travel", "weather"},
+	"⛅":                      {"behind", "cloud", "places", "sky", "sun", "travel", "weather"},
+	"⛈️":                     {"and", "cloud", "lightning", "places", "rain", "sky", "thunder", "travel", "weather", "with"},
+	"🌤️":                     {"behind", "cloud", "places", "sky", "small", "sun", "travel", "weather"},
+	"🌥️":                     {"behind", "cloud", "large", "places", "sky", "sun", "travel", "weather"},
+	"🌦️":                     {"behind", "cloud", "places", "rain", "sky", "sun", "travel", "weather"},
+	"🌧️":                     {"cloud", "places", "rain", "sky", "travel", "weather", "with"},
+	"🌨️":                     {"cloud", "cold", "places", "sky", "snow", "travel", "weather", "with"},
+	"🌩️":                     {"cloud", "lightning", "places", "sky", "travel", "weather", "with"},
+	"🌪️":                     {"cloud", "places", "sky", "tornado", "travel", "weather", "whirlwind"},
+	"🌫️":                     {"cloud", "fog", "places", "sky", "travel", "weather"},
+	"🌬️":                     {"blow", "cloud", "face", "places", "sky", "travel", "weather", "wind"},
+	"🌀":                      {"cyclone", "dizzy", "hurricane", "places", "sky", "travel", "twister", "typhoon", "weather"},
+	"🌈":                      {"places", "rain", "rainbow", "sky", "travel", "weather"},
+	"🌂":                      {"closed", "clothing", "places", "rain", "sky", "travel", "umbrella", "weather"},
+	"☂️":                     {"clothing", "places", "rain", "sky", "travel", "umbrella", "weather"},
+	"☔":                      {"drops", "places", "rain", "sky", "travel", "umbrella", "weather", "with"},
+	"⛱️":                     {"ground", "on", "places", "rain", "sky", "sun", "travel", "umbrella", "weather"},
+	"⚡":                      {"high", "places", "sky", "travel", "voltage", "weather"},
+	"❄️":                     {"cold", "places", "sky", "snow", "snowflake", "travel", "weather"},
+	"☃️":                     {"cold", "places", "sky", "snow", "snowman", "travel", "weather"},
+	"⛄":                      {"places", "sky", "snow", "snowman", "travel", "weather", "without"},
+	"☄️":                     {"comet", "places", "sky", "space", "travel", "weather"},
+	"🔥":                      {"fire", "flame", "places", "sky", "tool", "travel", "weather"},
+	"💧":                      {"cold", "comic", "drop", "droplet", "places", "sky", "sweat", "travel", "weather"},
+	"🌊":                      {"ocean", "places", "sky", "travel", "water", "wave", "weather"},
+	"🎃":                      {"activities", "celebration", "event", "halloween", "jack", "lantern", "o"},
+	"🎄":                      {"activities", "celebration", "christmas", "event", "tree"},
+	"🎆":                      {"activities", "celebration", "event", "fireworks"},
+	"🎇":                      {"activities", "celebration", "event", "fireworks", "sparkle", "sparkler"},
+	"🧨":                      {"activities", "dynamite", "event", "explosive", "firecracker", "fireworks"},
+	"✨":                      {"activities", "event", "sparkle", "sparkles", "star"},
+	"🎈":                      {"activities", "balloon", "celebration", "event"},
+	"🎉":                      {"activities", "celebration", "event", "party", "popper", "tada"},
+	"🎊":                      {"activities", "ball", "celebration", "confetti", "event"},
+	"🎋":                      {"activities", "banner", "celebration", "event", "japanese", "tanabata", "tree"},
+	"🎍":                      {"activities", "bamboo", "celebration", "decoration", "event", "japanese", "pine"},
+	"🎎":                      {"activities", "celebration", "doll", "dolls", "event", "festival", "japanese"},
+	"🎏":                      {"activities", "carp", "celebration", "event", "streamer"},
+	"🎐":                      {"activities", "bell", "celebration", "chime", "event", "wind"},
+	"🎑":                      {"activities", "celebration", "ceremony", "event", "moon", "viewing"},
+	"🧧":                      {"activities", "envelope", "event", "gift", "good", "hóngbāo", "lai", "luck", "money", "red", "see"},
+	"🎀":                      {"activities", "celebration", "event", "ribbon"},
+	"🎁":                      {"activities", "box", "celebration", "event", "gift", "present", "wrapped"},
+	"🎗️":                     {"activities", "celebration", "event", "reminder", "ribbon"},
+	"🎟️":                     {"activities", "admission", "event", "ticket", "tickets"},
+	"🎫":                      {"activities", "admission", "event", "ticket"},
+	"🎖️":                     {"activities", "award", "celebration", "medal", "military"},
+	"🏆":                      {"activities", "award", "medal", "trophy"},
+	"🏅":                      {"activities", "award", "medal", "sports"},
+	"🥇":                      {"activities", "award", "first", "gold", "medal", "place", "st"},
+	"🥈":                      {"activities", "award", "medal", "nd", "place", "second", "silver"},
+	"🥉":                      {"activities", "award", "bronze", "medal", "place", "rd", "third"},
+	"⚽":                      {"activities", "ball", "soccer", "sport"},
+	"⚾":                      {"activities", "baseball", "sport"},
+	"🥎":                      {"activities", "ball", "glove", "softball", "sport", "underarm"},
+	"🏀":                      {"activities", "ball", "basketball", "hoop", "sport"},
+	"🏐":                      {"activities", "ball", "game", "sport", "volleyball"},
+	"🏈":                      {"activities", "american", "ball", "football", "sport"},
+	"🏉":                      {"activities", "ball", "football", "rugby", "sport"},
+	"🎾":                      {"activities", "ball", "racquet", "sport", "tennis"},
+	"🥏":                      {"activities", "disc", "flying", "sport", "ultimate"},
+	"🎳":                      {"activities", "ball", "bowling", "game", "sport"},
+	"🏏":                      {"activities", "ball", "bat", "cricket", "game", "sport"},
+	"🏑":                      {"activities", "ball", "field", "game", "hockey", "sport", "stick"},
+	"🏒":                      {"activities", "game", "hockey", "ice", "puck", "sport", "stick"},
+	"🥍":                      {"activities", "ball", "goal", "lacrosse", "sport", "stick"},
+	"🏓":                      {"activities", "ball", "bat", "game", "paddle", "ping", "pong", "sport", "table", "tennis"},
+	"🏸":                      {"activities", "badminton", "birdie", "game", "racquet", "shuttlecock", "sport"},
+	"🥊":                      {"activities", "boxing", "glove", "sport"},
+	"🥋":                      {"activities", "arts", "judo", "karate", "martial", "sport", "taekwondo", "uniform"},
+	"🥅":                      {"activities", "goal", "net", "sport"},
+	"⛳":                      {"activities", "flag", "hole", "in", "sport"},
+	"⛸️":                     {"activities", "ice", "skate", "sport"},
+	"🎣":                      {"activities", "fish", "fishing", "pole", "sport"},
+	"🤿":                      {"activities", "diving", "mask", "scuba", "snorkeling", "sport"},
+	"🎽":                      {"activities", "athletics", "running", "sash", "shirt", "sport"},
+	"🎿":                      {"activities", "ski", "skis", "snow", "sport"},
+	"🛷":                      {"activities", "sled", "sledge", "sleigh", "sport"},
+	"🥌":                      {"activities", "curling", "game", "rock", "sport", "stone"},
+	"🎯":                      {"activities", "bullseye", "dart", "direct", "game", "hit", "target"},
+	"🪀":                      {"activities", "fluctuate", "game", "toy", "yo"},
+	"🪁":                      {"activities", "fly", "game", "kite", "soar"},
+	"🔫":                      {"activities", "game", "gun", "handgun", "pistol", "revolver", "tool", "water", "weapon"},
+	"🎱":                      {"activities", "ball", "billiard", "eight", "game", "pool"},
+	"🔮":                      {"activities", "ball", "crystal", "fairy", "fantasy", "fortune", "game", "tale", "tool"},
+	"🪄":                      {"activities", "game", "magic", "wand", "witch", "wizard"},
+	"🎮":                      {"activities", "game", "video"},
+	"🕹️":                     {"activities", "game", "joystick", "video"},
+	"🎰":                      {"activities", "game", "machine", "slot"},
+	"🎲":                      {"activities", "dice", "die", "game"},
+	"🧩":                      {"activities", "clue", "game", "interlocking", "jigsaw", "piece", "puzzle"},
+	"🧸":                      {"activities", "bear", "game", "plaything", "plush", "stuffed", "teddy", "toy"},
+	"🪅":                      {"activities", "celebration", "game", "party", "piñata"},
+	"🪩":                      {"activities", "ball", "dance", "disco", "game", "glitter", "mirror", "party"},
+	"🪆":                      {"activities", "doll", "dolls", "game", "nesting", "russia"},
+	"♠️":                     {"activities", "card", "game", "spade", "suit"},
+	"♥️":                     {"activities", "card", "game", "heart", "suit"},
+	"♦️":                     {"activities", "card", "diamond", "game", "suit"},
+	"♣️":                     {"activities", "card", "club", "game", "suit"},
+	"♟️":                     {"activities", "chess", "dupe", "expendable", "game", "pawn"},
+	"🃏":                      {"activities", "card", "game", "joker", "wildcard"},
+	"🀄":                      {"activities", "dragon", "game", "mahjong", "red"},
+	"🎴":                      {"activities", "card", "cards", "flower", "game", "japanese", "playing"},
+	"🎭":                      {"activities", "arts", "crafts", "performing"},
+	"🖼️":                     {"activities", "art", "arts", "crafts", "frame", "framed", "museum", "painting", "picture"},
+	"🎨":                      {"activities", "art", "artist", "arts", "crafts", "museum", "painting", "palette"},
+	"🧵":                      {"activities", "arts", "crafts", "needle", "sewing", "spool", "string", "thread"},
+	"🪡":                      {"activities", "arts", "crafts", "embroidery", "needle", "sewing", "stitches", "sutures", "tailoring"},
+	"🧶":                      {"activities", "arts", "ball", "crafts", "crochet", "knit", "yarn"},
+	"🪢":                      {"activities", "arts", "crafts", "knot", "rope", "tangled", "tie", "twine", "twist"},
+	"👓":                      {"clothing", "glasses", "objects"},
+	"🕶️":                     {"clothing", "dark", "eye", "eyewear", "glasses", "objects", "sunglasses"},
+	"🥽":                      {"clothing", "eye", "goggles", "objects", "protection", "swimming", "welding"},
+	"🥼":                      {"clothing", "coat", "doctor", "experiment", "lab", "objects", "scientist"},
+	"🦺":                      {"clothing", "emergency", "objects", "safety", "vest"},
+	"👔":                      {"clothing", "necktie", "objects", "tie"},
+	"👕":                      {"clothing", "objects", "shirt", "t", "tshirt"},
+	"👖":                      {"clothing", "jeans", "objects", "pants", "trousers"},
+	"🧣":                      {"clothing", "neck", "objects", "scarf"},
+	"🧤":                      {"clothing", "gloves", "hand", "objects"},
+	"🧥":                      {"clothing", "coat", "jacket", "objects"},
+	"🧦":                      {"clothing", "objects", "socks", "stocking"},
+	"👗":                      {"clothing", "dress", "objects"},
+	"👘":                      {"clothing", "kimono", "objects"},
+	"🥻":                      {"clothing", "dress", "objects", "sari"},
+	"🩱":                      {"bathing", "clothing", "objects", "one", "piece", "suit", "swimsuit"},
+	"🩲":                      {"bathing", "briefs", "clothing", "objects", "one", "piece", "suit", "swimsuit", "underwear"},
+	"🩳":                      {"bathing", "clothing", "objects", "pants", "shorts", "suit", "underwear"},
+	"👙":                      {"bikini", "clothing", "objects", "swim"},
+	"👚":                      {"clothes", "clothing", "objects", "s", "woman"},
+	"🪭":                      {"clothing", "fan", "folding", "hand", "objects"},
+	"👛":                      {"clothing", "coin", "objects", "purse"},
+	"👜":                      {"bag", "clothing", "handbag", "objects", "purse"},
+	"👝":                      {"bag", "clothing", "clutch", "objects", "pouch"},
+	"🛍️":                     {"bag", "bags", "clothing", "hotel", "objects", "shopping"},
+	"🎒":                      {"backpack", "bag", "clothing", "objects", "rucksack", "satchel", "school"},
+	"🩴":                      {"beach", "clothing", "objects", "sandal", "sandals", "thong", "thongs", "zōri"},
+	"👞":                      {"clothing", "man", "objects", "s", "shoe"},
+	"👟":                      {"athletic", "clothing", "objects", "running", "shoe", "sneaker"},
+	"🥾":                      {"backpacking", "boot", "camping", "clothing", "hiking", "objects"},
+	"🥿":                      {"ballet", "clothing", "flat", "objects", "on", "shoe", "slip", "slipper"},
+	"👠":                      {"clothing", "heel", "heeled", "high", "objects", "shoe", "woman"},
+	"👡":                      {"clothing", "objects", "s", "sandal", "shoe", "woman"},
+	"🩰":                      {"ballet", "clothing", "dance", "objects", "shoes"},
+	"👢":                      {"boot", "clothing", "objects", "s", "shoe", "woman"},
+	"🪮":                      {"clothing", "hair", "objects", "pick"},
+	"👑":                      {"clothing", "crown", "king", "objects", "queen"},
+	"👒":                      {"clothing", "hat", "objects", "s", "woman"},
+	"🎩":                      {"clothing", "hat", "objects", "top", "tophat"},
+	"🎓":                      {"cap", "clothing", "graduation", "objects"},
+	"🧢":                      {"baseball", "billed", "cap", "clothing", "objects"},
+	"🪖":                      {"army", "clothing", "helmet", "military", "objects", "soldier", "warrior"},
+	"⛑️":                     {"aid", "clothing", "cross", "face", "hat", "helmet", "objects", "rescue", "s", "worker"},
+	"📿":                      {"beads", "clothing", "necklace", "objects", "prayer", "religion"},
+	"💄":                      {"clothing", "cosmetics", "lipstick", "makeup", "objects"},
+	"💍":                      {"clothing", "diamond", "objects", "ring"},
+	"💎":                      {"clothing", "diamond", "gem", "jewel", "objects", "stone"},
+	"🔇":                      {"mute", "muted", "objects", "quiet", "silent", "sound", "speaker"},
+	"🔈":                      {"low", "objects", "sound", "speaker", "volume"},
+	"🔉":                      {"medium", "objects", "sound", "speaker", "volume"},
+	"🔊":                      {"high", "loud", "objects", "sound", "speaker", "volume"},
+	"📢":                      {"address", "loud", "loudspeaker", "objects", "public", "sound"},
+	"📣":                      {"cheering", "megaphone", "objects", "sound"},
+	"📯":                      {"horn", "objects", "post", "postal", "sound"},
+	"🔔":                      {"bell", "objects", "sound"},
+	"🔕":                      {"bell", "forbidden", "mute", "objects", "quiet", "silent", "slash", "sound", "with"},
+	"🎼":                      {"music", "musical", "objects", "score"},
+	"🎵":                      {"music", "musical", "note", "objects"},
+	"🎶":                      {"music", "musical", "note", "notes", "objects"},
+	"🎙️":                     {"mic", "microphone", "music", "objects", "studio"},
+	"🎚️":                     {"level", "music", "objects", "slider"},
+	"🎛️":                     {"control", "knobs", "music", "objects"},
+	"🎤":                      {"karaoke", "mic", "microphone", "music", "objects"},
+	"🎧":                      {"headphone", "music", "objects"},
+	"📻":                      {"music", "objects", "radio"},
+	"🎷":                      {"instrument", "music", "musical", "objects", "sax", "saxophone"},
+	"🪗":                      {"accordion", "box", "concertina", "instrument", "musical", "objects", "squeeze"},
+	"🎸":                      {"guitar", "instrument", "music", "musical", "objects"},
+	"🎹":                      {"instrument", "keyboard", "music", "musical", "objects", "piano"},
+	"🎺":                      {"instrument", "music", "musical", "objects", "trumpet"},
+	"🎻":                      {"instrument", "music", "musical", "objects", "violin"},
+	"🪕":                      {"banjo", "instrument", "music", "musical", "objects", "stringed"},
+	"🥁":                      {"drum", "drumsticks", "instrument", "music", "musical", "objects"},
+	"🪘":                      {"beat", "conga", "drum", "instrument", "long", "musical", "objects", "rhythm"},
+	"🪇":                      {"instrument", "maracas", "musical", "objects"},
+	"🪈":                      {"flute", "instrument", "musical", "objects"},
+	"📱":                      {"cell", "mobile", "objects", "phone", "telephone"},
+	"📲":                      {"arrow", "cell", "mobile", "objects", "phone", "receive", "with"},
+	"☎️":                     {"objects", "phone", "telephone"},
+	"📞":                      {"objects", "phone", "receiver", "telephone"},
+	"📟":                      {"objects", "pager", "phone"},
+	"📠":                      {"fax", "machine", "objects", "phone"},
+	"🔋":                      {"battery", "computer", "objects"},
+	"🪫":                      {"battery", "computer", "electronic", "energy", "low", "objects"},
+	"🔌":                      {"computer", "electric", "electricity", "objects", "plug"},
+	"💻":                      {"computer", "laptop", "objects"},
+	"🖥️":                     {"computer", "desktop", "objects"},
+	"🖨️":                     {"computer", "objects", "printer"},
+	"⌨️":                     {"computer", "keyboard", "objects"},
+	"🖱️":                     {"computer", "mouse", "objects"},
+	"🖲️":                     {"computer", "objects", "trackball"},
+	"💽":                      {"computer", "disk", "minidisk", "objects", "optical"},
+	"💾":                      {"computer", "disk", "floppy", "objects"},
+	"💿":                      {"computer", "disk", "objects", "optical"},
+	"📀":                      {"blu", "computer", "disk", "dvd", "objects", "optical", "ray"},
+	"🧮":                      {"abacus", "calculation", "computer", "objects"},
+	"🎥":                      {"camera", "cinema", "light", "movie", "objects", "video"},
+	"🎞️":                     {"cinema", "film", "frames", "light", "movie", "objects", "video"},
+	"📽️":                     {"cinema", "film", "light", "movie", "objects", "projector", "video"},
+	"🎬":                      {"board", "clapper", "light", "objects", "video"},
+	"📺":                      {"light", "objects", "television", "video"},
+	"📷":                      {"camera", "light", "objects", "video"},
+	"📸":                      {"camera", "flash", "light", "objects", "video", "with"},
+	"📹":                      {"camera", "light", "objects", "video"},
+	"📼":                      {"light", "objects", "tape", "vhs", "video", "videocassette"},
+	"🔍":                      {"glass", "left", "light", "magnifying", "objects", "tilted", "video"},
+	"🔎":                      {"glass", "light", "magnifying", "objects", "right", "search", "tilted", "tool", "video"},
+	"🕯️":                     {"candle", "light", "objects", "video"},
+	"💡":                      {"bulb", "comic", "electric", "idea", "light", "objects", "video"},
+	"🔦":                      {"electric", "flashlight", "light", "objects", "tool", "torch", "video"},
+	"🏮":                      {"bar", "lantern", "light", "objects", "paper", "red", "video"},
+	"🪔":                      {"diya", "lamp", "light", "objects", "oil", "video"},
+	"📔":                      {"book", "cover", "decorated", "decorative", "notebook", "objects", "paper", "with"},
+	"📕":                      {"book", "closed", "objects", "paper"},
+	"📖":                      {"book", "objects", "open", "paper"},
+	"📗":                      {"book", "green", "objects", "paper"},
+	"📘":                      {"blue", "book", "objects", "paper"},
+	"📙":                      {"book", "objects", "orange", "paper"},
+	"📚":                      {"book", "books", "objects", "paper"},
+	"📓":                      {"book", "notebook", "objects", "paper"},
+	"📒":                      {"book", "ledger", "notebook", "objects", "paper"},
+	"📃":                      {"book", "curl", "document", "objects", "page", "paper", "with"},
+	"📜":                      {"book", "objects", "paper", "scroll"},
+	"📄":                      {"book", "document", "facing", "objects", "page", "paper", "up"},
+	"📰":                      {"book", "news", "newspaper", "objects", "paper"},
+	"🗞️":                     {"book", "news", "newspaper", "objects", "paper", "rolled", "up"},
+	"📑":                      {"book", "bookmark", "mark", "marker", "objects", "paper", "tabs"},
+	"🔖":                      {"book", "bookmark", "mark", "objects", "paper"},
+	"🏷️":                     {"book", "label", "objects", "paper"},
+	"💰":                      {"bag", "money", "objects"},
+	"🪙":                      {"coin", "gold", "metal", "money", "objects", "silver", "treasure"},
+	"💴":                      {"banknote", "bill", "currency", "money", "note", "objects", "yen"},
+	"💵":                      {"banknote", "bill", "currency", "dollar", "money", "note", "objects"},
+	"💶":                      {"banknote", "bill", "currency", "euro", "money", "note", "objects"},
+	"💷":                      {"banknote", "bill", "currency", "money", "note", "objects", "pound"},
+	"💸":                      {"banknote", "bill", "fly", "money", "objects", "wings", "with"},
+	"💳":                      {"card", "credit", "money", "objects"},
+	"🧾":                      {"accounting", "bookkeeping", "evidence", "money", "objects", "proof", "receipt"},
+	"💹":                      {"chart", "graph", "growth", "increasing", "money", "objects", "with", "yen"},
+	"✉️":                     {"email", "envelope", "letter", "mail", "objects"},
+	"📧":                      {"e", "email", "letter", "mail", "objects"},
+	"📨":                      {"e", "email", "envelope", "incoming", "letter", "mail", "objects", "receive"},
+	"📩":                      {"arrow", "e", "email", "envelope", "mail", "objects", "outgoing", "with"},
+	"📤":                      {"mail", "objects", "outbox", "tray"},
+	"📥":                      {"inbox", "mail", "objects", "tray"},
+	"📦":                      {"mail", "objects", "package"},
+	"📫":                      {"closed", "flag", "mail", "mailbox", "objects", "raised", "with"},
+	"📪":                      {"closed", "flag", "lowered", "mail", "mailbox", "objects", "with"},
+	"📬":                      {"flag", "mail", "mailbox", "objects", "open", "raised", "with"},
+	"📭":                      {"flag", "lowered", "mail", "mailbox", "objects", "open", "with"},
+	"📮":                      {"mail", "mailbox", "objects", "postbox"},
+	"🗳️":                     {"ballot", "box", "mail", "objects", "with"},
+	"✏️":                     {"objects", "pencil", "writing"},
+	"✒️":                     {"black", "nib", "objects", "pen", "writing"},
+	"🖋️":                     {"fountain", "objects", "pen", "writing"},
+	"🖊️":                     {"ballpoint", "objects", "pen", "writing"},
+	"🖌️":                     {"objects", "paintbrush", "painting", "writing"},
+	"🖍️":                     {"crayon", "objects", "writing"},
+	"📝":                      {"memo", "objects", "pencil", "writing"},
+	"💼":                      {"briefcase", "objects", "office"},
+	"📁":                      {"file", "folder", "objects", "office"},
+	"📂":                      {"file", "folder", "objects", "office", "open"},
+	"🗂️":                     {"card", "dividers", "index", "objects", "office"},
+	"📅":                      {"calendar", "date", "objects", "office"},
+	"📆":                      {"calendar", "objects", "off", "office", "tear"},
+	"🗒️":                     {"note", "notepad", "objects", "office", "pad", "spiral"},
+	"🗓️":                     {"calendar", "objects", "office", "pad", "spiral"},
+	"📇":                      {"card", "index", "objects", "office", "rolodex"},
+	"📈":                      {"chart", "graph", "growth", "increasing", "objects", "office", "trend", "upward"},
+	"📉":                      {"chart", "decreasing", "down", "graph", "objects", "office", "trend"},
+	"📊":                      {"bar", "chart", "graph", "objects", "office"},
+	"📋":                      {"clipboard", "objects", "office"},
+	"📌":                      {"objects", "office", "pin", "pushpin"},
+	"📍":                      {"objects", "office", "pin", "pushpin", "round"},
+	"📎":                      {"objects", "office", "paperclip"},
+	"🖇️":                     {"link", "linked", "objects", "office", "paperclip", "paperclips"},
+	"📏":                      {"edge", "objects", "office", "ruler", "straight"},
+	"📐":                      {"objects", "office", "ruler", "set", "triangle", "triangular"},
+	"✂️":                     {"cutting", "objects", "office", "scissors", "tool"},
+	"🗃️":                     {"box", "card", "file", "objects", "office"},
+	"🗄️":                     {"cabinet", "file", "filing", "objects", "office"},
+	"🗑️":                     {"objects", "office", "wastebasket"},
+	"🔒":                      {"lock", "locked", "objects"},
+	"🔓":                      {"lock", "objects", "unlocked"},
+	"🔏":                      {"ink", "lock", "locked", "nib", "objects", "pen", "privacy", "with"},
+	"🔐":                      {"closed", "key", "lock", "locked", "objects", "secure", "with"},
+	"🔑":                      {"key", "lock", "objects", "password"},
+	"🗝️":                     {"clue", "key", "lock", "objects", "old"},
+	"🔨":                      {"hammer", "objects", "tool"},
+	"🪓":                      {"axe", "chop", "hatchet", "objects", "split", "tool", "wood"},
+	"⛏️":                     {"mining", "objects", "pick", "tool"},
+	"⚒️":                     {"and", "hammer", "objects", "pick", "tool"},
+	"🛠️":                     {"and", "hammer", "objects", "spanner", "tool", "wrench"},
+	"🗡️":                     {"dagger", "knife", "objects", "tool", "weapon"},
+	"⚔️":                     {"crossed", "objects", "swords", "tool", "weapon"},
+	"💣":                      {"bomb", "objects", "tool"},
+	"🪃":                      {"australia", "boomerang", "objects", "rebound", "repercussion", "tool"},
+	"🏹":                      {"and", "archer", "arrow", "bow", "objects", "sagittarius", "tool", "zodiac"},
+	"🛡️":                     {"objects", "shield", "tool", "weapon"},
+	"🪚":                      {"carpenter", "carpentry", "lumber", "objects", "saw", "tool"},
+	"🔧":                      {"objects", "spanner", "tool", "wrench"},
+	"🪛":                      {"objects", "screw", "screwdriver", "tool"},
+	"🔩":                      {"and", "bolt", "nut", "objects", "tool"},
+	"⚙️":                     {"cog", "cogwheel", "gear", "objects", "tool"},
+	"🗜️":                     {"clamp", "compress", "objects", "tool", "vice"},
+	"⚖️":                     {"balance", "justice", "libra", "objects", "scale", "tool", "zodiac"},
+	"🦯":                      {"accessibility", "blind", "cane", "objects", "tool", "white"},
+	"🔗":                      {"link", "objects", "tool"},
+	"⛓️":                     {"chain", "chains", "objects", "tool"},
+	"🪝":                      {"catch", "crook", "curve", "ensnare", "hook", "objects", "point", "selling", "tool"},
+	"🧰":                      {"chest", "mechanic", "objects", "tool", "toolbox"},
+	"🧲":                      {"attraction", "horseshoe", "magnet", "magnetic", "objects", "tool"},
+	"🪜":                      {"climb", "ladder", "objects", "rung", "step", "tool"},
+	"⚗️":                     {"alembic", "chemistry", "objects", "science", "tool"},
+	"🧪":                      {"chemist", "chemistry", "experiment", "lab", "objects", "science", "test", "tube"},
+	"🧫":                      {"bacteria", "biologist", "biology", "culture", "dish", "lab", "objects", "petri", "science"},
+	"🧬":                      {"biologist", "dna", "evolution", "gene", "genetics", "life", "objects", "science"},
+	"🔬":                      {"microscope", "objects", "science", "tool"},
+	"🔭":                      {"objects", "science", "telescope", "tool"},
+	"📡":                      {"antenna", "dish", "objects", "satellite", "science"},
+	"💉":                      {"medical", "medicine", "needle", "objects", "shot", "sick", "syringe"},
+	"🩸":                      {"bleed", "blood", "donation", "drop", "injury", "medical", "medicine", "menstruation", "objects", "of"},
+	"💊":                      {"doctor", "medical", "medicine", "objects", "pill", "sick"},
+	"🩹":                      {"adhesive", "bandage", "medical", "objects"},
+	"🩼":                      {"aid", "cane", "crutch", "disability", "hurt", "medical", "mobility", "objects", "stick"},
+	"🩺":                      {"doctor", "heart", "medical", "medicine", "objects", "stethoscope"},
+	"🩻":                      {"bones", "doctor", "medical", "objects", "ray", "skeleton", "x"},
+	"🚪":                      {"door", "household", "objects"},
+	"🛗":                      {"accessibility", "elevator", "hoist", "household", "lift", "objects"},
+	"🪞":                      {"household", "mirror", "objects", "reflection", "reflector", "speculum"},
+	"🪟":                      {"air", "frame", "fresh", "household", "objects", "opening", "transparent", "view", "window"},
+	"🛏️":                     {"bed", "hotel", "household", "objects", "sleep"},
+	"🛋️":                     {"and", "couch", "hotel", "household", "lamp", "objects"},
+	"🪑":                      {"chair", "household", "objects", "seat", "sit"},
+	"🚽":                      {"household", "objects", "toilet"},
+	"🪠":                      {"cup", "force", "household", "objects", "plumber", "plunger", "suction", "toilet"},
+	"🚿":                      {"household", "objects", "shower", "water"},
+	"🛁":                      {"bath", "bathtub", "household", "objects"},
+	"🪤":                      {"bait", "household", "mouse", "mousetrap", "objects", "snare", "trap"},
+	"🪒":                      {"household", "objects", "razor", "sharp", "shave"},
+	"🧴":                      {"bottle", "household", "lotion", "moisturizer", "objects", "shampoo", "sunscreen"},
+	"🧷":                      {"diaper", "household", "objects", "pin", "punk", "rock", "safety"},
+	"🧹":                      {"broom", "cleaning", "household", "objects", "sweeping", "witch"},
+	"🧺":                      {"basket", "farming", "household", "laundry", "objects", "picnic"},
+	"🧻":                      {"household", "objects", "of", "paper", "roll", "toilet", "towels"},
+	"🪣":                      {"bucket", "cask", "household", "objects", "pail", "vat"},
+	"🧼":                      {"bar", "bathing", "cleaning", "household", "lather", "objects", "soap", "soapdish"},
+	"🫧":                      {"bubbles", "burp", "clean", "household", "objects", "soap", "underwater"},
+	"🪥":                      {"bathroom", "brush", "clean", "dental", "household", "hygiene", "objects", "teeth", "toothbrush"},
+	"🧽":                      {"absorbing", "cleaning", "household", "objects", "porous", "sponge"},
+	"🧯":                      {"extinguish", "extinguisher", "fire", "household", "objects", "quench"},
+	"🛒":                      {"cart", "household", "objects", "shopping", "trolley"},
+	"🚬":                      {"cigarette", "object", "objects", "other", "smoking"},
+	"⚰️":                     {"coffin", "death", "object", "objects", "other"},
+	"🪦":                      {"cemetery", "grave", "graveyard", "headstone", "object", "objects", "other", "tombstone"},
+	"⚱️":                     {"ashes", "death", "funeral", "object", "objects", "other", "urn"},
+	"🧿":                      {"amulet", "bead", "charm", "evil", "eye", "nazar", "object", "objects", "other", "talisman"},
+	"🪬":                      {"amulet", "fatima", "hamsa", "hand", "mary", "miriam", "object", "objects", "other", "protection"},
+	"🗿":                      {"face", "moai", "moyai", "object", "objects", "other", "statue"},
+	"🪧":                      {"demonstration", "object", "objects", "other", "picket", "placard", "protest", "sign"},
+	"🪪":                      {"card", "credentials", "id", "identification", "license", "object", "objects", "other", "security"},
+	"🏧":                      {"atm", "automated", "bank", "sign", "symbols", "teller", "transport"},
+	"🚮":                      {"bin", "in", "litter", "sign", "symbols", "transport"},
+	"🚰":                      {"drinking", "potable", "sign", "symbols", "transport", "water"},
+	"♿":                      {"sign", "symbol", "symbols", "transport", "wheelchair"},
+	"🚹":                      {"men", "room", "s", "sign", "symbols", "transport"},
+	"🚺":                      {"room", "s", "sign", "symbols", "transport", "women"},
+	"🚻":                      {"bathroom", "lavatory", "restroom", "sign", "symbols", "toilet", "transport", "wc"},
+	"🚼":                      {"baby", "sign", "symbol", "symbols", "transport"},
+	"🚾":                      {"bathroom", "closet", "lavatory", "restroom", "sign", "symbols", "toilet", "transport", "water", "wc"},
+	"🛂":                      {"control", "passport", "sign", "symbols", "transport"},
+	"🛃":                      {"customs", "sign", "symbols", "transport"},
+	"🛄":                      {"baggage", "claim", "sign", "symbols", "transport"},
+	"🛅":                      {"baggage", "left", "locker", "luggage", "sign", "symbols", "transport"},
+	"⚠️":                     {"symbols", "warning"},
+	"🚸":                      {"child", "children", "crossing", "pedestrian", "symbols", "traffic", "warning"},
+	"⛔":                      {"entry", "no", "symbols", "warning"},
+	"🚫":                      {"entry", "forbidden", "no", "not", "prohibited", "symbols", "warning"},
+	"🚳":                      {"bicycle", "bicycles", "bike", "forbidden", "no", "prohibited", "symbols", "warning"},
+	"🚭":                      {"no", "smoking", "symbols", "warning"},
+	"🚯":                      {"forbidden", "litter", "littering", "no", "not", "prohibited", "symbols", "warning"},
+	"🚱":                      {"drinking", "non", "potable", "symbols", "warning", "water"},
+	"🚷":                      {"forbidden", "no", "not", "pedestrian", "pedestrians", "prohibited", "symbols", "warning"},
+	"📵":                      {"cell", "forbidden", "mobile", "no", "phone", "phones", "symbols", "warning"},
+	"🔞":                      {"age", "eighteen", "no", "one", "prohibited", "restriction", "symbols", "under", "underage", "warning"},
+	"☢️":                     {"radioactive", "sign", "symbols", "warning"},
+	"☣️":                     {"biohazard", "sign", "symbols", "warning"},
+	"⬆️":                     {"arrow", "cardinal", "direction", "north", "symbols", "up"},
+	"↗️":                     {"arrow", "direction", "intercardinal", "northeast", "right", "symbols", "up"},
+	"➡️":                     {"arrow", "cardinal", "direction", "east", "right", "symbols"},
+	"↘️":                     {"arrow", "direction", "down", "intercardinal", "right", "southeast", "symbols"},
+	"⬇️":                     {"arrow", "cardinal", "direction", "down", "south", "symbols"},
+	"↙️":                     {"arrow", "direction", "down", "intercardinal", "left", "southwest", "symbols"},
+	"⬅️":                     {"arrow", "cardinal", "direction", "left", "symbols", "west"},
+	"↖️":                     {"arrow", "direction", "intercardinal", "left", "northwest", "symbols", "up"},
+	"↕️":                     {"arrow", "down", "symbols", "up"},
+	"↔️":                     {"arrow", "left", "right", "symbols"},
+	"↩️":                     {"arrow", "curving", "left", "right", "symbols"},
+	"↪️":                     {"arrow", "curving", "left", "right", "symbols"},
+	"⤴️":                     {"arrow", "curving", "right", "symbols", "up"},
+	"⤵️":                     {"arrow", "curving", "down", "right", "symbols"},
+	"🔃":                      {"arrow", "arrows", "clockwise", "reload", "symbols", "vertical"},
+	"🔄":                      {"anticlockwise", "arrow", "arrows", "button", "counterclockwise", "symbols", "withershins"},
+	"🔙":                      {"arrow", "back", "symbols"},
+	"🔚":                      {"arrow", "end", "symbols"},
+	"🔛":                      {"arrow", "mark", "on", "symbols"},
+	"🔜":                      {"arrow", "soon", "symbols"},
+	"🔝":                      {"arrow", "symbols", "top", "up"},
+	"🛐":                      {"of", "place", "religion", "symbols", "worship"},
+	"⚛️":                     {"atheist", "atom", "religion", "symbol", "symbols"},
+	"🕉️":                     {"hindu", "om", "religion", "symbols"},
+	"✡️":                     {"david", "jew", "jewish", "of", "religion", "star", "symbols"},
+	"☸️":                     {"buddhist", "dharma", "of", "religion", "symbols", "wheel"},
+	"☯️":                     {"religion", "symbols", "tao", "taoist", "yang", "yin"},
+	"✝️":                     {"christian", "cross", "latin", "religion", "symbols"},
+	"☦️":                     {"christian", "cross", "orthodox", "religion", "symbols"},
+	"☪️":                     {"and", "crescent", "islam", "muslim", "religion", "star", "symbols"},
+	"☮️":                     {"peace", "religion", "symbol", "symbols"},
+	"🕎":                      {"candelabrum", "candlestick", "menorah", "religion", "symbols"},
+	"🔯":                      {"dotted", "fortune", "pointed", "religion", "six", "star", "symbols"},
+	"🪯":                      {"khanda", "religion", "symbols"},
+	"♈":                      {"aries", "symbols", "zodiac"},
+	"♉":                      {"symbols", "taurus", "zodiac"},
+	"♊":                      {"gemini", "symbols", "zodiac"},
+	"♋":                      {"cancer", "symbols", "zodiac"},
+	"♌":                      {"leo", "symbols", "zodiac"},
+	"♍":                      {"symbols", "virgo", "zodiac"},
+	"♎":                      {"libra", "symbols", "zodiac"},
+	"♏":                      {"scorpio", "symbols", "zodiac"},
+	"♐":                      {"sagittarius", "symbols", "zodiac"},
+	"♑":                      {"capricorn", "symbols", "zodiac"},
+	"♒":                      {"aquarius", "symbols", "zodiac"},
+	"♓":                      {"pisces", "symbols", "zodiac"},
+	"⛎":                      {"bearer", "ophiuchus", "serpent", "snake", "symbols", "zodiac"},
+	"🔀":                      {"arrow", "av", "button", "crossed", "shuffle", "symbol", "symbols", "tracks"},
+	"🔁":                      {"arrow", "av", "button", "clockwise", "repeat", "symbol", "symbols"},
+	"🔂":                      {"arrow", "av", "button", "clockwise", "once", "repeat", "single", "symbol", "symbols"},
+	"▶️":                     {"arrow", "av", "button", "play", "right", "symbol", "symbols", "triangle"},
+	"⏩":                      {"av", "button", "fast", "forward", "symbol", "symbols"},
+	"⏭️":                     {"arrow", "av", "button", "next", "scene", "symbol", "symbols", "track", "triangle"},
+	"⏯️":                     {"arrow", "av", "button", "or", "pause", "play", "right", "symbol", "symbols", "triangle"},
+	"◀️":                     {"arrow", "av", "button", "left", "reverse", "symbol", "symbols", "triangle"},
+	"⏪":                      {"av", "button", "fast", "reverse", "symbol", "symbols"},
+	"⏮️":                     {"arrow", "av", "button", "last", "previous", "scene", "symbol", "symbols", "track", "triangle"},
+	"🔼":                      {"arrow", "av", "button", "red", "symbol", "symbols", "upwards"},
+	"⏫":                      {"arrow", "av", "button", "double", "fast", "symbol", "symbols", "up"},
+	"🔽":                      {"arrow", "av", "button", "down", "downwards", "red", "symbol", "symbols"},
+	"⏬":                      {"arrow", "av", "button", "double", "down", "fast", "symbol", "symbols"},
+	"⏸️":                     {"av", "bar", "button", "double", "pause", "symbol", "symbols", "vertical"},
+	"⏹️":                     {"av", "button", "square", "stop", "symbol", "symbols"},
+	"⏺️":                     {"av", "button", "circle", "record", "symbol", "symbols"},
+	"⏏️":                     {"av", "button", "eject", "symbol", "symbols"},
+	"🎦":                      {"av", "camera", "cinema", "film", "movie", "symbol", "symbols"},
+	"🔅":                      {"av", "brightness", "button", "dim", "low", "symbol", "symbols"},
+	"🔆":                      {"av", "bright", "brightness", "button", "symbol", "symbols"},
+	"📶":                      {"antenna", "av", "bar", "bars", "cell", "mobile", "phone", "symbol", "symbols"},
+	"🛜":                      {"av", "symbol", "symbols", "wireless"},
+	"📳":                      {"av", "cell", "mobile", "mode", "phone", "symbol", "symbols", "telephone", "vibration"},
+	"📴":                      {"av", "cell", "mobile", "off", "phone", "symbol", "symbols", "telephone"},
+	"♀️":                     {"female", "gender", "sign", "symbols", "woman"},
+	"♂️":                     {"gender", "male", "man", "sign", "symbols"},
+	"⚧️":                     {"gender", "symbol", "symbols", "transgender"},
+	"✖️":                     {"cancel", "math", "multiplication", "multiply", "sign", "symbols", "x"},
+	"➕":                      {"math", "plus", "sign", "symbols"},
+	"➖":                      {"math", "minus", "sign", "symbols"},
+	"➗":                      {"divide", "division", "math", "sign", "symbols"},
+	"🟰":                      {"equality", "equals", "heavy", "math", "sign", "symbols"},
+	"♾️":                     {"forever", "infinity", "math", "symbols", "unbounded", "universal"},
+	"‼️":                     {"bangbang", "double", "exclamation", "mark", "punctuation", "symbols"},
+	"⁉️":                     {"exclamation", "interrobang", "mark", "punctuation", "question", "symbols"},
+	"❓":                      {"mark", "punctuation", "question", "red", "symbols"},
+	"❔":                      {"mark", "outlined", "punctuation", "question", "symbols", "white"},
+	"❕":                      {"exclamation", "mark", "outlined", "punctuation", "symbols", "white"},
+	"❗":                      {"exclamation", "mark", "punctuation", "red", "symbols"},
+	"〰️":                     {"dash", "punctuation", "symbols", "wavy"},
+	"💱":                      {"bank", "currency", "exchange", "money", "symbols"},
+	"💲":                      {"currency", "dollar", "heavy", "money", "sign", "symbols"},
+	"⚕️":                     {"aesculapius", "medical", "medicine", "other", "staff", "symbol", "symbols"},
+	"♻️":                     {"other", "recycle", "recycling", "symbol", "symbols"},
+	"⚜️":                     {"de", "fleur", "lis", "other", "symbol", "symbols"},
+	"🔱":                      {"anchor", "emblem", "other", "ship", "symbol", "symbols", "tool", "trident"},
+	"📛":                      {"badge", "name", "other", "symbol", "symbols"},
+	"🔰":                      {"beginner", "chevron", "for", "japanese", "leaf", "other", "symbol", "symbols"},
+	"⭕":                      {"circle", "hollow", "other", "red", "symbol", "symbols"},
+	"✅":                      {"button", "check", "mark", "other", "symbol", "symbols"},
+	"☑️":                     {"box", "check", "other", "symbol", "symbols", "with"},
+	"✔️":                     {"check", "mark", "other", "symbol", "symbols"},
+	"❌":                      {"cancel", "cross", "mark", "multiplication", "multiply", "other", "symbol", "symbols", "x"},
+	"❎":                      {"button", "cross", "mark", "other", "square", "symbol", "symbols", "x"},
+	"➰":                      {"curl", "curly", "loop", "other", "symbol", "symbols"},
+	"➿":                      {"curl", "curly", "double", "loop", "other", "symbol", "symbols"},
+	"〽️":                     {"alternation", "mark", "other", "part", "symbol", "symbols"},
+	"✳️":                     {"asterisk", "eight", "other", "spoked", "symbol", "symbols"},
+	"✴️":                     {"eight", "other", "pointed", "star", "symbol", "symbols"},
+	"❇️":                     {"other", "sparkle", "symbol", "symbols"},
+	"©️":                     {"c", "copyright", "other", "symbol", "symbols"},
+	"®️":                     {"other", "r", "registered", "symbol", "symbols"},
+	"™️":                     {"mark", "other", "symbol", "symbols", "tm", "trade", "trademark"},
+	"#️⃣":                    {"keycap", "symbols"},
+	"*️⃣":                    {"keycap", "symbols"},
+	"0️⃣":                    {"keycap", "symbols"},
+	"1️⃣":                    {"keycap", "symbols"},
+	"2️⃣":                    {"keycap", "symbols"},
+	"3️⃣":                    {"keycap", "symbols"},
+	"4️⃣":                    {"keycap", "symbols"},
+	"5️⃣":                    {"keycap", "symbols"},
+	"6️⃣":                    {"keycap", "symbols"},
+	"7️⃣":                    {"keycap", "symbols"},
+	"8️⃣":                    {"keycap", "symbols"},
+	"9️⃣":                    {"keycap", "symbols"},
+	"🔟":                      {"keycap", "symbols"},
+	"🔠":                      {"abcd", "alphanum", "input", "latin", "letters", "symbols", "uppercase"},
+	"🔡":                      {"abcd", "alphanum", "input", "latin", "letters", "lowercase", "symbols"},
+	"🔢":                      {"alphanum", "input", "numbers", "symbols"},
+	"🔣":                      {"alphanum", "input", "symbols"},
+	"🔤":                      {"abc", "alphabet", "alphanum", "input", "latin", "letters", "symbols"},
+	"🅰️":                     {"a", "alphanum", "blood", "button", "symbols", "type"},
+	"🆎":                      {"ab", "alphanum", "blood", "button", "symbols", "type"},
+	"🅱️":                     {"alphanum", "b", "blood", "button", "symbols", "type"},
+	"🆑":                      {"alphanum", "button", "cl", "symbols"},
+	"🆒":                      {"alphanum", "button", "cool", "symbols"},
+	"🆓":                      {"alphanum", "button", "free", "symbols"},
+	"ℹ️":                     {"alphanum", "i", "information", "symbols"},
+	"🆔":                      {"alphanum", "button", "id", "identity", "symbols"},
+	"Ⓜ️":                     {"alphanum", "circle", "circled", "m", "symbols"},
+	"🆕":                      {"alphanum", "button", "new", "symbols"},
+	"🆖":                      {"alphanum", "button", "ng", "symbols"},
+	"🅾️":                     {"alphanum", "blood", "button", "o", "symbols", "type"},
+	"🆗":                      {"alphanum", "button", "ok", "symbols"},
+	"🅿️":                     {"alphanum", "button", "p", "parking", "symbols"},
+	"🆘":                      {"alphanum", "button", "help", "sos", "symbols"},
+	"🆙":                      {"alphanum", "button", "mark", "symbols", "up"},
+	"🆚":                      {"alphanum", "button", "symbols", "versus", "vs"},
+	"🈁":                      {"alphanum", "button", "here", "japanese", "katakana", "symbols", "ココ"},
+	"🈂️":                     {"alphanum", "button", "charge", "japanese", "katakana", "service", "symbols", "サ"},
+	"🈷️":                     {"alphanum", "amount", "button", "ideograph", "japanese", "monthly", "symbols", "月"},
+	"🈶":                      {"alphanum", "button", "charge", "free", "ideograph", "japanese", "not", "of", "symbols", "有"},
+	"🈯":                      {"alphanum", "button", "japanese", "reserved", "symbols"},
+	"🉐":                      {"alphanum", "bargain", "button", "ideograph", "japanese", "symbols", "得"},
+	"🈹":                      {"alphanum", "button", "discount", "ideograph", "japanese", "symbols", "割"},
+	"🈚":                      {"alphanum", "button", "charge", "free", "japanese", "of", "symbols"},
+	"🈲":                      {"alphanum", "button", "ideograph", "japanese", "prohibited", "symbols", "禁"},
+	"🉑":                      {"acceptable", "alphanum", "button", "ideograph", "japanese", "symbols", "可"},
+	"🈸":                      {"alphanum", "application", "button", "ideograph", "japanese", "symbols", "申"},
+	"🈴":                      {"alphanum", "button", "grade", "ideograph", "japanese", "passing", "symbols", "合"},
+	"🈳":                      {"alphanum", "button", "ideograph", "japanese", "symbols", "vacancy", "空"},
+	"㊗️":                     {"alphanum", "button", "congratulations", "ideograph", "japanese", "symbols", "祝"},
+	"㊙️":                     {"alphanum", "button", "ideograph", "japanese", "secret", "symbols", "秘"},
+	"🈺":                      {"alphanum", "business", "button", "for", "ideograph", "japanese", "open", "symbols", "営"},
+	"🈵":                      {"alphanum", "button", "ideograph", "japanese", "no", "symbols", "vacancy", "満"},
+	"🔴":                      {"circle", "geometric", "red", "symbols"},
+	"🟠":                      {"circle", "geometric", "orange", "symbols"},
+	"🟡":                      {"circle", "geometric", "symbols", "yellow"},
+	"🟢":                      {"circle", "geometric", "green", "symbols"},
+	"🔵":                      {"blue", "circle", "geometric", "symbols"},
+	"🟣":                      {"circle", "geometric", "purple", "symbols"},
+	"🟤":                      {"brown", "circle", "geometric", "symbols"},
+	"⚫":                      {"black", "circle", "geometric", "symbols"},
+	"⚪":                      {"circle", "geometric", "symbols", "white"},
+	"🟥":                      {"geometric", "red", "square", "symbols"},
+	"🟧":                      {"geometric", "orange", "square", "symbols"},
+	"🟨":                      {"geometric", "square", "symbols", "yellow"},
+	"🟩":                      {"geometric", "green", "square", "symbols"},
+	"🟦":                      {"blue", "geometric", "square", "symbols"},
+	"🟪":                      {"geometric", "purple", "square", "symbols"},
+	"🟫":                      {"brown", "geometric", "square", "symbols"},
+	"⬛":                      {"black", "geometric", "large", "square", "symbols"},
+	"⬜":                      {"geometric", "large", "square", "symbols", "white"},
+	"◼️":                     {"black", "geometric", "medium", "square", "symbols"},
+	"◻️":                     {"geometric", "medium", "square", "symbols", "white"},
+	"◾":                      {"black", "geometric", "medium", "small", "square", "symbols"},
+	"◽":                      {"geometric", "medium", "small", "square", "symbols", "white"},
+	"▪️":                     {"black", "geometric", "small", "square", "symbols"},
+	"▫️":                     {"geometric", "small", "square", "symbols", "white"},
+	"🔶":                      {"diamond", "geometric", "large", "orange", "symbols"},
+	"🔷":                      {"blue", "diamond", "geometric", "large", "symbols"},
+	"🔸":                      {"diamond", "geometric", "orange", "small", "symbols"},
+	"🔹":                      {"blue", "diamond", "geometric", "small", "symbols"},
+	"🔺":                      {"geometric", "pointed", "red", "symbols", "triangle", "up"},
+	"🔻":                      {"down", "geometric", "pointed", "red", "symbols", "triangle"},
+	"💠":                      {"a", "comic", "diamond", "dot", "geometric", "inside", "symbols", "with"},
+	"🔘":                      {"button", "geometric", "radio", "symbols"},
+	"🔳":                      {"button", "geometric", "outlined", "square", "symbols", "white"},
+	"🔲":                      {"black", "button", "geometric", "square", "symbols"},
+	"🏁":                      {"checkered", "chequered", "flag", "flags", "racing"},
+	"🚩":                      {"flag", "flags", "post", "triangular"},
+	"🎌":                      {"celebration", "cross", "crossed", "flag", "flags", "japanese"},
+	"🏴":                      {"black", "flag", "flags", "waving"},
+	"🏳️":                     {"flag", "flags", "waving", "white"},
+	"🏳️\u200d🌈":              {"flag", "flags", "pride", "rainbow"},
+	"🏳️\u200d⚧️":             {"blue", "flag", "flags", "light", "pink", "transgender", "white"},
+	"🏴\u200d☠️":              {"flag", "flags", "jolly", "pirate", "plunder", "roger", "treasure"},
+	"🇦🇨":                     {"ac", "ascension", "country", "flag", "flags", "island"},
+	"🇦🇩":                     {"ad", "andorra", "country", "flag", "flags"},
+	"🇦🇪":                     {"ae", "arab", "country", "emirates", "flag", "flags", "united"},
+	"🇦🇫":                     {"af", "afghanistan", "country", "flag", "flags"},
+	"🇦🇬":                     {"ag", "antigua", "barbuda", "country", "flag", "flags"},
+	"🇦🇮":                     {"ai", "anguilla", "country", "flag", "flags"},
+	"🇦🇱":                     {"al", "albania", "country", "flag", "flags"},
+	"🇦🇲":                     {"am", "armenia", "country", "flag", "flags"},
+	"🇦🇴":                     {"angola", "ao", "country", "flag", "flags"},
+	"🇦🇶":                     {"antarctica", "aq", "country", "flag", "flags"},
+	"🇦🇷":                     {"ar", "argentina", "country", "flag", "flags"},
+	"🇦🇸":                     {"american", "as", "country", "flag", "flags", "samoa"},
+	"🇦🇹":                     {"at", "austria", "country", "flag", "flags"},
+	"🇦🇺":                     {"au", "australia", "country", "flag", "flags"},
+	"🇦🇼":                     {"aruba", "aw", "country", "flag", "flags"},
+	"🇦🇽":                     {"ax", "country", "flag", "flags", "islands", "åland"},
+	"🇦🇿":                     {"az", "azerbaijan", "country", "flag", "flags"},
+	"🇧🇦":                     {"ba", "bosnia", "country", "flag", "flags", "herzegovina"},
+	"🇧🇧":                     {"barbados", "bb", "country", "flag", "flags"},
+	"🇧🇩":                     {"bangladesh", "bd", "country", "flag", "flags"},
+	"🇧🇪":                     {"be", "belgium", "country", "flag", "flags"},
+	"🇧🇫":                     {"bf", "burkina", "country", "faso", "flag", "flags"},
+	"🇧🇬":                     {"bg", "bulgaria", "country", "flag", "flags"},
+	"🇧🇭":                     {"bahrain", "bh", "country", "flag", "flags"},
+	"🇧🇮":                     {"bi", "burundi", "country", "flag", "flags"},
+	"🇧🇯":                     {"benin", "bj", "country", "flag", "flags"},
+	"🇧🇱":                     {"barthélemy", "bl", "country", "flag", "flags", "st"},
+	"🇧🇲":                     {"bermuda", "bm", "country", "flag", "flags"},
+	"🇧🇳":                     {"bn", "brunei", "country", "flag", "flags"},
+	"🇧🇴":                     {"bo", "bolivia", "country", "flag", "flags"},
+	"🇧🇶":                     {"bq", "caribbean", "country", "flag", "flags", "netherlands"},
+	"🇧🇷":                     {"br", "brazil", "country", "flag", "flags"},
+	"🇧🇸":                     {"bahamas", "bs", "country", "flag", "flags"},
+	"🇧🇹":                     {"bhutan", "bt", "country", "flag", "flags"},
+	"🇧🇻":                     {"bouvet", "bv", "country", "flag", "flags", "island"},
+	"🇧🇼":                     {"botswana", "bw", "country", "flag", "flags"},
+	"🇧🇾":                     {"belarus", "by", "country", "flag", "flags"},
+	"🇧🇿":                     {"belize", "bz", "country", "flag", "flags"},
+	"🇨🇦":                     {"ca", "canada", "country", "flag", "flags"},
+	"🇨🇨":                     {"cc", "cocos", "country", "flag", "flags", "islands", "keeling"},
+	"🇨🇩":                     {"cd", "congo", "country", "flag", "flags", "kinshasa"},
+	"🇨🇫":                     {"african", "central", "cf", "country", "flag", "flags", "republic"},
+	"🇨🇬":                     {"brazzaville", "cg", "congo", "country", "flag", "flags"},
+	"🇨🇭":                     {"ch", "country", "flag", "flags", "switzerland"},
+	"🇨🇮":                     {"ci", "country", "côte", "d", "flag", "flags", "ivoire"},
+	"🇨🇰":                     {"ck", "cook", "country", "flag", "flags", "islands"},
+	"🇨🇱":                     {"chile", "cl", "country", "flag", "flags"},
+	"🇨🇲":                     {"cameroon", "cm", "country", "flag", "flags"},
+	"🇨🇳":                     {"china", "cn", "country", "flag", "flags"},
+	"🇨🇴":                     {"co", "colombia", "country", "flag", "flags"},
+	"🇨🇵":                     {"clipperton", "country", "cp", "flag", "flags", "island"},
+	"🇨🇷":                     {"costa", "country", "cr", "flag", "flags", "rica"},
+	"🇨🇺":                     {"country", "cu", "cuba", "flag", "flags"},
+	"🇨🇻":                     {"cape", "country", "cv", "flag", "flags", "verde"},
+	"🇨🇼":                     {"country", "curaçao", "cw", "flag", "flags"},
+	"🇨🇽":                     {"christmas", "country", "cx", "flag", "flags", "island"},
+	"🇨🇾":                     {"country", "cy", "cyprus", "flag", "flags"},
+	"🇨🇿":                     {"country", "cz", "czechia", "flag", "flags"},
+	"🇩🇪":                     {"country", "de", "flag", "flags", "germany"},
+	"🇩🇬":                     {"country", "dg", "diego", "flag", "flags", "garcia"},
+	"🇩🇯":                     {"country", "dj", "djibouti", "flag", "flags"},
+	"🇩🇰":                     {"country", "denmark", "dk", "flag", "flags"},
+	"🇩🇲":                     {"country", "dm", "dominica", "flag", "flags"},
+	"🇩🇴":                     {"country", "do", "dominican", "flag", "flags", "republic"},
+	"🇩🇿":                     {"algeria", "country", "dz", "flag", "flags"},
+	"🇪🇦":                     {"ceuta", "country", "ea", "flag", "flags", "melilla"},
+	"🇪🇨":                     {"country", "ec", "ecuador", "flag", "flags"},
+	"🇪🇪":                     {"country", "ee", "estonia", "flag", "flags"},
+	"🇪🇬":                     {"country", "eg", "egypt", "flag", "flags"},
+	"🇪🇭":                     {"country", "eh", "flag", "flags", "sahara", "western"},
+	"🇪🇷":                     {"country", "er", "eritrea", "flag", "flags"},
+	"🇪🇸":                     {"country", "es", "flag", "flags", "spain"},
+	"🇪🇹":                     {"country", "et", "ethiopia", "flag", "flags"},
+	"🇪🇺":                     {"country", "eu", "european", "flag", "flags", "union"},
+	"🇫🇮":                     {"country", "fi", "finland", "flag", "flags"},
+	"🇫🇯":                     {"country", "fiji", "fj", "flag", "flags"},
+	"🇫🇰":                     {"country", "falkland", "fk", "flag", "flags", "islands"},
+	"🇫🇲":                     {"country", "flag", "flags", "fm", "micronesia"},
+	"🇫🇴":                     {"country", "faroe", "flag", "flags", "fo", "islands"},
+	"🇫🇷":                     {"country", "flag", "flags", "fr", "france"},
+	"🇬🇦":                     {"country", "flag", "flags", "ga", "gabon"},
+	"🇬🇧":                     {"country", "flag", "flags", "gb", "kingdom", "united"},
+	"🇬🇩":                     {"country", "flag", "flags", "gd", "grenada"},
+	"🇬🇪":                     {"country", "flag", "flags", "ge", "georgia"},
+	"🇬🇫":                     {"country", "flag", "flags", "french", "gf", "guiana"},
+	"🇬🇬":                     {"country", "flag", "flags", "gg", "guernsey"},
+	"🇬🇭":                     {"country", "flag", "flags", "gh", "ghana"},
+	"🇬🇮":                     {"country", "flag", "flags", "gi", "gibraltar"},
+	"🇬🇱":                     {"country", "flag", "flags", "gl", "greenland"},
+	"🇬🇲":                     {"country", "flag", "flags", "gambia", "gm"},
+	"🇬🇳":                     {"country", "flag", "flags", "gn", "guinea"},
+	"🇬🇵":                     {"country", "flag", "flags", "gp", "guadeloupe"},
+	"🇬🇶":                     {"country", "equatorial", "flag", "flags", "gq", "guinea"},
+	"🇬🇷":                     {"country", "flag", "flags", "gr", "greece"},
+	"🇬🇸":                     {"country", "flag", "flags", "georgia", "gs", "islands", "sandwich", "south"},
+	"🇬🇹":                     {"country", "flag", "flags", "gt", "guatemala"},
+	"🇬🇺":                     {"country", "flag", "flags", "gu", "guam"},
+	"🇬🇼":                     {"bissau", "country", "flag", "flags", "guinea", "gw"},
+	"🇬🇾":                     {"country", "flag", "flags", "guyana", "gy"},
+	"🇭🇰":                     {"china", "country", "flag", "flags", "hk", "hong", "kong", "sar"},
+	"🇭🇲":                     {"country", "flag", "flags", "heard", "hm", "islands", "mcdonald"},
+	"🇭🇳":                     {"country", "flag", "flags", "hn", "honduras"},
+	"🇭🇷":                     {"country", "croatia", "flag", "flags", "hr"},
+	"🇭🇹":                     {"country", "flag", "flags", "haiti", "ht"},
+	"🇭🇺":                     {"country", "flag", "flags", "hu", "hungary"},
+	"🇮🇨":                     {"canary", "country", "flag", "flags", "ic", "islands"},
+	"🇮🇩":                     {"country", "flag", "flags", "id", "indonesia"},
+	"🇮🇪":                     {"country", "flag", "flags", "ie", "ireland"},
+	"🇮🇱":                     {"country", "flag", "flags", "il", "israel"},
+	"🇮🇲":                     {"country", "flag", "flags", "im", "isle", "man", "of"},
+	"🇮🇳":                     {"country", "flag", "flags", "in", "india"},
+	"🇮🇴":                     {"british", "country", "flag", "flags", "indian", "io", "ocean", "territory"},
+	"🇮🇶":                     {"country", "flag", "flags", "iq", "iraq"},
+	"🇮🇷":                     {"country", "flag", "flags", "ir", "iran"},
+	"🇮🇸":                     {"country", "flag", "flags", "iceland", "is"},
+	"🇮🇹":                     {"country", "flag", "flags", "it", "italy"},
+	"🇯🇪":                     {"country", "flag", "flags", "je", "jersey"},
+	"🇯🇲":                     {"country", "flag", "flags", "jamaica", "jm"},
+	"🇯🇴":                     {"country", "flag", "flags", "jo", "jordan"},
+	"🇯🇵":                     {"country", "flag", "flags", "japan", "jp"},
+	"🇰🇪":                     {"country", "flag", "flags", "ke", "kenya"},
+	"🇰🇬":                     {"country", "flag", "flags", "kg", "kyrgyzstan"},
+	"🇰🇭":                     {"cambodia", "country", "flag", "flags", "kh"},
+	"🇰🇮":                     {"country", "flag", "flags", "ki", "kiribati"},
+	"🇰🇲":                     {"comoros", "country", "flag", "flags", "km"},
+	"🇰🇳":                     {"country", "flag", "flags", "kitts", "kn", "nevis", "st"},
+	"🇰🇵":                     {"country", "flag", "flags", "korea", "kp", "north"},
+	"🇰🇷":                     {"country", "flag", "flags", "korea", "kr", "south"},
+	"🇰🇼":                     {"country", "flag", "flags", "kuwait", "kw"},
+	"🇰🇾":                     {"cayman", "country", "flag", "flags", "islands", "ky"},
+	"🇰🇿":                     {"country", "flag", "flags", "kazakhstan", "kz"},
+	"🇱🇦":                     {"country", "flag", "flags", "la", "laos"},
+	"🇱🇧":                     {"country", "flag", "flags", "lb", "lebanon"},
+	"🇱🇨":                     {"country", "flag", "flags", "lc", "lucia", "st"},
+	"🇱🇮":                     {"country", "flag", "flags", "li", "liechtenstein"},
+	"🇱🇰":                     {"country", "flag", "flags", "lanka", "lk", "sri"},
+	"🇱🇷":                     {"country", "flag", "flags", "liberia", "lr"},
+	"🇱🇸":                     {"country", "flag", "flags", "lesotho", "ls"},
+	"🇱🇹":                     {"country", "flag", "flags", "lithuania", "lt"},
+	"🇱🇺":                     {"country", "flag", "flags", "lu", "luxembourg"},
+	"🇱🇻":                     {"country", "flag", "flags", "latvia", "lv"},
+	"🇱🇾":                     {"country", "flag", "flags", "libya", "ly"},
+	"🇲🇦":                     {"country", "flag", "flags", "ma", "morocco"},
+	"🇲🇨":                     {"country", "flag", "flags", "mc", "monaco"},
+	"🇲🇩":                     {"country", "flag", "flags", "md", "moldova"},
+	"🇲🇪":                     {"country", "flag", "flags", "me", "montenegro"},
+	"🇲🇫":                     {"country", "flag", "flags", "martin", "mf", "st"},
+	"🇲🇬":                     {"country", "flag", "flags", "madagascar", "mg"},
+	"🇲🇭":                     {"country", "flag", "flags", "islands", "marshall", "mh"},
+	"🇲🇰":                     {"country", "flag", "flags", "macedonia", "mk", "north"},
+	"🇲🇱":                     {"country", "flag", "flags", "mali", "ml"},
+	"🇲🇲":                     {"burma", "country", "flag", "flags", "mm", "myanmar"},
+	"🇲🇳":                     {"country", "flag", "flags", "mn", "mongolia"},
+	"🇲🇴":                     {"china", "country", "flag", "flags", "macao", "mo", "sar"},
+	"🇲🇵":                     {"country", "flag", "flags", "islands", "mariana", "mp", "northern"},
+	"🇲🇶":                     {"country", "flag", "flags", "martinique", "mq"},
+	"🇲🇷":                     {"country", "flag", "flags", "mauritania", "mr"},
+	"🇲🇸":                     {"country", "flag", "flags", "montserrat", "ms"},
+	"🇲🇹":                     {"country", "flag", "flags", "malta", "mt"},
+	"🇲🇺":                     {"country", "flag", "flags", "mauritius", "mu"},
+	"🇲🇻":                     {"country", "flag", "flags", "maldives", "mv"},
+	"🇲🇼":                     {"country", "flag", "flags", "malawi", "mw"},
+	"🇲🇽":                     {"country", "flag", "flags", "mexico", "mx"},
+	"🇲🇾":                     {"country", "flag", "flags", "malaysia", "my"},
+	"🇲🇿":                     {"country", "flag", "flags", "mozambique", "mz"},
+	"🇳🇦":                     {"country", "flag", "flags", "na", "namibia"},
+	"🇳🇨":                     {"caledonia", "country", "flag", "flags", "nc", "new"},
+	"🇳🇪":                     {"country", "flag", "flags", "ne", "niger"},
+	"🇳🇫":                     {"country", "flag", "flags", "island", "nf", "norfolk"},
+	"🇳🇬":                     {"country", "flag", "flags", "ng", "nigeria"},
+	"🇳🇮":                     {"country", "flag", "flags", "ni", "nicaragua"},
+	"🇳🇱":                     {"country", "flag", "flags", "netherlands", "nl"},
+	"🇳🇴":                     {"country", "flag", "flags", "no", "norway"},
+	"🇳🇵":                     {"country", "flag", "flags", "nepal", "np"},
+	"🇳🇷":                     {"country", "flag", "flags", "nauru", "nr"},
+	"🇳🇺":                     {"country", "flag", "flags", "niue", "nu"},
+	"🇳🇿":                     {"country", "flag", "flags", "new", "nz", "zealand"},
+	"🇴🇲":                     {"country", "flag", "flags", "om", "oman"},
+	"🇵🇦":                     {"country", "flag", "flags", "pa", "panama"},
+	"🇵🇪":                     {"country", "flag", "flags", "pe", "peru"},
+	"🇵🇫":                     {"country", "flag", "flags", "french", "pf", "polynesia"},
+	"🇵🇬":                     {"country", "flag", "flags", "guinea", "new", "papua", "pg"},
+	"🇵🇭":                     {"country", "flag", "flags", "ph", "philippines"},
+	"🇵🇰":                     {"country", "flag", "flags", "pakistan", "pk"},
+	"🇵🇱":                     {"country", "flag", "flags", "pl", "poland"},
+	"🇵🇲":                     {"country", "flag", "flags", "miquelon", "pierre", "pm", "st"},
+	"🇵🇳":                     {"country", "flag", "flags", "islands", "pitcairn", "pn"},
+	"🇵🇷":                     {"country", "flag", "flags", "pr", "puerto", "rico"},
+	"🇵🇸":                     {"country", "flag", "flags", "palestinian", "ps", "territories"},
+	"🇵🇹":                     {"country", "flag", "flags", "portugal", "pt"},
+	"🇵🇼":                     {"country", "flag", "flags", "palau", "pw"},
+	"🇵🇾":                     {"country", "flag", "flags", "paraguay", "py"},
+	"🇶🇦":                     {"country", "flag", "flags", "qa", "qatar"},
+	"🇷🇪":                     {"country", "flag", "flags", "re", "réunion"},
+	"🇷🇴":                     {"country", "flag", "flags", "ro", "romania"},
+	"🇷🇸":                     {"country", "flag", "flags", "rs", "serbia"},
+	"🇷🇺":                     {"country", "flag", "flags", "ru", "russia"},
+	"🇷🇼":                     {"country", "flag", "flags", "rw", "rwanda"},
+	"🇸🇦":                     {"arabia", "country", "flag", "flags", "sa", "saudi"},
+	"🇸🇧":                     {"country", "flag", "flags", "islands", "sb", "solomon"},
+	"🇸🇨":                     {"country", "flag", "flags", "sc", "seychelles"},
+	"🇸🇩":                     {"country", "flag", "flags", "sd", "sudan"},
+	"🇸🇪":                     {"country", "flag", "flags", "se", "sweden"},
+	"🇸🇬":                     {"country", "flag", "flags", "sg", "singapore"},
+	"🇸🇭":                     {"country", "flag", "flags", "helena", "sh", "st"},
+	"🇸🇮":                     {"country", "flag", "flags", "si", "slovenia"},
+	"🇸🇯":                     {"country", "flag", "flags", "jan", "mayen", "sj", "svalbard"},
+	"🇸🇰":                     {"country", "flag", "flags", "sk", "slovakia"},
+	"🇸🇱":                     {"country", "flag", "flags", "leone", "sierra", "sl"},
+	"🇸🇲":                     {"country", "flag", "flags", "marino", "san", "sm"},
+	"🇸🇳":                     {"country", "flag", "flags", "senegal", "sn"},
+	"🇸🇴":                     {"country", "flag", "flags", "so", "somalia"},
+	"🇸🇷":                     {"country", "flag", "flags", "sr", "suriname"},
+	"🇸🇸":                     {"country", "flag", "flags", "south", "ss", "sudan"},
+	"🇸🇹":                     {"country", "flag", "flags", "príncipe", "st", "são", "tomé"},
+	"🇸🇻":                     {"country", "el", "flag", "flags", "salvador", "sv"},
+	"🇸🇽":                     {"country", "flag", "flags", "maarten", "sint", "sx"},
+	"🇸🇾":                     {"country", "flag", "flags", "sy", "syria"},
+	"🇸🇿":                     {"country", "eswatini", "flag", "flags", "sz"},
+	"🇹🇦":                     {"country", "cunha", "da", "flag", "flags", "ta", "tristan"},
+	"🇹🇨":                     {"caicos", "country", "flag", "flags", "islands", "tc", "turks"},
+	"🇹🇩":                     {"chad", "country", "flag", "flags", "td"},
+	"🇹🇫":                     {"country", "flag", "flags", "french", "southern", "territories", "tf"},
+	"🇹🇬":                     {"country", "flag", "flags", "tg", "togo"},
+	"🇹🇭":                     {"country", "flag", "flags", "th", "thailand"},
+	"🇹🇯":                     {"country", "flag", "flags", "tajikistan", "tj"},
+	"🇹🇰":                     {"country", "flag", "flags", "tk", "tokelau"},
+	"🇹🇱":                     {"country", "flag", "flags", "leste", "timor", "tl"},
+	"🇹🇲":                     {"country", "flag", "flags", "tm", "turkmenistan"},
+	"🇹🇳":                     {"country", "flag", "flags", "tn", "tunisia"},
+	"🇹🇴":                     {"country", "flag", "flags", "to", "tonga"},
+	"🇹🇷":                     {"country", "flag", "flags", "tr", "turkey"},
+	"🇹🇹":                     {"country", "flag", "flags", "tobago", "trinidad", "tt"},
+	"🇹🇻":                     {"country", "flag", "flags", "tuvalu", "tv"},
+	"🇹🇼":                     {"country", "flag", "flags", "taiwan", "tw"},
+	"🇹🇿":                     {"country", "flag", "flags", "tanzania", "tz"},
+	"🇺🇦":                     {"country", "flag", "flags", "ua", "ukraine"},
+	"🇺🇬":                     {"country", "flag", "flags", "ug", "uganda"},
+	"🇺🇲":                     {"country", "flag", "flags", "islands", "outlying", "um", "us"},
+	"🇺🇳":                     {"country", "flag", "flags", "nations", "un", "united"},
+	"🇺🇸":                     {"country", "flag", "flags", "states", "united", "us"},
+	"🇺🇾":                     {"country", "flag", "flags", "uruguay", "uy"},
+	"🇺🇿":                     {"country", "flag", "flags", "uz", "uzbekistan"},
+	"🇻🇦":                     {"city", "country", "flag", "flags", "va", "vatican"},
+	"🇻🇨":                     {"country", "flag", "flags", "grenadines", "st", "vc", "vincent"},
+	"🇻🇪":                     {"country", "flag", "flags", "ve", "venezuela"},
+	"🇻🇬":                     {"british", "country", "flag", "flags", "islands", "vg", "virgin"},
+	"🇻🇮":                     {"country", "flag", "flags", "islands", "us", "vi", "virgin"},
+	"🇻🇳":                     {"country", "flag", "flags", "vietnam", "vn"},
+	"🇻🇺":                     {"country", "flag", "flags", "vanuatu", "vu"},
+	"🇼🇫":                     {"country", "flag", "flags", "futuna", "wallis", "wf"},
+	"🇼🇸":                     {"country", "flag", "flags", "samoa", "ws"},
+	"🇽🇰":                     {"country", "flag", "flags", "kosovo", "xk"},
+	"🇾🇪":                     {"country", "flag", "flags", "ye", "yemen"},
+	"🇾🇹":                     {"country", "flag", "flags", "mayotte", "yt"},
+	"🇿🇦":                     {"africa", "country", "flag", "flags", "south", "za"},
+	"🇿🇲":                     {"country", "flag", "flags", "zambia", "zm"},
+	"🇿🇼":                     {"country", "flag", "flags", "zimbabwe", "zw"},
 	"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f": {"england", "flag", "flags", "gbeng", "subdivision"},
 	"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f": {"flag", "flags", "gbsct", "scotland", "subdivision"},
 	"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f": {"flag", "flags", "gbwls", "subdivision", "wales"},
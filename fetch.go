@@ -0,0 +1,67 @@
+package emojis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrNotPublished is the error wrapped by FetchEmojiTest and FetchTags when no
+// file is published for the requested version.
+var ErrNotPublished = errors.New("not published")
+
+// The formats of the urls downloaded by FetchEmojiTest and FetchTags, given a
+// version. They are variables so that tests can download from a test server.
+var (
+	emojiTestURL = "https://unicode.org/Public/emoji/%s/emoji-test.txt"
+	tagsURL      = "https://cdn.jsdelivr.net/npm/emojibase-data@%s/en/data.json"
+)
+
+// FetchEmojiTest downloads the emoji-test.txt file for the provided emoji
+// version (e.g., "15.0" or "latest") from unicode.org. The download is
+// canceled if ctx is canceled, in which case the context's error is returned.
+// If the version has no published emoji-test.txt, FetchEmojiTest returns an
+// error wrapping ErrNotPublished. The caller must close the returned reader.
+func FetchEmojiTest(ctx context.Context, version string) (io.ReadCloser, error) {
+	return fetch(ctx, fmt.Sprintf(emojiTestURL, version))
+}
+
+// FetchTags downloads the English data.json file for the provided version of
+// the emojibase-data package (e.g., "7.0.1" or "latest") from emojibase's
+// CDN. The returned reader can be passed to ParseTags. Note that emojibase
+// versions are not emoji versions; the emojibase changelog lists the emoji
+// version supported by every release. If the version has no published
+// data.json, FetchTags returns an error wrapping ErrNotPublished. Like
+// FetchEmojiTest, the download is canceled if ctx is canceled, and the caller
+// must close the returned reader.
+func FetchTags(ctx context.Context, version string) (io.ReadCloser, error) {
+	return fetch(ctx, fmt.Sprintf(tagsURL, version))
+}
+
+// fetch GETs url, returning the response body if the response is 200 OK. It
+// returns an error wrapping ErrNotPublished if the response is 404 Not Found.
+func fetch(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %q: %s: %w", url, resp.Status, ErrNotPublished)
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %q: %s", url, resp.Status)
+	}
+}
@@ -0,0 +1,112 @@
+package emojis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// serve points the fetch urls at a test server with the provided handler for
+// the duration of the test.
+func serve(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	oldEmojiTestURL, oldTagsURL := emojiTestURL, tagsURL
+	t.Cleanup(func() { emojiTestURL, tagsURL = oldEmojiTestURL, oldTagsURL })
+	emojiTestURL = server.URL + "/emoji/%s/emoji-test.txt"
+	tagsURL = server.URL + "/emojibase-data@%s/en/data.json"
+}
+
+func TestFetchEmojiTest(t *testing.T) {
+	serve(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/emoji/15.0/emoji-test.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, testEmojiTest)
+	})
+
+	body, err := FetchEmojiTest(context.Background(), "15.0")
+	if err != nil {
+		t.Fatalf("FetchEmojiTest: %v", err)
+	}
+	defer body.Close()
+	emojis, err := Parse(body)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got, want := len(emojis), 12; got != want {
+		t.Errorf("got %d emojis, want %d", got, want)
+	}
+
+	if _, err := FetchEmojiTest(context.Background(), "99.0"); !errors.Is(err, ErrNotPublished) {
+		t.Errorf("FetchEmojiTest(99.0): got error %v, want ErrNotPublished", err)
+	}
+}
+
+func TestFetchEmojiTestError(t *testing.T) {
+	serve(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "oops", http.StatusInternalServerError)
+	})
+	_, err := FetchEmojiTest(context.Background(), "15.0")
+	if err == nil || errors.Is(err, ErrNotPublished) {
+		t.Errorf("got error %v, want a non-ErrNotPublished error", err)
+	}
+}
+
+func TestFetchEmojiTestCanceled(t *testing.T) {
+	// The server blocks until the request is canceled.
+	serve(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	done := make(chan error)
+	go func() {
+		_, err := FetchEmojiTest(ctx, "15.0")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want context.Canceled", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("FetchEmojiTest didn't return after its context was canceled")
+	}
+}
+
+func TestFetchTags(t *testing.T) {
+	serve(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/emojibase-data@7.0.1/en/data.json" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, testDataJSON)
+	})
+
+	body, err := FetchTags(context.Background(), "7.0.1")
+	if err != nil {
+		t.Fatalf("FetchTags: %v", err)
+	}
+	defer body.Close()
+	tags, _, err := ParseTags(body)
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	if got, want := tags["😀"], []string{"face", "grin"}; !slices.Equal(got, want) {
+		t.Errorf("tags[😀]: got %v, want %v", got, want)
+	}
+
+	if _, err := FetchTags(context.Background(), "99.0.0"); !errors.Is(err, ErrNotPublished) {
+		t.Errorf("FetchTags(99.0.0): got error %v, want ErrNotPublished", err)
+	}
+}
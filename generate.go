@@ -0,0 +1,279 @@
+package emojis
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+// GenerateGoMap generates the source of a go file in package packageName that
+// declares a map from every emoji's grapheme to its tokens. See Tokens.
+func GenerateGoMap(emojis []*Emoji, packageName string) ([]byte, error) {
+	return GenerateGoMapWithOptions(emojis, packageName, TokensOptions{})
+}
+
+// GenerateGoMapWithOptions is like GenerateGoMap but tokenizes emojis with
+// opts. See TokensWithOptions.
+func GenerateGoMapWithOptions(emojis []*Emoji, packageName string, opts TokensOptions) ([]byte, error) {
+	graphemes := make([]string, len(emojis))
+	tokens := map[string][]string{}
+	for i, emoji := range emojis {
+		graphemes[i] = emoji.Grapheme
+		tokens[emoji.Grapheme] = TokensWithOptions(emoji, opts)
+	}
+	return generateGoMap(packageName, "emojis", graphemes, tokens)
+}
+
+// GenerateGoTokenMap generates the source of a go file in package packageName
+// that declares a map from every token to the graphemes of the emojis with
+// that token. It is the inverse of the map generated by GenerateGoMap. See
+// TokenIndex.
+func GenerateGoTokenMap(emojis []*Emoji, packageName string) ([]byte, error) {
+	return GenerateGoTokenMapWithOptions(emojis, packageName, TokensOptions{})
+}
+
+// GenerateGoTokenMapWithOptions is like GenerateGoTokenMap but tokenizes
+// emojis with opts. It is the inverse of the map generated by
+// GenerateGoMapWithOptions with the same opts.
+func GenerateGoTokenMapWithOptions(emojis []*Emoji, packageName string, opts TokensOptions) ([]byte, error) {
+	index := TokenIndex(emojis, opts)
+	tokens := maps.Keys(index)
+	sort.Strings(tokens)
+	return generateGoMap(packageName, "emojisByToken", tokens, index)
+}
+
+// GenerateGoShortcodeMap generates the source of a go file in package
+// packageName that declares a map from every emoji's shortcode to its
+// grapheme. See AssignShortcodes.
+func GenerateGoShortcodeMap(emojis []*Emoji, packageName string) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n", packageName)
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Taken from https://github.com/mwhittaker/emojis.")
+	fmt.Fprintln(&b, "var shortcodes = map[string]string{")
+	for _, emoji := range emojis {
+		fmt.Fprintf(&b, "\t%q: %q,\n", emoji.Shortcode, emoji.Grapheme)
+	}
+	fmt.Fprintln(&b, "}")
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format.Source: %w", err)
+	}
+	return source, nil
+}
+
+// GenerateGoNameMap generates the source of a go file in package packageName
+// that declares a map from every emoji's grapheme to its name (e.g., for
+// screen reader labels).
+func GenerateGoNameMap(emojis []*Emoji, packageName string) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n", packageName)
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Taken from https://github.com/mwhittaker/emojis.")
+	fmt.Fprintln(&b, "var names = map[string]string{")
+	for _, emoji := range emojis {
+		fmt.Fprintf(&b, "\t%q: %q,\n", emoji.Grapheme, emoji.Name)
+	}
+	fmt.Fprintln(&b, "}")
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format.Source: %w", err)
+	}
+	return source, nil
+}
+
+// GenerateGoStructs generates the source of a go file in package packageName
+// that declares an Emoji struct, mirroring this package's Emoji, and an
+// Emojis slice with every emoji. Because the file declares its own Emoji type,
+// it must be generated into a package that doesn't already declare an Emoji
+// type (i.e., not this package). The struct's fields are derived from Emoji's,
+// so they can't drift apart, and fields with zero values are omitted from the
+// emojis.
+func GenerateGoStructs(emojis []*Emoji, packageName string) ([]byte, error) {
+	fields := reflect.VisibleFields(reflect.TypeOf(Emoji{}))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n", packageName)
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Taken from https://github.com/mwhittaker/emojis.")
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Emoji represents an emoji or emoji sequence.")
+	fmt.Fprintln(&b, "type Emoji struct {")
+	for _, field := range fields {
+		typ, err := goType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		fmt.Fprintf(&b, "\t%s %s\n", field.Name, typ)
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Emojis contains every emoji.")
+	fmt.Fprintln(&b, "var Emojis = []Emoji{")
+	for _, emoji := range emojis {
+		v := reflect.ValueOf(emoji).Elem()
+		var values []string
+		for _, field := range fields {
+			value := v.FieldByIndex(field.Index)
+			if value.IsZero() {
+				continue
+			}
+			values = append(values, fmt.Sprintf("%s: %s", field.Name, goValue(value)))
+		}
+		fmt.Fprintf(&b, "\t{%s},\n", strings.Join(values, ", "))
+	}
+	fmt.Fprintln(&b, "}")
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format.Source: %w", err)
+	}
+	return source, nil
+}
+
+// runeType is the type of Emoji.Codes, which reflect names []int32.
+var runeType = reflect.TypeOf([]rune(nil))
+
+// goType returns the go source of a type of an Emoji field, or an error if
+// goValue can't format values of the type.
+func goType(t reflect.Type) (string, error) {
+	switch {
+	case t == runeType:
+		return "[]rune", nil
+	case t.Kind() == reflect.String, t.Kind() == reflect.Int, t.Kind() == reflect.Bool:
+		return t.String(), nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		return "[]string", nil
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.String:
+		return "map[string]string", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// goValue returns the go source of a value whose type is supported by goType.
+// Code points are formatted in hex (e.g., 0x1F600), and maps are formatted in
+// key order.
+func goValue(v reflect.Value) string {
+	switch {
+	case v.Type() == runeType:
+		codes := make([]string, v.Len())
+		for i := range codes {
+			codes[i] = fmt.Sprintf("0x%04X", v.Index(i).Int())
+		}
+		return fmt.Sprintf("[]rune{%s}", strings.Join(codes, ", "))
+	case v.Kind() == reflect.Slice:
+		return formatGoStrings(v.Interface().([]string))
+	case v.Kind() == reflect.Map:
+		m := v.Interface().(map[string]string)
+		keys := maps.Keys(m)
+		sort.Strings(keys)
+		entries := make([]string, len(keys))
+		for i, key := range keys {
+			entries[i] = fmt.Sprintf("%q: %q", key, m[key])
+		}
+		return fmt.Sprintf("map[string]string{%s}", strings.Join(entries, ", "))
+	case v.Kind() == reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// formatGoStrings formats ss as a go []string literal, or nil if ss is nil.
+func formatGoStrings(ss []string) string {
+	if ss == nil {
+		return "nil"
+	}
+	formatted := make([]string, len(ss))
+	for i, s := range ss {
+		formatted[i] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(formatted, ", "))
+}
+
+// generateGoMap generates the source of a go file in package packageName that
+// declares a map[string][]string named name with the provided entries. keys
+// determines the order of the entries.
+func generateGoMap(packageName, name string, keys []string, m map[string][]string) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n", packageName)
+	fmt.Fprintln(&b, "")
+	fmt.Fprintln(&b, "// Taken from https://github.com/mwhittaker/emojis.")
+	fmt.Fprintf(&b, "var %s = map[string][]string{\n", name)
+	for _, key := range keys {
+		values := m[key]
+		formatted := make([]string, len(values))
+		for i, value := range values {
+			formatted[i] = fmt.Sprintf("%q", value)
+		}
+		fmt.Fprintf(&b, "\t%q: {%s},\n", key, strings.Join(formatted, ", "))
+	}
+	fmt.Fprintln(&b, "}")
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format.Source: %w", err)
+	}
+	return source, nil
+}
+
+// GenerateTSMap generates the source of a TypeScript file that exports a map
+// from every emoji's grapheme to its tokens. The map has the same contents
+// and order as the one generated by GenerateGoMap.
+func GenerateTSMap(emojis []*Emoji, opts TokensOptions) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintln(&b, "// Taken from https://github.com/mwhittaker/emojis.")
+	fmt.Fprintln(&b, "export const emojis: Record<string, string[]> = {")
+	for i, emoji := range emojis {
+		// JSON strings are valid TypeScript strings.
+		key, err := json.Marshal(emoji.Grapheme)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(TokensWithOptions(emoji, opts))
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "  %s: %s", key, value)
+		if i < len(emojis)-1 {
+			fmt.Fprint(&b, ",")
+		}
+		fmt.Fprintln(&b)
+	}
+	fmt.Fprintln(&b, "};")
+	return []byte(b.String()), nil
+}
+
+// GenerateJSMap generates the source of a minified JavaScript file that sets
+// window.EMOJIS to a map from every emoji's grapheme to its tokens (e.g., for
+// a static site without a build step). The map has the same contents and order
+// as the one generated by GenerateGoMap.
+func GenerateJSMap(emojis []*Emoji, opts TokensOptions) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("window.EMOJIS={")
+	for i, emoji := range emojis {
+		// JSON strings are valid JavaScript strings. json.Marshal even
+		// escapes U+2028 and U+2029, which older JavaScript engines don't
+		// allow in strings.
+		key, err := json.Marshal(emoji.Grapheme)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(TokensWithOptions(emoji, opts))
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteString("};\n")
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,292 @@
+package emojis
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestGenerateGoMapIsFormatted(t *testing.T) {
+	source, err := GenerateGoMap(testEmojis(t), "emojis")
+	if err != nil {
+		t.Fatalf("GenerateGoMap: %v", err)
+	}
+	formatted, err := format.Source(source)
+	if err != nil {
+		t.Fatalf("format.Source: %v", err)
+	}
+	if !bytes.Equal(source, formatted) {
+		t.Errorf("GenerateGoMap output isn't gofmt-clean:\n%s", source)
+	}
+}
+
+func TestGenerateGoMapParses(t *testing.T) {
+	source, err := GenerateGoMap(testEmojis(t), "emojidata")
+	if err != nil {
+		t.Fatalf("GenerateGoMap: %v", err)
+	}
+	file, err := goparser.ParseFile(token.NewFileSet(), "emojis.go", source, 0)
+	if err != nil {
+		t.Fatalf("parser.ParseFile: %v", err)
+	}
+	if got, want := file.Name.Name, "emojidata"; got != want {
+		t.Errorf("got package %q, want %q", got, want)
+	}
+}
+
+func TestGenerateGoMapWithOptions(t *testing.T) {
+	emojis := testEmojis(t)
+	source, err := GenerateGoMap(emojis, "emojis")
+	if err != nil {
+		t.Fatalf("GenerateGoMap: %v", err)
+	}
+	withOptions, err := GenerateGoMapWithOptions(emojis, "emojis", TokensOptions{})
+	if err != nil {
+		t.Fatalf("GenerateGoMapWithOptions: %v", err)
+	}
+	if !bytes.Equal(source, withOptions) {
+		t.Errorf("GenerateGoMap and GenerateGoMapWithOptions with zero options differ")
+	}
+	noCategories, err := GenerateGoMapWithOptions(emojis, "emojis", TokensOptions{NoCategories: true})
+	if err != nil {
+		t.Fatalf("GenerateGoMapWithOptions: %v", err)
+	}
+	if want := `{"cat", "pet"},`; !bytes.Contains(noCategories, []byte(want)) {
+		t.Errorf("GenerateGoMapWithOptions(NoCategories) is missing %s:\n%s", want, noCategories)
+	}
+}
+
+func TestGenerateTSMap(t *testing.T) {
+	emojis := testEmojis(t)
+	source, err := GenerateTSMap(emojis, TokensOptions{})
+	if err != nil {
+		t.Fatalf("GenerateTSMap: %v", err)
+	}
+	_, body, ok := strings.Cut(string(source), "export const emojis: Record<string, string[]> = ")
+	if !ok {
+		t.Fatalf("GenerateTSMap output is missing the export:\n%s", source)
+	}
+	body = strings.TrimSuffix(strings.TrimSpace(body), ";")
+	var got map[string][]string
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("GenerateTSMap output isn't json: %v\n%s", err, body)
+	}
+	for _, emoji := range emojis {
+		if want := Tokens(emoji); !slices.Equal(got[emoji.Grapheme], want) {
+			t.Errorf("%s: got %v, want %v", emoji.Grapheme, got[emoji.Grapheme], want)
+		}
+	}
+}
+
+func TestGenerateJSMap(t *testing.T) {
+	// The last emoji's grapheme needs escaping in a JavaScript string.
+	emojis := append(testEmojis(t), &Emoji{Grapheme: "\"\u2028</script>", Name: "tricky"})
+	source, err := GenerateJSMap(emojis, TokensOptions{})
+	if err != nil {
+		t.Fatalf("GenerateJSMap: %v", err)
+	}
+	body, ok := strings.CutPrefix(string(source), "window.EMOJIS=")
+	if !ok {
+		t.Fatalf("GenerateJSMap output doesn't set window.EMOJIS:\n%s", source)
+	}
+	body, ok = strings.CutSuffix(body, ";\n")
+	if !ok {
+		t.Fatalf("GenerateJSMap output doesn't end with a semicolon:\n%s", source)
+	}
+	if strings.ContainsAny(body, " \n\u2028") {
+		t.Errorf("GenerateJSMap output isn't minified:\n%s", body)
+	}
+	var got map[string][]string
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("GenerateJSMap output isn't json: %v\n%s", err, body)
+	}
+	if len(got) != len(emojis) {
+		t.Errorf("got %d emojis, want %d", len(got), len(emojis))
+	}
+	for _, emoji := range emojis {
+		if want := Tokens(emoji); !slices.Equal(got[emoji.Grapheme], want) {
+			t.Errorf("%q: got %v, want %v", emoji.Grapheme, got[emoji.Grapheme], want)
+		}
+	}
+
+	// The emojis are in the same order as GenerateGoMap's.
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.Token()
+	for _, emoji := range emojis {
+		key, err := decoder.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key != emoji.Grapheme {
+			t.Errorf("got key %q, want %q", key, emoji.Grapheme)
+		}
+		var tokens []string
+		if err := decoder.Decode(&tokens); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// parseGoStructs parses the source generated by GenerateGoStructs, returning
+// the fields of its Emoji type and the key value pairs of every element of its
+// Emojis slice, keyed by field name.
+func parseGoStructs(t *testing.T, source []byte) ([]string, []map[string]ast.Expr) {
+	t.Helper()
+	file, err := goparser.ParseFile(token.NewFileSet(), "emojidata.go", source, 0)
+	if err != nil {
+		t.Fatalf("parser.ParseFile: %v\n%s", err, source)
+	}
+	var fields []string
+	var elements []map[string]ast.Expr
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.TypeSpec:
+			if n.Name.Name == "Emoji" {
+				for _, field := range n.Type.(*ast.StructType).Fields.List {
+					for _, name := range field.Names {
+						fields = append(fields, name.Name)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			if n.Names[0].Name == "Emojis" {
+				for _, elt := range n.Values[0].(*ast.CompositeLit).Elts {
+					element := map[string]ast.Expr{}
+					for _, kv := range elt.(*ast.CompositeLit).Elts {
+						kv := kv.(*ast.KeyValueExpr)
+						element[kv.Key.(*ast.Ident).Name] = kv.Value
+					}
+					elements = append(elements, element)
+				}
+			}
+		}
+		return true
+	})
+	return fields, elements
+}
+
+// unquote returns the value of a string literal.
+func unquote(t *testing.T, expr ast.Expr) string {
+	t.Helper()
+	s, err := strconv.Unquote(expr.(*ast.BasicLit).Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestGenerateGoStructs(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{SourceLines: true})
+	byGrapheme := ByGrapheme(emojis)
+	for _, emoji := range testEmojis(t) {
+		byGrapheme[emoji.Grapheme].Tags = emoji.Tags
+	}
+	AssignIDs(emojis)
+	AssignShortcodes(emojis)
+	emojis[0].LocalizedNames = map[string]string{"fr": "visage rieur"}
+	source, err := GenerateGoStructs(emojis, "emojidata")
+	if err != nil {
+		t.Fatalf("GenerateGoStructs: %v", err)
+	}
+
+	// The generated source type checks, and its Emoji has every field of
+	// this package's Emoji.
+	fset := token.NewFileSet()
+	file, err := goparser.ParseFile(fset, "emojidata.go", source, 0)
+	if err != nil {
+		t.Fatalf("parser.ParseFile: %v", err)
+	}
+	if _, err := new(types.Config).Check("emojidata", fset, []*ast.File{file}, nil); err != nil {
+		t.Errorf("types.Check: %v\n%s", err, source)
+	}
+	fields, elements := parseGoStructs(t, source)
+	var want []string
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(Emoji{})) {
+		want = append(want, field.Name)
+	}
+	if !slices.Equal(fields, want) {
+		t.Errorf("got fields %v, want %v", fields, want)
+	}
+	if len(elements) != len(emojis) {
+		t.Fatalf("got %d emojis, want %d", len(elements), len(emojis))
+	}
+	for i, emoji := range emojis {
+		element := elements[i]
+		for field, want := range map[string]string{
+			"Grapheme":  emoji.Grapheme,
+			"Name":      emoji.Name,
+			"Group":     emoji.Group,
+			"Version":   emoji.Version,
+			"Shortcode": emoji.Shortcode,
+		} {
+			if got := unquote(t, element[field]); got != want {
+				t.Errorf("%s: got %s %q, want %q", emoji.Grapheme, field, got, want)
+			}
+		}
+		if got, want := len(element["Codes"].(*ast.CompositeLit).Elts), len(emoji.Codes); got != want {
+			t.Errorf("%s: got %d codes, want %d", emoji.Grapheme, got, want)
+		}
+		var tags []string
+		if lit, ok := element["Tags"].(*ast.CompositeLit); ok {
+			for _, tag := range lit.Elts {
+				tags = append(tags, unquote(t, tag))
+			}
+		}
+		if !slices.Equal(tags, emoji.Tags) {
+			t.Errorf("%s: got tags %q, want %q", emoji.Grapheme, tags, emoji.Tags)
+		}
+		if got, want := element["SourceLine"].(*ast.BasicLit).Value, strconv.Itoa(emoji.SourceLine); got != want {
+			t.Errorf("%s: got source line %s, want %s", emoji.Grapheme, got, want)
+		}
+	}
+
+	// Zero fields are omitted.
+	if _, ok := elements[0]["LocalizedNames"]; !ok {
+		t.Errorf("%s: missing LocalizedNames", emojis[0].Grapheme)
+	}
+	if _, ok := elements[1]["LocalizedNames"]; ok {
+		t.Errorf("%s: got LocalizedNames, want none", emojis[1].Grapheme)
+	}
+}
+
+func TestGenerateGoNameMap(t *testing.T) {
+	emojis := testEmojis(t)
+	source, err := GenerateGoNameMap(emojis, "emojis")
+	if err != nil {
+		t.Fatalf("GenerateGoNameMap: %v", err)
+	}
+	file, err := goparser.ParseFile(token.NewFileSet(), "names.go", source, 0)
+	if err != nil {
+		t.Fatalf("parser.ParseFile: %v\n%s", err, source)
+	}
+	got := map[string]string{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if kv, ok := n.(*ast.KeyValueExpr); ok {
+			got[unquote(t, kv.Key)] = unquote(t, kv.Value)
+		}
+		return true
+	})
+	if len(got) != len(emojis) {
+		t.Errorf("got %d names, want %d", len(got), len(emojis))
+	}
+	for _, emoji := range emojis {
+		if got[emoji.Grapheme] != emoji.Name {
+			t.Errorf("names[%s]: got %q, want %q", emoji.Grapheme, got[emoji.Grapheme], emoji.Name)
+		}
+	}
+
+	// The generated names.go in this package is up to date.
+	if got, want := names["😀"], "grinning face"; got != want {
+		t.Errorf("names[😀]: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+package emojis
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// WriteGob encodes emojis to w with encoding/gob. Decoding gob is much faster
+// than decoding json, so Go programs can load the emojis written by WriteGob
+// with LoadGob nearly instantly.
+func WriteGob(w io.Writer, emojis []*Emoji) error {
+	if err := gob.NewEncoder(w).Encode(emojis); err != nil {
+		return fmt.Errorf("gob encode: %w", err)
+	}
+	return nil
+}
+
+// LoadGob decodes emojis written by WriteGob from r.
+func LoadGob(r io.Reader) ([]*Emoji, error) {
+	var emojis []*Emoji
+	if err := gob.NewDecoder(r).Decode(&emojis); err != nil {
+		return nil, fmt.Errorf("gob decode: %w", err)
+	}
+	return emojis, nil
+}
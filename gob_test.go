@@ -0,0 +1,27 @@
+package emojis
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestGobRoundTrip(t *testing.T) {
+	emojis := testEmojis(t)
+	AssignIDs(emojis)
+	AssignShortcodes(emojis)
+	AssignTokens(emojis, TokensOptions{})
+	AssignLocalizedNames(emojis, "fr", map[string]string{"😀": "visage rieur"})
+
+	var b bytes.Buffer
+	if err := WriteGob(&b, emojis); err != nil {
+		t.Fatalf("WriteGob: %v", err)
+	}
+	got, err := LoadGob(&b)
+	if err != nil {
+		t.Fatalf("LoadGob: %v", err)
+	}
+	if !reflect.DeepEqual(got, emojis) {
+		t.Errorf("LoadGob(WriteGob(emojis)) != emojis")
+	}
+}
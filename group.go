@@ -0,0 +1,71 @@
+package emojis
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// GroupByCategory buckets emojis by group and then by subgroup. For example,
+// GroupByCategory(emojis)["Smileys & Emotion"]["face-smiling"] contains the
+// smiling face emojis. Emojis within a subgroup appear in the same order as
+// in emojis.
+func GroupByCategory(emojis []*Emoji) map[string]map[string][]*Emoji {
+	groups := map[string]map[string][]*Emoji{}
+	for _, emoji := range emojis {
+		subgroups, ok := groups[emoji.Group]
+		if !ok {
+			subgroups = map[string][]*Emoji{}
+			groups[emoji.Group] = subgroups
+		}
+		subgroups[emoji.Subgroup] = append(subgroups[emoji.Subgroup], emoji)
+	}
+	return groups
+}
+
+// Groups returns the sorted, deduplicated groups of emojis.
+func Groups(emojis []*Emoji) []string {
+	seen := map[string]bool{}
+	for _, emoji := range emojis {
+		seen[emoji.Group] = true
+	}
+	groups := maps.Keys(seen)
+	sort.Strings(groups)
+	return groups
+}
+
+// Subgroups returns the sorted, deduplicated subgroups of the emojis in the
+// provided group.
+func Subgroups(emojis []*Emoji, group string) []string {
+	seen := map[string]bool{}
+	for _, emoji := range emojis {
+		if emoji.Group == group {
+			seen[emoji.Subgroup] = true
+		}
+	}
+	subgroups := maps.Keys(seen)
+	sort.Strings(subgroups)
+	return subgroups
+}
+
+// Less reports whether a sorts before b in category order: by group, then by
+// subgroup, and then by code points, starting with the first. For example, to
+// sort emojis like a picker would, call sort.Slice with Less or
+// slices.SortFunc with CompareEmojis.
+func Less(a, b *Emoji) bool {
+	return CompareEmojis(a, b) < 0
+}
+
+// CompareEmojis is like Less but returns -1 if a sorts before b, 1 if a sorts
+// after b, and 0 if a and b have the same group, subgroup, and code points.
+func CompareEmojis(a, b *Emoji) int {
+	if c := strings.Compare(a.Group, b.Group); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.Subgroup, b.Subgroup); c != 0 {
+		return c
+	}
+	return slices.Compare(a.Codes, b.Codes)
+}
@@ -0,0 +1,76 @@
+package emojis
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestGroupByCategory(t *testing.T) {
+	groups := GroupByCategory(mustParse(t, ParseOptions{}))
+	for _, test := range []struct {
+		group, subgroup string
+		want            []string
+	}{
+		{"Smileys & Emotion", "face-smiling", []string{"😀", "😃"}},
+		{"Smileys & Emotion", "face-concerned", []string{"☹️"}},
+		{"Animals & Nature", "animal-mammal", []string{"🐱", "🐈", "🐈‍⬛"}},
+	} {
+		if got := graphemes(groups[test.group][test.subgroup]); !slices.Equal(got, test.want) {
+			t.Errorf("%s/%s: got %v, want %v", test.group, test.subgroup, got, test.want)
+		}
+	}
+	if got, want := len(groups["People & Body"]), 2; got != want {
+		t.Errorf("People & Body: got %d subgroups, want %d", got, want)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{IncludeComponents: true})
+	want := []string{"Animals & Nature", "Component", "People & Body", "Smileys & Emotion", "Symbols"}
+	if got := Groups(emojis); !slices.Equal(got, want) {
+		t.Errorf("Groups: got %v, want %v", got, want)
+	}
+	for group, want := range map[string][]string{
+		"Smileys & Emotion": {"face-concerned", "face-smiling"},
+		"People & Body":     {"hand-fingers-open", "person-role"},
+		"Food & Drink":      nil,
+	} {
+		if got := Subgroups(emojis, group); !slices.Equal(got, want) {
+			t.Errorf("Subgroups(%q): got %v, want %v", group, got, want)
+		}
+	}
+}
+
+func TestLess(t *testing.T) {
+	want := []string{
+		// Animals & Nature, animal-mammal.
+		"🐈", "🐈‍⬛", "🐱",
+		// People & Body, hand-fingers-open and then person-role.
+		"👋", "👋🏻", "🧑‍⚕️",
+		// Smileys & Emotion, face-concerned and then face-smiling.
+		"☹️", "😀", "😃",
+		// Symbols, keycap.
+		"#️⃣", "*️⃣", "2️⃣",
+	}
+	emojis := mustParse(t, ParseOptions{})
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 10; i++ {
+		r.Shuffle(len(emojis), func(i, j int) { emojis[i], emojis[j] = emojis[j], emojis[i] })
+		sort.Slice(emojis, func(i, j int) bool { return Less(emojis[i], emojis[j]) })
+		if got := graphemes(emojis); !slices.Equal(got, want) {
+			t.Errorf("sort.Slice with Less: got %v, want %v", got, want)
+		}
+		r.Shuffle(len(emojis), func(i, j int) { emojis[i], emojis[j] = emojis[j], emojis[i] })
+		slices.SortFunc(emojis, CompareEmojis)
+		if got := graphemes(emojis); !slices.Equal(got, want) {
+			t.Errorf("slices.SortFunc with CompareEmojis: got %v, want %v", got, want)
+		}
+	}
+
+	if got := CompareEmojis(emojis[0], emojis[0]); got != 0 {
+		t.Errorf("CompareEmojis(%s, %s): got %d, want 0", emojis[0].Grapheme, emojis[0].Grapheme, got)
+	}
+}
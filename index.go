@@ -0,0 +1,104 @@
+package emojis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// ByGrapheme returns a map from every emoji's grapheme to the emoji. Building
+// the map takes linear time, but looking up an emoji by grapheme in the
+// returned map takes constant time. If more than one emoji has the same
+// grapheme, the last one wins.
+func ByGrapheme(emojis []*Emoji) map[string]*Emoji {
+	m := make(map[string]*Emoji, len(emojis))
+	for _, emoji := range emojis {
+		m[emoji.Grapheme] = emoji
+	}
+	return m
+}
+
+// ByCodes returns a map from every emoji's code points to the emoji. The map
+// is keyed by space separated hex code points, like in emoji-test.txt (e.g.,
+// "2639 FE0F"); see CodesKey. If more than one emoji has the same code points,
+// the last one wins.
+func ByCodes(emojis []*Emoji) map[string]*Emoji {
+	m := make(map[string]*Emoji, len(emojis))
+	for _, emoji := range emojis {
+		m[CodesKey(emoji.Codes)] = emoji
+	}
+	return m
+}
+
+// CodesKey returns the key of the provided code points in the map returned by
+// ByCodes (e.g., "2639 FE0F" for [0x2639, 0xFE0F]).
+func CodesKey(codes []rune) string {
+	hex := make([]string, len(codes))
+	for i, code := range codes {
+		hex[i] = fmt.Sprintf("%04X", code)
+	}
+	return strings.Join(hex, " ")
+}
+
+// LookupCodes returns the first emoji with the provided code points (e.g.,
+// code points decoded from a protocol), or false if there is no such emoji.
+// Unlike LookupByGrapheme, variation selectors aren't ignored.
+func LookupCodes(emojis []*Emoji, codes []rune) (*Emoji, bool) {
+	for _, emoji := range emojis {
+		if slices.Equal(emoji.Codes, codes) {
+			return emoji, true
+		}
+	}
+	return nil, false
+}
+
+// The variation selectors that request text (U+FE0E) or emoji (U+FE0F)
+// presentation of the preceding code point.
+const (
+	textVariationSelector  = 0xFE0E
+	emojiVariationSelector = 0xFE0F
+)
+
+// Normalize returns grapheme without variation selectors. Users often type
+// emojis without the emoji variation selector that fully qualified emojis
+// include (e.g., "☹" rather than "☹️"), so normalizing both the typed and the
+// listed grapheme lets them match.
+func Normalize(grapheme string) string {
+	return strings.Map(func(r rune) rune {
+		if r == textVariationSelector || r == emojiVariationSelector {
+			return -1
+		}
+		return r
+	}, grapheme)
+}
+
+// LookupByGrapheme returns the first emoji whose grapheme equals grapheme,
+// ignoring variation selectors (see Normalize). For example, both "☹️" and
+// "☹" find the frowning face emoji. It returns false if there is no such
+// emoji.
+func LookupByGrapheme(emojis []*Emoji, grapheme string) (*Emoji, bool) {
+	want := Normalize(grapheme)
+	for _, emoji := range emojis {
+		if Normalize(emoji.Grapheme) == want {
+			return emoji, true
+		}
+	}
+	return nil, false
+}
+
+// AssignIDs sets the ID of every emoji to its 1-based position in the emojis
+// sorted by grapheme. Because the IDs don't depend on the order of emojis,
+// they are stable across regenerations of the same set of emojis, even if
+// upstream data files reorder them.
+func AssignIDs(emojis []*Emoji) {
+	sorted := make([]*Emoji, len(emojis))
+	copy(sorted, emojis)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Grapheme < sorted[j].Grapheme
+	})
+	for i, emoji := range sorted {
+		emoji.ID = i + 1
+	}
+}
@@ -0,0 +1,115 @@
+package emojis
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestByGrapheme(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{})
+	byGrapheme := ByGrapheme(emojis)
+	if got, want := len(byGrapheme), len(emojis); got != want {
+		t.Errorf("got %d entries, want %d", got, want)
+	}
+	for _, emoji := range emojis {
+		if got := byGrapheme[emoji.Grapheme]; got != emoji {
+			t.Errorf("byGrapheme[%s]: got %p, want %p", emoji.Grapheme, got, emoji)
+		}
+	}
+	if got, ok := byGrapheme["🦄"]; ok {
+		t.Errorf("byGrapheme[🦄]: got %v, want nothing", got)
+	}
+}
+
+func TestAssignIDs(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{})
+	AssignIDs(emojis)
+	sorted := slices.Clone(emojis)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Grapheme < sorted[j].Grapheme })
+	for i, emoji := range sorted {
+		if emoji.ID != i+1 {
+			t.Errorf("%s: got id %d, want %d", emoji.Grapheme, emoji.ID, i+1)
+		}
+	}
+
+	// IDs don't depend on the order of the emojis.
+	ids := map[string]int{}
+	for _, emoji := range emojis {
+		ids[emoji.Grapheme] = emoji.ID
+	}
+	reversed := mustParse(t, ParseOptions{})
+	slices.Reverse(reversed)
+	AssignIDs(reversed)
+	for _, emoji := range reversed {
+		if emoji.ID != ids[emoji.Grapheme] {
+			t.Errorf("%s: got id %d after reordering, want %d", emoji.Grapheme, emoji.ID, ids[emoji.Grapheme])
+		}
+	}
+}
+
+func TestLookupByGrapheme(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{IncludeUnqualified: true})
+	for _, grapheme := range []string{"☹️", "☹", "☹︎"} {
+		emoji, ok := LookupByGrapheme(emojis, grapheme)
+		if !ok || emoji.Grapheme != "☹️" {
+			t.Errorf("LookupByGrapheme(%+q): got %v, want ☹️", grapheme, emoji)
+		}
+	}
+	if got, want := Normalize("☹️"), "☹"; got != want {
+		t.Errorf("Normalize(☹️): got %+q, want %+q", got, want)
+	}
+	if got, ok := LookupByGrapheme(emojis, "🦄"); ok {
+		t.Errorf("LookupByGrapheme(🦄): got %v, want nothing", got)
+	}
+}
+
+func TestByCodes(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{IncludeUnqualified: true})
+	byCodes := ByCodes(emojis)
+	if got, want := len(byCodes), len(emojis); got != want {
+		t.Errorf("got %d entries, want %d", got, want)
+	}
+	for key, want := range map[string]string{
+		"1F600":                "😀",
+		"2639 FE0F":            "☹️",
+		"2639":                 "☹",
+		"1F408 200D 2B1B":      "🐈‍⬛",
+		"1F9D1 200D 2695 FE0F": "🧑‍⚕️",
+		"0023 FE0F 20E3":       "#️⃣",
+	} {
+		if got, ok := byCodes[key]; !ok || got.Grapheme != want {
+			t.Errorf("byCodes[%q]: got %v, want %s", key, got, want)
+		}
+	}
+	for _, emoji := range emojis {
+		if got := byCodes[CodesKey(emoji.Codes)]; got != emoji {
+			t.Errorf("byCodes[CodesKey(%s)]: got %p, want %p", emoji.Grapheme, got, emoji)
+		}
+	}
+}
+
+func TestLookupCodes(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{IncludeUnqualified: true})
+	for _, test := range []struct {
+		codes []rune
+		want  string
+	}{
+		{[]rune{0x1F600}, "😀"},
+		{[]rune{0x1F408, 0x200D, 0x2B1B}, "🐈‍⬛"},
+		{[]rune{0x1F9D1, 0x200D, 0x2695, 0xFE0F}, "🧑‍⚕️"},
+		// Variation selectors aren't ignored.
+		{[]rune{0x2639, 0xFE0F}, "☹️"},
+		{[]rune{0x2639}, "☹"},
+	} {
+		if got, ok := LookupCodes(emojis, test.codes); !ok || got.Grapheme != test.want {
+			t.Errorf("LookupCodes(%U): got %v, %t, want %s", test.codes, got, ok, test.want)
+		}
+	}
+	for _, codes := range [][]rune{nil, {0x1F408, 0x200D}, {0x1F600, 0xFE0F}, {0x1F984}} {
+		if got, ok := LookupCodes(emojis, codes); ok {
+			t.Errorf("LookupCodes(%U): got %s, want false", codes, got.Grapheme)
+		}
+	}
+}
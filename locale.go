@@ -0,0 +1,100 @@
+package emojis
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseAnnotations parses the names of emojis from a CLDR annotations file
+// for a single locale (e.g., common/annotations/fr.xml). The returned map is
+// keyed by grapheme. Both CLDR's xml and the cldr-json package's json (e.g.,
+// cldr-annotations-full/annotations/fr/annotations.json) are parsed. Skin
+// tone variants and other sequences are listed separately, in CLDR's derived
+// annotations (e.g., common/annotationsDerived/fr.xml).
+//
+// CLDR often lists emojis without variation selectors (e.g., "☹" rather than
+// "☹️"), so use AssignLocalizedNames, which ignores them, to look up names.
+func ParseAnnotations(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimLeft(data, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '{' {
+		return parseJSONAnnotations(data)
+	}
+	return parseXMLAnnotations(data)
+}
+
+// parseXMLAnnotations parses CLDR annotations xml. Every emoji's name is
+// listed in an annotation with type "tts" (e.g., <annotation cp="😀"
+// type="tts">visage rieur</annotation>).
+func parseXMLAnnotations(data []byte) (map[string]string, error) {
+	var ldml struct {
+		Annotations []struct {
+			CP   string `xml:"cp,attr"`
+			Type string `xml:"type,attr"`
+			Text string `xml:",chardata"`
+		} `xml:"annotations>annotation"`
+	}
+	if err := xml.Unmarshal(data, &ldml); err != nil {
+		return nil, fmt.Errorf("xml decode: %w", err)
+	}
+	names := map[string]string{}
+	for _, annotation := range ldml.Annotations {
+		if annotation.Type == "tts" {
+			names[annotation.CP] = strings.TrimSpace(annotation.Text)
+		}
+	}
+	return names, nil
+}
+
+// parseJSONAnnotations parses cldr-json annotations (e.g., {"annotations":
+// {"annotations": {"😀": {"tts": ["visage rieur"]}}}}). Derived annotations
+// use an "annotationsDerived" key instead.
+func parseJSONAnnotations(data []byte) (map[string]string, error) {
+	var files map[string]struct {
+		Annotations map[string]struct {
+			TTS []string `json:"tts"`
+		} `json:"annotations"`
+	}
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, fmt.Errorf("json decode: %w", err)
+	}
+	names := map[string]string{}
+	for _, file := range files {
+		for grapheme, annotation := range file.Annotations {
+			if len(annotation.TTS) > 0 {
+				names[grapheme] = annotation.TTS[0]
+			}
+		}
+	}
+	return names, nil
+}
+
+// AssignLocalizedNames sets the name of every emoji in the provided locale
+// (e.g., "fr") to its name in names, like those returned by
+// ParseAnnotations, ignoring variation selectors (see Normalize). Emojis
+// without a name in names fall back to their English Name.
+func AssignLocalizedNames(emojis []*Emoji, locale string, names map[string]string) {
+	normalized := make(map[string]string, len(names))
+	for grapheme, name := range names {
+		normalized[Normalize(grapheme)] = name
+	}
+	for _, emoji := range emojis {
+		name, ok := names[emoji.Grapheme]
+		if !ok {
+			name, ok = normalized[Normalize(emoji.Grapheme)]
+		}
+		if !ok {
+			name = emoji.Name
+		}
+		if emoji.LocalizedNames == nil {
+			emoji.LocalizedNames = map[string]string{}
+		}
+		emoji.LocalizedNames[locale] = name
+	}
+}
@@ -0,0 +1,92 @@
+package emojis
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/maps"
+)
+
+// testAnnotationsXML and testAnnotationsJSON are the same excerpt of CLDR's
+// fr annotations in xml and cldr-json. Like CLDR, they list ☹ without its
+// variation selector.
+const testAnnotationsXML = `<?xml version="1.0" encoding="UTF-8" ?>
+<ldml>
+	<identity>
+		<language type="fr"/>
+	</identity>
+	<annotations>
+		<annotation cp="😀">rire | sourire | visage | visage rieur</annotation>
+		<annotation cp="😀" type="tts">visage rieur</annotation>
+		<annotation cp="☹">mécontent | visage</annotation>
+		<annotation cp="☹" type="tts">visage mécontent</annotation>
+	</annotations>
+</ldml>
+`
+
+const testAnnotationsJSON = `{
+	"annotations": {
+		"identity": {"language": "fr"},
+		"annotations": {
+			"😀": {"default": ["rire", "sourire", "visage", "visage rieur"], "tts": ["visage rieur"]},
+			"☹": {"default": ["mécontent", "visage"], "tts": ["visage mécontent"]}
+		}
+	}
+}`
+
+func TestParseAnnotations(t *testing.T) {
+	want := map[string]string{"😀": "visage rieur", "☹": "visage mécontent"}
+	for _, test := range []struct {
+		format, annotations string
+	}{
+		{"xml", testAnnotationsXML},
+		{"json", testAnnotationsJSON},
+	} {
+		got, err := ParseAnnotations(strings.NewReader(test.annotations))
+		if err != nil {
+			t.Errorf("ParseAnnotations(%s): %v", test.format, err)
+			continue
+		}
+		if !maps.Equal(got, want) {
+			t.Errorf("ParseAnnotations(%s): got %v, want %v", test.format, got, want)
+		}
+	}
+}
+
+func TestParseAnnotationsMalformed(t *testing.T) {
+	for _, annotations := range []string{
+		`<ldml><annotations><annotation cp="😀" type="tts">visage rieur</ldml>`,
+		`{"annotations": {"annotations": {"😀": {"tts": "visage rieur"}}}}`,
+		`{"annotations":`,
+	} {
+		if got, err := ParseAnnotations(strings.NewReader(annotations)); err == nil {
+			t.Errorf("ParseAnnotations(%q): got %v, want error", annotations, got)
+		}
+	}
+}
+
+func TestAssignLocalizedNames(t *testing.T) {
+	names, err := ParseAnnotations(strings.NewReader(testAnnotationsXML))
+	if err != nil {
+		t.Fatalf("ParseAnnotations: %v", err)
+	}
+	emojis := mustParse(t, ParseOptions{})
+	AssignLocalizedNames(emojis, "fr", names)
+	byGrapheme := ByGrapheme(emojis)
+	for grapheme, want := range map[string]string{
+		"😀": "visage rieur",
+		// ☹️ is named despite its variation selector.
+		"☹️": "visage mécontent",
+		// 😃 isn't in names, so it falls back to its English name.
+		"😃": "grinning face with big eyes",
+	} {
+		if got := byGrapheme[grapheme].LocalizedNames["fr"]; got != want {
+			t.Errorf("fr name of %s: got %q, want %q", grapheme, got, want)
+		}
+	}
+	for _, emoji := range emojis {
+		if _, ok := emoji.LocalizedNames["fr"]; !ok {
+			t.Errorf("%s has no fr name", emoji.Grapheme)
+		}
+	}
+}
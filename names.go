@@ -0,0 +1,3660 @@
+package emojis
+
+// Taken from https://github.com/mwhittaker/emojis.
+var names = map[string]string{
+	"😀":                 "grinning face",
+	"😃":                 "grinning face with big eyes",
+	"😄":                 "grinning face with smiling eyes",
+	"😁":                 "beaming face with smiling eyes",
+	"😆":                 "grinning squinting face",
+	"😅":                 "grinning face with sweat",
+	"🤣":                 "rolling on the floor laughing",
+	"😂":                 "face with tears of joy",
+	"🙂":                 "slightly smiling face",
+	"🙃":                 "upside-down face",
+	"🫠":                 "melting face",
+	"😉":                 "winking face",
+	"😊":                 "smiling face with smiling eyes",
+	"😇":                 "smiling face with halo",
+	"🥰":                 "smiling face with hearts",
+	"😍":                 "smiling face with heart-eyes",
+	"🤩":                 "star-struck",
+	"😘":                 "face blowing a kiss",
+	"😗":                 "kissing face",
+	"☺️":                "smiling face",
+	"😚":                 "kissing face with closed eyes",
+	"😙":                 "kissing face with smiling eyes",
+	"🥲":                 "smiling face with tear",
+	"😋":                 "face savoring food",
+	"😛":                 "face with tongue",
+	"😜":                 "winking face with tongue",
+	"🤪":                 "zany face",
+	"😝":                 "squinting face with tongue",
+	"🤑":                 "money-mouth face",
+	"🤗":                 "smiling face with open hands",
+	"🤭":                 "face with hand over mouth",
+	"🫢":                 "face with open eyes and hand over mouth",
+	"🫣":                 "face with peeking eye",
+	"🤫":                 "shushing face",
+	"🤔":                 "thinking face",
+	"🫡":                 "saluting face",
+	"🤐":                 "zipper-mouth face",
+	"🤨":                 "face with raised eyebrow",
+	"😐":                 "neutral face",
+	"😑":                 "expressionless face",
+	"😶":                 "face without mouth",
+	"🫥":                 "dotted line face",
+	"😶\u200d🌫️":         "face in clouds",
+	"😏":                 "smirking face",
+	"😒":                 "unamused face",
+	"🙄":                 "face with rolling eyes",
+	"😬":                 "grimacing face",
+	"😮\u200d💨":          "face exhaling",
+	"🤥":                 "lying face",
+	"🫨":                 "shaking face",
+	"😌":                 "relieved face",
+	"😔":                 "pensive face",
+	"😪":                 "sleepy face",
+	"🤤":                 "drooling face",
+	"😴":                 "sleeping face",
+	"😷":                 "face with medical mask",
+	"🤒":                 "face with thermometer",
+	"🤕":                 "face with head-bandage",
+	"🤢":                 "nauseated face",
+	"🤮":                 "face vomiting",
+	"🤧":                 "sneezing face",
+	"🥵":                 "hot face",
+	"🥶":                 "cold face",
+	"🥴":                 "woozy face",
+	"😵":                 "face with crossed-out eyes",
+	"😵\u200d💫":          "face with spiral eyes",
+	"🤯":                 "exploding head",
+	"🤠":                 "cowboy hat face",
+	"🥳":                 "partying face",
+	"🥸":                 "disguised face",
+	"😎":                 "smiling face with sunglasses",
+	"🤓":                 "nerd face",
+	"🧐":                 "face with monocle",
+	"😕":                 "confused face",
+	"🫤":                 "face with diagonal mouth",
+	"😟":                 "worried face",
+	"🙁":                 "slightly frowning face",
+	"☹️":                "frowning face",
+	"😮":                 "face with open mouth",
+	"😯":                 "hushed face",
+	"😲":                 "astonished face",
+	"😳":                 "flushed face",
+	"🥺":                 "pleading face",
+	"🥹":                 "face holding back tears",
+	"😦":                 "frowning face with open mouth",
+	"😧":                 "anguished face",
+	"😨":                 "fearful face",
+	"😰":                 "anxious face with sweat",
+	"😥":                 "sad but relieved face",
+	"😢":                 "crying face",
+	"😭":                 "loudly crying face",
+	"😱":                 "face screaming in fear",
+	"😖":                 "confounded face",
+	"😣":                 "persevering face",
+	"😞":                 "disappointed face",
+	"😓":                 "downcast face with sweat",
+	"😩":                 "weary face",
+	"😫":                 "tired face",
+	"🥱":                 "yawning face",
+	"😤":                 "face with steam from nose",
+	"😡":                 "enraged face",
+	"😠":                 "angry face",
+	"🤬":                 "face with symbols on mouth",
+	"😈":                 "smiling face with horns",
+	"👿":                 "angry face with horns",
+	"💀":                 "skull",
+	"☠️":                "skull and crossbones",
+	"💩":                 "pile of poo",
+	"🤡":                 "clown face",
+	"👹":                 "ogre",
+	"👺":                 "goblin",
+	"👻":                 "ghost",
+	"👽":                 "alien",
+	"👾":                 "alien monster",
+	"🤖":                 "robot",
+	"😺":                 "grinning cat",
+	"😸":                 "grinning cat with smiling eyes",
+	"😹":                 "cat with tears of joy",
+	"😻":                 "smiling cat with heart-eyes",
+	"😼":                 "cat with wry smile",
+	"😽":                 "kissing cat",
+	"🙀":                 "weary cat",
+	"😿":                 "crying cat",
+	"😾":                 "pouting cat",
+	"🙈":                 "see-no-evil monkey",
+	"🙉":                 "hear-no-evil monkey",
+	"🙊":                 "speak-no-evil monkey",
+	"💌":                 "love letter",
+	"💘":                 "heart with arrow",
+	"💝":                 "heart with ribbon",
+	"💖":                 "sparkling heart",
+	"💗":                 "growing heart",
+	"💓":                 "beating heart",
+	"💞":                 "revolving hearts",
+	"💕":                 "two hearts",
+	"💟":                 "heart decoration",
+	"❣️":                "heart exclamation",
+	"💔":                 "broken heart",
+	"❤️\u200d🔥":         "heart on fire",
+	"❤️\u200d🩹":         "mending heart",
+	"❤️":                "red heart",
+	"🩷":                 "pink heart",
+	"🧡":                 "orange heart",
+	"💛":                 "yellow heart",
+	"💚":                 "green heart",
+	"💙":                 "blue heart",
+	"🩵":                 "light blue heart",
+	"💜":                 "purple heart",
+	"🤎":                 "brown heart",
+	"🖤":                 "black heart",
+	"🩶":                 "grey heart",
+	"🤍":                 "white heart",
+	"💋":                 "kiss mark",
+	"💯":                 "hundred points",
+	"💢":                 "anger symbol",
+	"💥":                 "collision",
+	"💫":                 "dizzy",
+	"💦":                 "sweat droplets",
+	"💨":                 "dashing away",
+	"🕳️":                "hole",
+	"💬":                 "speech balloon",
+	"👁️\u200d🗨️":        "eye in speech bubble",
+	"🗨️":                "left speech bubble",
+	"🗯️":                "right anger bubble",
+	"💭":                 "thought balloon",
+	"💤":                 "ZZZ",
+	"👋":                 "waving hand",
+	"👋🏻":                "waving hand: light skin tone",
+	"👋🏼":                "waving hand: medium-light skin tone",
+	"👋🏽":                "waving hand: medium skin tone",
+	"👋🏾":                "waving hand: medium-dark skin tone",
+	"👋🏿":                "waving hand: dark skin tone",
+	"🤚":                 "raised back of hand",
+	"🤚🏻":                "raised back of hand: light skin tone",
+	"🤚🏼":                "raised back of hand: medium-light skin tone",
+	"🤚🏽":                "raised back of hand: medium skin tone",
+	"🤚🏾":                "raised back of hand: medium-dark skin tone",
+	"🤚🏿":                "raised back of hand: dark skin tone",
+	"🖐️":                "hand with fingers splayed",
+	"🖐🏻":                "hand with fingers splayed: light skin tone",
+	"🖐🏼":                "hand with fingers splayed: medium-light skin tone",
+	"🖐🏽":                "hand with fingers splayed: medium skin tone",
+	"🖐🏾":                "hand with fingers splayed: medium-dark skin tone",
+	"🖐🏿":                "hand with fingers splayed: dark skin tone",
+	"✋":                 "raised hand",
+	"✋🏻":                "raised hand: light skin tone",
+	"✋🏼":                "raised hand: medium-light skin tone",
+	"✋🏽":                "raised hand: medium skin tone",
+	"✋🏾":                "raised hand: medium-dark skin tone",
+	"✋🏿":                "raised hand: dark skin tone",
+	"🖖":                 "vulcan salute",
+	"🖖🏻":                "vulcan salute: light skin tone",
+	"🖖🏼":                "vulcan salute: medium-light skin tone",
+	"🖖🏽":                "vulcan salute: medium skin tone",
+	"🖖🏾":                "vulcan salute: medium-dark skin tone",
+	"🖖🏿":                "vulcan salute: dark skin tone",
+	"🫱":                 "rightwards hand",
+	"🫱🏻":                "rightwards hand: light skin tone",
+	"🫱🏼":                "rightwards hand: medium-light skin tone",
+	"🫱🏽":                "rightwards hand: medium skin tone",
+	"🫱🏾":                "rightwards hand: medium-dark skin tone",
+	"🫱🏿":                "rightwards hand: dark skin tone",
+	"🫲":                 "leftwards hand",
+	"🫲🏻":                "leftwards hand: light skin tone",
+	"🫲🏼":                "leftwards hand: medium-light skin tone",
+	"🫲🏽":                "leftwards hand: medium skin tone",
+	"🫲🏾":                "leftwards hand: medium-dark skin tone",
+	"🫲🏿":                "leftwards hand: dark skin tone",
+	"🫳":                 "palm down hand",
+	"🫳🏻":                "palm down hand: light skin tone",
+	"🫳🏼":                "palm down hand: medium-light skin tone",
+	"🫳🏽":                "palm down hand: medium skin tone",
+	"🫳🏾":                "palm down hand: medium-dark skin tone",
+	"🫳🏿":                "palm down hand: dark skin tone",
+	"🫴":                 "palm up hand",
+	"🫴🏻":                "palm up hand: light skin tone",
+	"🫴🏼":                "palm up hand: medium-light skin tone",
+	"🫴🏽":                "palm up hand: medium skin tone",
+	"🫴🏾":                "palm up hand: medium-dark skin tone",
+	"🫴🏿":                "palm up hand: dark skin tone",
+	"🫷":                 "leftwards pushing hand",
+	"🫷🏻":                "leftwards pushing hand: light skin tone",
+	"🫷🏼":                "leftwards pushing hand: medium-light skin tone",
+	"🫷🏽":                "leftwards pushing hand: medium skin tone",
+	"🫷🏾":                "leftwards pushing hand: medium-dark skin tone",
+	"🫷🏿":                "leftwards pushing hand: dark skin tone",
+	"🫸":                 "rightwards pushing hand",
+	"🫸🏻":                "rightwards pushing hand: light skin tone",
+	"🫸🏼":                "rightwards pushing hand: medium-light skin tone",
+	"🫸🏽":                "rightwards pushing hand: medium skin tone",
+	"🫸🏾":                "rightwards pushing hand: medium-dark skin tone",
+	"🫸🏿":                "rightwards pushing hand: dark skin tone",
+	"👌":                 "OK hand",
+	"👌🏻":                "OK hand: light skin tone",
+	"👌🏼":                "OK hand: medium-light skin tone",
+	"👌🏽":                "OK hand: medium skin tone",
+	"👌🏾":                "OK hand: medium-dark skin tone",
+	"👌🏿":                "OK hand: dark skin tone",
+	"🤌":                 "pinched fingers",
+	"🤌🏻":                "pinched fingers: light skin tone",
+	"🤌🏼":                "pinched fingers: medium-light skin tone",
+	"🤌🏽":                "pinched fingers: medium skin tone",
+	"🤌🏾":                "pinched fingers: medium-dark skin tone",
+	"🤌🏿":                "pinched fingers: dark skin tone",
+	"🤏":                 "pinching hand",
+	"🤏🏻":                "pinching hand: light skin tone",
+	"🤏🏼":                "pinching hand: medium-light skin tone",
+	"🤏🏽":                "pinching hand: medium skin tone",
+	"🤏🏾":                "pinching hand: medium-dark skin tone",
+	"🤏🏿":                "pinching hand: dark skin tone",
+	"✌️":                "victory hand",
+	"✌🏻":                "victory hand: light skin tone",
+	"✌🏼":                "victory hand: medium-light skin tone",
+	"✌🏽":                "victory hand: medium skin tone",
+	"✌🏾":                "victory hand: medium-dark skin tone",
+	"✌🏿":                "victory hand: dark skin tone",
+	"🤞":                 "crossed fingers",
+	"🤞🏻":                "crossed fingers: light skin tone",
+	"🤞🏼":                "crossed fingers: medium-light skin tone",
+	"🤞🏽":                "crossed fingers: medium skin tone",
+	"🤞🏾":                "crossed fingers: medium-dark skin tone",
+	"🤞🏿":                "crossed fingers: dark skin tone",
+	"🫰":                 "hand with index finger and thumb crossed",
+	"🫰🏻":                "hand with index finger and thumb crossed: light skin tone",
+	"🫰🏼":                "hand with index finger and thumb crossed: medium-light skin tone",
+	"🫰🏽":                "hand with index finger and thumb crossed: medium skin tone",
+	"🫰🏾":                "hand with index finger and thumb crossed: medium-dark skin tone",
+	"🫰🏿":                "hand with index finger and thumb crossed: dark skin tone",
+	"🤟":                 "love-you gesture",
+	"🤟🏻":                "love-you gesture: light skin tone",
+	"🤟🏼":                "love-you gesture: medium-light skin tone",
+	"🤟🏽":                "love-you gesture: medium skin tone",
+	"🤟🏾":                "love-you gesture: medium-dark skin tone",
+	"🤟🏿":                "love-you gesture: dark skin tone",
+	"🤘":                 "sign of the horns",
+	"🤘🏻":                "sign of the horns: light skin tone",
+	"🤘🏼":                "sign of the horns: medium-light skin tone",
+	"🤘🏽":                "sign of the horns: medium skin tone",
+	"🤘🏾":                "sign of the horns: medium-dark skin tone",
+	"🤘🏿":                "sign of the horns: dark skin tone",
+	"🤙":                 "call me hand",
+	"🤙🏻":                "call me hand: light skin tone",
+	"🤙🏼":                "call me hand: medium-light skin tone",
+	"🤙🏽":                "call me hand: medium skin tone",
+	"🤙🏾":                "call me hand: medium-dark skin tone",
+	"🤙🏿":                "call me hand: dark skin tone",
+	"👈":                 "backhand index pointing left",
+	"👈🏻":                "backhand index pointing left: light skin tone",
+	"👈🏼":                "backhand index pointing left: medium-light skin tone",
+	"👈🏽":                "backhand index pointing left: medium skin tone",
+	"👈🏾":                "backhand index pointing left: medium-dark skin tone",
+	"👈🏿":                "backhand index pointing left: dark skin tone",
+	"👉":                 "backhand index pointing right",
+	"👉🏻":                "backhand index pointing right: light skin tone",
+	"👉🏼":                "backhand index pointing right: medium-light skin tone",
+	"👉🏽":                "backhand index pointing right: medium skin tone",
+	"👉🏾":                "backhand index pointing right: medium-dark skin tone",
+	"👉🏿":                "backhand index pointing right: dark skin tone",
+	"👆":                 "backhand index pointing up",
+	"👆🏻":                "backhand index pointing up: light skin tone",
+	"👆🏼":                "backhand index pointing up: medium-light skin tone",
+	"👆🏽":                "backhand index pointing up: medium skin tone",
+	"👆🏾":                "backhand index pointing up: medium-dark skin tone",
+	"👆🏿":                "backhand index pointing up: dark skin tone",
+	"🖕":                 "middle finger",
+	"🖕🏻":                "middle finger: light skin tone",
+	"🖕🏼":                "middle finger: medium-light skin tone",
+	"🖕🏽":                "middle finger: medium skin tone",
+	"🖕🏾":                "middle finger: medium-dark skin tone",
+	"🖕🏿":                "middle finger: dark skin tone",
+	"👇":                 "backhand index pointing down",
+	"👇🏻":                "backhand index pointing down: light skin tone",
+	"👇🏼":                "backhand index pointing down: medium-light skin tone",
+	"👇🏽":                "backhand index pointing down: medium skin tone",
+	"👇🏾":                "backhand index pointing down: medium-dark skin tone",
+	"👇🏿":                "backhand index pointing down: dark skin tone",
+	"☝️":                "index pointing up",
+	"☝🏻":                "index pointing up: light skin tone",
+	"☝🏼":                "index pointing up: medium-light skin tone",
+	"☝🏽":                "index pointing up: medium skin tone",
+	"☝🏾":                "index pointing up: medium-dark skin tone",
+	"☝🏿":                "index pointing up: dark skin tone",
+	"🫵":                 "index pointing at the viewer",
+	"🫵🏻":                "index pointing at the viewer: light skin tone",
+	"🫵🏼":                "index pointing at the viewer: medium-light skin tone",
+	"🫵🏽":                "index pointing at the viewer: medium skin tone",
+	"🫵🏾":                "index pointing at the viewer: medium-dark skin tone",
+	"🫵🏿":                "index pointing at the viewer: dark skin tone",
+	"👍":                 "thumbs up",
+	"👍🏻":                "thumbs up: light skin tone",
+	"👍🏼":                "thumbs up: medium-light skin tone",
+	"👍🏽":                "thumbs up: medium skin tone",
+	"👍🏾":                "thumbs up: medium-dark skin tone",
+	"👍🏿":                "thumbs up: dark skin tone",
+	"👎":                 "thumbs down",
+	"👎🏻":                "thumbs down: light skin tone",
+	"👎🏼":                "thumbs down: medium-light skin tone",
+	"👎🏽":                "thumbs down: medium skin tone",
+	"👎🏾":                "thumbs down: medium-dark skin tone",
+	"👎🏿":                "thumbs down: dark skin tone",
+	"✊":                 "raised fist",
+	"✊🏻":                "raised fist: light skin tone",
+	"✊🏼":                "raised fist: medium-light skin tone",
+	"✊🏽":                "raised fist: medium skin tone",
+	"✊🏾":                "raised fist: medium-dark skin tone",
+	"✊🏿":                "raised fist: dark skin tone",
+	"👊":                 "oncoming fist",
+	"👊🏻":                "oncoming fist: light skin tone",
+	"👊🏼":                "oncoming fist: medium-light skin tone",
+	"👊🏽":                "oncoming fist: medium skin tone",
+	"👊🏾":                "oncoming fist: medium-dark skin tone",
+	"👊🏿":                "oncoming fist: dark skin tone",
+	"🤛":                 "left-facing fist",
+	"🤛🏻":                "left-facing fist: light skin tone",
+	"🤛🏼":                "left-facing fist: medium-light skin tone",
+	"🤛🏽":                "left-facing fist: medium skin tone",
+	"🤛🏾":                "left-facing fist: medium-dark skin tone",
+	"🤛🏿":                "left-facing fist: dark skin tone",
+	"🤜":                 "right-facing fist",
+	"🤜🏻":                "right-facing fist: light skin tone",
+	"🤜🏼":                "right-facing fist: medium-light skin tone",
+	"🤜🏽":                "right-facing fist: medium skin tone",
+	"🤜🏾":                "right-facing fist: medium-dark skin tone",
+	"🤜🏿":                "right-facing fist: dark skin tone",
+	"👏":                 "clapping hands",
+	"👏🏻":                "clapping hands: light skin tone",
+	"👏🏼":                "clapping hands: medium-light skin tone",
+	"👏🏽":                "clapping hands: medium skin tone",
+	"👏🏾":                "clapping hands: medium-dark skin tone",
+	"👏🏿":                "clapping hands: dark skin tone",
+	"🙌":                 "raising hands",
+	"🙌🏻":                "raising hands: light skin tone",
+	"🙌🏼":                "raising hands: medium-light skin tone",
+	"🙌🏽":                "raising hands: medium skin tone",
+	"🙌🏾":                "raising hands: medium-dark skin tone",
+	"🙌🏿":                "raising hands: dark skin tone",
+	"🫶":                 "heart hands",
+	"🫶🏻":                "heart hands: light skin tone",
+	"🫶🏼":                "heart hands: medium-light skin tone",
+	"🫶🏽":                "heart hands: medium skin tone",
+	"🫶🏾":                "heart hands: medium-dark skin tone",
+	"🫶🏿":                "heart hands: dark skin tone",
+	"👐":                 "open hands",
+	"👐🏻":                "open hands: light skin tone",
+	"👐🏼":                "open hands: medium-light skin tone",
+	"👐🏽":                "open hands: medium skin tone",
+	"👐🏾":                "open hands: medium-dark skin tone",
+	"👐🏿":                "open hands: dark skin tone",
+	"🤲":                 "palms up together",
+	"🤲🏻":                "palms up together: light skin tone",
+	"🤲🏼":                "palms up together: medium-light skin tone",
+	"🤲🏽":                "palms up together: medium skin tone",
+	"🤲🏾":                "palms up together: medium-dark skin tone",
+	"🤲🏿":                "palms up together: dark skin tone",
+	"🤝":                 "handshake",
+	"🤝🏻":                "handshake: light skin tone",
+	"🤝🏼":                "handshake: medium-light skin tone",
+	"🤝🏽":                "handshake: medium skin tone",
+	"🤝🏾":                "handshake: medium-dark skin tone",
+	"🤝🏿":                "handshake: dark skin tone",
+	"🫱🏻\u200d🫲🏼":        "handshake: light skin tone, medium-light skin tone",
+	"🫱🏻\u200d🫲🏽":        "handshake: light skin tone, medium skin tone",
+	"🫱🏻\u200d🫲🏾":        "handshake: light skin tone, medium-dark skin tone",
+	"🫱🏻\u200d🫲🏿":        "handshake: light skin tone, dark skin tone",
+	"🫱🏼\u200d🫲🏻":        "handshake: medium-light skin tone, light skin tone",
+	"🫱🏼\u200d🫲🏽":        "handshake: medium-light skin tone, medium skin tone",
+	"🫱🏼\u200d🫲🏾":        "handshake: medium-light skin tone, medium-dark skin tone",
+	"🫱🏼\u200d🫲🏿":        "handshake: medium-light skin tone, dark skin tone",
+	"🫱🏽\u200d🫲🏻":        "handshake: medium skin tone, light skin tone",
+	"🫱🏽\u200d🫲🏼":        "handshake: medium skin tone, medium-light skin tone",
+	"🫱🏽\u200d🫲🏾":        "handshake: medium skin tone, medium-dark skin tone",
+	"🫱🏽\u200d🫲🏿":        "handshake: medium skin tone, dark skin tone",
+	"🫱🏾\u200d🫲🏻":        "handshake: medium-dark skin tone, light skin tone",
+	"🫱🏾\u200d🫲🏼":        "handshake: medium-dark skin tone, medium-light skin tone",
+	"🫱🏾\u200d🫲🏽":        "handshake: medium-dark skin tone, medium skin tone",
+	"🫱🏾\u200d🫲🏿":        "handshake: medium-dark skin tone, dark skin tone",
+	"🫱🏿\u200d🫲🏻":        "handshake: dark skin tone, light skin tone",
+	"🫱🏿\u200d🫲🏼":        "handshake: dark skin tone, medium-light skin tone",
+	"🫱🏿\u200d🫲🏽":        "handshake: dark skin tone, medium skin tone",
+	"🫱🏿\u200d🫲🏾":        "handshake: dark skin tone, medium-dark skin tone",
+	"🙏":                 "folded hands",
+	"🙏🏻":                "folded hands: light skin tone",
+	"🙏🏼":                "folded hands: medium-light skin tone",
+	"🙏🏽":                "folded hands: medium skin tone",
+	"🙏🏾":                "folded hands: medium-dark skin tone",
+	"🙏🏿":                "folded hands: dark skin tone",
+	"✍️":                "writing hand",
+	"✍🏻":                "writing hand: light skin tone",
+	"✍🏼":                "writing hand: medium-light skin tone",
+	"✍🏽":                "writing hand: medium skin tone",
+	"✍🏾":                "writing hand: medium-dark skin tone",
+	"✍🏿":                "writing hand: dark skin tone",
+	"💅":                 "nail polish",
+	"💅🏻":                "nail polish: light skin tone",
+	"💅🏼":                "nail polish: medium-light skin tone",
+	"💅🏽":                "nail polish: medium skin tone",
+	"💅🏾":                "nail polish: medium-dark skin tone",
+	"💅🏿":                "nail polish: dark skin tone",
+	"🤳":                 "selfie",
+	"🤳🏻":                "selfie: light skin tone",
+	"🤳🏼":                "selfie: medium-light skin tone",
+	"🤳🏽":                "selfie: medium skin tone",
+	"🤳🏾":                "selfie: medium-dark skin tone",
+	"🤳🏿":                "selfie: dark skin tone",
+	"💪":                 "flexed biceps",
+	"💪🏻":                "flexed biceps: light skin tone",
+	"💪🏼":                "flexed biceps: medium-light skin tone",
+	"💪🏽":                "flexed biceps: medium skin tone",
+	"💪🏾":                "flexed biceps: medium-dark skin tone",
+	"💪🏿":                "flexed biceps: dark skin tone",
+	"🦾":                 "mechanical arm",
+	"🦿":                 "mechanical leg",
+	"🦵":                 "leg",
+	"🦵🏻":                "leg: light skin tone",
+	"🦵🏼":                "leg: medium-light skin tone",
+	"🦵🏽":                "leg: medium skin tone",
+	"🦵🏾":                "leg: medium-dark skin tone",
+	"🦵🏿":                "leg: dark skin tone",
+	"🦶":                 "foot",
+	"🦶🏻":                "foot: light skin tone",
+	"🦶🏼":                "foot: medium-light skin tone",
+	"🦶🏽":                "foot: medium skin tone",
+	"🦶🏾":                "foot: medium-dark skin tone",
+	"🦶🏿":                "foot: dark skin tone",
+	"👂":                 "ear",
+	"👂🏻":                "ear: light skin tone",
+	"👂🏼":                "ear: medium-light skin tone",
+	"👂🏽":                "ear: medium skin tone",
+	"👂🏾":                "ear: medium-dark skin tone",
+	"👂🏿":                "ear: dark skin tone",
+	"🦻":                 "ear with hearing aid",
+	"🦻🏻":                "ear with hearing aid: light skin tone",
+	"🦻🏼":                "ear with hearing aid: medium-light skin tone",
+	"🦻🏽":                "ear with hearing aid: medium skin tone",
+	"🦻🏾":                "ear with hearing aid: medium-dark skin tone",
+	"🦻🏿":                "ear with hearing aid: dark skin tone",
+	"👃":                 "nose",
+	"👃🏻":                "nose: light skin tone",
+	"👃🏼":                "nose: medium-light skin tone",
+	"👃🏽":                "nose: medium skin tone",
+	"👃🏾":                "nose: medium-dark skin tone",
+	"👃🏿":                "nose: dark skin tone",
+	"🧠":                 "brain",
+	"🫀":                 "anatomical heart",
+	"🫁":                 "lungs",
+	"🦷":                 "tooth",
+	"🦴":                 "bone",
+	"👀":                 "eyes",
+	"👁️":                "eye",
+	"👅":                 "tongue",
+	"👄":                 "mouth",
+	"🫦":                 "biting lip",
+	"👶":                 "baby",
+	"👶🏻":                "baby: light skin tone",
+	"👶🏼":                "baby: medium-light skin tone",
+	"👶🏽":                "baby: medium skin tone",
+	"👶🏾":                "baby: medium-dark skin tone",
+	"👶🏿":                "baby: dark skin tone",
+	"🧒":                 "child",
+	"🧒🏻":                "child: light skin tone",
+	"🧒🏼":                "child: medium-light skin tone",
+	"🧒🏽":                "child: medium skin tone",
+	"🧒🏾":                "child: medium-dark skin tone",
+	"🧒🏿":                "child: dark skin tone",
+	"👦":                 "boy",
+	"👦🏻":                "boy: light skin tone",
+	"👦🏼":                "boy: medium-light skin tone",
+	"👦🏽":                "boy: medium skin tone",
+	"👦🏾":                "boy: medium-dark skin tone",
+	"👦🏿":                "boy: dark skin tone",
+	"👧":                 "girl",
+	"👧🏻":                "girl: light skin tone",
+	"👧🏼":                "girl: medium-light skin tone",
+	"👧🏽":                "girl: medium skin tone",
+	"👧🏾":                "girl: medium-dark skin tone",
+	"👧🏿":                "girl: dark skin tone",
+	"🧑":                 "person",
+	"🧑🏻":                "person: light skin tone",
+	"🧑🏼":                "person: medium-light skin tone",
+	"🧑🏽":                "person: medium skin tone",
+	"🧑🏾":                "person: medium-dark skin tone",
+	"🧑🏿":                "person: dark skin tone",
+	"👱":                 "person: blond hair",
+	"👱🏻":                "person: light skin tone, blond hair",
+	"👱🏼":                "person: medium-light skin tone, blond hair",
+	"👱🏽":                "person: medium skin tone, blond hair",
+	"👱🏾":                "person: medium-dark skin tone, blond hair",
+	"👱🏿":                "person: dark skin tone, blond hair",
+	"👨":                 "man",
+	"👨🏻":                "man: light skin tone",
+	"👨🏼":                "man: medium-light skin tone",
+	"👨🏽":                "man: medium skin tone",
+	"👨🏾":                "man: medium-dark skin tone",
+	"👨🏿":                "man: dark skin tone",
+	"🧔":                 "person: beard",
+	"🧔🏻":                "person: light skin tone, beard",
+	"🧔🏼":                "person: medium-light skin tone, beard",
+	"🧔🏽":                "person: medium skin tone, beard",
+	"🧔🏾":                "person: medium-dark skin tone, beard",
+	"🧔🏿":                "person: dark skin tone, beard",
+	"🧔\u200d♂️":         "man: beard",
+	"🧔🏻\u200d♂️":        "man: light skin tone, beard",
+	"🧔🏼\u200d♂️":        "man: medium-light skin tone, beard",
+	"🧔🏽\u200d♂️":        "man: medium skin tone, beard",
+	"🧔🏾\u200d♂️":        "man: medium-dark skin tone, beard",
+	"🧔🏿\u200d♂️":        "man: dark skin tone, beard",
+	"🧔\u200d♀️":         "woman: beard",
+	"🧔🏻\u200d♀️":        "woman: light skin tone, beard",
+	"🧔🏼\u200d♀️":        "woman: medium-light skin tone, beard",
+	"🧔🏽\u200d♀️":        "woman: medium skin tone, beard",
+	"🧔🏾\u200d♀️":        "woman: medium-dark skin tone, beard",
+	"🧔🏿\u200d♀️":        "woman: dark skin tone, beard",
+	"👨\u200d🦰":          "man: red hair",
+	"👨🏻\u200d🦰":         "man: light skin tone, red hair",
+	"👨🏼\u200d🦰":         "man: medium-light skin tone, red hair",
+	"👨🏽\u200d🦰":         "man: medium skin tone, red hair",
+	"👨🏾\u200d🦰":         "man: medium-dark skin tone, red hair",
+	"👨🏿\u200d🦰":         "man: dark skin tone, red hair",
+	"👨\u200d🦱":          "man: curly hair",
+	"👨🏻\u200d🦱":         "man: light skin tone, curly hair",
+	"👨🏼\u200d🦱":         "man: medium-light skin tone, curly hair",
+	"👨🏽\u200d🦱":         "man: medium skin tone, curly hair",
+	"👨🏾\u200d🦱":         "man: medium-dark skin tone, curly hair",
+	"👨🏿\u200d🦱":         "man: dark skin tone, curly hair",
+	"👨\u200d🦳":          "man: white hair",
+	"👨🏻\u200d🦳":         "man: light skin tone, white hair",
+	"👨🏼\u200d🦳":         "man: medium-light skin tone, white hair",
+	"👨🏽\u200d🦳":         "man: medium skin tone, white hair",
+	"👨🏾\u200d🦳":         "man: medium-dark skin tone, white hair",
+	"👨🏿\u200d🦳":         "man: dark skin tone, white hair",
+	"👨\u200d🦲":          "man: bald",
+	"👨🏻\u200d🦲":         "man: light skin tone, bald",
+	"👨🏼\u200d🦲":         "man: medium-light skin tone, bald",
+	"👨🏽\u200d🦲":         "man: medium skin tone, bald",
+	"👨🏾\u200d🦲":         "man: medium-dark skin tone, bald",
+	"👨🏿\u200d🦲":         "man: dark skin tone, bald",
+	"👩":                 "woman",
+	"👩🏻":                "woman: light skin tone",
+	"👩🏼":                "woman: medium-light skin tone",
+	"👩🏽":                "woman: medium skin tone",
+	"👩🏾":                "woman: medium-dark skin tone",
+	"👩🏿":                "woman: dark skin tone",
+	"👩\u200d🦰":          "woman: red hair",
+	"👩🏻\u200d🦰":         "woman: light skin tone, red hair",
+	"👩🏼\u200d🦰":         "woman: medium-light skin tone, red hair",
+	"👩🏽\u200d🦰":         "woman: medium skin tone, red hair",
+	"👩🏾\u200d🦰":         "woman: medium-dark skin tone, red hair",
+	"👩🏿\u200d🦰":         "woman: dark skin tone, red hair",
+	"🧑\u200d🦰":          "person: red hair",
+	"🧑🏻\u200d🦰":         "person: light skin tone, red hair",
+	"🧑🏼\u200d🦰":         "person: medium-light skin tone, red hair",
+	"🧑🏽\u200d🦰":         "person: medium skin tone, red hair",
+	"🧑🏾\u200d🦰":         "person: medium-dark skin tone, red hair",
+	"🧑🏿\u200d🦰":         "person: dark skin tone, red hair",
+	"👩\u200d🦱":          "woman: curly hair",
+	"👩🏻\u200d🦱":         "woman: light skin tone, curly hair",
+	"👩🏼\u200d🦱":         "woman: medium-light skin tone, curly hair",
+	"👩🏽\u200d🦱":         "woman: medium skin tone, curly hair",
+	"👩🏾\u200d🦱":         "woman: medium-dark skin tone, curly hair",
+	"👩🏿\u200d🦱":         "woman: dark skin tone, curly hair",
+	"🧑\u200d🦱":          "person: curly hair",
+	"🧑🏻\u200d🦱":         "person: light skin tone, curly hair",
+	"🧑🏼\u200d🦱":         "person: medium-light skin tone, curly hair",
+	"🧑🏽\u200d🦱":         "person: medium skin tone, curly hair",
+	"🧑🏾\u200d🦱":         "person: medium-dark skin tone, curly hair",
+	"🧑🏿\u200d🦱":         "person: dark skin tone, curly hair",
+	"👩\u200d🦳":          "woman: white hair",
+	"👩🏻\u200d🦳":         "woman: light skin tone, white hair",
+	"👩🏼\u200d🦳":         "woman: medium-light skin tone, white hair",
+	"👩🏽\u200d🦳":         "woman: medium skin tone, white hair",
+	"👩🏾\u200d🦳":         "woman: medium-dark skin tone, white hair",
+	"👩🏿\u200d🦳":         "woman: dark skin tone, white hair",
+	"🧑\u200d🦳":          "person: white hair",
+	"🧑🏻\u200d🦳":         "person: light skin tone, white hair",
+	"🧑🏼\u200d🦳":         "person: medium-light skin tone, white hair",
+	"🧑🏽\u200d🦳":         "person: medium skin tone, white hair",
+	"🧑🏾\u200d🦳":         "person: medium-dark skin tone, white hair",
+	"🧑🏿\u200d🦳":         "person: dark skin tone, white hair",
+	"👩\u200d🦲":          "woman: bald",
+	"👩🏻\u200d🦲":         "woman: light skin tone, bald",
+	"👩🏼\u200d🦲":         "woman: medium-light skin tone, bald",
+	"👩🏽\u200d🦲":         "woman: medium skin tone, bald",
+	"👩🏾\u200d🦲":         "woman: medium-dark skin tone, bald",
+	"👩🏿\u200d🦲":         "woman: dark skin tone, bald",
+	"🧑\u200d🦲":          "person: bald",
+	"🧑🏻\u200d🦲":         "person: light skin tone, bald",
+	"🧑🏼\u200d🦲":         "person: medium-light skin tone, bald",
+	"🧑🏽\u200d🦲":         "person: medium skin tone, bald",
+	"🧑🏾\u200d🦲":         "person: medium-dark skin tone, bald",
+	"🧑🏿\u200d🦲":         "person: dark skin tone, bald",
+	"👱\u200d♀️":         "woman: blond hair",
+	"👱🏻\u200d♀️":        "woman: light skin tone, blond hair",
+	"👱🏼\u200d♀️":        "woman: medium-light skin tone, blond hair",
+	"👱🏽\u200d♀️":        "woman: medium skin tone, blond hair",
+	"👱🏾\u200d♀️":        "woman: medium-dark skin tone, blond hair",
+	"👱🏿\u200d♀️":        "woman: dark skin tone, blond hair",
+	"👱\u200d♂️":         "man: blond hair",
+	"👱🏻\u200d♂️":        "man: light skin tone, blond hair",
+	"👱🏼\u200d♂️":        "man: medium-light skin tone, blond hair",
+	"👱🏽\u200d♂️":        "man: medium skin tone, blond hair",
+	"👱🏾\u200d♂️":        "man: medium-dark skin tone, blond hair",
+	"👱🏿\u200d♂️":        "man: dark skin tone, blond hair",
+	"🧓":                 "older person",
+	"🧓🏻":                "older person: light skin tone",
+	"🧓🏼":                "older person: medium-light skin tone",
+	"🧓🏽":                "older person: medium skin tone",
+	"🧓🏾":                "older person: medium-dark skin tone",
+	"🧓🏿":                "older person: dark skin tone",
+	"👴":                 "old man",
+	"👴🏻":                "old man: light skin tone",
+	"👴🏼":                "old man: medium-light skin tone",
+	"👴🏽":                "old man: medium skin tone",
+	"👴🏾":                "old man: medium-dark skin tone",
+	"👴🏿":                "old man: dark skin tone",
+	"👵":                 "old woman",
+	"👵🏻":                "old woman: light skin tone",
+	"👵🏼":                "old woman: medium-light skin tone",
+	"👵🏽":                "old woman: medium skin tone",
+	"👵🏾":                "old woman: medium-dark skin tone",
+	"👵🏿":                "old woman: dark skin tone",
+	"🙍":                 "person frowning",
+	"🙍🏻":                "person frowning: light skin tone",
+	"🙍🏼":                "person frowning: medium-light skin tone",
+	"🙍🏽":                "person frowning: medium skin tone",
+	"🙍🏾":                "person frowning: medium-dark skin tone",
+	"🙍🏿":                "person frowning: dark skin tone",
+	"🙍\u200d♂️":         "man frowning",
+	"🙍🏻\u200d♂️":        "man frowning: light skin tone",
+	"🙍🏼\u200d♂️":        "man frowning: medium-light skin tone",
+	"🙍🏽\u200d♂️":        "man frowning: medium skin tone",
+	"🙍🏾\u200d♂️":        "man frowning: medium-dark skin tone",
+	"🙍🏿\u200d♂️":        "man frowning: dark skin tone",
+	"🙍\u200d♀️":         "woman frowning",
+	"🙍🏻\u200d♀️":        "woman frowning: light skin tone",
+	"🙍🏼\u200d♀️":        "woman frowning: medium-light skin tone",
+	"🙍🏽\u200d♀️":        "woman frowning: medium skin tone",
+	"🙍🏾\u200d♀️":        "woman frowning: medium-dark skin tone",
+	"🙍🏿\u200d♀️":        "woman frowning: dark skin tone",
+	"🙎":                 "person pouting",
+	"🙎🏻":                "person pouting: light skin tone",
+	"🙎🏼":                "person pouting: medium-light skin tone",
+	"🙎🏽":                "person pouting: medium skin tone",
+	"🙎🏾":                "person pouting: medium-dark skin tone",
+	"🙎🏿":                "person pouting: dark skin tone",
+	"🙎\u200d♂️":         "man pouting",
+	"🙎🏻\u200d♂️":        "man pouting: light skin tone",
+	"🙎🏼\u200d♂️":        "man pouting: medium-light skin tone",
+	"🙎🏽\u200d♂️":        "man pouting: medium skin tone",
+	"🙎🏾\u200d♂️":        "man pouting: medium-dark skin tone",
+	"🙎🏿\u200d♂️":        "man pouting: dark skin tone",
+	"🙎\u200d♀️":         "woman pouting",
+	"🙎🏻\u200d♀️":        "woman pouting: light skin tone",
+	"🙎🏼\u200d♀️":        "woman pouting: medium-light skin tone",
+	"🙎🏽\u200d♀️":        "woman pouting: medium skin tone",
+	"🙎🏾\u200d♀️":        "woman pouting: medium-dark skin tone",
+	"🙎🏿\u200d♀️":        "woman pouting: dark skin tone",
+	"🙅":                 "person gesturing NO",
+	"🙅🏻":                "person gesturing NO: light skin tone",
+	"🙅🏼":                "person gesturing NO: medium-light skin tone",
+	"🙅🏽":                "person gesturing NO: medium skin tone",
+	"🙅🏾":                "person gesturing NO: medium-dark skin tone",
+	"🙅🏿":                "person gesturing NO: dark skin tone",
+	"🙅\u200d♂️":         "man gesturing NO",
+	"🙅🏻\u200d♂️":        "man gesturing NO: light skin tone",
+	"🙅🏼\u200d♂️":        "man gesturing NO: medium-light skin tone",
+	"🙅🏽\u200d♂️":        "man gesturing NO: medium skin tone",
+	"🙅🏾\u200d♂️":        "man gesturing NO: medium-dark skin tone",
+	"🙅🏿\u200d♂️":        "man gesturing NO: dark skin tone",
+	"🙅\u200d♀️":         "woman gesturing NO",
+	"🙅🏻\u200d♀️":        "woman gesturing NO: light skin tone",
+	"🙅🏼\u200d♀️":        "woman gesturing NO: medium-light skin tone",
+	"🙅🏽\u200d♀️":        "woman gesturing NO: medium skin tone",
+	"🙅🏾\u200d♀️":        "woman gesturing NO: medium-dark skin tone",
+	"🙅🏿\u200d♀️":        "woman gesturing NO: dark skin tone",
+	"🙆":                 "person gesturing OK",
+	"🙆🏻":                "person gesturing OK: light skin tone",
+	"🙆🏼":                "person gesturing OK: medium-light skin tone",
+	"🙆🏽":                "person gesturing OK: medium skin tone",
+	"🙆🏾":                "person gesturing OK: medium-dark skin tone",
+	"🙆🏿":                "person gesturing OK: dark skin tone",
+	"🙆\u200d♂️":         "man gesturing OK",
+	"🙆🏻\u200d♂️":        "man gesturing OK: light skin tone",
+	"🙆🏼\u200d♂️":        "man gesturing OK: medium-light skin tone",
+	"🙆🏽\u200d♂️":        "man gesturing OK: medium skin tone",
+	"🙆🏾\u200d♂️":        "man gesturing OK: medium-dark skin tone",
+	"🙆🏿\u200d♂️":        "man gesturing OK: dark skin tone",
+	"🙆\u200d♀️":         "woman gesturing OK",
+	"🙆🏻\u200d♀️":        "woman gesturing OK: light skin tone",
+	"🙆🏼\u200d♀️":        "woman gesturing OK: medium-light skin tone",
+	"🙆🏽\u200d♀️":        "woman gesturing OK: medium skin tone",
+	"🙆🏾\u200d♀️":        "woman gesturing OK: medium-dark skin tone",
+	"🙆🏿\u200d♀️":        "woman gesturing OK: dark skin tone",
+	"💁":                 "person tipping hand",
+	"💁🏻":                "person tipping hand: light skin tone",
+	"💁🏼":                "person tipping hand: medium-light skin tone",
+	"💁🏽":                "person tipping hand: medium skin tone",
+	"💁🏾":                "person tipping hand: medium-dark skin tone",
+	"💁🏿":                "person tipping hand: dark skin tone",
+	"💁\u200d♂️":         "man tipping hand",
+	"💁🏻\u200d♂️":        "man tipping hand: light skin tone",
+	"💁🏼\u200d♂️":        "man tipping hand: medium-light skin tone",
+	"💁🏽\u200d♂️":        "man tipping hand: medium skin tone",
+	"💁🏾\u200d♂️":        "man tipping hand: medium-dark skin tone",
+	"💁🏿\u200d♂️":        "man tipping hand: dark skin tone",
+	"💁\u200d♀️":         "woman tipping hand",
+	"💁🏻\u200d♀️":        "woman tipping hand: light skin tone",
+	"💁🏼\u200d♀️":        "woman tipping hand: medium-light skin tone",
+	"💁🏽\u200d♀️":        "woman tipping hand: medium skin tone",
+	"💁🏾\u200d♀️":        "woman tipping hand: medium-dark skin tone",
+	"💁🏿\u200d♀️":        "woman tipping hand: dark skin tone",
+	"🙋":                 "person raising hand",
+	"🙋🏻":                "person raising hand: light skin tone",
+	"🙋🏼":                "person raising hand: medium-light skin tone",
+	"🙋🏽":                "person raising hand: medium skin tone",
+	"🙋🏾":                "person raising hand: medium-dark skin tone",
+	"🙋🏿":                "person raising hand: dark skin tone",
+	"🙋\u200d♂️":         "man raising hand",
+	"🙋🏻\u200d♂️":        "man raising hand: light skin tone",
+	"🙋🏼\u200d♂️":        "man raising hand: medium-light skin tone",
+	"🙋🏽\u200d♂️":        "man raising hand: medium skin tone",
+	"🙋🏾\u200d♂️":        "man raising hand: medium-dark skin tone",
+	"🙋🏿\u200d♂️":        "man raising hand: dark skin tone",
+	"🙋\u200d♀️":         "woman raising hand",
+	"🙋🏻\u200d♀️":        "woman raising hand: light skin tone",
+	"🙋🏼\u200d♀️":        "woman raising hand: medium-light skin tone",
+	"🙋🏽\u200d♀️":        "woman raising hand: medium skin tone",
+	"🙋🏾\u200d♀️":        "woman raising hand: medium-dark skin tone",
+	"🙋🏿\u200d♀️":        "woman raising hand: dark skin tone",
+	"🧏":                 "deaf person",
+	"🧏🏻":                "deaf person: light skin tone",
+	"🧏🏼":                "deaf person: medium-light skin tone",
+	"🧏🏽":                "deaf person: medium skin tone",
+	"🧏🏾":                "deaf person: medium-dark skin tone",
+	"🧏🏿":                "deaf person: dark skin tone",
+	"🧏\u200d♂️":         "deaf man",
+	"🧏🏻\u200d♂️":        "deaf man: light skin tone",
+	"🧏🏼\u200d♂️":        "deaf man: medium-light skin tone",
+	"🧏🏽\u200d♂️":        "deaf man: medium skin tone",
+	"🧏🏾\u200d♂️":        "deaf man: medium-dark skin tone",
+	"🧏🏿\u200d♂️":        "deaf man: dark skin tone",
+	"🧏\u200d♀️":         "deaf woman",
+	"🧏🏻\u200d♀️":        "deaf woman: light skin tone",
+	"🧏🏼\u200d♀️":        "deaf woman: medium-light skin tone",
+	"🧏🏽\u200d♀️":        "deaf woman: medium skin tone",
+	"🧏🏾\u200d♀️":        "deaf woman: medium-dark skin tone",
+	"🧏🏿\u200d♀️":        "deaf woman: dark skin tone",
+	"🙇":                 "person bowing",
+	"🙇🏻":                "person bowing: light skin tone",
+	"🙇🏼":                "person bowing: medium-light skin tone",
+	"🙇🏽":                "person bowing: medium skin tone",
+	"🙇🏾":                "person bowing: medium-dark skin tone",
+	"🙇🏿":                "person bowing: dark skin tone",
+	"🙇\u200d♂️":         "man bowing",
+	"🙇🏻\u200d♂️":        "man bowing: light skin tone",
+	"🙇🏼\u200d♂️":        "man bowing: medium-light skin tone",
+	"🙇🏽\u200d♂️":        "man bowing: medium skin tone",
+	"🙇🏾\u200d♂️":        "man bowing: medium-dark skin tone",
+	"🙇🏿\u200d♂️":        "man bowing: dark skin tone",
+	"🙇\u200d♀️":         "woman bowing",
+	"🙇🏻\u200d♀️":        "woman bowing: light skin tone",
+	"🙇🏼\u200d♀️":        "woman bowing: medium-light skin tone",
+	"🙇🏽\u200d♀️":        "woman bowing: medium skin tone",
+	"🙇🏾\u200d♀️":        "woman bowing: medium-dark skin tone",
+	"🙇🏿\u200d♀️":        "woman bowing: dark skin tone",
+	"🤦":                 "person facepalming",
+	"🤦🏻":                "person facepalming: light skin tone",
+	"🤦🏼":                "person facepalming: medium-light skin tone",
+	"🤦🏽":                "person facepalming: medium skin tone",
+	"🤦🏾":                "person facepalming: medium-dark skin tone",
+	"🤦🏿":                "person facepalming: dark skin tone",
+	"🤦\u200d♂️":         "man facepalming",
+	"🤦🏻\u200d♂️":        "man facepalming: light skin tone",
+	"🤦🏼\u200d♂️":        "man facepalming: medium-light skin tone",
+	"🤦🏽\u200d♂️":        "man facepalming: medium skin tone",
+	"🤦🏾\u200d♂️":        "man facepalming: medium-dark skin tone",
+	"🤦🏿\u200d♂️":        "man facepalming: dark skin tone",
+	"🤦\u200d♀️":         "woman facepalming",
+	"🤦🏻\u200d♀️":        "woman facepalming: light skin tone",
+	"🤦🏼\u200d♀️":        "woman facepalming: medium-light skin tone",
+	"🤦🏽\u200d♀️":        "woman facepalming: medium skin tone",
+	"🤦🏾\u200d♀️":        "woman facepalming: medium-dark skin tone",
+	"🤦🏿\u200d♀️":        "woman facepalming: dark skin tone",
+	"🤷":                 "person shrugging",
+	"🤷🏻":                "person shrugging: light skin tone",
+	"🤷🏼":                "person shrugging: medium-light skin tone",
+	"🤷🏽":                "person shrugging: medium skin tone",
+	"🤷🏾":                "person shrugging: medium-dark skin tone",
+	"🤷🏿":                "person shrugging: dark skin tone",
+	"🤷\u200d♂️":         "man shrugging",
+	"🤷🏻\u200d♂️":        "man shrugging: light skin tone",
+	"🤷🏼\u200d♂️":        "man shrugging: medium-light skin tone",
+	"🤷🏽\u200d♂️":        "man shrugging: medium skin tone",
+	"🤷🏾\u200d♂️":        "man shrugging: medium-dark skin tone",
+	"🤷🏿\u200d♂️":        "man shrugging: dark skin tone",
+	"🤷\u200d♀️":         "woman shrugging",
+	"🤷🏻\u200d♀️":        "woman shrugging: light skin tone",
+	"🤷🏼\u200d♀️":        "woman shrugging: medium-light skin tone",
+	"🤷🏽\u200d♀️":        "woman shrugging: medium skin tone",
+	"🤷🏾\u200d♀️":        "woman shrugging: medium-dark skin tone",
+	"🤷🏿\u200d♀️":        "woman shrugging: dark skin tone",
+	"🧑\u200d⚕️":         "health worker",
+	"🧑🏻\u200d⚕️":        "health worker: light skin tone",
+	"🧑🏼\u200d⚕️":        "health worker: medium-light skin tone",
+	"🧑🏽\u200d⚕️":        "health worker: medium skin tone",
+	"🧑🏾\u200d⚕️":        "health worker: medium-dark skin tone",
+	"🧑🏿\u200d⚕️":        "health worker: dark skin tone",
+	"👨\u200d⚕️":         "man health worker",
+	"👨🏻\u200d⚕️":        "man health worker: light skin tone",
+	"👨🏼\u200d⚕️":        "man health worker: medium-light skin tone",
+	"👨🏽\u200d⚕️":        "man health worker: medium skin tone",
+	"👨🏾\u200d⚕️":        "man health worker: medium-dark skin tone",
+	"👨🏿\u200d⚕️":        "man health worker: dark skin tone",
+	"👩\u200d⚕️":         "woman health worker",
+	"👩🏻\u200d⚕️":        "woman health worker: light skin tone",
+	"👩🏼\u200d⚕️":        "woman health worker: medium-light skin tone",
+	"👩🏽\u200d⚕️":        "woman health worker: medium skin tone",
+	"👩🏾\u200d⚕️":        "woman health worker: medium-dark skin tone",
+	"👩🏿\u200d⚕️":        "woman health worker: dark skin tone",
+	"🧑\u200d🎓":          "student",
+	"🧑🏻\u200d🎓":         "student: light skin tone",
+	"🧑🏼\u200d🎓":         "student: medium-light skin tone",
+	"🧑🏽\u200d🎓":         "student: medium skin tone",
+	"🧑🏾\u200d🎓":         "student: medium-dark skin tone",
+	"🧑🏿\u200d🎓":         "student: dark skin tone",
+	"👨\u200d🎓":          "man student",
+	"👨🏻\u200d🎓":         "man student: light skin tone",
+	"👨🏼\u200d🎓":         "man student: medium-light skin tone",
+	"👨🏽\u200d🎓":         "man student: medium skin tone",
+	"👨🏾\u200d🎓":         "man student: medium-dark skin tone",
+	"👨🏿\u200d🎓":         "man student: dark skin tone",
+	"👩\u200d🎓":          "woman student",
+	"👩🏻\u200d🎓":         "woman student: light skin tone",
+	"👩🏼\u200d🎓":         "woman student: medium-light skin tone",
+	"👩🏽\u200d🎓":         "woman student: medium skin tone",
+	"👩🏾\u200d🎓":         "woman student: medium-dark skin tone",
+	"👩🏿\u200d🎓":         "woman student: dark skin tone",
+	"🧑\u200d🏫":          "teacher",
+	"🧑🏻\u200d🏫":         "teacher: light skin tone",
+	"🧑🏼\u200d🏫":         "teacher: medium-light skin tone",
+	"🧑🏽\u200d🏫":         "teacher: medium skin tone",
+	"🧑🏾\u200d🏫":         "teacher: medium-dark skin tone",
+	"🧑🏿\u200d🏫":         "teacher: dark skin tone",
+	"👨\u200d🏫":          "man teacher",
+	"👨🏻\u200d🏫":         "man teacher: light skin tone",
+	"👨🏼\u200d🏫":         "man teacher: medium-light skin tone",
+	"👨🏽\u200d🏫":         "man teacher: medium skin tone",
+	"👨🏾\u200d🏫":         "man teacher: medium-dark skin tone",
+	"👨🏿\u200d🏫":         "man teacher: dark skin tone",
+	"👩\u200d🏫":          "woman teacher",
+	"👩🏻\u200d🏫":         "woman teacher: light skin tone",
+	"👩🏼\u200d🏫":         "woman teacher: medium-light skin tone",
+	"👩🏽\u200d🏫":         "woman teacher: medium skin tone",
+	"👩🏾\u200d🏫":         "woman teacher: medium-dark skin tone",
+	"👩🏿\u200d🏫":         "woman teacher: dark skin tone",
+	"🧑\u200d⚖️":         "judge",
+	"🧑🏻\u200d⚖️":        "judge: light skin tone",
+	"🧑🏼\u200d⚖️":        "judge: medium-light skin tone",
+	"🧑🏽\u200d⚖️":        "judge: medium skin tone",
+	"🧑🏾\u200d⚖️":        "judge: medium-dark skin tone",
+	"🧑🏿\u200d⚖️":        "judge: dark skin tone",
+	"👨\u200d⚖️":         "man judge",
+	"👨🏻\u200d⚖️":        "man judge: light skin tone",
+	"👨🏼\u200d⚖️":        "man judge: medium-light skin tone",
+	"👨🏽\u200d⚖️":        "man judge: medium skin tone",
+	"👨🏾\u200d⚖️":        "man judge: medium-dark skin tone",
+	"👨🏿\u200d⚖️":        "man judge: dark skin tone",
+	"👩\u200d⚖️":         "woman judge",
+	"👩🏻\u200d⚖️":        "woman judge: light skin tone",
+	"👩🏼\u200d⚖️":        "woman judge: medium-light skin tone",
+	"👩🏽\u200d⚖️":        "woman judge: medium skin tone",
+	"👩🏾\u200d⚖️":        "woman judge: medium-dark skin tone",
+	"👩🏿\u200d⚖️":        "woman judge: dark skin tone",
+	"🧑\u200d🌾":          "farmer",
+	"🧑🏻\u200d🌾":         "farmer: light skin tone",
+	"🧑🏼\u200d🌾":         "farmer: medium-light skin tone",
+	"🧑🏽\u200d🌾":         "farmer: medium skin tone",
+	"🧑🏾\u200d🌾":         "farmer: medium-dark skin tone",
+	"🧑🏿\u200d🌾":         "farmer: dark skin tone",
+	"👨\u200d🌾":          "man farmer",
+	"👨🏻\u200d🌾":         "man farmer: light skin tone",
+	"👨🏼\u200d🌾":         "man farmer: medium-light skin tone",
+	"👨🏽\u200d🌾":         "man farmer: medium skin tone",
+	"👨🏾\u200d🌾":         "man farmer: medium-dark skin tone",
+	"👨🏿\u200d🌾":         "man farmer: dark skin tone",
+	"👩\u200d🌾":          "woman farmer",
+	"👩🏻\u200d🌾":         "woman farmer: light skin tone",
+	"👩🏼\u200d🌾":         "woman farmer: medium-light skin tone",
+	"👩🏽\u200d🌾":         "woman farmer: medium skin tone",
+	"👩🏾\u200d🌾":         "woman farmer: medium-dark skin tone",
+	"👩🏿\u200d🌾":         "woman farmer: dark skin tone",
+	"🧑\u200d🍳":          "cook",
+	"🧑🏻\u200d🍳":         "cook: light skin tone",
+	"🧑🏼\u200d🍳":         "cook: medium-light skin tone",
+	"🧑🏽\u200d🍳":         "cook: medium skin tone",
+	"🧑🏾\u200d🍳":         "cook: medium-dark skin tone",
+	"🧑🏿\u200d🍳":         "cook: dark skin tone",
+	"👨\u200d🍳":          "man cook",
+	"👨🏻\u200d🍳":         "man cook: light skin tone",
+	"👨🏼\u200d🍳":         "man cook: medium-light skin tone",
+	"👨🏽\u200d🍳":         "man cook: medium skin tone",
+	"👨🏾\u200d🍳":         "man cook: medium-dark skin tone",
+	"👨🏿\u200d🍳":         "man cook: dark skin tone",
+	"👩\u200d🍳":          "woman cook",
+	"👩🏻\u200d🍳":         "woman cook: light skin tone",
+	"👩🏼\u200d🍳":         "woman cook: medium-light skin tone",
+	"👩🏽\u200d🍳":         "woman cook: medium skin tone",
+	"👩🏾\u200d🍳":         "woman cook: medium-dark skin tone",
+	"👩🏿\u200d🍳":         "woman cook: dark skin tone",
+	"🧑\u200d🔧":          "mechanic",
+	"🧑🏻\u200d🔧":         "mechanic: light skin tone",
+	"🧑🏼\u200d🔧":         "mechanic: medium-light skin tone",
+	"🧑🏽\u200d🔧":         "mechanic: medium skin tone",
+	"🧑🏾\u200d🔧":         "mechanic: medium-dark skin tone",
+	"🧑🏿\u200d🔧":         "mechanic: dark skin tone",
+	"👨\u200d🔧":          "man mechanic",
+	"👨🏻\u200d🔧":         "man mechanic: light skin tone",
+	"👨🏼\u200d🔧":         "man mechanic: medium-light skin tone",
+	"👨🏽\u200d🔧":         "man mechanic: medium skin tone",
+	"👨🏾\u200d🔧":         "man mechanic: medium-dark skin tone",
+	"👨🏿\u200d🔧":         "man mechanic: dark skin tone",
+	"👩\u200d🔧":          "woman mechanic",
+	"👩🏻\u200d🔧":         "woman mechanic: light skin tone",
+	"👩🏼\u200d🔧":         "woman mechanic: medium-light skin tone",
+	"👩🏽\u200d🔧":         "woman mechanic: medium skin tone",
+	"👩🏾\u200d🔧":         "woman mechanic: medium-dark skin tone",
+	"👩🏿\u200d🔧":         "woman mechanic: dark skin tone",
+	"🧑\u200d🏭":          "factory worker",
+	"🧑🏻\u200d🏭":         "factory worker: light skin tone",
+	"🧑🏼\u200d🏭":         "factory worker: medium-light skin tone",
+	"🧑🏽\u200d🏭":         "factory worker: medium skin tone",
+	"🧑🏾\u200d🏭":         "factory worker: medium-dark skin tone",
+	"🧑🏿\u200d🏭":         "factory worker: dark skin tone",
+	"👨\u200d🏭":          "man factory worker",
+	"👨🏻\u200d🏭":         "man factory worker: light skin tone",
+	"👨🏼\u200d🏭":         "man factory worker: medium-light skin tone",
+	"👨🏽\u200d🏭":         "man factory worker: medium skin tone",
+	"👨🏾\u200d🏭":         "man factory worker: medium-dark skin tone",
+	"👨🏿\u200d🏭":         "man factory worker: dark skin tone",
+	"👩\u200d🏭":          "woman factory worker",
+	"👩🏻\u200d🏭":         "woman factory worker: light skin tone",
+	"👩🏼\u200d🏭":         "woman factory worker: medium-light skin tone",
+	"👩🏽\u200d🏭":         "woman factory worker: medium skin tone",
+	"👩🏾\u200d🏭":         "woman factory worker: medium-dark skin tone",
+	"👩🏿\u200d🏭":         "woman factory worker: dark skin tone",
+	"🧑\u200d💼":          "office worker",
+	"🧑🏻\u200d💼":         "office worker: light skin tone",
+	"🧑🏼\u200d💼":         "office worker: medium-light skin tone",
+	"🧑🏽\u200d💼":         "office worker: medium skin tone",
+	"🧑🏾\u200d💼":         "office worker: medium-dark skin tone",
+	"🧑🏿\u200d💼":         "office worker: dark skin tone",
+	"👨\u200d💼":          "man office worker",
+	"👨🏻\u200d💼":         "man office worker: light skin tone",
+	"👨🏼\u200d💼":         "man office worker: medium-light skin tone",
+	"👨🏽\u200d💼":         "man office worker: medium skin tone",
+	"👨🏾\u200d💼":         "man office worker: medium-dark skin tone",
+	"👨🏿\u200d💼":         "man office worker: dark skin tone",
+	"👩\u200d💼":          "woman office worker",
+	"👩🏻\u200d💼":         "woman office worker: light skin tone",
+	"👩🏼\u200d💼":         "woman office worker: medium-light skin tone",
+	"👩🏽\u200d💼":         "woman office worker: medium skin tone",
+	"👩🏾\u200d💼":         "woman office worker: medium-dark skin tone",
+	"👩🏿\u200d💼":         "woman office worker: dark skin tone",
+	"🧑\u200d🔬":          "scientist",
+	"🧑🏻\u200d🔬":         "scientist: light skin tone",
+	"🧑🏼\u200d🔬":         "scientist: medium-light skin tone",
+	"🧑🏽\u200d🔬":         "scientist: medium skin tone",
+	"🧑🏾\u200d🔬":         "scientist: medium-dark skin tone",
+	"🧑🏿\u200d🔬":         "scientist: dark skin tone",
+	"👨\u200d🔬":          "man scientist",
+	"👨🏻\u200d🔬":         "man scientist: light skin tone",
+	"👨🏼\u200d🔬":         "man scientist: medium-light skin tone",
+	"👨🏽\u200d🔬":         "man scientist: medium skin tone",
+	"👨🏾\u200d🔬":         "man scientist: medium-dark skin tone",
+	"👨🏿\u200d🔬":         "man scientist: dark skin tone",
+	"👩\u200d🔬":          "woman scientist",
+	"👩🏻\u200d🔬":         "woman scientist: light skin tone",
+	"👩🏼\u200d🔬":         "woman scientist: medium-light skin tone",
+	"👩🏽\u200d🔬":         "woman scientist: medium skin tone",
+	"👩🏾\u200d🔬":         "woman scientist: medium-dark skin tone",
+	"👩🏿\u200d🔬":         "woman scientist: dark skin tone",
+	"🧑\u200d💻":          "technologist",
+	"🧑🏻\u200d💻":         "technologist: light skin tone",
+	"🧑🏼\u200d💻":         "technologist: medium-light skin tone",
+	"🧑🏽\u200d💻":         "technologist: medium skin tone",
+	"🧑🏾\u200d💻":         "technologist: medium-dark skin tone",
+	"🧑🏿\u200d💻":         "technologist: dark skin tone",
+	"👨\u200d💻":          "man technologist",
+	"👨🏻\u200d💻":         "man technologist: light skin tone",
+	"👨🏼\u200d💻":         "man technologist: medium-light skin tone",
+	"👨🏽\u200d💻":         "man technologist: medium skin tone",
+	"👨🏾\u200d💻":         "man technologist: medium-dark skin tone",
+	"👨🏿\u200d💻":         "man technologist: dark skin tone",
+	"👩\u200d💻":          "woman technologist",
+	"👩🏻\u200d💻":         "woman technologist: light skin tone",
+	"👩🏼\u200d💻":         "woman technologist: medium-light skin tone",
+	"👩🏽\u200d💻":         "woman technologist: medium skin tone",
+	"👩🏾\u200d💻":         "woman technologist: medium-dark skin tone",
+	"👩🏿\u200d💻":         "woman technologist: dark skin tone",
+	"🧑\u200d🎤":          "singer",
+	"🧑🏻\u200d🎤":         "singer: light skin tone",
+	"🧑🏼\u200d🎤":         "singer: medium-light skin tone",
+	"🧑🏽\u200d🎤":         "singer: medium skin tone",
+	"🧑🏾\u200d🎤":         "singer: medium-dark skin tone",
+	"🧑🏿\u200d🎤":         "singer: dark skin tone",
+	"👨\u200d🎤":          "man singer",
+	"👨🏻\u200d🎤":         "man singer: light skin tone",
+	"👨🏼\u200d🎤":         "man singer: medium-light skin tone",
+	"👨🏽\u200d🎤":         "man singer: medium skin tone",
+	"👨🏾\u200d🎤":         "man singer: medium-dark skin tone",
+	"👨🏿\u200d🎤":         "man singer: dark skin tone",
+	"👩\u200d🎤":          "woman singer",
+	"👩🏻\u200d🎤":         "woman singer: light skin tone",
+	"👩🏼\u200d🎤":         "woman singer: medium-light skin tone",
+	"👩🏽\u200d🎤":         "woman singer: medium skin tone",
+	"👩🏾\u200d🎤":         "woman singer: medium-dark skin tone",
+	"👩🏿\u200d🎤":         "woman singer: dark skin tone",
+	"🧑\u200d🎨":          "artist",
+	"🧑🏻\u200d🎨":         "artist: light skin tone",
+	"🧑🏼\u200d🎨":         "artist: medium-light skin tone",
+	"🧑🏽\u200d🎨":         "artist: medium skin tone",
+	"🧑🏾\u200d🎨":         "artist: medium-dark skin tone",
+	"🧑🏿\u200d🎨":         "artist: dark skin tone",
+	"👨\u200d🎨":          "man artist",
+	"👨🏻\u200d🎨":         "man artist: light skin tone",
+	"👨🏼\u200d🎨":         "man artist: medium-light skin tone",
+	"👨🏽\u200d🎨":         "man artist: medium skin tone",
+	"👨🏾\u200d🎨":         "man artist: medium-dark skin tone",
+	"👨🏿\u200d🎨":         "man artist: dark skin tone",
+	"👩\u200d🎨":          "woman artist",
+	"👩🏻\u200d🎨":         "woman artist: light skin tone",
+	"👩🏼\u200d🎨":         "woman artist: medium-light skin tone",
+	"👩🏽\u200d🎨":         "woman artist: medium skin tone",
+	"👩🏾\u200d🎨":         "woman artist: medium-dark skin tone",
+	"👩🏿\u200d🎨":         "woman artist: dark skin tone",
+	"🧑\u200d✈️":         "pilot",
+	"🧑🏻\u200d✈️":        "pilot: light skin tone",
+	"🧑🏼\u200d✈️":        "pilot: medium-light skin tone",
+	"🧑🏽\u200d✈️":        "pilot: medium skin tone",
+	"🧑🏾\u200d✈️":        "pilot: medium-dark skin tone",
+	"🧑🏿\u200d✈️":        "pilot: dark skin tone",
+	"👨\u200d✈️":         "man pilot",
+	"👨🏻\u200d✈️":        "man pilot: light skin tone",
+	"👨🏼\u200d✈️":        "man pilot: medium-light skin tone",
+	"👨🏽\u200d✈️":        "man pilot: medium skin tone",
+	"👨🏾\u200d✈️":        "man pilot: medium-dark skin tone",
+	"👨🏿\u200d✈️":        "man pilot: dark skin tone",
+	"👩\u200d✈️":         "woman pilot",
+	"👩🏻\u200d✈️":        "woman pilot: light skin tone",
+	"👩🏼\u200d✈️":        "woman pilot: medium-light skin tone",
+	"👩🏽\u200d✈️":        "woman pilot: medium skin tone",
+	"👩🏾\u200d✈️":        "woman pilot: medium-dark skin tone",
+	"👩🏿\u200d✈️":        "woman pilot: dark skin tone",
+	"🧑\u200d🚀":          "astronaut",
+	"🧑🏻\u200d🚀":         "astronaut: light skin tone",
+	"🧑🏼\u200d🚀":         "astronaut: medium-light skin tone",
+	"🧑🏽\u200d🚀":         "astronaut: medium skin tone",
+	"🧑🏾\u200d🚀":         "astronaut: medium-dark skin tone",
+	"🧑🏿\u200d🚀":         "astronaut: dark skin tone",
+	"👨\u200d🚀":          "man astronaut",
+	"👨🏻\u200d🚀":         "man astronaut: light skin tone",
+	"👨🏼\u200d🚀":         "man astronaut: medium-light skin tone",
+	"👨🏽\u200d🚀":         "man astronaut: medium skin tone",
+	"👨🏾\u200d🚀":         "man astronaut: medium-dark skin tone",
+	"👨🏿\u200d🚀":         "man astronaut: dark skin tone",
+	"👩\u200d🚀":          "woman astronaut",
+	"👩🏻\u200d🚀":         "woman astronaut: light skin tone",
+	"👩🏼\u200d🚀":         "woman astronaut: medium-light skin tone",
+	"👩🏽\u200d🚀":         "woman astronaut: medium skin tone",
+	"👩🏾\u200d🚀":         "woman astronaut: medium-dark skin tone",
+	"👩🏿\u200d🚀":         "woman astronaut: dark skin tone",
+	"🧑\u200d🚒":          "firefighter",
+	"🧑🏻\u200d🚒":         "firefighter: light skin tone",
+	"🧑🏼\u200d🚒":         "firefighter: medium-light skin tone",
+	"🧑🏽\u200d🚒":         "firefighter: medium skin tone",
+	"🧑🏾\u200d🚒":         "firefighter: medium-dark skin tone",
+	"🧑🏿\u200d🚒":         "firefighter: dark skin tone",
+	"👨\u200d🚒":          "man firefighter",
+	"👨🏻\u200d🚒":         "man firefighter: light skin tone",
+	"👨🏼\u200d🚒":         "man firefighter: medium-light skin tone",
+	"👨🏽\u200d🚒":         "man firefighter: medium skin tone",
+	"👨🏾\u200d🚒":         "man firefighter: medium-dark skin tone",
+	"👨🏿\u200d🚒":         "man firefighter: dark skin tone",
+	"👩\u200d🚒":          "woman firefighter",
+	"👩🏻\u200d🚒":         "woman firefighter: light skin tone",
+	"👩🏼\u200d🚒":         "woman firefighter: medium-light skin tone",
+	"👩🏽\u200d🚒":         "woman firefighter: medium skin tone",
+	"👩🏾\u200d🚒":         "woman firefighter: medium-dark skin tone",
+	"👩🏿\u200d🚒":         "woman firefighter: dark skin tone",
+	"👮":                 "police officer",
+	"👮🏻":                "police officer: light skin tone",
+	"👮🏼":                "police officer: medium-light skin tone",
+	"👮🏽":                "police officer: medium skin tone",
+	"👮🏾":                "police officer: medium-dark skin tone",
+	"👮🏿":                "police officer: dark skin tone",
+	"👮\u200d♂️":         "man police officer",
+	"👮🏻\u200d♂️":        "man police officer: light skin tone",
+	"👮🏼\u200d♂️":        "man police officer: medium-light skin tone",
+	"👮🏽\u200d♂️":        "man police officer: medium skin tone",
+	"👮🏾\u200d♂️":        "man police officer: medium-dark skin tone",
+	"👮🏿\u200d♂️":        "man police officer: dark skin tone",
+	"👮\u200d♀️":         "woman police officer",
+	"👮🏻\u200d♀️":        "woman police officer: light skin tone",
+	"👮🏼\u200d♀️":        "woman police officer: medium-light skin tone",
+	"👮🏽\u200d♀️":        "woman police officer: medium skin tone",
+	"👮🏾\u200d♀️":        "woman police officer: medium-dark skin tone",
+	"👮🏿\u200d♀️":        "woman police officer: dark skin tone",
+	"🕵️":                "detective",
+	"🕵🏻":                "detective: light skin tone",
+	"🕵🏼":                "detective: medium-light skin tone",
+	"🕵🏽":                "detective: medium skin tone",
+	"🕵🏾":                "detective: medium-dark skin tone",
+	"🕵🏿":                "detective: dark skin tone",
+	"🕵️\u200d♂️":        "man detective",
+	"🕵🏻\u200d♂️":        "man detective: light skin tone",
+	"🕵🏼\u200d♂️":        "man detective: medium-light skin tone",
+	"🕵🏽\u200d♂️":        "man detective: medium skin tone",
+	"🕵🏾\u200d♂️":        "man detective: medium-dark skin tone",
+	"🕵🏿\u200d♂️":        "man detective: dark skin tone",
+	"🕵️\u200d♀️":        "woman detective",
+	"🕵🏻\u200d♀️":        "woman detective: light skin tone",
+	"🕵🏼\u200d♀️":        "woman detective: medium-light skin tone",
+	"🕵🏽\u200d♀️":        "woman detective: medium skin tone",
+	"🕵🏾\u200d♀️":        "woman detective: medium-dark skin tone",
+	"🕵🏿\u200d♀️":        "woman detective: dark skin tone",
+	"💂":                 "guard",
+	"💂🏻":                "guard: light skin tone",
+	"💂🏼":                "guard: medium-light skin tone",
+	"💂🏽":                "guard: medium skin tone",
+	"💂🏾":                "guard: medium-dark skin tone",
+	"💂🏿":                "guard: dark skin tone",
+	"💂\u200d♂️":         "man guard",
+	"💂🏻\u200d♂️":        "man guard: light skin tone",
+	"💂🏼\u200d♂️":        "man guard: medium-light skin tone",
+	"💂🏽\u200d♂️":        "man guard: medium skin tone",
+	"💂🏾\u200d♂️":        "man guard: medium-dark skin tone",
+	"💂🏿\u200d♂️":        "man guard: dark skin tone",
+	"💂\u200d♀️":         "woman guard",
+	"💂🏻\u200d♀️":        "woman guard: light skin tone",
+	"💂🏼\u200d♀️":        "woman guard: medium-light skin tone",
+	"💂🏽\u200d♀️":        "woman guard: medium skin tone",
+	"💂🏾\u200d♀️":        "woman guard: medium-dark skin tone",
+	"💂🏿\u200d♀️":        "woman guard: dark skin tone",
+	"🥷":                 "ninja",
+	"🥷🏻":                "ninja: light skin tone",
+	"🥷🏼":                "ninja: medium-light skin tone",
+	"🥷🏽":                "ninja: medium skin tone",
+	"🥷🏾":                "ninja: medium-dark skin tone",
+	"🥷🏿":                "ninja: dark skin tone",
+	"👷":                 "construction worker",
+	"👷🏻":                "construction worker: light skin tone",
+	"👷🏼":                "construction worker: medium-light skin tone",
+	"👷🏽":                "construction worker: medium skin tone",
+	"👷🏾":                "construction worker: medium-dark skin tone",
+	"👷🏿":                "construction worker: dark skin tone",
+	"👷\u200d♂️":         "man construction worker",
+	"👷🏻\u200d♂️":        "man construction worker: light skin tone",
+	"👷🏼\u200d♂️":        "man construction worker: medium-light skin tone",
+	"👷🏽\u200d♂️":        "man construction worker: medium skin tone",
+	"👷🏾\u200d♂️":        "man construction worker: medium-dark skin tone",
+	"👷🏿\u200d♂️":        "man construction worker: dark skin tone",
+	"👷\u200d♀️":         "woman construction worker",
+	"👷🏻\u200d♀️":        "woman construction worker: light skin tone",
+	"👷🏼\u200d♀️":        "woman construction worker: medium-light skin tone",
+	"👷🏽\u200d♀️":        "woman construction worker: medium skin tone",
+	"👷🏾\u200d♀️":        "woman construction worker: medium-dark skin tone",
+	"👷🏿\u200d♀️":        "woman construction worker: dark skin tone",
+	"🫅":                 "person with crown",
+	"🫅🏻":                "person with crown: light skin tone",
+	"🫅🏼":                "person with crown: medium-light skin tone",
+	"🫅🏽":                "person with crown: medium skin tone",
+	"🫅🏾":                "person with crown: medium-dark skin tone",
+	"🫅🏿":                "person with crown: dark skin tone",
+	"🤴":                 "prince",
+	"🤴🏻":                "prince: light skin tone",
+	"🤴🏼":                "prince: medium-light skin tone",
+	"🤴🏽":                "prince: medium skin tone",
+	"🤴🏾":                "prince: medium-dark skin tone",
+	"🤴🏿":                "prince: dark skin tone",
+	"👸":                 "princess",
+	"👸🏻":                "princess: light skin tone",
+	"👸🏼":                "princess: medium-light skin tone",
+	"👸🏽":                "princess: medium skin tone",
+	"👸🏾":                "princess: medium-dark skin tone",
+	"👸🏿":                "princess: dark skin tone",
+	"👳":                 "person wearing turban",
+	"👳🏻":                "person wearing turban: light skin tone",
+	"👳🏼":                "person wearing turban: medium-light skin tone",
+	"👳🏽":                "person wearing turban: medium skin tone",
+	"👳🏾":                "person wearing turban: medium-dark skin tone",
+	"👳🏿":                "person wearing turban: dark skin tone",
+	"👳\u200d♂️":         "man wearing turban",
+	"👳🏻\u200d♂️":        "man wearing turban: light skin tone",
+	"👳🏼\u200d♂️":        "man wearing turban: medium-light skin tone",
+	"👳🏽\u200d♂️":        "man wearing turban: medium skin tone",
+	"👳🏾\u200d♂️":        "man wearing turban: medium-dark skin tone",
+	"👳🏿\u200d♂️":        "man wearing turban: dark skin tone",
+	"👳\u200d♀️":         "woman wearing turban",
+	"👳🏻\u200d♀️":        "woman wearing turban: light skin tone",
+	"👳🏼\u200d♀️":        "woman wearing turban: medium-light skin tone",
+	"👳🏽\u200d♀️":        "woman wearing turban: medium skin tone",
+	"👳🏾\u200d♀️":        "woman wearing turban: medium-dark skin tone",
+	"👳🏿\u200d♀️":        "woman wearing turban: dark skin tone",
+	"👲":                 "person with skullcap",
+	"👲🏻":                "person with skullcap: light skin tone",
+	"👲🏼":                "person with skullcap: medium-light skin tone",
+	"👲🏽":                "person with skullcap: medium skin tone",
+	"👲🏾":                "person with skullcap: medium-dark skin tone",
+	"👲🏿":                "person with skullcap: dark skin tone",
+	"🧕":                 "woman with headscarf",
+	"🧕🏻":                "woman with headscarf: light skin tone",
+	"🧕🏼":                "woman with headscarf: medium-light skin tone",
+	"🧕🏽":                "woman with headscarf: medium skin tone",
+	"🧕🏾":                "woman with headscarf: medium-dark skin tone",
+	"🧕🏿":                "woman with headscarf: dark skin tone",
+	"🤵":                 "person in tuxedo",
+	"🤵🏻":                "person in tuxedo: light skin tone",
+	"🤵🏼":                "person in tuxedo: medium-light skin tone",
+	"🤵🏽":                "person in tuxedo: medium skin tone",
+	"🤵🏾":                "person in tuxedo: medium-dark skin tone",
+	"🤵🏿":                "person in tuxedo: dark skin tone",
+	"🤵\u200d♂️":         "man in tuxedo",
+	"🤵🏻\u200d♂️":        "man in tuxedo: light skin tone",
+	"🤵🏼\u200d♂️":        "man in tuxedo: medium-light skin tone",
+	"🤵🏽\u200d♂️":        "man in tuxedo: medium skin tone",
+	"🤵🏾\u200d♂️":        "man in tuxedo: medium-dark skin tone",
+	"🤵🏿\u200d♂️":        "man in tuxedo: dark skin tone",
+	"🤵\u200d♀️":         "woman in tuxedo",
+	"🤵🏻\u200d♀️":        "woman in tuxedo: light skin tone",
+	"🤵🏼\u200d♀️":        "woman in tuxedo: medium-light skin tone",
+	"🤵🏽\u200d♀️":        "woman in tuxedo: medium skin tone",
+	"🤵🏾\u200d♀️":        "woman in tuxedo: medium-dark skin tone",
+	"🤵🏿\u200d♀️":        "woman in tuxedo: dark skin tone",
+	"👰":                 "person with veil",
+	"👰🏻":                "person with veil: light skin tone",
+	"👰🏼":                "person with veil: medium-light skin tone",
+	"👰🏽":                "person with veil: medium skin tone",
+	"👰🏾":                "person with veil: medium-dark skin tone",
+	"👰🏿":                "person with veil: dark skin tone",
+	"👰\u200d♂️":         "man with veil",
+	"👰🏻\u200d♂️":        "man with veil: light skin tone",
+	"👰🏼\u200d♂️":        "man with veil: medium-light skin tone",
+	"👰🏽\u200d♂️":        "man with veil: medium skin tone",
+	"👰🏾\u200d♂️":        "man with veil: medium-dark skin tone",
+	"👰🏿\u200d♂️":        "man with veil: dark skin tone",
+	"👰\u200d♀️":         "woman with veil",
+	"👰🏻\u200d♀️":        "woman with veil: light skin tone",
+	"👰🏼\u200d♀️":        "woman with veil: medium-light skin tone",
+	"👰🏽\u200d♀️":        "woman with veil: medium skin tone",
+	"👰🏾\u200d♀️":        "woman with veil: medium-dark skin tone",
+	"👰🏿\u200d♀️":        "woman with veil: dark skin tone",
+	"🤰":                 "pregnant woman",
+	"🤰🏻":                "pregnant woman: light skin tone",
+	"🤰🏼":                "pregnant woman: medium-light skin tone",
+	"🤰🏽":                "pregnant woman: medium skin tone",
+	"🤰🏾":                "pregnant woman: medium-dark skin tone",
+	"🤰🏿":                "pregnant woman: dark skin tone",
+	"🫃":                 "pregnant man",
+	"🫃🏻":                "pregnant man: light skin tone",
+	"🫃🏼":                "pregnant man: medium-light skin tone",
+	"🫃🏽":                "pregnant man: medium skin tone",
+	"🫃🏾":                "pregnant man: medium-dark skin tone",
+	"🫃🏿":                "pregnant man: dark skin tone",
+	"🫄":                 "pregnant person",
+	"🫄🏻":                "pregnant person: light skin tone",
+	"🫄🏼":                "pregnant person: medium-light skin tone",
+	"🫄🏽":                "pregnant person: medium skin tone",
+	"🫄🏾":                "pregnant person: medium-dark skin tone",
+	"🫄🏿":                "pregnant person: dark skin tone",
+	"🤱":                 "breast-feeding",
+	"🤱🏻":                "breast-feeding: light skin tone",
+	"🤱🏼":                "breast-feeding: medium-light skin tone",
+	"🤱🏽":                "breast-feeding: medium skin tone",
+	"🤱🏾":                "breast-feeding: medium-dark skin tone",
+	"🤱🏿":                "breast-feeding: dark skin tone",
+	"👩\u200d🍼":          "woman feeding baby",
+	"👩🏻\u200d🍼":         "woman feeding baby: light skin tone",
+	"👩🏼\u200d🍼":         "woman feeding baby: medium-light skin tone",
+	"👩🏽\u200d🍼":         "woman feeding baby: medium skin tone",
+	"👩🏾\u200d🍼":         "woman feeding baby: medium-dark skin tone",
+	"👩🏿\u200d🍼":         "woman feeding baby: dark skin tone",
+	"👨\u200d🍼":          "man feeding baby",
+	"👨🏻\u200d🍼":         "man feeding baby: light skin tone",
+	"👨🏼\u200d🍼":         "man feeding baby: medium-light skin tone",
+	"👨🏽\u200d🍼":         "man feeding baby: medium skin tone",
+	"👨🏾\u200d🍼":         "man feeding baby: medium-dark skin tone",
+	"👨🏿\u200d🍼":         "man feeding baby: dark skin tone",
+	"🧑\u200d🍼":          "person feeding baby",
+	"🧑🏻\u200d🍼":         "person feeding baby: light skin tone",
+	"🧑🏼\u200d🍼":         "person feeding baby: medium-light skin tone",
+	"🧑🏽\u200d🍼":         "person feeding baby: medium skin tone",
+	"🧑🏾\u200d🍼":         "person feeding baby: medium-dark skin tone",
+	"🧑🏿\u200d🍼":         "person feeding baby: dark skin tone",
+	"👼":                 "baby angel",
+	"👼🏻":                "baby angel: light skin tone",
+	"👼🏼":                "baby angel: medium-light skin tone",
+	"👼🏽":                "baby angel: medium skin tone",
+	"👼🏾":                "baby angel: medium-dark skin tone",
+	"👼🏿":                "baby angel: dark skin tone",
+	"🎅":                 "Santa Claus",
+	"🎅🏻":                "Santa Claus: light skin tone",
+	"🎅🏼":                "Santa Claus: medium-light skin tone",
+	"🎅🏽":                "Santa Claus: medium skin tone",
+	"🎅🏾":                "Santa Claus: medium-dark skin tone",
+	"🎅🏿":                "Santa Claus: dark skin tone",
+	"🤶":                 "Mrs. Claus",
+	"🤶🏻":                "Mrs. Claus: light skin tone",
+	"🤶🏼":                "Mrs. Claus: medium-light skin tone",
+	"🤶🏽":                "Mrs. Claus: medium skin tone",
+	"🤶🏾":                "Mrs. Claus: medium-dark skin tone",
+	"🤶🏿":                "Mrs. Claus: dark skin tone",
+	"🧑\u200d🎄":          "mx claus",
+	"🧑🏻\u200d🎄":         "mx claus: light skin tone",
+	"🧑🏼\u200d🎄":         "mx claus: medium-light skin tone",
+	"🧑🏽\u200d🎄":         "mx claus: medium skin tone",
+	"🧑🏾\u200d🎄":         "mx claus: medium-dark skin tone",
+	"🧑🏿\u200d🎄":         "mx claus: dark skin tone",
+	"🦸":                 "superhero",
+	"🦸🏻":                "superhero: light skin tone",
+	"🦸🏼":                "superhero: medium-light skin tone",
+	"🦸🏽":                "superhero: medium skin tone",
+	"🦸🏾":                "superhero: medium-dark skin tone",
+	"🦸🏿":                "superhero: dark skin tone",
+	"🦸\u200d♂️":         "man superhero",
+	"🦸🏻\u200d♂️":        "man superhero: light skin tone",
+	"🦸🏼\u200d♂️":        "man superhero: medium-light skin tone",
+	"🦸🏽\u200d♂️":        "man superhero: medium skin tone",
+	"🦸🏾\u200d♂️":        "man superhero: medium-dark skin tone",
+	"🦸🏿\u200d♂️":        "man superhero: dark skin tone",
+	"🦸\u200d♀️":         "woman superhero",
+	"🦸🏻\u200d♀️":        "woman superhero: light skin tone",
+	"🦸🏼\u200d♀️":        "woman superhero: medium-light skin tone",
+	"🦸🏽\u200d♀️":        "woman superhero: medium skin tone",
+	"🦸🏾\u200d♀️":        "woman superhero: medium-dark skin tone",
+	"🦸🏿\u200d♀️":        "woman superhero: dark skin tone",
+	"🦹":                 "supervillain",
+	"🦹🏻":                "supervillain: light skin tone",
+	"🦹🏼":                "supervillain: medium-light skin tone",
+	"🦹🏽":                "supervillain: medium skin tone",
+	"🦹🏾":                "supervillain: medium-dark skin tone",
+	"🦹🏿":                "supervillain: dark skin tone",
+	"🦹\u200d♂️":         "man supervillain",
+	"🦹🏻\u200d♂️":        "man supervillain: light skin tone",
+	"🦹🏼\u200d♂️":        "man supervillain: medium-light skin tone",
+	"🦹🏽\u200d♂️":        "man supervillain: medium skin tone",
+	"🦹🏾\u200d♂️":        "man supervillain: medium-dark skin tone",
+	"🦹🏿\u200d♂️":        "man supervillain: dark skin tone",
+	"🦹\u200d♀️":         "woman supervillain",
+	"🦹🏻\u200d♀️":        "woman supervillain: light skin tone",
+	"🦹🏼\u200d♀️":        "woman supervillain: medium-light skin tone",
+	"🦹🏽\u200d♀️":        "woman supervillain: medium skin tone",
+	"🦹🏾\u200d♀️":        "woman supervillain: medium-dark skin tone",
+	"🦹🏿\u200d♀️":        "woman supervillain: dark skin tone",
+	"🧙":                 "mage",
+	"🧙🏻":                "mage: light skin tone",
+	"🧙🏼":                "mage: medium-light skin tone",
+	"🧙🏽":                "mage: medium skin tone",
+	"🧙🏾":                "mage: medium-dark skin tone",
+	"🧙🏿":                "mage: dark skin tone",
+	"🧙\u200d♂️":         "man mage",
+	"🧙🏻\u200d♂️":        "man mage: light skin tone",
+	"🧙🏼\u200d♂️":        "man mage: medium-light skin tone",
+	"🧙🏽\u200d♂️":        "man mage: medium skin tone",
+	"🧙🏾\u200d♂️":        "man mage: medium-dark skin tone",
+	"🧙🏿\u200d♂️":        "man mage: dark skin tone",
+	"🧙\u200d♀️":         "woman mage",
+	"🧙🏻\u200d♀️":        "woman mage: light skin tone",
+	"🧙🏼\u200d♀️":        "woman mage: medium-light skin tone",
+	"🧙🏽\u200d♀️":        "woman mage: medium skin tone",
+	"🧙🏾\u200d♀️":        "woman mage: medium-dark skin tone",
+	"🧙🏿\u200d♀️":        "woman mage: dark skin tone",
+	"🧚":                 "fairy",
+	"🧚🏻":                "fairy: light skin tone",
+	"🧚🏼":                "fairy: medium-light skin tone",
+	"🧚🏽":                "fairy: medium skin tone",
+	"🧚🏾":                "fairy: medium-dark skin tone",
+	"🧚🏿":                "fairy: dark skin tone",
+	"🧚\u200d♂️":         "man fairy",
+	"🧚🏻\u200d♂️":        "man fairy: light skin tone",
+	"🧚🏼\u200d♂️":        "man fairy: medium-light skin tone",
+	"🧚🏽\u200d♂️":        "man fairy: medium skin tone",
+	"🧚🏾\u200d♂️":        "man fairy: medium-dark skin tone",
+	"🧚🏿\u200d♂️":        "man fairy: dark skin tone",
+	"🧚\u200d♀️":         "woman fairy",
+	"🧚🏻\u200d♀️":        "woman fairy: light skin tone",
+	"🧚🏼\u200d♀️":        "woman fairy: medium-light skin tone",
+	"🧚🏽\u200d♀️":        "woman fairy: medium skin tone",
+	"🧚🏾\u200d♀️":        "woman fairy: medium-dark skin tone",
+	"🧚🏿\u200d♀️":        "woman fairy: dark skin tone",
+	"🧛":                 "vampire",
+	"🧛🏻":                "vampire: light skin tone",
+	"🧛🏼":                "vampire: medium-light skin tone",
+	"🧛🏽":                "vampire: medium skin tone",
+	"🧛🏾":                "vampire: medium-dark skin tone",
+	"🧛🏿":                "vampire: dark skin tone",
+	"🧛\u200d♂️":         "man vampire",
+	"🧛🏻\u200d♂️":        "man vampire: light skin tone",
+	"🧛🏼\u200d♂️":        "man vampire: medium-light skin tone",
+	"🧛🏽\u200d♂️":        "man vampire: medium skin tone",
+	"🧛🏾\u200d♂️":        "man vampire: medium-dark skin tone",
+	"🧛🏿\u200d♂️":        "man vampire: dark skin tone",
+	"🧛\u200d♀️":         "woman vampire",
+	"🧛🏻\u200d♀️":        "woman vampire: light skin tone",
+	"🧛🏼\u200d♀️":        "woman vampire: medium-light skin tone",
+	"🧛🏽\u200d♀️":        "woman vampire: medium skin tone",
+	"🧛🏾\u200d♀️":        "woman vampire: medium-dark skin tone",
+	"🧛🏿\u200d♀️":        "woman vampire: dark skin tone",
+	"🧜":                 "merperson",
+	"🧜🏻":                "merperson: light skin tone",
+	"🧜🏼":                "merperson: medium-light skin tone",
+	"🧜🏽":                "merperson: medium skin tone",
+	"🧜🏾":                "merperson: medium-dark skin tone",
+	"🧜🏿":                "merperson: dark skin tone",
+	"🧜\u200d♂️":         "merman",
+	"🧜🏻\u200d♂️":        "merman: light skin tone",
+	"🧜🏼\u200d♂️":        "merman: medium-light skin tone",
+	"🧜🏽\u200d♂️":        "merman: medium skin tone",
+	"🧜🏾\u200d♂️":        "merman: medium-dark skin tone",
+	"🧜🏿\u200d♂️":        "merman: dark skin tone",
+	"🧜\u200d♀️":         "mermaid",
+	"🧜🏻\u200d♀️":        "mermaid: light skin tone",
+	"🧜🏼\u200d♀️":        "mermaid: medium-light skin tone",
+	"🧜🏽\u200d♀️":        "mermaid: medium skin tone",
+	"🧜🏾\u200d♀️":        "mermaid: medium-dark skin tone",
+	"🧜🏿\u200d♀️":        "mermaid: dark skin tone",
+	"🧝":                 "elf",
+	"🧝🏻":                "elf: light skin tone",
+	"🧝🏼":                "elf: medium-light skin tone",
+	"🧝🏽":                "elf: medium skin tone",
+	"🧝🏾":                "elf: medium-dark skin tone",
+	"🧝🏿":                "elf: dark skin tone",
+	"🧝\u200d♂️":         "man elf",
+	"🧝🏻\u200d♂️":        "man elf: light skin tone",
+	"🧝🏼\u200d♂️":        "man elf: medium-light skin tone",
+	"🧝🏽\u200d♂️":        "man elf: medium skin tone",
+	"🧝🏾\u200d♂️":        "man elf: medium-dark skin tone",
+	"🧝🏿\u200d♂️":        "man elf: dark skin tone",
+	"🧝\u200d♀️":         "woman elf",
+	"🧝🏻\u200d♀️":        "woman elf: light skin tone",
+	"🧝🏼\u200d♀️":        "woman elf: medium-light skin tone",
+	"🧝🏽\u200d♀️":        "woman elf: medium skin tone",
+	"🧝🏾\u200d♀️":        "woman elf: medium-dark skin tone",
+	"🧝🏿\u200d♀️":        "woman elf: dark skin tone",
+	"🧞":                 "genie",
+	"🧞\u200d♂️":         "man genie",
+	"🧞\u200d♀️":         "woman genie",
+	"🧟":                 "zombie",
+	"🧟\u200d♂️":         "man zombie",
+	"🧟\u200d♀️":         "woman zombie",
+	"🧌":                 "troll",
+	"💆":                 "person getting massage",
+	"💆🏻":                "person getting massage: light skin tone",
+	"💆🏼":                "person getting massage: medium-light skin tone",
+	"💆🏽":                "person getting massage: medium skin tone",
+	"💆🏾":                "person getting massage: medium-dark skin tone",
+	"💆🏿":                "person getting massage: dark skin tone",
+	"💆\u200d♂️":         "man getting massage",
+	"💆🏻\u200d♂️":        "man getting massage: light skin tone",
+	"💆🏼\u200d♂️":        "man getting massage: medium-light skin tone",
+	"💆🏽\u200d♂️":        "man getting massage: medium skin tone",
+	"💆🏾\u200d♂️":        "man getting massage: medium-dark skin tone",
+	"💆🏿\u200d♂️":        "man getting massage: dark skin tone",
+	"💆\u200d♀️":         "woman getting massage",
+	"💆🏻\u200d♀️":        "woman getting massage: light skin tone",
+	"💆🏼\u200d♀️":        "woman getting massage: medium-light skin tone",
+	"💆🏽\u200d♀️":        "woman getting massage: medium skin tone",
+	"💆🏾\u200d♀️":        "woman getting massage: medium-dark skin tone",
+	"💆🏿\u200d♀️":        "woman getting massage: dark skin tone",
+	"💇":                 "person getting haircut",
+	"💇🏻":                "person getting haircut: light skin tone",
+	"💇🏼":                "person getting haircut: medium-light skin tone",
+	"💇🏽":                "person getting haircut: medium skin tone",
+	"💇🏾":                "person getting haircut: medium-dark skin tone",
+	"💇🏿":                "person getting haircut: dark skin tone",
+	"💇\u200d♂️":         "man getting haircut",
+	"💇🏻\u200d♂️":        "man getting haircut: light skin tone",
+	"💇🏼\u200d♂️":        "man getting haircut: medium-light skin tone",
+	"💇🏽\u200d♂️":        "man getting haircut: medium skin tone",
+	"💇🏾\u200d♂️":        "man getting haircut: medium-dark skin tone",
+	"💇🏿\u200d♂️":        "man getting haircut: dark skin tone",
+	"💇\u200d♀️":         "woman getting haircut",
+	"💇🏻\u200d♀️":        "woman getting haircut: light skin tone",
+	"💇🏼\u200d♀️":        "woman getting haircut: medium-light skin tone",
+	"💇🏽\u200d♀️":        "woman getting haircut: medium skin tone",
+	"💇🏾\u200d♀️":        "woman getting haircut: medium-dark skin tone",
+	"💇🏿\u200d♀️":        "woman getting haircut: dark skin tone",
+	"🚶":                 "person walking",
+	"🚶🏻":                "person walking: light skin tone",
+	"🚶🏼":                "person walking: medium-light skin tone",
+	"🚶🏽":                "person walking: medium skin tone",
+	"🚶🏾":                "person walking: medium-dark skin tone",
+	"🚶🏿":                "person walking: dark skin tone",
+	"🚶\u200d♂️":         "man walking",
+	"🚶🏻\u200d♂️":        "man walking: light skin tone",
+	"🚶🏼\u200d♂️":        "man walking: medium-light skin tone",
+	"🚶🏽\u200d♂️":        "man walking: medium skin tone",
+	"🚶🏾\u200d♂️":        "man walking: medium-dark skin tone",
+	"🚶🏿\u200d♂️":        "man walking: dark skin tone",
+	"🚶\u200d♀️":         "woman walking",
+	"🚶🏻\u200d♀️":        "woman walking: light skin tone",
+	"🚶🏼\u200d♀️":        "woman walking: medium-light skin tone",
+	"🚶🏽\u200d♀️":        "woman walking: medium skin tone",
+	"🚶🏾\u200d♀️":        "woman walking: medium-dark skin tone",
+	"🚶🏿\u200d♀️":        "woman walking: dark skin tone",
+	"🧍":                 "person standing",
+	"🧍🏻":                "person standing: light skin tone",
+	"🧍🏼":                "person standing: medium-light skin tone",
+	"🧍🏽":                "person standing: medium skin tone",
+	"🧍🏾":                "person standing: medium-dark skin tone",
+	"🧍🏿":                "person standing: dark skin tone",
+	"🧍\u200d♂️":         "man standing",
+	"🧍🏻\u200d♂️":        "man standing: light skin tone",
+	"🧍🏼\u200d♂️":        "man standing: medium-light skin tone",
+	"🧍🏽\u200d♂️":        "man standing: medium skin tone",
+	"🧍🏾\u200d♂️":        "man standing: medium-dark skin tone",
+	"🧍🏿\u200d♂️":        "man standing: dark skin tone",
+	"🧍\u200d♀️":         "woman standing",
+	"🧍🏻\u200d♀️":        "woman standing: light skin tone",
+	"🧍🏼\u200d♀️":        "woman standing: medium-light skin tone",
+	"🧍🏽\u200d♀️":        "woman standing: medium skin tone",
+	"🧍🏾\u200d♀️":        "woman standing: medium-dark skin tone",
+	"🧍🏿\u200d♀️":        "woman standing: dark skin tone",
+	"🧎":                 "person kneeling",
+	"🧎🏻":                "person kneeling: light skin tone",
+	"🧎🏼":                "person kneeling: medium-light skin tone",
+	"🧎🏽":                "person kneeling: medium skin tone",
+	"🧎🏾":                "person kneeling: medium-dark skin tone",
+	"🧎🏿":                "person kneeling: dark skin tone",
+	"🧎\u200d♂️":         "man kneeling",
+	"🧎🏻\u200d♂️":        "man kneeling: light skin tone",
+	"🧎🏼\u200d♂️":        "man kneeling: medium-light skin tone",
+	"🧎🏽\u200d♂️":        "man kneeling: medium skin tone",
+	"🧎🏾\u200d♂️":        "man kneeling: medium-dark skin tone",
+	"🧎🏿\u200d♂️":        "man kneeling: dark skin tone",
+	"🧎\u200d♀️":         "woman kneeling",
+	"🧎🏻\u200d♀️":        "woman kneeling: light skin tone",
+	"🧎🏼\u200d♀️":        "woman kneeling: medium-light skin tone",
+	"🧎🏽\u200d♀️":        "woman kneeling: medium skin tone",
+	"🧎🏾\u200d♀️":        "woman kneeling: medium-dark skin tone",
+	"🧎🏿\u200d♀️":        "woman kneeling: dark skin tone",
+	"🧑\u200d🦯":          "person with white cane",
+	"🧑🏻\u200d🦯":         "person with white cane: light skin tone",
+	"🧑🏼\u200d🦯":         "person with white cane: medium-light skin tone",
+	"🧑🏽\u200d🦯":         "person with white cane: medium skin tone",
+	"🧑🏾\u200d🦯":         "person with white cane: medium-dark skin tone",
+	"🧑🏿\u200d🦯":         "person with white cane: dark skin tone",
+	"👨\u200d🦯":          "man with white cane",
+	"👨🏻\u200d🦯":         "man with white cane: light skin tone",
+	"👨🏼\u200d🦯":         "man with white cane: medium-light skin tone",
+	"👨🏽\u200d🦯":         "man with white cane: medium skin tone",
+	"👨🏾\u200d🦯":         "man with white cane: medium-dark skin tone",
+	"👨🏿\u200d🦯":         "man with white cane: dark skin tone",
+	"👩\u200d🦯":          "woman with white cane",
+	"👩🏻\u200d🦯":         "woman with white cane: light skin tone",
+	"👩🏼\u200d🦯":         "woman with white cane: medium-light skin tone",
+	"👩🏽\u200d🦯":         "woman with white cane: medium skin tone",
+	"👩🏾\u200d🦯":         "woman with white cane: medium-dark skin tone",
+	"👩🏿\u200d🦯":         "woman with white cane: dark skin tone",
+	"🧑\u200d🦼":          "person in motorized wheelchair",
+	"🧑🏻\u200d🦼":         "person in motorized wheelchair: light skin tone",
+	"🧑🏼\u200d🦼":         "person in motorized wheelchair: medium-light skin tone",
+	"🧑🏽\u200d🦼":         "person in motorized wheelchair: medium skin tone",
+	"🧑🏾\u200d🦼":         "person in motorized wheelchair: medium-dark skin tone",
+	"🧑🏿\u200d🦼":         "person in motorized wheelchair: dark skin tone",
+	"👨\u200d🦼":          "man in motorized wheelchair",
+	"👨🏻\u200d🦼":         "man in motorized wheelchair: light skin tone",
+	"👨🏼\u200d🦼":         "man in motorized wheelchair: medium-light skin tone",
+	"👨🏽\u200d🦼":         "man in motorized wheelchair: medium skin tone",
+	"👨🏾\u200d🦼":         "man in motorized wheelchair: medium-dark skin tone",
+	"👨🏿\u200d🦼":         "man in motorized wheelchair: dark skin tone",
+	"👩\u200d🦼":          "woman in motorized wheelchair",
+	"👩🏻\u200d🦼":         "woman in motorized wheelchair: light skin tone",
+	"👩🏼\u200d🦼":         "woman in motorized wheelchair: medium-light skin tone",
+	"👩🏽\u200d🦼":         "woman in motorized wheelchair: medium skin tone",
+	"👩🏾\u200d🦼":         "woman in motorized wheelchair: medium-dark skin tone",
+	"👩🏿\u200d🦼":         "woman in motorized wheelchair: dark skin tone",
+	"🧑\u200d🦽":          "person in manual wheelchair",
+	"🧑🏻\u200d🦽":         "person in manual wheelchair: light skin tone",
+	"🧑🏼\u200d🦽":         "person in manual wheelchair: medium-light skin tone",
+	"🧑🏽\u200d🦽":         "person in manual wheelchair: medium skin tone",
+	"🧑🏾\u200d🦽":         "person in manual wheelchair: medium-dark skin tone",
+	"🧑🏿\u200d🦽":         "person in manual wheelchair: dark skin tone",
+	"👨\u200d🦽":          "man in manual wheelchair",
+	"👨🏻\u200d🦽":         "man in manual wheelchair: light skin tone",
+	"👨🏼\u200d🦽":         "man in manual wheelchair: medium-light skin tone",
+	"👨🏽\u200d🦽":         "man in manual wheelchair: medium skin tone",
+	"👨🏾\u200d🦽":         "man in manual wheelchair: medium-dark skin tone",
+	"👨🏿\u200d🦽":         "man in manual wheelchair: dark skin tone",
+	"👩\u200d🦽":          "woman in manual wheelchair",
+	"👩🏻\u200d🦽":         "woman in manual wheelchair: light skin tone",
+	"👩🏼\u200d🦽":         "woman in manual wheelchair: medium-light skin tone",
+	"👩🏽\u200d🦽":         "woman in manual wheelchair: medium skin tone",
+	"👩🏾\u200d🦽":         "woman in manual wheelchair: medium-dark skin tone",
+	"👩🏿\u200d🦽":         "woman in manual wheelchair: dark skin tone",
+	"🏃":                 "person running",
+	"🏃🏻":                "person running: light skin tone",
+	"🏃🏼":                "person running: medium-light skin tone",
+	"🏃🏽":                "person running: medium skin tone",
+	"🏃🏾":                "person running: medium-dark skin tone",
+	"🏃🏿":                "person running: dark skin tone",
+	"🏃\u200d♂️":         "man running",
+	"🏃🏻\u200d♂️":        "man running: light skin tone",
+	"🏃🏼\u200d♂️":        "man running: medium-light skin tone",
+	"🏃🏽\u200d♂️":        "man running: medium skin tone",
+	"🏃🏾\u200d♂️":        "man running: medium-dark skin tone",
+	"🏃🏿\u200d♂️":        "man running: dark skin tone",
+	"🏃\u200d♀️":         "woman running",
+	"🏃🏻\u200d♀️":        "woman running: light skin tone",
+	"🏃🏼\u200d♀️":        "woman running: medium-light skin tone",
+	"🏃🏽\u200d♀️":        "woman running: medium skin tone",
+	"🏃🏾\u200d♀️":        "woman running: medium-dark skin tone",
+	"🏃🏿\u200d♀️":        "woman running: dark skin tone",
+	"💃":                 "woman dancing",
+	"💃🏻":                "woman dancing: light skin tone",
+	"💃🏼":                "woman dancing: medium-light skin tone",
+	"💃🏽":                "woman dancing: medium skin tone",
+	"💃🏾":                "woman dancing: medium-dark skin tone",
+	"💃🏿":                "woman dancing: dark skin tone",
+	"🕺":                 "man dancing",
+	"🕺🏻":                "man dancing: light skin tone",
+	"🕺🏼":                "man dancing: medium-light skin tone",
+	"🕺🏽":                "man dancing: medium skin tone",
+	"🕺🏾":                "man dancing: medium-dark skin tone",
+	"🕺🏿":                "man dancing: dark skin tone",
+	"🕴️":                "person in suit levitating",
+	"🕴🏻":                "person in suit levitating: light skin tone",
+	"🕴🏼":                "person in suit levitating: medium-light skin tone",
+	"🕴🏽":                "person in suit levitating: medium skin tone",
+	"🕴🏾":                "person in suit levitating: medium-dark skin tone",
+	"🕴🏿":                "person in suit levitating: dark skin tone",
+	"👯":                 "people with bunny ears",
+	"👯\u200d♂️":         "men with bunny ears",
+	"👯\u200d♀️":         "women with bunny ears",
+	"🧖":                 "person in steamy room",
+	"🧖🏻":                "person in steamy room: light skin tone",
+	"🧖🏼":                "person in steamy room: medium-light skin tone",
+	"🧖🏽":                "person in steamy room: medium skin tone",
+	"🧖🏾":                "person in steamy room: medium-dark skin tone",
+	"🧖🏿":                "person in steamy room: dark skin tone",
+	"🧖\u200d♂️":         "man in steamy room",
+	"🧖🏻\u200d♂️":        "man in steamy room: light skin tone",
+	"🧖🏼\u200d♂️":        "man in steamy room: medium-light skin tone",
+	"🧖🏽\u200d♂️":        "man in steamy room: medium skin tone",
+	"🧖🏾\u200d♂️":        "man in steamy room: medium-dark skin tone",
+	"🧖🏿\u200d♂️":        "man in steamy room: dark skin tone",
+	"🧖\u200d♀️":         "woman in steamy room",
+	"🧖🏻\u200d♀️":        "woman in steamy room: light skin tone",
+	"🧖🏼\u200d♀️":        "woman in steamy room: medium-light skin tone",
+	"🧖🏽\u200d♀️":        "woman in steamy room: medium skin tone",
+	"🧖🏾\u200d♀️":        "woman in steamy room: medium-dark skin tone",
+	"🧖🏿\u200d♀️":        "woman in steamy room: dark skin tone",
+	"🧗":                 "person climbing",
+	"🧗🏻":                "person climbing: light skin tone",
+	"🧗🏼":                "person climbing: medium-light skin tone",
+	"🧗🏽":                "person climbing: medium skin tone",
+	"🧗🏾":                "person climbing: medium-dark skin tone",
+	"🧗🏿":                "person climbing: dark skin tone",
+	"🧗\u200d♂️":         "man climbing",
+	"🧗🏻\u200d♂️":        "man climbing: light skin tone",
+	"🧗🏼\u200d♂️":        "man climbing: medium-light skin tone",
+	"🧗🏽\u200d♂️":        "man climbing: medium skin tone",
+	"🧗🏾\u200d♂️":        "man climbing: medium-dark skin tone",
+	"🧗🏿\u200d♂️":        "man climbing: dark skin tone",
+	"🧗\u200d♀️":         "woman climbing",
+	"🧗🏻\u200d♀️":        "woman climbing: light skin tone",
+	"🧗🏼\u200d♀️":        "woman climbing: medium-light skin tone",
+	"🧗🏽\u200d♀️":        "woman climbing: medium skin tone",
+	"🧗🏾\u200d♀️":        "woman climbing: medium-dark skin tone",
+	"🧗🏿\u200d♀️":        "woman climbing: dark skin tone",
+	"🤺":                 "person fencing",
+	"🏇":                 "horse racing",
+	"🏇🏻":                "horse racing: light skin tone",
+	"🏇🏼":                "horse racing: medium-light skin tone",
+	"🏇🏽":                "horse racing: medium skin tone",
+	"🏇🏾":                "horse racing: medium-dark skin tone",
+	"🏇🏿":                "horse racing: dark skin tone",
+	"⛷️":                "skier",
+	"🏂":                 "snowboarder",
+	"🏂🏻":                "snowboarder: light skin tone",
+	"🏂🏼":                "snowboarder: medium-light skin tone",
+	"🏂🏽":                "snowboarder: medium skin tone",
+	"🏂🏾":                "snowboarder: medium-dark skin tone",
+	"🏂🏿":                "snowboarder: dark skin tone",
+	"🏌️":                "person golfing",
+	"🏌🏻":                "person golfing: light skin tone",
+	"🏌🏼":                "person golfing: medium-light skin tone",
+	"🏌🏽":                "person golfing: medium skin tone",
+	"🏌🏾":                "person golfing: medium-dark skin tone",
+	"🏌🏿":                "person golfing: dark skin tone",
+	"🏌️\u200d♂️":        "man golfing",
+	"🏌🏻\u200d♂️":        "man golfing: light skin tone",
+	"🏌🏼\u200d♂️":        "man golfing: medium-light skin tone",
+	"🏌🏽\u200d♂️":        "man golfing: medium skin tone",
+	"🏌🏾\u200d♂️":        "man golfing: medium-dark skin tone",
+	"🏌🏿\u200d♂️":        "man golfing: dark skin tone",
+	"🏌️\u200d♀️":        "woman golfing",
+	"🏌🏻\u200d♀️":        "woman golfing: light skin tone",
+	"🏌🏼\u200d♀️":        "woman golfing: medium-light skin tone",
+	"🏌🏽\u200d♀️":        "woman golfing: medium skin tone",
+	"🏌🏾\u200d♀️":        "woman golfing: medium-dark skin tone",
+	"🏌🏿\u200d♀️":        "woman golfing: dark skin tone",
+	"🏄":                 "person surfing",
+	"🏄🏻":                "person surfing: light skin tone",
+	"🏄🏼":                "person surfing: medium-light skin tone",
+	"🏄🏽":                "person surfing: medium skin tone",
+	"🏄🏾":                "person surfing: medium-dark skin tone",
+	"🏄🏿":                "person surfing: dark skin tone",
+	"🏄\u200d♂️":         "man surfing",
+	"🏄🏻\u200d♂️":        "man surfing: light skin tone",
+	"🏄🏼\u200d♂️":        "man surfing: medium-light skin tone",
+	"🏄🏽\u200d♂️":        "man surfing: medium skin tone",
+	"🏄🏾\u200d♂️":        "man surfing: medium-dark skin tone",
+	"🏄🏿\u200d♂️":        "man surfing: dark skin tone",
+	"🏄\u200d♀️":         "woman surfing",
+	"🏄🏻\u200d♀️":        "woman surfing: light skin tone",
+	"🏄🏼\u200d♀️":        "woman surfing: medium-light skin tone",
+	"🏄🏽\u200d♀️":        "woman surfing: medium skin tone",
+	"🏄🏾\u200d♀️":        "woman surfing: medium-dark skin tone",
+	"🏄🏿\u200d♀️":        "woman surfing: dark skin tone",
+	"🚣":                 "person rowing boat",
+	"🚣🏻":                "person rowing boat: light skin tone",
+	"🚣🏼":                "person rowing boat: medium-light skin tone",
+	"🚣🏽":                "person rowing boat: medium skin tone",
+	"🚣🏾":                "person rowing boat: medium-dark skin tone",
+	"🚣🏿":                "person rowing boat: dark skin tone",
+	"🚣\u200d♂️":         "man rowing boat",
+	"🚣🏻\u200d♂️":        "man rowing boat: light skin tone",
+	"🚣🏼\u200d♂️":        "man rowing boat: medium-light skin tone",
+	"🚣🏽\u200d♂️":        "man rowing boat: medium skin tone",
+	"🚣🏾\u200d♂️":        "man rowing boat: medium-dark skin tone",
+	"🚣🏿\u200d♂️":        "man rowing boat: dark skin tone",
+	"🚣\u200d♀️":         "woman rowing boat",
+	"🚣🏻\u200d♀️":        "woman rowing boat: light skin tone",
+	"🚣🏼\u200d♀️":        "woman rowing boat: medium-light skin tone",
+	"🚣🏽\u200d♀️":        "woman rowing boat: medium skin tone",
+	"🚣🏾\u200d♀️":        "woman rowing boat: medium-dark skin tone",
+	"🚣🏿\u200d♀️":        "woman rowing boat: dark skin tone",
+	"🏊":                 "person swimming",
+	"🏊🏻":                "person swimming: light skin tone",
+	"🏊🏼":                "person swimming: medium-light skin tone",
+	"🏊🏽":                "person swimming: medium skin tone",
+	"🏊🏾":                "person swimming: medium-dark skin tone",
+	"🏊🏿":                "person swimming: dark skin tone",
+	"🏊\u200d♂️":         "man swimming",
+	"🏊🏻\u200d♂️":        "man swimming: light skin tone",
+	"🏊🏼\u200d♂️":        "man swimming: medium-light skin tone",
+	"🏊🏽\u200d♂️":        "man swimming: medium skin tone",
+	"🏊🏾\u200d♂️":        "man swimming: medium-dark skin tone",
+	"🏊🏿\u200d♂️":        "man swimming: dark skin tone",
+	"🏊\u200d♀️":         "woman swimming",
+	"🏊🏻\u200d♀️":        "woman swimming: light skin tone",
+	"🏊🏼\u200d♀️":        "woman swimming: medium-light skin tone",
+	"🏊🏽\u200d♀️":        "woman swimming: medium skin tone",
+	"🏊🏾\u200d♀️":        "woman swimming: medium-dark skin tone",
+	"🏊🏿\u200d♀️":        "woman swimming: dark skin tone",
+	"⛹️":                "person bouncing ball",
+	"⛹🏻":                "person bouncing ball: light skin tone",
+	"⛹🏼":                "person bouncing ball: medium-light skin tone",
+	"⛹🏽":                "person bouncing ball: medium skin tone",
+	"⛹🏾":                "person bouncing ball: medium-dark skin tone",
+	"⛹🏿":                "person bouncing ball: dark skin tone",
+	"⛹️\u200d♂️":        "man bouncing ball",
+	"⛹🏻\u200d♂️":        "man bouncing ball: light skin tone",
+	"⛹🏼\u200d♂️":        "man bouncing ball: medium-light skin tone",
+	"⛹🏽\u200d♂️":        "man bouncing ball: medium skin tone",
+	"⛹🏾\u200d♂️":        "man bouncing ball: medium-dark skin tone",
+	"⛹🏿\u200d♂️":        "man bouncing ball: dark skin tone",
+	"⛹️\u200d♀️":        "woman bouncing ball",
+	"⛹🏻\u200d♀️":        "woman bouncing ball: light skin tone",
+	"⛹🏼\u200d♀️":        "woman bouncing ball: medium-light skin tone",
+	"⛹🏽\u200d♀️":        "woman bouncing ball: medium skin tone",
+	"⛹🏾\u200d♀️":        "woman bouncing ball: medium-dark skin tone",
+	"⛹🏿\u200d♀️":        "woman bouncing ball: dark skin tone",
+	"🏋️":                "person lifting weights",
+	"🏋🏻":                "person lifting weights: light skin tone",
+	"🏋🏼":                "person lifting weights: medium-light skin tone",
+	"🏋🏽":                "person lifting weights: medium skin tone",
+	"🏋🏾":                "person lifting weights: medium-dark skin tone",
+	"🏋🏿":                "person lifting weights: dark skin tone",
+	"🏋️\u200d♂️":        "man lifting weights",
+	"🏋🏻\u200d♂️":        "man lifting weights: light skin tone",
+	"🏋🏼\u200d♂️":        "man lifting weights: medium-light skin tone",
+	"🏋🏽\u200d♂️":        "man lifting weights: medium skin tone",
+	"🏋🏾\u200d♂️":        "man lifting weights: medium-dark skin tone",
+	"🏋🏿\u200d♂️":        "man lifting weights: dark skin tone",
+	"🏋️\u200d♀️":        "woman lifting weights",
+	"🏋🏻\u200d♀️":        "woman lifting weights: light skin tone",
+	"🏋🏼\u200d♀️":        "woman lifting weights: medium-light skin tone",
+	"🏋🏽\u200d♀️":        "woman lifting weights: medium skin tone",
+	"🏋🏾\u200d♀️":        "woman lifting weights: medium-dark skin tone",
+	"🏋🏿\u200d♀️":        "woman lifting weights: dark skin tone",
+	"🚴":                 "person biking",
+	"🚴🏻":                "person biking: light skin tone",
+	"🚴🏼":                "person biking: medium-light skin tone",
+	"🚴🏽":                "person biking: medium skin tone",
+	"🚴🏾":                "person biking: medium-dark skin tone",
+	"🚴🏿":                "person biking: dark skin tone",
+	"🚴\u200d♂️":         "man biking",
+	"🚴🏻\u200d♂️":        "man biking: light skin tone",
+	"🚴🏼\u200d♂️":        "man biking: medium-light skin tone",
+	"🚴🏽\u200d♂️":        "man biking: medium skin tone",
+	"🚴🏾\u200d♂️":        "man biking: medium-dark skin tone",
+	"🚴🏿\u200d♂️":        "man biking: dark skin tone",
+	"🚴\u200d♀️":         "woman biking",
+	"🚴🏻\u200d♀️":        "woman biking: light skin tone",
+	"🚴🏼\u200d♀️":        "woman biking: medium-light skin tone",
+	"🚴🏽\u200d♀️":        "woman biking: medium skin tone",
+	"🚴🏾\u200d♀️":        "woman biking: medium-dark skin tone",
+	"🚴🏿\u200d♀️":        "woman biking: dark skin tone",
+	"🚵":                 "person mountain biking",
+	"🚵🏻":                "person mountain biking: light skin tone",
+	"🚵🏼":                "person mountain biking: medium-light skin tone",
+	"🚵🏽":                "person mountain biking: medium skin tone",
+	"🚵🏾":                "person mountain biking: medium-dark skin tone",
+	"🚵🏿":                "person mountain biking: dark skin tone",
+	"🚵\u200d♂️":         "man mountain biking",
+	"🚵🏻\u200d♂️":        "man mountain biking: light skin tone",
+	"🚵🏼\u200d♂️":        "man mountain biking: medium-light skin tone",
+	"🚵🏽\u200d♂️":        "man mountain biking: medium skin tone",
+	"🚵🏾\u200d♂️":        "man mountain biking: medium-dark skin tone",
+	"🚵🏿\u200d♂️":        "man mountain biking: dark skin tone",
+	"🚵\u200d♀️":         "woman mountain biking",
+	"🚵🏻\u200d♀️":        "woman mountain biking: light skin tone",
+	"🚵🏼\u200d♀️":        "woman mountain biking: medium-light skin tone",
+	"🚵🏽\u200d♀️":        "woman mountain biking: medium skin tone",
+	"🚵🏾\u200d♀️":        "woman mountain biking: medium-dark skin tone",
+	"🚵🏿\u200d♀️":        "woman mountain biking: dark skin tone",
+	"🤸":                 "person cartwheeling",
+	"🤸🏻":                "person cartwheeling: light skin tone",
+	"🤸🏼":                "person cartwheeling: medium-light skin tone",
+	"🤸🏽":                "person cartwheeling: medium skin tone",
+	"🤸🏾":                "person cartwheeling: medium-dark skin tone",
+	"🤸🏿":                "person cartwheeling: dark skin tone",
+	"🤸\u200d♂️":         "man cartwheeling",
+	"🤸🏻\u200d♂️":        "man cartwheeling: light skin tone",
+	"🤸🏼\u200d♂️":        "man cartwheeling: medium-light skin tone",
+	"🤸🏽\u200d♂️":        "man cartwheeling: medium skin tone",
+	"🤸🏾\u200d♂️":        "man cartwheeling: medium-dark skin tone",
+	"🤸🏿\u200d♂️":        "man cartwheeling: dark skin tone",
+	"🤸\u200d♀️":         "woman cartwheeling",
+	"🤸🏻\u200d♀️":        "woman cartwheeling: light skin tone",
+	"🤸🏼\u200d♀️":        "woman cartwheeling: medium-light skin tone",
+	"🤸🏽\u200d♀️":        "woman cartwheeling: medium skin tone",
+	"🤸🏾\u200d♀️":        "woman cartwheeling: medium-dark skin tone",
+	"🤸🏿\u200d♀️":        "woman cartwheeling: dark skin tone",
+	"🤼":                 "people wrestling",
+	"🤼\u200d♂️":         "men wrestling",
+	"🤼\u200d♀️":         "women wrestling",
+	"🤽":                 "person playing water polo",
+	"🤽🏻":                "person playing water polo: light skin tone",
+	"🤽🏼":                "person playing water polo: medium-light skin tone",
+	"🤽🏽":                "person playing water polo: medium skin tone",
+	"🤽🏾":                "person playing water polo: medium-dark skin tone",
+	"🤽🏿":                "person playing water polo: dark skin tone",
+	"🤽\u200d♂️":         "man playing water polo",
+	"🤽🏻\u200d♂️":        "man playing water polo: light skin tone",
+	"🤽🏼\u200d♂️":        "man playing water polo: medium-light skin tone",
+	"🤽🏽\u200d♂️":        "man playing water polo: medium skin tone",
+	"🤽🏾\u200d♂️":        "man playing water polo: medium-dark skin tone",
+	"🤽🏿\u200d♂️":        "man playing water polo: dark skin tone",
+	"🤽\u200d♀️":         "woman playing water polo",
+	"🤽🏻\u200d♀️":        "woman playing water polo: light skin tone",
+	"🤽🏼\u200d♀️":        "woman playing water polo: medium-light skin tone",
+	"🤽🏽\u200d♀️":        "woman playing water polo: medium skin tone",
+	"🤽🏾\u200d♀️":        "woman playing water polo: medium-dark skin tone",
+	"🤽🏿\u200d♀️":        "woman playing water polo: dark skin tone",
+	"🤾":                 "person playing handball",
+	"🤾🏻":                "person playing handball: light skin tone",
+	"🤾🏼":                "person playing handball: medium-light skin tone",
+	"🤾🏽":                "person playing handball: medium skin tone",
+	"🤾🏾":                "person playing handball: medium-dark skin tone",
+	"🤾🏿":                "person playing handball: dark skin tone",
+	"🤾\u200d♂️":         "man playing handball",
+	"🤾🏻\u200d♂️":        "man playing handball: light skin tone",
+	"🤾🏼\u200d♂️":        "man playing handball: medium-light skin tone",
+	"🤾🏽\u200d♂️":        "man playing handball: medium skin tone",
+	"🤾🏾\u200d♂️":        "man playing handball: medium-dark skin tone",
+	"🤾🏿\u200d♂️":        "man playing handball: dark skin tone",
+	"🤾\u200d♀️":         "woman playing handball",
+	"🤾🏻\u200d♀️":        "woman playing handball: light skin tone",
+	"🤾🏼\u200d♀️":        "woman playing handball: medium-light skin tone",
+	"🤾🏽\u200d♀️":        "woman playing handball: medium skin tone",
+	"🤾🏾\u200d♀️":        "woman playing handball: medium-dark skin tone",
+	"🤾🏿\u200d♀️":        "woman playing handball: dark skin tone",
+	"🤹":                 "person juggling",
+	"🤹🏻":                "person juggling: light skin tone",
+	"🤹🏼":                "person juggling: medium-light skin tone",
+	"🤹🏽":                "person juggling: medium skin tone",
+	"🤹🏾":                "person juggling: medium-dark skin tone",
+	"🤹🏿":                "person juggling: dark skin tone",
+	"🤹\u200d♂️":         "man juggling",
+	"🤹🏻\u200d♂️":        "man juggling: light skin tone",
+	"🤹🏼\u200d♂️":        "man juggling: medium-light skin tone",
+	"🤹🏽\u200d♂️":        "man juggling: medium skin tone",
+	"🤹🏾\u200d♂️":        "man juggling: medium-dark skin tone",
+	"🤹🏿\u200d♂️":        "man juggling: dark skin tone",
+	"🤹\u200d♀️":         "woman juggling",
+	"🤹🏻\u200d♀️":        "woman juggling: light skin tone",
+	"🤹🏼\u200d♀️":        "woman juggling: medium-light skin tone",
+	"🤹🏽\u200d♀️":        "woman juggling: medium skin tone",
+	"🤹🏾\u200d♀️":        "woman juggling: medium-dark skin tone",
+	"🤹🏿\u200d♀️":        "woman juggling: dark skin tone",
+	"🧘":                 "person in lotus position",
+	"🧘🏻":                "person in lotus position: light skin tone",
+	"🧘🏼":                "person in lotus position: medium-light skin tone",
+	"🧘🏽":                "person in lotus position: medium skin tone",
+	"🧘🏾":                "person in lotus position: medium-dark skin tone",
+	"🧘🏿":                "person in lotus position: dark skin tone",
+	"🧘\u200d♂️":         "man in lotus position",
+	"🧘🏻\u200d♂️":        "man in lotus position: light skin tone",
+	"🧘🏼\u200d♂️":        "man in lotus position: medium-light skin tone",
+	"🧘🏽\u200d♂️":        "man in lotus position: medium skin tone",
+	"🧘🏾\u200d♂️":        "man in lotus position: medium-dark skin tone",
+	"🧘🏿\u200d♂️":        "man in lotus position: dark skin tone",
+	"🧘\u200d♀️":         "woman in lotus position",
+	"🧘🏻\u200d♀️":        "woman in lotus position: light skin tone",
+	"🧘🏼\u200d♀️":        "woman in lotus position: medium-light skin tone",
+	"🧘🏽\u200d♀️":        "woman in lotus position: medium skin tone",
+	"🧘🏾\u200d♀️":        "woman in lotus position: medium-dark skin tone",
+	"🧘🏿\u200d♀️":        "woman in lotus position: dark skin tone",
+	"🛀":                 "person taking bath",
+	"🛀🏻":                "person taking bath: light skin tone",
+	"🛀🏼":                "person taking bath: medium-light skin tone",
+	"🛀🏽":                "person taking bath: medium skin tone",
+	"🛀🏾":                "person taking bath: medium-dark skin tone",
+	"🛀🏿":                "person taking bath: dark skin tone",
+	"🛌":                 "person in bed",
+	"🛌🏻":                "person in bed: light skin tone",
+	"🛌🏼":                "person in bed: medium-light skin tone",
+	"🛌🏽":                "person in bed: medium skin tone",
+	"🛌🏾":                "person in bed: medium-dark skin tone",
+	"🛌🏿":                "person in bed: dark skin tone",
+	"🧑\u200d🤝\u200d🧑":   "people holding hands",
+	"🧑🏻\u200d🤝\u200d🧑🏻": "people holding hands: light skin tone",
+	"🧑🏻\u200d🤝\u200d🧑🏼": "people holding hands: light skin tone, medium-light skin tone",
+	"🧑🏻\u200d🤝\u200d🧑🏽": "people holding hands: light skin tone, medium skin tone",
+	"🧑🏻\u200d🤝\u200d🧑🏾": "people holding hands: light skin tone, medium-dark skin tone",
+	"🧑🏻\u200d🤝\u200d🧑🏿": "people holding hands: light skin tone, dark skin tone",
+	"🧑🏼\u200d🤝\u200d🧑🏻": "people holding hands: medium-light skin tone, light skin tone",
+	"🧑🏼\u200d🤝\u200d🧑🏼": "people holding hands: medium-light skin tone",
+	"🧑🏼\u200d🤝\u200d🧑🏽": "people holding hands: medium-light skin tone, medium skin tone",
+	"🧑🏼\u200d🤝\u200d🧑🏾": "people holding hands: medium-light skin tone, medium-dark skin tone",
+	"🧑🏼\u200d🤝\u200d🧑🏿": "people holding hands: medium-light skin tone, dark skin tone",
+	"🧑🏽\u200d🤝\u200d🧑🏻": "people holding hands: medium skin tone, light skin tone",
+	"🧑🏽\u200d🤝\u200d🧑🏼": "people holding hands: medium skin tone, medium-light skin tone",
+	"🧑🏽\u200d🤝\u200d🧑🏽": "people holding hands: medium skin tone",
+	"🧑🏽\u200d🤝\u200d🧑🏾": "people holding hands: medium skin tone, medium-dark skin tone",
+	"🧑🏽\u200d🤝\u200d🧑🏿": "people holding hands: medium skin tone, dark skin tone",
+	"🧑🏾\u200d🤝\u200d🧑🏻": "people holding hands: medium-dark skin tone, light skin tone",
+	"🧑🏾\u200d🤝\u200d🧑🏼": "people holding hands: medium-dark skin tone, medium-light skin tone",
+	"🧑🏾\u200d🤝\u200d🧑🏽": "people holding hands: medium-dark skin tone, medium skin tone",
+	"🧑🏾\u200d🤝\u200d🧑🏾": "people holding hands: medium-dark skin tone",
+	"🧑🏾\u200d🤝\u200d🧑🏿": "people holding hands: medium-dark skin tone, dark skin tone",
+	"🧑🏿\u200d🤝\u200d🧑🏻": "people holding hands: dark skin tone, light skin tone",
+	"🧑🏿\u200d🤝\u200d🧑🏼": "people holding hands: dark skin tone, medium-light skin tone",
+	"🧑🏿\u200d🤝\u200d🧑🏽": "people holding hands: dark skin tone, medium skin tone",
+	"🧑🏿\u200d🤝\u200d🧑🏾": "people holding hands: dark skin tone, medium-dark skin tone",
+	"🧑🏿\u200d🤝\u200d🧑🏿": "people holding hands: dark skin tone",
+	"👭":                 "women holding hands",
+	"👭🏻":                "women holding hands: light skin tone",
+	"👩🏻\u200d🤝\u200d👩🏼": "women holding hands: light skin tone, medium-light skin tone",
+	"👩🏻\u200d🤝\u200d👩🏽": "women holding hands: light skin tone, medium skin tone",
+	"👩🏻\u200d🤝\u200d👩🏾": "women holding hands: light skin tone, medium-dark skin tone",
+	"👩🏻\u200d🤝\u200d👩🏿": "women holding hands: light skin tone, dark skin tone",
+	"👩🏼\u200d🤝\u200d👩🏻": "women holding hands: medium-light skin tone, light skin tone",
+	"👭🏼":                "women holding hands: medium-light skin tone",
+	"👩🏼\u200d🤝\u200d👩🏽": "women holding hands: medium-light skin tone, medium skin tone",
+	"👩🏼\u200d🤝\u200d👩🏾": "women holding hands: medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d🤝\u200d👩🏿": "women holding hands: medium-light skin tone, dark skin tone",
+	"👩🏽\u200d🤝\u200d👩🏻": "women holding hands: medium skin tone, light skin tone",
+	"👩🏽\u200d🤝\u200d👩🏼": "women holding hands: medium skin tone, medium-light skin tone",
+	"👭🏽":                "women holding hands: medium skin tone",
+	"👩🏽\u200d🤝\u200d👩🏾": "women holding hands: medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d🤝\u200d👩🏿": "women holding hands: medium skin tone, dark skin tone",
+	"👩🏾\u200d🤝\u200d👩🏻": "women holding hands: medium-dark skin tone, light skin tone",
+	"👩🏾\u200d🤝\u200d👩🏼": "women holding hands: medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d🤝\u200d👩🏽": "women holding hands: medium-dark skin tone, medium skin tone",
+	"👭🏾":                "women holding hands: medium-dark skin tone",
+	"👩🏾\u200d🤝\u200d👩🏿": "women holding hands: medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d🤝\u200d👩🏻": "women holding hands: dark skin tone, light skin tone",
+	"👩🏿\u200d🤝\u200d👩🏼": "women holding hands: dark skin tone, medium-light skin tone",
+	"👩🏿\u200d🤝\u200d👩🏽": "women holding hands: dark skin tone, medium skin tone",
+	"👩🏿\u200d🤝\u200d👩🏾": "women holding hands: dark skin tone, medium-dark skin tone",
+	"👭🏿":                "women holding hands: dark skin tone",
+	"👫":                 "woman and man holding hands",
+	"👫🏻":                "woman and man holding hands: light skin tone",
+	"👩🏻\u200d🤝\u200d👨🏼": "woman and man holding hands: light skin tone, medium-light skin tone",
+	"👩🏻\u200d🤝\u200d👨🏽": "woman and man holding hands: light skin tone, medium skin tone",
+	"👩🏻\u200d🤝\u200d👨🏾": "woman and man holding hands: light skin tone, medium-dark skin tone",
+	"👩🏻\u200d🤝\u200d👨🏿": "woman and man holding hands: light skin tone, dark skin tone",
+	"👩🏼\u200d🤝\u200d👨🏻": "woman and man holding hands: medium-light skin tone, light skin tone",
+	"👫🏼":                "woman and man holding hands: medium-light skin tone",
+	"👩🏼\u200d🤝\u200d👨🏽": "woman and man holding hands: medium-light skin tone, medium skin tone",
+	"👩🏼\u200d🤝\u200d👨🏾": "woman and man holding hands: medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d🤝\u200d👨🏿": "woman and man holding hands: medium-light skin tone, dark skin tone",
+	"👩🏽\u200d🤝\u200d👨🏻": "woman and man holding hands: medium skin tone, light skin tone",
+	"👩🏽\u200d🤝\u200d👨🏼": "woman and man holding hands: medium skin tone, medium-light skin tone",
+	"👫🏽":                "woman and man holding hands: medium skin tone",
+	"👩🏽\u200d🤝\u200d👨🏾": "woman and man holding hands: medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d🤝\u200d👨🏿": "woman and man holding hands: medium skin tone, dark skin tone",
+	"👩🏾\u200d🤝\u200d👨🏻": "woman and man holding hands: medium-dark skin tone, light skin tone",
+	"👩🏾\u200d🤝\u200d👨🏼": "woman and man holding hands: medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d🤝\u200d👨🏽": "woman and man holding hands: medium-dark skin tone, medium skin tone",
+	"👫🏾":                "woman and man holding hands: medium-dark skin tone",
+	"👩🏾\u200d🤝\u200d👨🏿": "woman and man holding hands: medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d🤝\u200d👨🏻": "woman and man holding hands: dark skin tone, light skin tone",
+	"👩🏿\u200d🤝\u200d👨🏼": "woman and man holding hands: dark skin tone, medium-light skin tone",
+	"👩🏿\u200d🤝\u200d👨🏽": "woman and man holding hands: dark skin tone, medium skin tone",
+	"👩🏿\u200d🤝\u200d👨🏾": "woman and man holding hands: dark skin tone, medium-dark skin tone",
+	"👫🏿":                "woman and man holding hands: dark skin tone",
+	"👬":                 "men holding hands",
+	"👬🏻":                "men holding hands: light skin tone",
+	"👨🏻\u200d🤝\u200d👨🏼": "men holding hands: light skin tone, medium-light skin tone",
+	"👨🏻\u200d🤝\u200d👨🏽": "men holding hands: light skin tone, medium skin tone",
+	"👨🏻\u200d🤝\u200d👨🏾": "men holding hands: light skin tone, medium-dark skin tone",
+	"👨🏻\u200d🤝\u200d👨🏿": "men holding hands: light skin tone, dark skin tone",
+	"👨🏼\u200d🤝\u200d👨🏻": "men holding hands: medium-light skin tone, light skin tone",
+	"👬🏼":                "men holding hands: medium-light skin tone",
+	"👨🏼\u200d🤝\u200d👨🏽": "men holding hands: medium-light skin tone, medium skin tone",
+	"👨🏼\u200d🤝\u200d👨🏾": "men holding hands: medium-light skin tone, medium-dark skin tone",
+	"👨🏼\u200d🤝\u200d👨🏿": "men holding hands: medium-light skin tone, dark skin tone",
+	"👨🏽\u200d🤝\u200d👨🏻": "men holding hands: medium skin tone, light skin tone",
+	"👨🏽\u200d🤝\u200d👨🏼": "men holding hands: medium skin tone, medium-light skin tone",
+	"👬🏽":                "men holding hands: medium skin tone",
+	"👨🏽\u200d🤝\u200d👨🏾": "men holding hands: medium skin tone, medium-dark skin tone",
+	"👨🏽\u200d🤝\u200d👨🏿": "men holding hands: medium skin tone, dark skin tone",
+	"👨🏾\u200d🤝\u200d👨🏻": "men holding hands: medium-dark skin tone, light skin tone",
+	"👨🏾\u200d🤝\u200d👨🏼": "men holding hands: medium-dark skin tone, medium-light skin tone",
+	"👨🏾\u200d🤝\u200d👨🏽": "men holding hands: medium-dark skin tone, medium skin tone",
+	"👬🏾":                "men holding hands: medium-dark skin tone",
+	"👨🏾\u200d🤝\u200d👨🏿": "men holding hands: medium-dark skin tone, dark skin tone",
+	"👨🏿\u200d🤝\u200d👨🏻": "men holding hands: dark skin tone, light skin tone",
+	"👨🏿\u200d🤝\u200d👨🏼": "men holding hands: dark skin tone, medium-light skin tone",
+	"👨🏿\u200d🤝\u200d👨🏽": "men holding hands: dark skin tone, medium skin tone",
+	"👨🏿\u200d🤝\u200d👨🏾": "men holding hands: dark skin tone, medium-dark skin tone",
+	"👬🏿":                "men holding hands: dark skin tone",
+	"💏":                 "kiss",
+	"💏🏻":                "kiss: light skin tone",
+	"💏🏼":                "kiss: medium-light skin tone",
+	"💏🏽":                "kiss: medium skin tone",
+	"💏🏾":                "kiss: medium-dark skin tone",
+	"💏🏿":                "kiss: dark skin tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼": "kiss: person, person, light skin tone, medium-light skin tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽": "kiss: person, person, light skin tone, medium skin tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾": "kiss: person, person, light skin tone, medium-dark skin tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿": "kiss: person, person, light skin tone, dark skin tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻": "kiss: person, person, medium-light skin tone, light skin tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽": "kiss: person, person, medium-light skin tone, medium skin tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾": "kiss: person, person, medium-light skin tone, medium-dark skin tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿": "kiss: person, person, medium-light skin tone, dark skin tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻": "kiss: person, person, medium skin tone, light skin tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼": "kiss: person, person, medium skin tone, medium-light skin tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾": "kiss: person, person, medium skin tone, medium-dark skin tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿": "kiss: person, person, medium skin tone, dark skin tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻": "kiss: person, person, medium-dark skin tone, light skin tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼": "kiss: person, person, medium-dark skin tone, medium-light skin tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽": "kiss: person, person, medium-dark skin tone, medium skin tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿": "kiss: person, person, medium-dark skin tone, dark skin tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻": "kiss: person, person, dark skin tone, light skin tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼": "kiss: person, person, dark skin tone, medium-light skin tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽": "kiss: person, person, dark skin tone, medium skin tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾": "kiss: person, person, dark skin tone, medium-dark skin tone",
+	"👩\u200d❤️\u200d💋\u200d👨":   "kiss: woman, man",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏻": "kiss: woman, man, light skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏼": "kiss: woman, man, light skin tone, medium-light skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏽": "kiss: woman, man, light skin tone, medium skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏾": "kiss: woman, man, light skin tone, medium-dark skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏿": "kiss: woman, man, light skin tone, dark skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏻": "kiss: woman, man, medium-light skin tone, light skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏼": "kiss: woman, man, medium-light skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏽": "kiss: woman, man, medium-light skin tone, medium skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏾": "kiss: woman, man, medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏿": "kiss: woman, man, medium-light skin tone, dark skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏻": "kiss: woman, man, medium skin tone, light skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏼": "kiss: woman, man, medium skin tone, medium-light skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏽": "kiss: woman, man, medium skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏾": "kiss: woman, man, medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏿": "kiss: woman, man, medium skin tone, dark skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏻": "kiss: woman, man, medium-dark skin tone, light skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏼": "kiss: woman, man, medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏽": "kiss: woman, man, medium-dark skin tone, medium skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏾": "kiss: woman, man, medium-dark skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏿": "kiss: woman, man, medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏻": "kiss: woman, man, dark skin tone, light skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏼": "kiss: woman, man, dark skin tone, medium-light skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏽": "kiss: woman, man, dark skin tone, medium skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏾": "kiss: woman, man, dark skin tone, medium-dark skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏿": "kiss: woman, man, dark skin tone",
+	"👨\u200d❤️\u200d💋\u200d👨":   "kiss: man, man",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏻": "kiss: man, man, light skin tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏼": "kiss: man, man, light skin tone, medium-light skin tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏽": "kiss: man, man, light skin tone, medium skin tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏾": "kiss: man, man, light skin tone, medium-dark skin tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏿": "kiss: man, man, light skin tone, dark skin tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏻": "kiss: man, man, medium-light skin tone, light skin tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏼": "kiss: man, man, medium-light skin tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏽": "kiss: man, man, medium-light skin tone, medium skin tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏾": "kiss: man, man, medium-light skin tone, medium-dark skin tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏿": "kiss: man, man, medium-light skin tone, dark skin tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏻": "kiss: man, man, medium skin tone, light skin tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏼": "kiss: man, man, medium skin tone, medium-light skin tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏽": "kiss: man, man, medium skin tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏾": "kiss: man, man, medium skin tone, medium-dark skin tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏿": "kiss: man, man, medium skin tone, dark skin tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏻": "kiss: man, man, medium-dark skin tone, light skin tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏼": "kiss: man, man, medium-dark skin tone, medium-light skin tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏽": "kiss: man, man, medium-dark skin tone, medium skin tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏾": "kiss: man, man, medium-dark skin tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏿": "kiss: man, man, medium-dark skin tone, dark skin tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏻": "kiss: man, man, dark skin tone, light skin tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏼": "kiss: man, man, dark skin tone, medium-light skin tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏽": "kiss: man, man, dark skin tone, medium skin tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏾": "kiss: man, man, dark skin tone, medium-dark skin tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏿": "kiss: man, man, dark skin tone",
+	"👩\u200d❤️\u200d💋\u200d👩":   "kiss: woman, woman",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏻": "kiss: woman, woman, light skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏼": "kiss: woman, woman, light skin tone, medium-light skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏽": "kiss: woman, woman, light skin tone, medium skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏾": "kiss: woman, woman, light skin tone, medium-dark skin tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏿": "kiss: woman, woman, light skin tone, dark skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏻": "kiss: woman, woman, medium-light skin tone, light skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏼": "kiss: woman, woman, medium-light skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏽": "kiss: woman, woman, medium-light skin tone, medium skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏾": "kiss: woman, woman, medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏿": "kiss: woman, woman, medium-light skin tone, dark skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏻": "kiss: woman, woman, medium skin tone, light skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏼": "kiss: woman, woman, medium skin tone, medium-light skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏽": "kiss: woman, woman, medium skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏾": "kiss: woman, woman, medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏿": "kiss: woman, woman, medium skin tone, dark skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏻": "kiss: woman, woman, medium-dark skin tone, light skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏼": "kiss: woman, woman, medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏽": "kiss: woman, woman, medium-dark skin tone, medium skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏾": "kiss: woman, woman, medium-dark skin tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏿": "kiss: woman, woman, medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏻": "kiss: woman, woman, dark skin tone, light skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏼": "kiss: woman, woman, dark skin tone, medium-light skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏽": "kiss: woman, woman, dark skin tone, medium skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏾": "kiss: woman, woman, dark skin tone, medium-dark skin tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏿": "kiss: woman, woman, dark skin tone",
+	"💑":                      "couple with heart",
+	"💑🏻":                     "couple with heart: light skin tone",
+	"💑🏼":                     "couple with heart: medium-light skin tone",
+	"💑🏽":                     "couple with heart: medium skin tone",
+	"💑🏾":                     "couple with heart: medium-dark skin tone",
+	"💑🏿":                     "couple with heart: dark skin tone",
+	"🧑🏻\u200d❤️\u200d🧑🏼":     "couple with heart: person, person, light skin tone, medium-light skin tone",
+	"🧑🏻\u200d❤️\u200d🧑🏽":     "couple with heart: person, person, light skin tone, medium skin tone",
+	"🧑🏻\u200d❤️\u200d🧑🏾":     "couple with heart: person, person, light skin tone, medium-dark skin tone",
+	"🧑🏻\u200d❤️\u200d🧑🏿":     "couple with heart: person, person, light skin tone, dark skin tone",
+	"🧑🏼\u200d❤️\u200d🧑🏻":     "couple with heart: person, person, medium-light skin tone, light skin tone",
+	"🧑🏼\u200d❤️\u200d🧑🏽":     "couple with heart: person, person, medium-light skin tone, medium skin tone",
+	"🧑🏼\u200d❤️\u200d🧑🏾":     "couple with heart: person, person, medium-light skin tone, medium-dark skin tone",
+	"🧑🏼\u200d❤️\u200d🧑🏿":     "couple with heart: person, person, medium-light skin tone, dark skin tone",
+	"🧑🏽\u200d❤️\u200d🧑🏻":     "couple with heart: person, person, medium skin tone, light skin tone",
+	"🧑🏽\u200d❤️\u200d🧑🏼":     "couple with heart: person, person, medium skin tone, medium-light skin tone",
+	"🧑🏽\u200d❤️\u200d🧑🏾":     "couple with heart: person, person, medium skin tone, medium-dark skin tone",
+	"🧑🏽\u200d❤️\u200d🧑🏿":     "couple with heart: person, person, medium skin tone, dark skin tone",
+	"🧑🏾\u200d❤️\u200d🧑🏻":     "couple with heart: person, person, medium-dark skin tone, light skin tone",
+	"🧑🏾\u200d❤️\u200d🧑🏼":     "couple with heart: person, person, medium-dark skin tone, medium-light skin tone",
+	"🧑🏾\u200d❤️\u200d🧑🏽":     "couple with heart: person, person, medium-dark skin tone, medium skin tone",
+	"🧑🏾\u200d❤️\u200d🧑🏿":     "couple with heart: person, person, medium-dark skin tone, dark skin tone",
+	"🧑🏿\u200d❤️\u200d🧑🏻":     "couple with heart: person, person, dark skin tone, light skin tone",
+	"🧑🏿\u200d❤️\u200d🧑🏼":     "couple with heart: person, person, dark skin tone, medium-light skin tone",
+	"🧑🏿\u200d❤️\u200d🧑🏽":     "couple with heart: person, person, dark skin tone, medium skin tone",
+	"🧑🏿\u200d❤️\u200d🧑🏾":     "couple with heart: person, person, dark skin tone, medium-dark skin tone",
+	"👩\u200d❤️\u200d👨":       "couple with heart: woman, man",
+	"👩🏻\u200d❤️\u200d👨🏻":     "couple with heart: woman, man, light skin tone",
+	"👩🏻\u200d❤️\u200d👨🏼":     "couple with heart: woman, man, light skin tone, medium-light skin tone",
+	"👩🏻\u200d❤️\u200d👨🏽":     "couple with heart: woman, man, light skin tone, medium skin tone",
+	"👩🏻\u200d❤️\u200d👨🏾":     "couple with heart: woman, man, light skin tone, medium-dark skin tone",
+	"👩🏻\u200d❤️\u200d👨🏿":     "couple with heart: woman, man, light skin tone, dark skin tone",
+	"👩🏼\u200d❤️\u200d👨🏻":     "couple with heart: woman, man, medium-light skin tone, light skin tone",
+	"👩🏼\u200d❤️\u200d👨🏼":     "couple with heart: woman, man, medium-light skin tone",
+	"👩🏼\u200d❤️\u200d👨🏽":     "couple with heart: woman, man, medium-light skin tone, medium skin tone",
+	"👩🏼\u200d❤️\u200d👨🏾":     "couple with heart: woman, man, medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d❤️\u200d👨🏿":     "couple with heart: woman, man, medium-light skin tone, dark skin tone",
+	"👩🏽\u200d❤️\u200d👨🏻":     "couple with heart: woman, man, medium skin tone, light skin tone",
+	"👩🏽\u200d❤️\u200d👨🏼":     "couple with heart: woman, man, medium skin tone, medium-light skin tone",
+	"👩🏽\u200d❤️\u200d👨🏽":     "couple with heart: woman, man, medium skin tone",
+	"👩🏽\u200d❤️\u200d👨🏾":     "couple with heart: woman, man, medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d❤️\u200d👨🏿":     "couple with heart: woman, man, medium skin tone, dark skin tone",
+	"👩🏾\u200d❤️\u200d👨🏻":     "couple with heart: woman, man, medium-dark skin tone, light skin tone",
+	"👩🏾\u200d❤️\u200d👨🏼":     "couple with heart: woman, man, medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d❤️\u200d👨🏽":     "couple with heart: woman, man, medium-dark skin tone, medium skin tone",
+	"👩🏾\u200d❤️\u200d👨🏾":     "couple with heart: woman, man, medium-dark skin tone",
+	"👩🏾\u200d❤️\u200d👨🏿":     "couple with heart: woman, man, medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d❤️\u200d👨🏻":     "couple with heart: woman, man, dark skin tone, light skin tone",
+	"👩🏿\u200d❤️\u200d👨🏼":     "couple with heart: woman, man, dark skin tone, medium-light skin tone",
+	"👩🏿\u200d❤️\u200d👨🏽":     "couple with heart: woman, man, dark skin tone, medium skin tone",
+	"👩🏿\u200d❤️\u200d👨🏾":     "couple with heart: woman, man, dark skin tone, medium-dark skin tone",
+	"👩🏿\u200d❤️\u200d👨🏿":     "couple with heart: woman, man, dark skin tone",
+	"👨\u200d❤️\u200d👨":       "couple with heart: man, man",
+	"👨🏻\u200d❤️\u200d👨🏻":     "couple with heart: man, man, light skin tone",
+	"👨🏻\u200d❤️\u200d👨🏼":     "couple with heart: man, man, light skin tone, medium-light skin tone",
+	"👨🏻\u200d❤️\u200d👨🏽":     "couple with heart: man, man, light skin tone, medium skin tone",
+	"👨🏻\u200d❤️\u200d👨🏾":     "couple with heart: man, man, light skin tone, medium-dark skin tone",
+	"👨🏻\u200d❤️\u200d👨🏿":     "couple with heart: man, man, light skin tone, dark skin tone",
+	"👨🏼\u200d❤️\u200d👨🏻":     "couple with heart: man, man, medium-light skin tone, light skin tone",
+	"👨🏼\u200d❤️\u200d👨🏼":     "couple with heart: man, man, medium-light skin tone",
+	"👨🏼\u200d❤️\u200d👨🏽":     "couple with heart: man, man, medium-light skin tone, medium skin tone",
+	"👨🏼\u200d❤️\u200d👨🏾":     "couple with heart: man, man, medium-light skin tone, medium-dark skin tone",
+	"👨🏼\u200d❤️\u200d👨🏿":     "couple with heart: man, man, medium-light skin tone, dark skin tone",
+	"👨🏽\u200d❤️\u200d👨🏻":     "couple with heart: man, man, medium skin tone, light skin tone",
+	"👨🏽\u200d❤️\u200d👨🏼":     "couple with heart: man, man, medium skin tone, medium-light skin tone",
+	"👨🏽\u200d❤️\u200d👨🏽":     "couple with heart: man, man, medium skin tone",
+	"👨🏽\u200d❤️\u200d👨🏾":     "couple with heart: man, man, medium skin tone, medium-dark skin tone",
+	"👨🏽\u200d❤️\u200d👨🏿":     "couple with heart: man, man, medium skin tone, dark skin tone",
+	"👨🏾\u200d❤️\u200d👨🏻":     "couple with heart: man, man, medium-dark skin tone, light skin tone",
+	"👨🏾\u200d❤️\u200d👨🏼":     "couple with heart: man, man, medium-dark skin tone, medium-light skin tone",
+	"👨🏾\u200d❤️\u200d👨🏽":     "couple with heart: man, man, medium-dark skin tone, medium skin tone",
+	"👨🏾\u200d❤️\u200d👨🏾":     "couple with heart: man, man, medium-dark skin tone",
+	"👨🏾\u200d❤️\u200d👨🏿":     "couple with heart: man, man, medium-dark skin tone, dark skin tone",
+	"👨🏿\u200d❤️\u200d👨🏻":     "couple with heart: man, man, dark skin tone, light skin tone",
+	"👨🏿\u200d❤️\u200d👨🏼":     "couple with heart: man, man, dark skin tone, medium-light skin tone",
+	"👨🏿\u200d❤️\u200d👨🏽":     "couple with heart: man, man, dark skin tone, medium skin tone",
+	"👨🏿\u200d❤️\u200d👨🏾":     "couple with heart: man, man, dark skin tone, medium-dark skin tone",
+	"👨🏿\u200d❤️\u200d👨🏿":     "couple with heart: man, man, dark skin tone",
+	"👩\u200d❤️\u200d👩":       "couple with heart: woman, woman",
+	"👩🏻\u200d❤️\u200d👩🏻":     "couple with heart: woman, woman, light skin tone",
+	"👩🏻\u200d❤️\u200d👩🏼":     "couple with heart: woman, woman, light skin tone, medium-light skin tone",
+	"👩🏻\u200d❤️\u200d👩🏽":     "couple with heart: woman, woman, light skin tone, medium skin tone",
+	"👩🏻\u200d❤️\u200d👩🏾":     "couple with heart: woman, woman, light skin tone, medium-dark skin tone",
+	"👩🏻\u200d❤️\u200d👩🏿":     "couple with heart: woman, woman, light skin tone, dark skin tone",
+	"👩🏼\u200d❤️\u200d👩🏻":     "couple with heart: woman, woman, medium-light skin tone, light skin tone",
+	"👩🏼\u200d❤️\u200d👩🏼":     "couple with heart: woman, woman, medium-light skin tone",
+	"👩🏼\u200d❤️\u200d👩🏽":     "couple with heart: woman, woman, medium-light skin tone, medium skin tone",
+	"👩🏼\u200d❤️\u200d👩🏾":     "couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone",
+	"👩🏼\u200d❤️\u200d👩🏿":     "couple with heart: woman, woman, medium-light skin tone, dark skin tone",
+	"👩🏽\u200d❤️\u200d👩🏻":     "couple with heart: woman, woman, medium skin tone, light skin tone",
+	"👩🏽\u200d❤️\u200d👩🏼":     "couple with heart: woman, woman, medium skin tone, medium-light skin tone",
+	"👩🏽\u200d❤️\u200d👩🏽":     "couple with heart: woman, woman, medium skin tone",
+	"👩🏽\u200d❤️\u200d👩🏾":     "couple with heart: woman, woman, medium skin tone, medium-dark skin tone",
+	"👩🏽\u200d❤️\u200d👩🏿":     "couple with heart: woman, woman, medium skin tone, dark skin tone",
+	"👩🏾\u200d❤️\u200d👩🏻":     "couple with heart: woman, woman, medium-dark skin tone, light skin tone",
+	"👩🏾\u200d❤️\u200d👩🏼":     "couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone",
+	"👩🏾\u200d❤️\u200d👩🏽":     "couple with heart: woman, woman, medium-dark skin tone, medium skin tone",
+	"👩🏾\u200d❤️\u200d👩🏾":     "couple with heart: woman, woman, medium-dark skin tone",
+	"👩🏾\u200d❤️\u200d👩🏿":     "couple with heart: woman, woman, medium-dark skin tone, dark skin tone",
+	"👩🏿\u200d❤️\u200d👩🏻":     "couple with heart: woman, woman, dark skin tone, light skin tone",
+	"👩🏿\u200d❤️\u200d👩🏼":     "couple with heart: woman, woman, dark skin tone, medium-light skin tone",
+	"👩🏿\u200d❤️\u200d👩🏽":     "couple with heart: woman, woman, dark skin tone, medium skin tone",
+	"👩🏿\u200d❤️\u200d👩🏾":     "couple with heart: woman, woman, dark skin tone, medium-dark skin tone",
+	"👩🏿\u200d❤️\u200d👩🏿":     "couple with heart: woman, woman, dark skin tone",
+	"👪":                      "family",
+	"👨\u200d👩\u200d👦":        "family: man, woman, boy",
+	"👨\u200d👩\u200d👧":        "family: man, woman, girl",
+	"👨\u200d👩\u200d👧\u200d👦": "family: man, woman, girl, boy",
+	"👨\u200d👩\u200d👦\u200d👦": "family: man, woman, boy, boy",
+	"👨\u200d👩\u200d👧\u200d👧": "family: man, woman, girl, girl",
+	"👨\u200d👨\u200d👦":        "family: man, man, boy",
+	"👨\u200d👨\u200d👧":        "family: man, man, girl",
+	"👨\u200d👨\u200d👧\u200d👦": "family: man, man, girl, boy",
+	"👨\u200d👨\u200d👦\u200d👦": "family: man, man, boy, boy",
+	"👨\u200d👨\u200d👧\u200d👧": "family: man, man, girl, girl",
+	"👩\u200d👩\u200d👦":        "family: woman, woman, boy",
+	"👩\u200d👩\u200d👧":        "family: woman, woman, girl",
+	"👩\u200d👩\u200d👧\u200d👦": "family: woman, woman, girl, boy",
+	"👩\u200d👩\u200d👦\u200d👦": "family: woman, woman, boy, boy",
+	"👩\u200d👩\u200d👧\u200d👧": "family: woman, woman, girl, girl",
+	"👨\u200d👦":               "family: man, boy",
+	"👨\u200d👦\u200d👦":        "family: man, boy, boy",
+	"👨\u200d👧":               "family: man, girl",
+	"👨\u200d👧\u200d👦":        "family: man, girl, boy",
+	"👨\u200d👧\u200d👧":        "family: man, girl, girl",
+	"👩\u200d👦":               "family: woman, boy",
+	"👩\u200d👦\u200d👦":        "family: woman, boy, boy",
+	"👩\u200d👧":               "family: woman, girl",
+	"👩\u200d👧\u200d👦":        "family: woman, girl, boy",
+	"👩\u200d👧\u200d👧":        "family: woman, girl, girl",
+	"🗣️":                     "speaking head",
+	"👤":                      "bust in silhouette",
+	"👥":                      "busts in silhouette",
+	"🫂":                      "people hugging",
+	"👣":                      "footprints",
+	"🐵":                      "monkey face",
+	"🐒":                      "monkey",
+	"🦍":                      "gorilla",
+	"🦧":                      "orangutan",
+	"🐶":                      "dog face",
+	"🐕":                      "dog",
+	"🦮":                      "guide dog",
+	"🐕\u200d🦺":               "service dog",
+	"🐩":                      "poodle",
+	"🐺":                      "wolf",
+	"🦊":                      "fox",
+	"🦝":                      "raccoon",
+	"🐱":                      "cat face",
+	"🐈":                      "cat",
+	"🐈\u200d⬛":               "black cat",
+	"🦁":                      "lion",
+	"🐯":                      "tiger face",
+	"🐅":                      "tiger",
+	"🐆":                      "leopard",
+	"🐴":                      "horse face",
+	"🫎":                      "moose",
+	"🫏":                      "donkey",
+	"🐎":                      "horse",
+	"🦄":                      "unicorn",
+	"🦓":                      "zebra",
+	"🦌":                      "deer",
+	"🦬":                      "bison",
+	"🐮":                      "cow face",
+	"🐂":                      "ox",
+	"🐃":                      "water buffalo",
+	"🐄":                      "cow",
+	"🐷":                      "pig face",
+	"🐖":                      "pig",
+	"🐗":                      "boar",
+	"🐽":                      "pig nose",
+	"🐏":                      "ram",
+	"🐑":                      "ewe",
+	"🐐":                      "goat",
+	"🐪":                      "camel",
+	"🐫":                      "two-hump camel",
+	"🦙":                      "llama",
+	"🦒":                      "giraffe",
+	"🐘":                      "elephant",
+	"🦣":                      "mammoth",
+	"🦏":                      "rhinoceros",
+	"🦛":                      "hippopotamus",
+	"🐭":                      "mouse face",
+	"🐁":                      "mouse",
+	"🐀":                      "rat",
+	"🐹":                      "hamster",
+	"🐰":                      "rabbit face",
+	"🐇":                      "rabbit",
+	"🐿️":                     "chipmunk",
+	"🦫":                      "beaver",
+	"🦔":                      "hedgehog",
+	"🦇":                      "bat",
+	"🐻":                      "bear",
+	"🐻\u200d❄️":              "polar bear",
+	"🐨":                      "koala",
+	"🐼":                      "panda",
+	"🦥":                      "sloth",
+	"🦦":                      "otter",
+	"🦨":                      "skunk",
+	"🦘":                      "kangaroo",
+	"🦡":                      "badger",
+	"🐾":                      "paw prints",
+	"🦃":                      "turkey",
+	"🐔":                      "chicken",
+	"🐓":                      "rooster",
+	"🐣":                      "hatching chick",
+	"🐤":                      "baby chick",
+	"🐥":                      "front-facing baby chick",
+	"🐦":                      "bird",
+	"🐧":                      "penguin",
+	"🕊️":                     "dove",
+	"🦅":                      "eagle",
+	"🦆":                      "duck",
+	"🦢":                      "swan",
+	"🦉":                      "owl",
+	"🦤":                      "dodo",
+	"🪶":                      "feather",
+	"🦩":                      "flamingo",
+	"🦚":                      "peacock",
+	"🦜":                      "parrot",
+	"🪽":                      "wing",
+	"🐦\u200d⬛":               "black bird",
+	"🪿":                      "goose",
+	"🐸":                      "frog",
+	"🐊":                      "crocodile",
+	"🐢":                      "turtle",
+	"🦎":                      "lizard",
+	"🐍":                      "snake",
+	"🐲":                      "dragon face",
+	"🐉":                      "dragon",
+	"🦕":                      "sauropod",
+	"🦖":                      "T-Rex",
+	"🐳":                      "spouting whale",
+	"🐋":                      "whale",
+	"🐬":                      "dolphin",
+	"🦭":                      "seal",
+	"🐟":                      "fish",
+	"🐠":                      "tropical fish",
+	"🐡":                      "blowfish",
+	"🦈":                      "shark",
+	"🐙":                      "octopus",
+	"🐚":                      "spiral shell",
+	"🪸":                      "coral",
+	"🪼":                      "jellyfish",
+	"🐌":                      "snail",
+	"🦋":                      "butterfly",
+	"🐛":                      "bug",
+	"🐜":                      "ant",
+	"🐝":                      "honeybee",
+	"🪲":                      "beetle",
+	"🐞":                      "lady beetle",
+	"🦗":                      "cricket",
+	"🪳":                      "cockroach",
+	"🕷️":                     "spider",
+	"🕸️":                     "spider web",
+	"🦂":                      "scorpion",
+	"🦟":                      "mosquito",
+	"🪰":                      "fly",
+	"🪱":                      "worm",
+	"🦠":                      "microbe",
+	"💐":                      "bouquet",
+	"🌸":                      "cherry blossom",
+	"💮":                      "white flower",
+	"🪷":                      "lotus",
+	"🏵️":                     "rosette",
+	"🌹":                      "rose",
+	"🥀":                      "wilted flower",
+	"🌺":                      "hibiscus",
+	"🌻":                      "sunflower",
+	"🌼":                      "blossom",
+	"🌷":                      "tulip",
+	"🪻":                      "hyacinth",
+	"🌱":                      "seedling",
+	"🪴":                      "potted plant",
+	"🌲":                      "evergreen tree",
+	"🌳":                      "deciduous tree",
+	"🌴":                      "palm tree",
+	"🌵":                      "cactus",
+	"🌾":                      "sheaf of rice",
+	"🌿":                      "herb",
+	"☘️":                     "shamrock",
+	"🍀":                      "four leaf clover",
+	"🍁":                      "maple leaf",
+	"🍂":                      "fallen leaf",
+	"🍃":                      "leaf fluttering in wind",
+	"🪹":                      "empty nest",
+	"🪺":                      "nest with eggs",
+	"🍄":                      "mushroom",
+	"🍇":                      "grapes",
+	"🍈":                      "melon",
+	"🍉":                      "watermelon",
+	"🍊":                      "tangerine",
+	"🍋":                      "lemon",
+	"🍌":                      "banana",
+	"🍍":                      "pineapple",
+	"🥭":                      "mango",
+	"🍎":                      "red apple",
+	"🍏":                      "green apple",
+	"🍐":                      "pear",
+	"🍑":                      "peach",
+	"🍒":                      "cherries",
+	"🍓":                      "strawberry",
+	"🫐":                      "blueberries",
+	"🥝":                      "kiwi fruit",
+	"🍅":                      "tomato",
+	"🫒":                      "olive",
+	"🥥":                      "coconut",
+	"🥑":                      "avocado",
+	"🍆":                      "eggplant",
+	"🥔":                      "potato",
+	"🥕":                      "carrot",
+	"🌽":                      "ear of corn",
+	"🌶️":                     "hot pepper",
+	"🫑":                      "bell pepper",
+	"🥒":                      "cucumber",
+	"🥬":                      "leafy green",
+	"🥦":                      "broccoli",
+	"🧄":                      "garlic",
+	"🧅":                      "onion",
+	"🥜":                      "peanuts",
+	"🫘":                      "beans",
+	"🌰":                      "chestnut",
+	"🫚":                      "ginger root",
+	"🫛":                      "pea pod",
+	"🍞":                      "bread",
+	"🥐":                      "croissant",
+	"🥖":                      "baguette bread",
+	"🫓":                      "flatbread",
+	"🥨":                      "pretzel",
+	"🥯":                      "bagel",
+	"🥞":                      "pancakes",
+	"🧇":                      "waffle",
+	"🧀":                      "cheese wedge",
+	"🍖":                      "meat on bone",
+	"🍗":                      "poultry leg",
+	"🥩":                      "cut of meat",
+	"🥓":                      "bacon",
+	"🍔":                      "hamburger",
+	"🍟":                      "french fries",
+	"🍕":                      "pizza",
+	"🌭":                      "hot dog",
+	"🥪":                      "sandwich",
+	"🌮":                      "taco",
+	"🌯":                      "burrito",
+	"🫔":                      "tamale",
+	"🥙":                      "stuffed flatbread",
+	"🧆":                      "falafel",
+	"🥚":                      "egg",
+	"🍳":                      "cooking",
+	"🥘":                      "shallow pan of food",
+	"🍲":                      "pot of food",
+	"🫕":                      "fondue",
+	"🥣":                      "bowl with spoon",
+	"🥗":                      "green salad",
+	"🍿":                      "popcorn",
+	"🧈":                      "butter",
+	"🧂":                      "salt",
+	"🥫":                      "canned food",
+	"🍱":                      "bento box",
+	"🍘":                      "rice cracker",
+	"🍙":                      "rice ball",
+	"🍚":                      "cooked rice",
+	"🍛":                      "curry rice",
+	"🍜":                      "steaming bowl",
+	"🍝":                      "spaghetti",
+	"🍠":                      "roasted sweet potato",
+	"🍢":                      "oden",
+	"🍣":                      "sushi",
+	"🍤":                      "fried shrimp",
+	"🍥":                      "fish cake with swirl",
+	"🥮":                      "moon cake",
+	"🍡":                      "dango",
+	"🥟":                      "dumpling",
+	"🥠":                      "fortune cookie",
+	"🥡":                      "takeout box",
+	"🦀":                      "crab",
+	"🦞":                      "lobster",
+	"🦐":                      "shrimp",
+	"🦑":                      "squid",
+	"🦪":                      "oyster",
+	"🍦":                      "soft ice cream",
+	"🍧":                      "shaved ice",
+	"🍨":                      "ice cream",
+	"🍩":                      "doughnut",
+	"🍪":                      "cookie",
+	"🎂":                      "birthday cake",
+	"🍰":                      "shortcake",
+	"🧁":                      "cupcake",
+	"🥧":                      "pie",
+	"🍫":                      "chocolate bar",
+	"🍬":                      "candy",
+	"🍭":                      "lollipop",
+	"🍮":                      "custard",
+	"🍯":                      "honey pot",
+	"🍼":                      "baby bottle",
+	"🥛":                      "glass of milk",
+	"☕":                      "hot beverage",
+	"🫖":                      "teapot",
+	"🍵":                      "teacup without handle",
+	"🍶":                      "sake",
+	"🍾":                      "bottle with popping cork",
+	"🍷":                      "wine glass",
+	"🍸":                      "cocktail glass",
+	"🍹":                      "tropical drink",
+	"🍺":                      "beer mug",
+	"🍻":                      "clinking beer mugs",
+	"🥂":                      "clinking glasses",
+	"🥃":                      "tumbler glass",
+	"🫗":                      "pouring liquid",
+	"🥤":                      "cup with straw",
+	"🧋":                      "bubble tea",
+	"🧃":                      "beverage box",
+	"🧉":                      "mate",
+	"🧊":                      "ice",
+	"🥢":                      "chopsticks",
+	"🍽️":                     "fork and knife with plate",
+	"🍴":                      "fork and knife",
+	"🥄":                      "spoon",
+	"🔪":                      "kitchen knife",
+	"🫙":                      "jar",
+	"🏺":                      "amphora",
+	"🌍":                      "globe showing Europe-Africa",
+	"🌎":                      "globe showing Americas",
+	"🌏":                      "globe showing Asia-Australia",
+	"🌐":                      "globe with meridians",
+	"🗺️":                     "world map",
+	"🗾":                      "map of Japan",
+	"🧭":                      "compass",
+	"🏔️":                     "snow-capped mountain",
+	"⛰️":                     "mountain",
+	"🌋":                      "volcano",
+	"🗻":                      "mount fuji",
+	"🏕️":                     "camping",
+	"🏖️":                     "beach with umbrella",
+	"🏜️":                     "desert",
+	"🏝️":                     "desert island",
+	"🏞️":                     "national park",
+	"🏟️":                     "stadium",
+	"🏛️":                     "classical building",
+	"🏗️":                     "building construction",
+	"🧱":                      "brick",
+	"🪨":                      "rock",
+	"🪵":                      "wood",
+	"🛖":                      "hut",
+	"🏘️":                     "houses",
+	"🏚️":                     "derelict house",
+	"🏠":                      "house",
+	"🏡":                      "house with garden",
+	"🏢":                      "office building",
+	"🏣":                      "Japanese post office",
+	"🏤":                      "post office",
+	"🏥":                      "hospital",
+	"🏦":                      "bank",
+	"🏨":                      "hotel",
+	"🏩":                      "love hotel",
+	"🏪":                      "convenience store",
+	"🏫":                      "school",
+	"🏬":                      "department store",
+	"🏭":                      "factory",
+	"🏯":                      "Japanese castle",
+	"🏰":                      "castle",
+	"💒":                      "wedding",
+	"🗼":                      "Tokyo tower",
+	"🗽":                      "Statue of Liberty",
+	"⛪":                      "church",
+	"🕌":                      "mosque",
+	"🛕":                      "hindu temple",
+	"🕍":                      "synagogue",
+	"⛩️":                     "shinto shrine",
+	"🕋":                      "kaaba",
+	"⛲":                      "fountain",
+	"⛺":                      "tent",
+	"🌁":                      "foggy",
+	"🌃":                      "night with stars",
+	"🏙️":                     "cityscape",
+	"🌄":                      "sunrise over mountains",
+	"🌅":                      "sunrise",
+	"🌆":                      "cityscape at dusk",
+	"🌇":                      "sunset",
+	"🌉":                      "bridge at night",
+	"♨️":                     "hot springs",
+	"🎠":                      "carousel horse",
+	"🛝":                      "playground slide",
+	"🎡":                      "ferris wheel",
+	"🎢":                      "roller coaster",
+	"💈":                      "barber pole",
+	"🎪":                      "circus tent",
+	"🚂":                      "locomotive",
+	"🚃":                      "railway car",
+	"🚄":                      "high-speed train",
+	"🚅":                      "bullet train",
+	"🚆":                      "train",
+	"🚇":                      "metro",
+	"🚈":                      "light rail",
+	"🚉":                      "station",
+	"🚊":                      "tram",
+	"🚝":                      "monorail",
+	"🚞":                      "mountain railway",
+	"🚋":                      "tram car",
+	"🚌":                      "bus",
+	"🚍":                      "oncoming bus",
+	"🚎":                      "trolleybus",
+	"🚐":                      "minibus",
+	"🚑":                      "ambulance",
+	"🚒":                      "fire engine",
+	"🚓":                      "police car",
+	"🚔":                      "oncoming police car",
+	"🚕":                      "taxi",
+	"🚖":                      "oncoming taxi",
+	"🚗":                      "automobile",
+	"🚘":                      "oncoming automobile",
+	"🚙":                      "sport utility vehicle",
+	"🛻":                      "pickup truck",
+	"🚚":                      "delivery truck",
+	"🚛":                      "articulated lorry",
+	"🚜":                      "tractor",
+	"🏎️":                     "racing car",
+	"🏍️":                     "motorcycle",
+	"🛵":                      "motor scooter",
+	"🦽":                      "manual wheelchair",
+	"🦼":                      "motorized wheelchair",
+	"🛺":                      "auto rickshaw",
+	"🚲":                      "bicycle",
+	"🛴":                      "kick scooter",
+	"🛹":                      "skateboard",
+	"🛼":                      "roller skate",
+	"🚏":                      "bus stop",
+	"🛣️":                     "motorway",
+	"🛤️":                     "railway track",
+	"🛢️":                     "oil drum",
+	"⛽":                      "fuel pump",
+	"🛞":                      "wheel",
+	"🚨":                      "police car light",
+	"🚥":                      "horizontal traffic light",
+	"🚦":                      "vertical traffic light",
+	"🛑":                      "stop sign",
+	"🚧":                      "construction",
+	"⚓":                      "anchor",
+	"🛟":                      "ring buoy",
+	"⛵":                      "sailboat",
+	"🛶":                      "canoe",
+	"🚤":                      "speedboat",
+	"🛳️":                     "passenger ship",
+	"⛴️":                     "ferry",
+	"🛥️":                     "motor boat",
+	"🚢":                      "ship",
+	"✈️":                     "airplane",
+	"🛩️":                     "small airplane",
+	"🛫":                      "airplane departure",
+	"🛬":                      "airplane arrival",
+	"🪂":                      "parachute",
+	"💺":                      "seat",
+	"🚁":                      "helicopter",
+	"🚟":                      "suspension railway",
+	"🚠":                      "mountain cableway",
+	"🚡":                      "aerial tramway",
+	"🛰️":                     "satellite",
+	"🚀":                      "rocket",
+	"🛸":                      "flying saucer",
+	"🛎️":                     "bellhop bell",
+	"🧳":                      "luggage",
+	"⌛":                      "hourglass done",
+	"⏳":                      "hourglass not done",
+	"⌚":                      "watch",
+	"⏰":                      "alarm clock",
+	"⏱️":                     "stopwatch",
+	"⏲️":                     "timer clock",
+	"🕰️":                     "mantelpiece clock",
+	"🕛":                      "twelve o’clock",
+	"🕧":                      "twelve-thirty",
+	"🕐":                      "one o’clock",
+	"🕜":                      "one-thirty",
+	"🕑":                      "two o’clock",
+	"🕝":                      "two-thirty",
+	"🕒":                      "three o’clock",
+	"🕞":                      "three-thirty",
+	"🕓":                      "four o’clock",
+	"🕟":                      "four-thirty",
+	"🕔":                      "five o’clock",
+	"🕠":                      "five-thirty",
+	"🕕":                      "six o’clock",
+	"🕡":                      "six-thirty",
+	"🕖":                      "seven o’clock",
+	"🕢":                      "seven-thirty",
+	"🕗":                      "eight o’clock",
+	"🕣":                      "eight-thirty",
+	"🕘":                      "nine o’clock",
+	"🕤":                      "nine-thirty",
+	"🕙":                      "ten o’clock",
+	"🕥":                      "ten-thirty",
+	"🕚":                      "eleven o’clock",
+	"🕦":                      "eleven-thirty",
+	"🌑":                      "new moon",
+	"🌒":                      "waxing crescent moon",
+	"🌓":                      "first quarter moon",
+	"🌔":                      "waxing gibbous moon",
+	"🌕":                      "full moon",
+	"🌖":                      "waning gibbous moon",
+	"🌗":                      "last quarter moon",
+	"🌘":                      "waning crescent moon",
+	"🌙":                      "crescent moon",
+	"🌚":                      "new moon face",
+	"🌛":                      "first quarter moon face",
+	"🌜":                      "last quarter moon face",
+	"🌡️":                     "thermometer",
+	"☀️":                     "sun",
+	"🌝":                      "full moon face",
+	"🌞":                      "sun with face",
+	"🪐":                      "ringed planet",
+	"⭐":                      "star",
+	"🌟":                      "glowing star",
+	"🌠":                      "shooting star",
+	"🌌":                      "milky way",
+	"☁️":                     "cloud",
+	"⛅":                      "sun behind cloud",
+	"⛈️":                     "cloud with lightning and rain",
+	"🌤️":                     "sun behind small cloud",
+	"🌥️":                     "sun behind large cloud",
+	"🌦️":                     "sun behind rain cloud",
+	"🌧️":                     "cloud with rain",
+	"🌨️":                     "cloud with snow",
+	"🌩️":                     "cloud with lightning",
+	"🌪️":                     "tornado",
+	"🌫️":                     "fog",
+	"🌬️":                     "wind face",
+	"🌀":                      "cyclone",
+	"🌈":                      "rainbow",
+	"🌂":                      "closed umbrella",
+	"☂️":                     "umbrella",
+	"☔":                      "umbrella with rain drops",
+	"⛱️":                     "umbrella on ground",
+	"⚡":                      "high voltage",
+	"❄️":                     "snowflake",
+	"☃️":                     "snowman",
+	"⛄":                      "snowman without snow",
+	"☄️":                     "comet",
+	"🔥":                      "fire",
+	"💧":                      "droplet",
+	"🌊":                      "water wave",
+	"🎃":                      "jack-o-lantern",
+	"🎄":                      "Christmas tree",
+	"🎆":                      "fireworks",
+	"🎇":                      "sparkler",
+	"🧨":                      "firecracker",
+	"✨":                      "sparkles",
+	"🎈":                      "balloon",
+	"🎉":                      "party popper",
+	"🎊":                      "confetti ball",
+	"🎋":                      "tanabata tree",
+	"🎍":                      "pine decoration",
+	"🎎":                      "Japanese dolls",
+	"🎏":                      "carp streamer",
+	"🎐":                      "wind chime",
+	"🎑":                      "moon viewing ceremony",
+	"🧧":                      "red envelope",
+	"🎀":                      "ribbon",
+	"🎁":                      "wrapped gift",
+	"🎗️":                     "reminder ribbon",
+	"🎟️":                     "admission tickets",
+	"🎫":                      "ticket",
+	"🎖️":                     "military medal",
+	"🏆":                      "trophy",
+	"🏅":                      "sports medal",
+	"🥇":                      "1st place medal",
+	"🥈":                      "2nd place medal",
+	"🥉":                      "3rd place medal",
+	"⚽":                      "soccer ball",
+	"⚾":                      "baseball",
+	"🥎":                      "softball",
+	"🏀":                      "basketball",
+	"🏐":                      "volleyball",
+	"🏈":                      "american football",
+	"🏉":                      "rugby football",
+	"🎾":                      "tennis",
+	"🥏":                      "flying disc",
+	"🎳":                      "bowling",
+	"🏏":                      "cricket game",
+	"🏑":                      "field hockey",
+	"🏒":                      "ice hockey",
+	"🥍":                      "lacrosse",
+	"🏓":                      "ping pong",
+	"🏸":                      "badminton",
+	"🥊":                      "boxing glove",
+	"🥋":                      "martial arts uniform",
+	"🥅":                      "goal net",
+	"⛳":                      "flag in hole",
+	"⛸️":                     "ice skate",
+	"🎣":                      "fishing pole",
+	"🤿":                      "diving mask",
+	"🎽":                      "running shirt",
+	"🎿":                      "skis",
+	"🛷":                      "sled",
+	"🥌":                      "curling stone",
+	"🎯":                      "bullseye",
+	"🪀":                      "yo-yo",
+	"🪁":                      "kite",
+	"🔫":                      "water pistol",
+	"🎱":                      "pool 8 ball",
+	"🔮":                      "crystal ball",
+	"🪄":                      "magic wand",
+	"🎮":                      "video game",
+	"🕹️":                     "joystick",
+	"🎰":                      "slot machine",
+	"🎲":                      "game die",
+	"🧩":                      "puzzle piece",
+	"🧸":                      "teddy bear",
+	"🪅":                      "piñata",
+	"🪩":                      "mirror ball",
+	"🪆":                      "nesting dolls",
+	"♠️":                     "spade suit",
+	"♥️":                     "heart suit",
+	"♦️":                     "diamond suit",
+	"♣️":                     "club suit",
+	"♟️":                     "chess pawn",
+	"🃏":                      "joker",
+	"🀄":                      "mahjong red dragon",
+	"🎴":                      "flower playing cards",
+	"🎭":                      "performing arts",
+	"🖼️":                     "framed picture",
+	"🎨":                      "artist palette",
+	"🧵":                      "thread",
+	"🪡":                      "sewing needle",
+	"🧶":                      "yarn",
+	"🪢":                      "knot",
+	"👓":                      "glasses",
+	"🕶️":                     "sunglasses",
+	"🥽":                      "goggles",
+	"🥼":                      "lab coat",
+	"🦺":                      "safety vest",
+	"👔":                      "necktie",
+	"👕":                      "t-shirt",
+	"👖":                      "jeans",
+	"🧣":                      "scarf",
+	"🧤":                      "gloves",
+	"🧥":                      "coat",
+	"🧦":                      "socks",
+	"👗":                      "dress",
+	"👘":                      "kimono",
+	"🥻":                      "sari",
+	"🩱":                      "one-piece swimsuit",
+	"🩲":                      "briefs",
+	"🩳":                      "shorts",
+	"👙":                      "bikini",
+	"👚":                      "woman’s clothes",
+	"🪭":                      "folding hand fan",
+	"👛":                      "purse",
+	"👜":                      "handbag",
+	"👝":                      "clutch bag",
+	"🛍️":                     "shopping bags",
+	"🎒":                      "backpack",
+	"🩴":                      "thong sandal",
+	"👞":                      "man’s shoe",
+	"👟":                      "running shoe",
+	"🥾":                      "hiking boot",
+	"🥿":                      "flat shoe",
+	"👠":                      "high-heeled shoe",
+	"👡":                      "woman’s sandal",
+	"🩰":                      "ballet shoes",
+	"👢":                      "woman’s boot",
+	"🪮":                      "hair pick",
+	"👑":                      "crown",
+	"👒":                      "woman’s hat",
+	"🎩":                      "top hat",
+	"🎓":                      "graduation cap",
+	"🧢":                      "billed cap",
+	"🪖":                      "military helmet",
+	"⛑️":                     "rescue worker’s helmet",
+	"📿":                      "prayer beads",
+	"💄":                      "lipstick",
+	"💍":                      "ring",
+	"💎":                      "gem stone",
+	"🔇":                      "muted speaker",
+	"🔈":                      "speaker low volume",
+	"🔉":                      "speaker medium volume",
+	"🔊":                      "speaker high volume",
+	"📢":                      "loudspeaker",
+	"📣":                      "megaphone",
+	"📯":                      "postal horn",
+	"🔔":                      "bell",
+	"🔕":                      "bell with slash",
+	"🎼":                      "musical score",
+	"🎵":                      "musical note",
+	"🎶":                      "musical notes",
+	"🎙️":                     "studio microphone",
+	"🎚️":                     "level slider",
+	"🎛️":                     "control knobs",
+	"🎤":                      "microphone",
+	"🎧":                      "headphone",
+	"📻":                      "radio",
+	"🎷":                      "saxophone",
+	"🪗":                      "accordion",
+	"🎸":                      "guitar",
+	"🎹":                      "musical keyboard",
+	"🎺":                      "trumpet",
+	"🎻":                      "violin",
+	"🪕":                      "banjo",
+	"🥁":                      "drum",
+	"🪘":                      "long drum",
+	"🪇":                      "maracas",
+	"🪈":                      "flute",
+	"📱":                      "mobile phone",
+	"📲":                      "mobile phone with arrow",
+	"☎️":                     "telephone",
+	"📞":                      "telephone receiver",
+	"📟":                      "pager",
+	"📠":                      "fax machine",
+	"🔋":                      "battery",
+	"🪫":                      "low battery",
+	"🔌":                      "electric plug",
+	"💻":                      "laptop",
+	"🖥️":                     "desktop computer",
+	"🖨️":                     "printer",
+	"⌨️":                     "keyboard",
+	"🖱️":                     "computer mouse",
+	"🖲️":                     "trackball",
+	"💽":                      "computer disk",
+	"💾":                      "floppy disk",
+	"💿":                      "optical disk",
+	"📀":                      "dvd",
+	"🧮":                      "abacus",
+	"🎥":                      "movie camera",
+	"🎞️":                     "film frames",
+	"📽️":                     "film projector",
+	"🎬":                      "clapper board",
+	"📺":                      "television",
+	"📷":                      "camera",
+	"📸":                      "camera with flash",
+	"📹":                      "video camera",
+	"📼":                      "videocassette",
+	"🔍":                      "magnifying glass tilted left",
+	"🔎":                      "magnifying glass tilted right",
+	"🕯️":                     "candle",
+	"💡":                      "light bulb",
+	"🔦":                      "flashlight",
+	"🏮":                      "red paper lantern",
+	"🪔":                      "diya lamp",
+	"📔":                      "notebook with decorative cover",
+	"📕":                      "closed book",
+	"📖":                      "open book",
+	"📗":                      "green book",
+	"📘":                      "blue book",
+	"📙":                      "orange book",
+	"📚":                      "books",
+	"📓":                      "notebook",
+	"📒":                      "ledger",
+	"📃":                      "page with curl",
+	"📜":                      "scroll",
+	"📄":                      "page facing up",
+	"📰":                      "newspaper",
+	"🗞️":                     "rolled-up newspaper",
+	"📑":                      "bookmark tabs",
+	"🔖":                      "bookmark",
+	"🏷️":                     "label",
+	"💰":                      "money bag",
+	"🪙":                      "coin",
+	"💴":                      "yen banknote",
+	"💵":                      "dollar banknote",
+	"💶":                      "euro banknote",
+	"💷":                      "pound banknote",
+	"💸":                      "money with wings",
+	"💳":                      "credit card",
+	"🧾":                      "receipt",
+	"💹":                      "chart increasing with yen",
+	"✉️":                     "envelope",
+	"📧":                      "e-mail",
+	"📨":                      "incoming envelope",
+	"📩":                      "envelope with arrow",
+	"📤":                      "outbox tray",
+	"📥":                      "inbox tray",
+	"📦":                      "package",
+	"📫":                      "closed mailbox with raised flag",
+	"📪":                      "closed mailbox with lowered flag",
+	"📬":                      "open mailbox with raised flag",
+	"📭":                      "open mailbox with lowered flag",
+	"📮":                      "postbox",
+	"🗳️":                     "ballot box with ballot",
+	"✏️":                     "pencil",
+	"✒️":                     "black nib",
+	"🖋️":                     "fountain pen",
+	"🖊️":                     "pen",
+	"🖌️":                     "paintbrush",
+	"🖍️":                     "crayon",
+	"📝":                      "memo",
+	"💼":                      "briefcase",
+	"📁":                      "file folder",
+	"📂":                      "open file folder",
+	"🗂️":                     "card index dividers",
+	"📅":                      "calendar",
+	"📆":                      "tear-off calendar",
+	"🗒️":                     "spiral notepad",
+	"🗓️":                     "spiral calendar",
+	"📇":                      "card index",
+	"📈":                      "chart increasing",
+	"📉":                      "chart decreasing",
+	"📊":                      "bar chart",
+	"📋":                      "clipboard",
+	"📌":                      "pushpin",
+	"📍":                      "round pushpin",
+	"📎":                      "paperclip",
+	"🖇️":                     "linked paperclips",
+	"📏":                      "straight ruler",
+	"📐":                      "triangular ruler",
+	"✂️":                     "scissors",
+	"🗃️":                     "card file box",
+	"🗄️":                     "file cabinet",
+	"🗑️":                     "wastebasket",
+	"🔒":                      "locked",
+	"🔓":                      "unlocked",
+	"🔏":                      "locked with pen",
+	"🔐":                      "locked with key",
+	"🔑":                      "key",
+	"🗝️":                     "old key",
+	"🔨":                      "hammer",
+	"🪓":                      "axe",
+	"⛏️":                     "pick",
+	"⚒️":                     "hammer and pick",
+	"🛠️":                     "hammer and wrench",
+	"🗡️":                     "dagger",
+	"⚔️":                     "crossed swords",
+	"💣":                      "bomb",
+	"🪃":                      "boomerang",
+	"🏹":                      "bow and arrow",
+	"🛡️":                     "shield",
+	"🪚":                      "carpentry saw",
+	"🔧":                      "wrench",
+	"🪛":                      "screwdriver",
+	"🔩":                      "nut and bolt",
+	"⚙️":                     "gear",
+	"🗜️":                     "clamp",
+	"⚖️":                     "balance scale",
+	"🦯":                      "white cane",
+	"🔗":                      "link",
+	"⛓️":                     "chains",
+	"🪝":                      "hook",
+	"🧰":                      "toolbox",
+	"🧲":                      "magnet",
+	"🪜":                      "ladder",
+	"⚗️":                     "alembic",
+	"🧪":                      "test tube",
+	"🧫":                      "petri dish",
+	"🧬":                      "dna",
+	"🔬":                      "microscope",
+	"🔭":                      "telescope",
+	"📡":                      "satellite antenna",
+	"💉":                      "syringe",
+	"🩸":                      "drop of blood",
+	"💊":                      "pill",
+	"🩹":                      "adhesive bandage",
+	"🩼":                      "crutch",
+	"🩺":                      "stethoscope",
+	"🩻":                      "x-ray",
+	"🚪":                      "door",
+	"🛗":                      "elevator",
+	"🪞":                      "mirror",
+	"🪟":                      "window",
+	"🛏️":                     "bed",
+	"🛋️":                     "couch and lamp",
+	"🪑":                      "chair",
+	"🚽":                      "toilet",
+	"🪠":                      "plunger",
+	"🚿":                      "shower",
+	"🛁":                      "bathtub",
+	"🪤":                      "mouse trap",
+	"🪒":                      "razor",
+	"🧴":                      "lotion bottle",
+	"🧷":                      "safety pin",
+	"🧹":                      "broom",
+	"🧺":                      "basket",
+	"🧻":                      "roll of paper",
+	"🪣":                      "bucket",
+	"🧼":                      "soap",
+	"🫧":                      "bubbles",
+	"🪥":                      "toothbrush",
+	"🧽":                      "sponge",
+	"🧯":                      "fire extinguisher",
+	"🛒":                      "shopping cart",
+	"🚬":                      "cigarette",
+	"⚰️":                     "coffin",
+	"🪦":                      "headstone",
+	"⚱️":                     "funeral urn",
+	"🧿":                      "nazar amulet",
+	"🪬":                      "hamsa",
+	"🗿":                      "moai",
+	"🪧":                      "placard",
+	"🪪":                      "identification card",
+	"🏧":                      "ATM sign",
+	"🚮":                      "litter in bin sign",
+	"🚰":                      "potable water",
+	"♿":                      "wheelchair symbol",
+	"🚹":                      "men’s room",
+	"🚺":                      "women’s room",
+	"🚻":                      "restroom",
+	"🚼":                      "baby symbol",
+	"🚾":                      "water closet",
+	"🛂":                      "passport control",
+	"🛃":                      "customs",
+	"🛄":                      "baggage claim",
+	"🛅":                      "left luggage",
+	"⚠️":                     "warning",
+	"🚸":                      "children crossing",
+	"⛔":                      "no entry",
+	"🚫":                      "prohibited",
+	"🚳":                      "no bicycles",
+	"🚭":                      "no smoking",
+	"🚯":                      "no littering",
+	"🚱":                      "non-potable water",
+	"🚷":                      "no pedestrians",
+	"📵":                      "no mobile phones",
+	"🔞":                      "no one under eighteen",
+	"☢️":                     "radioactive",
+	"☣️":                     "biohazard",
+	"⬆️":                     "up arrow",
+	"↗️":                     "up-right arrow",
+	"➡️":                     "right arrow",
+	"↘️":                     "down-right arrow",
+	"⬇️":                     "down arrow",
+	"↙️":                     "down-left arrow",
+	"⬅️":                     "left arrow",
+	"↖️":                     "up-left arrow",
+	"↕️":                     "up-down arrow",
+	"↔️":                     "left-right arrow",
+	"↩️":                     "right arrow curving left",
+	"↪️":                     "left arrow curving right",
+	"⤴️":                     "right arrow curving up",
+	"⤵️":                     "right arrow curving down",
+	"🔃":                      "clockwise vertical arrows",
+	"🔄":                      "counterclockwise arrows button",
+	"🔙":                      "BACK arrow",
+	"🔚":                      "END arrow",
+	"🔛":                      "ON! arrow",
+	"🔜":                      "SOON arrow",
+	"🔝":                      "TOP arrow",
+	"🛐":                      "place of worship",
+	"⚛️":                     "atom symbol",
+	"🕉️":                     "om",
+	"✡️":                     "star of David",
+	"☸️":                     "wheel of dharma",
+	"☯️":                     "yin yang",
+	"✝️":                     "latin cross",
+	"☦️":                     "orthodox cross",
+	"☪️":                     "star and crescent",
+	"☮️":                     "peace symbol",
+	"🕎":                      "menorah",
+	"🔯":                      "dotted six-pointed star",
+	"🪯":                      "khanda",
+	"♈":                      "Aries",
+	"♉":                      "Taurus",
+	"♊":                      "Gemini",
+	"♋":                      "Cancer",
+	"♌":                      "Leo",
+	"♍":                      "Virgo",
+	"♎":                      "Libra",
+	"♏":                      "Scorpio",
+	"♐":                      "Sagittarius",
+	"♑":                      "Capricorn",
+	"♒":                      "Aquarius",
+	"♓":                      "Pisces",
+	"⛎":                      "Ophiuchus",
+	"🔀":                      "shuffle tracks button",
+	"🔁":                      "repeat button",
+	"🔂":                      "repeat single button",
+	"▶️":                     "play button",
+	"⏩":                      "fast-forward button",
+	"⏭️":                     "next track button",
+	"⏯️":                     "play or pause button",
+	"◀️":                     "reverse button",
+	"⏪":                      "fast reverse button",
+	"⏮️":                     "last track button",
+	"🔼":                      "upwards button",
+	"⏫":                      "fast up button",
+	"🔽":                      "downwards button",
+	"⏬":                      "fast down button",
+	"⏸️":                     "pause button",
+	"⏹️":                     "stop button",
+	"⏺️":                     "record button",
+	"⏏️":                     "eject button",
+	"🎦":                      "cinema",
+	"🔅":                      "dim button",
+	"🔆":                      "bright button",
+	"📶":                      "antenna bars",
+	"🛜":                      "wireless",
+	"📳":                      "vibration mode",
+	"📴":                      "mobile phone off",
+	"♀️":                     "female sign",
+	"♂️":                     "male sign",
+	"⚧️":                     "transgender symbol",
+	"✖️":                     "multiply",
+	"➕":                      "plus",
+	"➖":                      "minus",
+	"➗":                      "divide",
+	"🟰":                      "heavy equals sign",
+	"♾️":                     "infinity",
+	"‼️":                     "double exclamation mark",
+	"⁉️":                     "exclamation question mark",
+	"❓":                      "red question mark",
+	"❔":                      "white question mark",
+	"❕":                      "white exclamation mark",
+	"❗":                      "red exclamation mark",
+	"〰️":                     "wavy dash",
+	"💱":                      "currency exchange",
+	"💲":                      "heavy dollar sign",
+	"⚕️":                     "medical symbol",
+	"♻️":                     "recycling symbol",
+	"⚜️":                     "fleur-de-lis",
+	"🔱":                      "trident emblem",
+	"📛":                      "name badge",
+	"🔰":                      "Japanese symbol for beginner",
+	"⭕":                      "hollow red circle",
+	"✅":                      "check mark button",
+	"☑️":                     "check box with check",
+	"✔️":                     "check mark",
+	"❌":                      "cross mark",
+	"❎":                      "cross mark button",
+	"➰":                      "curly loop",
+	"➿":                      "double curly loop",
+	"〽️":                     "part alternation mark",
+	"✳️":                     "eight-spoked asterisk",
+	"✴️":                     "eight-pointed star",
+	"❇️":                     "sparkle",
+	"©️":                     "copyright",
+	"®️":                     "registered",
+	"™️":                     "trade mark",
+	"#️⃣":                    "keycap: #",
+	"*️⃣":                    "keycap: *",
+	"0️⃣":                    "keycap: 0",
+	"1️⃣":                    "keycap: 1",
+	"2️⃣":                    "keycap: 2",
+	"3️⃣":                    "keycap: 3",
+	"4️⃣":                    "keycap: 4",
+	"5️⃣":                    "keycap: 5",
+	"6️⃣":                    "keycap: 6",
+	"7️⃣":                    "keycap: 7",
+	"8️⃣":                    "keycap: 8",
+	"9️⃣":                    "keycap: 9",
+	"🔟":                      "keycap: 10",
+	"🔠":                      "input latin uppercase",
+	"🔡":                      "input latin lowercase",
+	"🔢":                      "input numbers",
+	"🔣":                      "input symbols",
+	"🔤":                      "input latin letters",
+	"🅰️":                     "A button (blood type)",
+	"🆎":                      "AB button (blood type)",
+	"🅱️":                     "B button (blood type)",
+	"🆑":                      "CL button",
+	"🆒":                      "COOL button",
+	"🆓":                      "FREE button",
+	"ℹ️":                     "information",
+	"🆔":                      "ID button",
+	"Ⓜ️":                     "circled M",
+	"🆕":                      "NEW button",
+	"🆖":                      "NG button",
+	"🅾️":                     "O button (blood type)",
+	"🆗":                      "OK button",
+	"🅿️":                     "P button",
+	"🆘":                      "SOS button",
+	"🆙":                      "UP! button",
+	"🆚":                      "VS button",
+	"🈁":                      "Japanese “here” button",
+	"🈂️":                     "Japanese “service charge” button",
+	"🈷️":                     "Japanese “monthly amount” button",
+	"🈶":                      "Japanese “not free of charge” button",
+	"🈯":                      "Japanese “reserved” button",
+	"🉐":                      "Japanese “bargain” button",
+	"🈹":                      "Japanese “discount” button",
+	"🈚":                      "Japanese “free of charge” button",
+	"🈲":                      "Japanese “prohibited” button",
+	"🉑":                      "Japanese “acceptable” button",
+	"🈸":                      "Japanese “application” button",
+	"🈴":                      "Japanese “passing grade” button",
+	"🈳":                      "Japanese “vacancy” button",
+	"㊗️":                     "Japanese “congratulations” button",
+	"㊙️":                     "Japanese “secret” button",
+	"🈺":                      "Japanese “open for business” button",
+	"🈵":                      "Japanese “no vacancy” button",
+	"🔴":                      "red circle",
+	"🟠":                      "orange circle",
+	"🟡":                      "yellow circle",
+	"🟢":                      "green circle",
+	"🔵":                      "blue circle",
+	"🟣":                      "purple circle",
+	"🟤":                      "brown circle",
+	"⚫":                      "black circle",
+	"⚪":                      "white circle",
+	"🟥":                      "red square",
+	"🟧":                      "orange square",
+	"🟨":                      "yellow square",
+	"🟩":                      "green square",
+	"🟦":                      "blue square",
+	"🟪":                      "purple square",
+	"🟫":                      "brown square",
+	"⬛":                      "black large square",
+	"⬜":                      "white large square",
+	"◼️":                     "black medium square",
+	"◻️":                     "white medium square",
+	"◾":                      "black medium-small square",
+	"◽":                      "white medium-small square",
+	"▪️":                     "black small square",
+	"▫️":                     "white small square",
+	"🔶":                      "large orange diamond",
+	"🔷":                      "large blue diamond",
+	"🔸":                      "small orange diamond",
+	"🔹":                      "small blue diamond",
+	"🔺":                      "red triangle pointed up",
+	"🔻":                      "red triangle pointed down",
+	"💠":                      "diamond with a dot",
+	"🔘":                      "radio button",
+	"🔳":                      "white square button",
+	"🔲":                      "black square button",
+	"🏁":                      "chequered flag",
+	"🚩":                      "triangular flag",
+	"🎌":                      "crossed flags",
+	"🏴":                      "black flag",
+	"🏳️":                     "white flag",
+	"🏳️\u200d🌈":              "rainbow flag",
+	"🏳️\u200d⚧️":             "transgender flag",
+	"🏴\u200d☠️":              "pirate flag",
+	"🇦🇨":                     "flag: Ascension Island",
+	"🇦🇩":                     "flag: Andorra",
+	"🇦🇪":                     "flag: United Arab Emirates",
+	"🇦🇫":                     "flag: Afghanistan",
+	"🇦🇬":                     "flag: Antigua & Barbuda",
+	"🇦🇮":                     "flag: Anguilla",
+	"🇦🇱":                     "flag: Albania",
+	"🇦🇲":                     "flag: Armenia",
+	"🇦🇴":                     "flag: Angola",
+	"🇦🇶":                     "flag: Antarctica",
+	"🇦🇷":                     "flag: Argentina",
+	"🇦🇸":                     "flag: American Samoa",
+	"🇦🇹":                     "flag: Austria",
+	"🇦🇺":                     "flag: Australia",
+	"🇦🇼":                     "flag: Aruba",
+	"🇦🇽":                     "flag: Åland Islands",
+	"🇦🇿":                     "flag: Azerbaijan",
+	"🇧🇦":                     "flag: Bosnia & Herzegovina",
+	"🇧🇧":                     "flag: Barbados",
+	"🇧🇩":                     "flag: Bangladesh",
+	"🇧🇪":                     "flag: Belgium",
+	"🇧🇫":                     "flag: Burkina Faso",
+	"🇧🇬":                     "flag: Bulgaria",
+	"🇧🇭":                     "flag: Bahrain",
+	"🇧🇮":                     "flag: Burundi",
+	"🇧🇯":                     "flag: Benin",
+	"🇧🇱":                     "flag: St. Barthélemy",
+	"🇧🇲":                     "flag: Bermuda",
+	"🇧🇳":                     "flag: Brunei",
+	"🇧🇴":                     "flag: Bolivia",
+	"🇧🇶":                     "flag: Caribbean Netherlands",
+	"🇧🇷":                     "flag: Brazil",
+	"🇧🇸":                     "flag: Bahamas",
+	"🇧🇹":                     "flag: Bhutan",
+	"🇧🇻":                     "flag: Bouvet Island",
+	"🇧🇼":                     "flag: Botswana",
+	"🇧🇾":                     "flag: Belarus",
+	"🇧🇿":                     "flag: Belize",
+	"🇨🇦":                     "flag: Canada",
+	"🇨🇨":                     "flag: Cocos (Keeling) Islands",
+	"🇨🇩":                     "flag: Congo - Kinshasa",
+	"🇨🇫":                     "flag: Central African Republic",
+	"🇨🇬":                     "flag: Congo - Brazzaville",
+	"🇨🇭":                     "flag: Switzerland",
+	"🇨🇮":                     "flag: Côte d’Ivoire",
+	"🇨🇰":                     "flag: Cook Islands",
+	"🇨🇱":                     "flag: Chile",
+	"🇨🇲":                     "flag: Cameroon",
+	"🇨🇳":                     "flag: China",
+	"🇨🇴":                     "flag: Colombia",
+	"🇨🇵":                     "flag: Clipperton Island",
+	"🇨🇷":                     "flag: Costa Rica",
+	"🇨🇺":                     "flag: Cuba",
+	"🇨🇻":                     "flag: Cape Verde",
+	"🇨🇼":                     "flag: Curaçao",
+	"🇨🇽":                     "flag: Christmas Island",
+	"🇨🇾":                     "flag: Cyprus",
+	"🇨🇿":                     "flag: Czechia",
+	"🇩🇪":                     "flag: Germany",
+	"🇩🇬":                     "flag: Diego Garcia",
+	"🇩🇯":                     "flag: Djibouti",
+	"🇩🇰":                     "flag: Denmark",
+	"🇩🇲":                     "flag: Dominica",
+	"🇩🇴":                     "flag: Dominican Republic",
+	"🇩🇿":                     "flag: Algeria",
+	"🇪🇦":                     "flag: Ceuta & Melilla",
+	"🇪🇨":                     "flag: Ecuador",
+	"🇪🇪":                     "flag: Estonia",
+	"🇪🇬":                     "flag: Egypt",
+	"🇪🇭":                     "flag: Western Sahara",
+	"🇪🇷":                     "flag: Eritrea",
+	"🇪🇸":                     "flag: Spain",
+	"🇪🇹":                     "flag: Ethiopia",
+	"🇪🇺":                     "flag: European Union",
+	"🇫🇮":                     "flag: Finland",
+	"🇫🇯":                     "flag: Fiji",
+	"🇫🇰":                     "flag: Falkland Islands",
+	"🇫🇲":                     "flag: Micronesia",
+	"🇫🇴":                     "flag: Faroe Islands",
+	"🇫🇷":                     "flag: France",
+	"🇬🇦":                     "flag: Gabon",
+	"🇬🇧":                     "flag: United Kingdom",
+	"🇬🇩":                     "flag: Grenada",
+	"🇬🇪":                     "flag: Georgia",
+	"🇬🇫":                     "flag: French Guiana",
+	"🇬🇬":                     "flag: Guernsey",
+	"🇬🇭":                     "flag: Ghana",
+	"🇬🇮":                     "flag: Gibraltar",
+	"🇬🇱":                     "flag: Greenland",
+	"🇬🇲":                     "flag: Gambia",
+	"🇬🇳":                     "flag: Guinea",
+	"🇬🇵":                     "flag: Guadeloupe",
+	"🇬🇶":                     "flag: Equatorial Guinea",
+	"🇬🇷":                     "flag: Greece",
+	"🇬🇸":                     "flag: South Georgia & South Sandwich Islands",
+	"🇬🇹":                     "flag: Guatemala",
+	"🇬🇺":                     "flag: Guam",
+	"🇬🇼":                     "flag: Guinea-Bissau",
+	"🇬🇾":                     "flag: Guyana",
+	"🇭🇰":                     "flag: Hong Kong SAR China",
+	"🇭🇲":                     "flag: Heard & McDonald Islands",
+	"🇭🇳":                     "flag: Honduras",
+	"🇭🇷":                     "flag: Croatia",
+	"🇭🇹":                     "flag: Haiti",
+	"🇭🇺":                     "flag: Hungary",
+	"🇮🇨":                     "flag: Canary Islands",
+	"🇮🇩":                     "flag: Indonesia",
+	"🇮🇪":                     "flag: Ireland",
+	"🇮🇱":                     "flag: Israel",
+	"🇮🇲":                     "flag: Isle of Man",
+	"🇮🇳":                     "flag: India",
+	"🇮🇴":                     "flag: British Indian Ocean Territory",
+	"🇮🇶":                     "flag: Iraq",
+	"🇮🇷":                     "flag: Iran",
+	"🇮🇸":                     "flag: Iceland",
+	"🇮🇹":                     "flag: Italy",
+	"🇯🇪":                     "flag: Jersey",
+	"🇯🇲":                     "flag: Jamaica",
+	"🇯🇴":                     "flag: Jordan",
+	"🇯🇵":                     "flag: Japan",
+	"🇰🇪":                     "flag: Kenya",
+	"🇰🇬":                     "flag: Kyrgyzstan",
+	"🇰🇭":                     "flag: Cambodia",
+	"🇰🇮":                     "flag: Kiribati",
+	"🇰🇲":                     "flag: Comoros",
+	"🇰🇳":                     "flag: St. Kitts & Nevis",
+	"🇰🇵":                     "flag: North Korea",
+	"🇰🇷":                     "flag: South Korea",
+	"🇰🇼":                     "flag: Kuwait",
+	"🇰🇾":                     "flag: Cayman Islands",
+	"🇰🇿":                     "flag: Kazakhstan",
+	"🇱🇦":                     "flag: Laos",
+	"🇱🇧":                     "flag: Lebanon",
+	"🇱🇨":                     "flag: St. Lucia",
+	"🇱🇮":                     "flag: Liechtenstein",
+	"🇱🇰":                     "flag: Sri Lanka",
+	"🇱🇷":                     "flag: Liberia",
+	"🇱🇸":                     "flag: Lesotho",
+	"🇱🇹":                     "flag: Lithuania",
+	"🇱🇺":                     "flag: Luxembourg",
+	"🇱🇻":                     "flag: Latvia",
+	"🇱🇾":                     "flag: Libya",
+	"🇲🇦":                     "flag: Morocco",
+	"🇲🇨":                     "flag: Monaco",
+	"🇲🇩":                     "flag: Moldova",
+	"🇲🇪":                     "flag: Montenegro",
+	"🇲🇫":                     "flag: St. Martin",
+	"🇲🇬":                     "flag: Madagascar",
+	"🇲🇭":                     "flag: Marshall Islands",
+	"🇲🇰":                     "flag: North Macedonia",
+	"🇲🇱":                     "flag: Mali",
+	"🇲🇲":                     "flag: Myanmar (Burma)",
+	"🇲🇳":                     "flag: Mongolia",
+	"🇲🇴":                     "flag: Macao SAR China",
+	"🇲🇵":                     "flag: Northern Mariana Islands",
+	"🇲🇶":                     "flag: Martinique",
+	"🇲🇷":                     "flag: Mauritania",
+	"🇲🇸":                     "flag: Montserrat",
+	"🇲🇹":                     "flag: Malta",
+	"🇲🇺":                     "flag: Mauritius",
+	"🇲🇻":                     "flag: Maldives",
+	"🇲🇼":                     "flag: Malawi",
+	"🇲🇽":                     "flag: Mexico",
+	"🇲🇾":                     "flag: Malaysia",
+	"🇲🇿":                     "flag: Mozambique",
+	"🇳🇦":                     "flag: Namibia",
+	"🇳🇨":                     "flag: New Caledonia",
+	"🇳🇪":                     "flag: Niger",
+	"🇳🇫":                     "flag: Norfolk Island",
+	"🇳🇬":                     "flag: Nigeria",
+	"🇳🇮":                     "flag: Nicaragua",
+	"🇳🇱":                     "flag: Netherlands",
+	"🇳🇴":                     "flag: Norway",
+	"🇳🇵":                     "flag: Nepal",
+	"🇳🇷":                     "flag: Nauru",
+	"🇳🇺":                     "flag: Niue",
+	"🇳🇿":                     "flag: New Zealand",
+	"🇴🇲":                     "flag: Oman",
+	"🇵🇦":                     "flag: Panama",
+	"🇵🇪":                     "flag: Peru",
+	"🇵🇫":                     "flag: French Polynesia",
+	"🇵🇬":                     "flag: Papua New Guinea",
+	"🇵🇭":                     "flag: Philippines",
+	"🇵🇰":                     "flag: Pakistan",
+	"🇵🇱":                     "flag: Poland",
+	"🇵🇲":                     "flag: St. Pierre & Miquelon",
+	"🇵🇳":                     "flag: Pitcairn Islands",
+	"🇵🇷":                     "flag: Puerto Rico",
+	"🇵🇸":                     "flag: Palestinian Territories",
+	"🇵🇹":                     "flag: Portugal",
+	"🇵🇼":                     "flag: Palau",
+	"🇵🇾":                     "flag: Paraguay",
+	"🇶🇦":                     "flag: Qatar",
+	"🇷🇪":                     "flag: Réunion",
+	"🇷🇴":                     "flag: Romania",
+	"🇷🇸":                     "flag: Serbia",
+	"🇷🇺":                     "flag: Russia",
+	"🇷🇼":                     "flag: Rwanda",
+	"🇸🇦":                     "flag: Saudi Arabia",
+	"🇸🇧":                     "flag: Solomon Islands",
+	"🇸🇨":                     "flag: Seychelles",
+	"🇸🇩":                     "flag: Sudan",
+	"🇸🇪":                     "flag: Sweden",
+	"🇸🇬":                     "flag: Singapore",
+	"🇸🇭":                     "flag: St. Helena",
+	"🇸🇮":                     "flag: Slovenia",
+	"🇸🇯":                     "flag: Svalbard & Jan Mayen",
+	"🇸🇰":                     "flag: Slovakia",
+	"🇸🇱":                     "flag: Sierra Leone",
+	"🇸🇲":                     "flag: San Marino",
+	"🇸🇳":                     "flag: Senegal",
+	"🇸🇴":                     "flag: Somalia",
+	"🇸🇷":                     "flag: Suriname",
+	"🇸🇸":                     "flag: South Sudan",
+	"🇸🇹":                     "flag: São Tomé & Príncipe",
+	"🇸🇻":                     "flag: El Salvador",
+	"🇸🇽":                     "flag: Sint Maarten",
+	"🇸🇾":                     "flag: Syria",
+	"🇸🇿":                     "flag: Eswatini",
+	"🇹🇦":                     "flag: Tristan da Cunha",
+	"🇹🇨":                     "flag: Turks & Caicos Islands",
+	"🇹🇩":                     "flag: Chad",
+	"🇹🇫":                     "flag: French Southern Territories",
+	"🇹🇬":                     "flag: Togo",
+	"🇹🇭":                     "flag: Thailand",
+	"🇹🇯":                     "flag: Tajikistan",
+	"🇹🇰":                     "flag: Tokelau",
+	"🇹🇱":                     "flag: Timor-Leste",
+	"🇹🇲":                     "flag: Turkmenistan",
+	"🇹🇳":                     "flag: Tunisia",
+	"🇹🇴":                     "flag: Tonga",
+	"🇹🇷":                     "flag: Turkey",
+	"🇹🇹":                     "flag: Trinidad & Tobago",
+	"🇹🇻":                     "flag: Tuvalu",
+	"🇹🇼":                     "flag: Taiwan",
+	"🇹🇿":                     "flag: Tanzania",
+	"🇺🇦":                     "flag: Ukraine",
+	"🇺🇬":                     "flag: Uganda",
+	"🇺🇲":                     "flag: U.S. Outlying Islands",
+	"🇺🇳":                     "flag: United Nations",
+	"🇺🇸":                     "flag: United States",
+	"🇺🇾":                     "flag: Uruguay",
+	"🇺🇿":                     "flag: Uzbekistan",
+	"🇻🇦":                     "flag: Vatican City",
+	"🇻🇨":                     "flag: St. Vincent & Grenadines",
+	"🇻🇪":                     "flag: Venezuela",
+	"🇻🇬":                     "flag: British Virgin Islands",
+	"🇻🇮":                     "flag: U.S. Virgin Islands",
+	"🇻🇳":                     "flag: Vietnam",
+	"🇻🇺":                     "flag: Vanuatu",
+	"🇼🇫":                     "flag: Wallis & Futuna",
+	"🇼🇸":                     "flag: Samoa",
+	"🇽🇰":                     "flag: Kosovo",
+	"🇾🇪":                     "flag: Yemen",
+	"🇾🇹":                     "flag: Mayotte",
+	"🇿🇦":                     "flag: South Africa",
+	"🇿🇲":                     "flag: Zambia",
+	"🇿🇼":                     "flag: Zimbabwe",
+	"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f": "flag: England",
+	"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f": "flag: Scotland",
+	"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f": "flag: Wales",
+}
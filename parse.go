@@ -0,0 +1,469 @@
+package emojis
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"golang.org/x/text/unicode/norm"
+)
+
+// emojiRegex is a regex that matches a non-empty non-comment line from
+// emoji-test.txt. Fields may be separated by any amount of whitespace,
+// including tabs.
+var emojiRegex = regexp.MustCompile(`^([0-9A-F\s]*?)\s*;\s*(component|fully-qualified|minimally-qualified|unqualified)\s*#\s*(.*?)\s+E([0-9]+\.[0-9]+)\s+(.*)$`)
+
+// groupRegex is a regex that matches a line from emoji-test.txt that begins a
+// group or subgroup (e.g., "# group: Smileys & Emotion"). Some mirrors omit
+// the spaces (e.g., "#group:Smileys & Emotion").
+var groupRegex = regexp.MustCompile(`^#\s*(group|subgroup)\s*:\s*(.*?)\s*$`)
+
+// RuneMismatchError is the error returned when the code points listed for an
+// emoji don't match the runes of its grapheme. Some emoji data sources list
+// incorrect graphemes.
+type RuneMismatchError struct {
+	Grapheme string // the listed grapheme
+	Got      []rune // the listed code points
+	Want     []rune // the runes of the grapheme
+}
+
+func (e *RuneMismatchError) Error() string {
+	return fmt.Sprintf("%s: mismatched runes: got %v, want %v", e.Grapheme, e.Got, e.Want)
+}
+
+// ParseOptions configures ParseWithOptions. The zero value parses only fully
+// qualified emojis. See https://unicode.org/reports/tr51/ for details on
+// qualification.
+type ParseOptions struct {
+	IncludeUnqualified        bool // include unqualified emojis
+	IncludeMinimallyQualified bool // include minimally qualified emojis
+	IncludeComponents         bool // include components (e.g., skin tones); see Emoji.IsComponent
+
+	// If NormalizeNames is true, names are converted to Unicode Normalization
+	// Form C, so that names that differ only in how accents are encoded
+	// (e.g., "e" followed by a combining acute accent versus "é") are equal.
+	NormalizeNames bool
+
+	// By default, parsing fails on the first line with invalid code points or
+	// code points that don't match its grapheme (see RuneMismatchError). If
+	// Lenient is true, these lines are instead skipped and reported in
+	// Stats.Warnings and to Logger. A lenient parse requires Stats or Logger,
+	// so that skipped lines aren't silently dropped.
+	Lenient bool
+
+	// If SourceLines is true, the SourceLine of every emoji is set to the
+	// line that lists it (e.g., to find the line of an emoji that fails
+	// Validate).
+	SourceLines bool
+
+	// If Stats is not nil, it is populated with statistics about the parse.
+	Stats *Stats
+
+	// If Logger is not nil, every skipped line that isn't empty or a comment
+	// is logged at debug level with the reason it was skipped, except for
+	// the lines of a lenient parse with invalid code points, which are
+	// logged at warn level.
+	Logger *slog.Logger
+}
+
+// Stats contains statistics about a parse.
+type Stats struct {
+	Lines      int            // the number of lines scanned
+	Emojis     int            // the number of emojis returned
+	Skipped    map[string]int // the number of emojis skipped, by qualification
+	Duplicates int            // the number of skipped duplicate emojis
+	Warnings   []error        // the errors of lines skipped by a lenient parse
+}
+
+// check returns an error if the options are invalid.
+func (o ParseOptions) check() error {
+	if o.Lenient && o.Stats == nil && o.Logger == nil {
+		return errors.New("lenient parse requires Stats or Logger to report skipped lines")
+	}
+	return nil
+}
+
+// includes returns whether emojis with the provided qualification should be
+// parsed.
+func (o ParseOptions) includes(qualification string) bool {
+	switch qualification {
+	case FullyQualified:
+		return true
+	case MinimallyQualified:
+		return o.IncludeMinimallyQualified
+	case Unqualified:
+		return o.IncludeUnqualified
+	case Component:
+		return o.IncludeComponents
+	default:
+		return false
+	}
+}
+
+// Parse parses the fully qualified emojis from an emoji-test.txt file. See
+// ParseWithOptions for details.
+func Parse(r io.Reader) ([]*Emoji, error) {
+	return ParseWithOptions(r, ParseOptions{})
+}
+
+// ParseString is a convenience wrapper around Parse that parses emojis from
+// the contents of an emoji-test.txt file.
+func ParseString(s string) ([]*Emoji, error) {
+	return Parse(strings.NewReader(s))
+}
+
+// ParseWithOptions parses emojis from an emoji-test.txt file. If an emoji is
+// listed more than once, only the first occurrence is returned.
+func ParseWithOptions(r io.Reader, opts ParseOptions) ([]*Emoji, error) {
+	if err := opts.check(); err != nil {
+		return nil, err
+	}
+	p := newParser(opts, "", "")
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// Trim the carriage return from files with CRLF line endings.
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if err := p.parseLine(line, p.stats.Lines+1); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p.finish(), nil
+}
+
+// parser parses the lines of an emoji-test.txt file, or of a contiguous chunk
+// of one (see ParseConcurrent).
+type parser struct {
+	opts     ParseOptions
+	group    string // the current group
+	subgroup string // the current subgroup
+	stats    Stats
+	emojis   []*Emoji // the parsed emojis, including duplicates
+
+	// needsSelector is keyed by the normalized graphemes of the fully
+	// qualified emojis. See Emoji.NeedsVariationSelector.
+	needsSelector map[string]bool
+}
+
+// newParser returns a parser that starts in the provided group and subgroup.
+func newParser(opts ParseOptions, group, subgroup string) *parser {
+	return &parser{
+		opts:          opts,
+		group:         group,
+		subgroup:      subgroup,
+		stats:         Stats{Skipped: map[string]int{}},
+		needsSelector: map[string]bool{},
+	}
+}
+
+// parseLine parses the line with the provided 1-based line number.
+func (p *parser) parseLine(line string, number int) error {
+	p.stats.Lines++
+	if strings.TrimSpace(line) == "" {
+		// The line is empty.
+		return nil
+	}
+	if matches := groupRegex.FindStringSubmatch(line); matches != nil {
+		if matches[1] == "group" {
+			// The line begins a group.
+			p.group = matches[2]
+		} else {
+			// The line begins a subgroup.
+			p.subgroup = matches[2]
+		}
+		return nil
+	}
+	if strings.HasPrefix(line, "#") {
+		// The line is an uninteresting comment.
+		return nil
+	}
+	matches := emojiRegex.FindStringSubmatch(line)
+	if matches == nil {
+		// The line does not list an emoji.
+		p.debug("skipped line", "line", number, "reason", "not an emoji")
+		return nil
+	}
+
+	// The line lists an emoji.
+	codes := strings.Fields(matches[1])
+	qualification := strings.TrimSpace(matches[2])
+	grapheme := strings.TrimSpace(matches[3])
+	version := matches[4]
+	name := strings.TrimSpace(matches[5])
+	if p.opts.NormalizeNames {
+		name = norm.NFC.String(name)
+	}
+
+	if qualification == FullyQualified {
+		// Only a selector after the first code point changes how the emoji
+		// itself is presented. Sequences like 🧑‍⚕️ have selectors after later
+		// code points (e.g., ⚕), but 🧑 is presented as an emoji without one.
+		runes := []rune(grapheme)
+		p.needsSelector[Normalize(grapheme)] = len(runes) > 1 && runes[1] == emojiVariationSelector
+	}
+
+	if !p.opts.includes(qualification) {
+		// Ignore component, minimally qualified, and unqualified emojis
+		// unless requested.
+		p.stats.Skipped[qualification]++
+		p.debug("skipped line", "line", number, "reason", qualification, "grapheme", grapheme)
+		return nil
+	}
+
+	// Double check that the grapheme's runes match the expected runes.
+	// Some emoji data sources list incorrect graphemes.
+	runes, err := parseCodes(codes)
+	if err == nil && !slices.Equal(runes, []rune(grapheme)) {
+		err = &RuneMismatchError{grapheme, runes, []rune(grapheme)}
+	}
+	if err != nil && !p.opts.Lenient {
+		return err
+	}
+	if err != nil {
+		p.stats.Warnings = append(p.stats.Warnings, fmt.Errorf("line %d: %w", number, err))
+		if p.opts.Logger != nil {
+			p.opts.Logger.Warn("skipped line", "line", number, "reason", err)
+		}
+		return nil
+	}
+
+	emoji := &Emoji{
+		Grapheme: grapheme,
+		Codes:    runes,
+		Name:     name,
+		Group:    p.group,
+		Subgroup: p.subgroup,
+		Version:  version,
+
+		Qualification: qualification,
+	}
+	if p.opts.SourceLines {
+		emoji.SourceLine = number
+	}
+	p.emojis = append(p.emojis, emoji)
+	return nil
+}
+
+// debug logs a debug message to p.opts.Logger, if it is not nil.
+func (p *parser) debug(msg string, args ...any) {
+	if p.opts.Logger != nil {
+		p.opts.Logger.Debug(msg, args...)
+	}
+}
+
+// merge merges the results of q, which parsed the lines after the lines
+// parsed by p, into p.
+func (p *parser) merge(q *parser) {
+	p.stats.Lines += q.stats.Lines
+	for qualification, n := range q.stats.Skipped {
+		p.stats.Skipped[qualification] += n
+	}
+	p.stats.Warnings = append(p.stats.Warnings, q.stats.Warnings...)
+	p.emojis = append(p.emojis, q.emojis...)
+	maps.Copy(p.needsSelector, q.needsSelector)
+}
+
+// finish returns the parsed emojis, without duplicates, and records the
+// parse's statistics in p.opts.Stats.
+func (p *parser) finish() []*Emoji {
+	seen := map[string]bool{}
+	var emojis []*Emoji
+	for _, emoji := range p.emojis {
+		if seen[emoji.Grapheme] {
+			// Some emoji data sources list the same emoji more than once.
+			// We keep only the first.
+			p.stats.Duplicates++
+			p.debug("skipped emoji", "reason", "duplicate", "grapheme", emoji.Grapheme)
+			continue
+		}
+		seen[emoji.Grapheme] = true
+		// emoji-test.txt lists a fully qualified emoji before its minimally
+		// qualified and unqualified versions, but not necessarily in the same
+		// chunk, so we wait until every line is parsed.
+		emoji.NeedsVariationSelector = p.needsSelector[Normalize(emoji.Grapheme)]
+		emojis = append(emojis, emoji)
+	}
+	if p.opts.Stats != nil {
+		p.stats.Emojis = len(emojis)
+		*p.opts.Stats = p.stats
+	}
+	return emojis
+}
+
+// parseCodes parses a slice of unicode code points in hex (e.g., ["2639",
+// "FE0F"]) into the corresponding runes (e.g., [0x2639, 0xFE0F]).
+func parseCodes(codes []string) ([]rune, error) {
+	var runes []rune
+	for _, code := range codes {
+		// Runes are 32 bits, so we parse at most 32 bits. Larger values
+		// (e.g., "1FFFFFFFF") fail to parse rather than wrap when converted
+		// to a rune.
+		x, err := strconv.ParseUint(code, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("strconv.ParseUint(%s): %w", code, err)
+		}
+		if x > unicode.MaxRune || (0xD800 <= x && x <= 0xDFFF) {
+			// Surrogate halves and values beyond the last code point aren't
+			// valid runes. This also rejects values like "FFFFFFFF" that fit
+			// in 32 bits but would be negative runes.
+			return nil, fmt.Errorf("invalid code point %s", code)
+		}
+		runes = append(runes, rune(x))
+	}
+	return runes, nil
+}
+
+// CodesString returns the emoji's code points as space separated "U+XXXX"
+// strings (e.g., "U+2639 U+FE0F"). It is roughly the inverse of parseCodes.
+func (e *Emoji) CodesString() string {
+	codes := make([]string, len(e.Codes))
+	for i, code := range e.Codes {
+		codes[i] = fmt.Sprintf("U+%04X", code)
+	}
+	return strings.Join(codes, " ")
+}
+
+// ParseTags parses tags and skin tone variants from a data.json file. Both
+// returned maps are keyed by grapheme. skins maps the grapheme of a base emoji
+// (e.g., 👋) to the graphemes of its skin tone variants (e.g., 👋🏻, 👋🏼, ...).
+// Tags are returned in the order they appear in data.json.
+//
+// Some data.json exports list curated keywords separately from tags. These
+// are merged into the tags. Labels are not, since they duplicate the names in
+// emoji-test.txt.
+//
+// Besides emojibase's array of entries, ParseTags also parses its compact
+// format, an object of entries keyed by hex code points (e.g., {"1F600":
+// {"tags": ["face", "grin"]}}). Entries without an emoji are identified by
+// their hex code points (e.g., "1F44B-1F3FB").
+func ParseTags(r io.Reader) (tags, skins map[string][]string, err error) {
+	type entry struct {
+		Emoji    string
+		Hexcode  string
+		Tags     []string
+		Keywords []string
+		Skins    []entry
+	}
+
+	decoder := json.NewDecoder(r)
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, nil, fmt.Errorf("json decode: %w", err)
+	}
+	var entries []entry
+	if trimmed := bytes.TrimLeft(raw, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '{' {
+		// The compact format is keyed by hex code points.
+		var keyed map[string]entry
+		if err := json.Unmarshal(raw, &keyed); err != nil {
+			return nil, nil, fmt.Errorf("json decode: %w", err)
+		}
+		hexcodes := maps.Keys(keyed)
+		sort.Strings(hexcodes)
+		for _, hexcode := range hexcodes {
+			entry := keyed[hexcode]
+			if entry.Hexcode == "" {
+				entry.Hexcode = hexcode
+			}
+			entries = append(entries, entry)
+		}
+	} else if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, nil, fmt.Errorf("json decode: %w", err)
+	}
+
+	// grapheme returns the grapheme of an entry.
+	grapheme := func(e entry) (string, error) {
+		if e.Emoji != "" || e.Hexcode == "" {
+			return e.Emoji, nil
+		}
+		runes, err := parseCodes(strings.FieldsFunc(e.Hexcode, func(r rune) bool {
+			return r == '-' || unicode.IsSpace(r)
+		}))
+		if err != nil {
+			return "", fmt.Errorf("hexcode %q: %w", e.Hexcode, err)
+		}
+		return string(runes), nil
+	}
+
+	tags = map[string][]string{}
+	skins = map[string][]string{}
+	for _, entry := range entries {
+		emoji, err := grapheme(entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		entryTags := appendUnique(nil, entry.Tags...)
+		entryTags = appendUnique(entryTags, entry.Keywords...)
+		tags[emoji] = entryTags
+		for _, skin := range entry.Skins {
+			skinEmoji, err := grapheme(skin)
+			if err != nil {
+				return nil, nil, err
+			}
+			// Copy entryTags so that the skins don't share (and clobber)
+			// the same backing array.
+			skinTags := appendUnique(slices.Clone(entryTags), skin.Tags...)
+			skinTags = appendUnique(skinTags, skin.Keywords...)
+			tags[skinEmoji] = skinTags
+			skins[emoji] = append(skins[emoji], skinEmoji)
+		}
+	}
+	return tags, skins, nil
+}
+
+// appendUnique appends the elements of src to dst that aren't already in dst.
+func appendUnique(dst []string, src ...string) []string {
+	for _, s := range src {
+		if !slices.Contains(dst, s) {
+			dst = append(dst, s)
+		}
+	}
+	return dst
+}
+
+// ParseSynonyms parses a synonyms json file that maps extra tags to the
+// graphemes of the emojis they describe (e.g., {"lol": ["🤣", "😂"]}). The
+// returned map is keyed by grapheme, like the tags returned by ParseTags.
+func ParseSynonyms(r io.Reader) (map[string][]string, error) {
+	decoder := json.NewDecoder(r)
+	var synonyms map[string][]string
+	if err := decoder.Decode(&synonyms); err != nil {
+		return nil, fmt.Errorf("json decode: %w", err)
+	}
+
+	tags := map[string][]string{}
+	for tag, graphemes := range synonyms {
+		for _, grapheme := range graphemes {
+			tags[grapheme] = append(tags[grapheme], tag)
+		}
+	}
+	for _, ts := range tags {
+		sort.Strings(ts)
+	}
+	return tags, nil
+}
+
+// MergeTags merges tags, like those returned by ParseTags, from multiple
+// sources. The merged tags of every grapheme are the union of its tags in
+// every source, without duplicates, in the order they first appear.
+func MergeTags(sources ...map[string][]string) map[string][]string {
+	merged := map[string][]string{}
+	for _, tags := range sources {
+		for grapheme, ts := range tags {
+			merged[grapheme] = appendUnique(merged[grapheme], ts...)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,693 @@
+package emojis
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// testEmojiTest is a small excerpt of emoji-test.txt shared by the tests.
+const testEmojiTest = `# emoji-test.txt
+# Version: 15.0
+
+# group: Smileys & Emotion
+
+# subgroup: face-smiling
+1F600                                                  ; fully-qualified     # 😀 E1.0 grinning face
+1F603                                                  ; fully-qualified     # 😃 E0.6 grinning face with big eyes
+
+# subgroup: face-concerned
+2639 FE0F                                              ; fully-qualified     # ☹️ E0.7 frowning face
+2639                                                   ; unqualified         # ☹ E0.7 frowning face
+
+# group: People & Body
+
+# subgroup: hand-fingers-open
+1F44B                                                  ; fully-qualified     # 👋 E0.6 waving hand
+1F44B 1F3FB                                            ; fully-qualified     # 👋🏻 E1.0 waving hand: light skin tone
+
+# subgroup: person-role
+1F9D1 200D 2695 FE0F                                   ; fully-qualified     # 🧑‍⚕️ E12.1 health worker
+1F9D1 200D 2695                                        ; minimally-qualified # 🧑‍⚕ E12.1 health worker
+
+# group: Component
+
+# subgroup: skin-tone
+1F3FB                                                  ; component           # 🏻 E1.0 light skin tone
+
+# group: Animals & Nature
+
+# subgroup: animal-mammal
+1F431                                                  ; fully-qualified     # 🐱 E0.6 cat face
+1F408                                                  ; fully-qualified     # 🐈 E0.7 cat
+1F408 200D 2B1B                                        ; fully-qualified     # 🐈‍⬛ E13.0 black cat
+
+# group: Symbols
+
+# subgroup: keycap
+0023 FE0F 20E3                                         ; fully-qualified     # #️⃣ E0.6 keycap: #
+002A FE0F 20E3                                         ; fully-qualified     # *️⃣ E2.0 keycap: *
+0032 FE0F 20E3                                         ; fully-qualified     # 2️⃣ E0.6 keycap: 2
+
+#EOF
+`
+
+// mustParse parses testEmojiTest with the provided options.
+func mustParse(t testing.TB, opts ParseOptions) []*Emoji {
+	t.Helper()
+	emojis, err := ParseWithOptions(strings.NewReader(testEmojiTest), opts)
+	if err != nil {
+		t.Fatalf("ParseWithOptions: %v", err)
+	}
+	return emojis
+}
+
+func TestParse(t *testing.T) {
+	emojis, err := ParseString(testEmojiTest)
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+
+	want := []Emoji{
+		{Grapheme: "😀", Codes: []rune{0x1F600}, Name: "grinning face", Group: "Smileys & Emotion", Subgroup: "face-smiling"},
+		{Grapheme: "😃", Codes: []rune{0x1F603}, Name: "grinning face with big eyes", Group: "Smileys & Emotion", Subgroup: "face-smiling"},
+		{Grapheme: "☹️", Codes: []rune{0x2639, 0xFE0F}, Name: "frowning face", Group: "Smileys & Emotion", Subgroup: "face-concerned"},
+		{Grapheme: "👋", Codes: []rune{0x1F44B}, Name: "waving hand", Group: "People & Body", Subgroup: "hand-fingers-open"},
+		{Grapheme: "👋🏻", Codes: []rune{0x1F44B, 0x1F3FB}, Name: "waving hand: light skin tone", Group: "People & Body", Subgroup: "hand-fingers-open"},
+		{Grapheme: "🧑‍⚕️", Codes: []rune{0x1F9D1, 0x200D, 0x2695, 0xFE0F}, Name: "health worker", Group: "People & Body", Subgroup: "person-role"},
+		{Grapheme: "🐱", Codes: []rune{0x1F431}, Name: "cat face", Group: "Animals & Nature", Subgroup: "animal-mammal"},
+		{Grapheme: "🐈", Codes: []rune{0x1F408}, Name: "cat", Group: "Animals & Nature", Subgroup: "animal-mammal"},
+		{Grapheme: "🐈‍⬛", Codes: []rune{0x1F408, 0x200D, 0x2B1B}, Name: "black cat", Group: "Animals & Nature", Subgroup: "animal-mammal"},
+		{Grapheme: "#️⃣", Codes: []rune{0x23, 0xFE0F, 0x20E3}, Name: "keycap: #", Group: "Symbols", Subgroup: "keycap"},
+		{Grapheme: "*️⃣", Codes: []rune{0x2A, 0xFE0F, 0x20E3}, Name: "keycap: *", Group: "Symbols", Subgroup: "keycap"},
+		{Grapheme: "2️⃣", Codes: []rune{0x32, 0xFE0F, 0x20E3}, Name: "keycap: 2", Group: "Symbols", Subgroup: "keycap"},
+	}
+	if len(emojis) != len(want) {
+		t.Fatalf("got %d emojis, want %d", len(emojis), len(want))
+	}
+	for i, got := range emojis {
+		w := want[i]
+		if got.Grapheme != w.Grapheme || !slices.Equal(got.Codes, w.Codes) ||
+			got.Name != w.Name || got.Group != w.Group || got.Subgroup != w.Subgroup {
+			t.Errorf("emoji %d: got {%s %U %q %q %q}, want {%s %U %q %q %q}", i,
+				got.Grapheme, got.Codes, got.Name, got.Group, got.Subgroup,
+				w.Grapheme, w.Codes, w.Name, w.Group, w.Subgroup)
+		}
+	}
+}
+
+// testDataJSON is a small excerpt of data.json, matching testEmojiTest, shared
+// by the tests. 🦄 isn't in testEmojiTest, and *️⃣ and 2️⃣ have no tags.
+const testDataJSON = `[
+	{"emoji": "😀", "hexcode": "1F600", "tags": ["face", "grin"]},
+	{"emoji": "😃", "hexcode": "1F603", "tags": ["face", "happy", "mouth", "open", "smile"]},
+	{"emoji": "☹️", "hexcode": "2639", "tags": ["face", "frown"]},
+	{"emoji": "👋", "hexcode": "1F44B", "tags": ["hand", "wave", "waving"], "skins": [
+		{"emoji": "👋🏻", "hexcode": "1F44B-1F3FB", "tags": ["light skin tone"]}
+	]},
+	{"emoji": "🧑‍⚕️", "hexcode": "1F9D1-200D-2695-FE0F", "tags": ["doctor", "healthcare", "nurse", "therapist"]},
+	{"emoji": "🏻", "hexcode": "1F3FB", "tags": ["skin tone", "type 1–2"]},
+	{"emoji": "🐱", "hexcode": "1F431", "tags": ["cat", "face", "pet"]},
+	{"emoji": "🐈", "hexcode": "1F408", "tags": ["cat", "pet"]},
+	{"emoji": "🐈‍⬛", "hexcode": "1F408-200D-2B1B", "tags": ["black", "cat", "unlucky"]},
+	{"emoji": "#️⃣", "hexcode": "0023-FE0F-20E3", "tags": ["keycap"]},
+	{"emoji": "🇦", "hexcode": "1F1E6"},
+	{"emoji": "🦄", "hexcode": "1F984", "tags": ["face", "unicorn"]}
+]`
+
+// testEmojis returns the emojis of testEmojiTest, tagged with the tags of
+// testDataJSON.
+func testEmojis(t testing.TB) []*Emoji {
+	t.Helper()
+	emojis := mustParse(t, ParseOptions{})
+	tags, _, err := ParseTags(strings.NewReader(testDataJSON))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	for _, emoji := range emojis {
+		emoji.Tags = tags[emoji.Grapheme]
+	}
+	return emojis
+}
+
+// graphemes returns the graphemes of emojis.
+func graphemes(emojis []*Emoji) []string {
+	var gs []string
+	for _, emoji := range emojis {
+		gs = append(gs, emoji.Grapheme)
+	}
+	return gs
+}
+
+func TestParseVersion(t *testing.T) {
+	byGrapheme := ByGrapheme(mustParse(t, ParseOptions{}))
+	for grapheme, want := range map[string]string{
+		"😀":    "1.0",
+		"😃":    "0.6",
+		"🧑‍⚕️": "12.1",
+		"🐈‍⬛":  "13.0",
+	} {
+		if got := byGrapheme[grapheme].Version; got != want {
+			t.Errorf("%s: got version %q, want %q", grapheme, got, want)
+		}
+	}
+}
+
+func TestParseQualifications(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		opts ParseOptions
+		want map[string]int // the number of emojis by qualification
+	}{
+		{"default", ParseOptions{}, map[string]int{FullyQualified: 12}},
+		{"unqualified", ParseOptions{IncludeUnqualified: true}, map[string]int{FullyQualified: 12, Unqualified: 1}},
+		{"minimally qualified", ParseOptions{IncludeMinimallyQualified: true}, map[string]int{FullyQualified: 12, MinimallyQualified: 1}},
+		{"components", ParseOptions{IncludeComponents: true}, map[string]int{FullyQualified: 12, Component: 1}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := map[string]int{}
+			for _, emoji := range mustParse(t, test.opts) {
+				got[emoji.Qualification]++
+			}
+			if !maps.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseTagsSkins(t *testing.T) {
+	tags, skins, err := ParseTags(strings.NewReader(testDataJSON))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	if got, want := skins["👋"], []string{"👋🏻"}; !slices.Equal(got, want) {
+		t.Errorf("skins of 👋: got %v, want %v", got, want)
+	}
+	if got, want := len(skins), 1; got != want {
+		t.Errorf("got skins of %d emojis, want %d", got, want)
+	}
+	if got, want := tags["👋🏻"], []string{"hand", "wave", "waving", "light skin tone"}; !slices.Equal(got, want) {
+		t.Errorf("tags of 👋🏻: got %v, want %v", got, want)
+	}
+}
+
+func TestParseDuplicates(t *testing.T) {
+	// 😀 is listed again under another subgroup.
+	input := testEmojiTest + `
+# group: Smileys & Emotion
+# subgroup: face-affection
+1F600                                                  ; fully-qualified     # 😀 E1.0 grinning face
+`
+	var stats Stats
+	emojis, err := ParseWithOptions(strings.NewReader(input), ParseOptions{Stats: &stats})
+	if err != nil {
+		t.Fatalf("ParseWithOptions: %v", err)
+	}
+	if got, want := len(emojis), 12; got != want {
+		t.Errorf("got %d emojis, want %d", got, want)
+	}
+	if got, want := stats.Duplicates, 1; got != want {
+		t.Errorf("got %d duplicates, want %d", got, want)
+	}
+	if got, want := ByGrapheme(emojis)["😀"].Subgroup, "face-smiling"; got != want {
+		t.Errorf("got subgroup %q for 😀, want the first occurrence's %q", got, want)
+	}
+}
+
+func TestParseTagsSkinsDontAlias(t *testing.T) {
+	// The base emoji's three tags are appended into a slice with spare
+	// capacity, so appending skin tags to it without copying would make the
+	// second skin clobber the first's tags.
+	const data = `[
+		{"emoji": "👋", "tags": ["hand", "wave", "waving"], "skins": [
+			{"emoji": "👋🏻", "tags": ["light"]},
+			{"emoji": "👋🏿", "tags": ["dark"]}
+		]}
+	]`
+	tags, _, err := ParseTags(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	for grapheme, want := range map[string][]string{
+		"👋":  {"hand", "wave", "waving"},
+		"👋🏻": {"hand", "wave", "waving", "light"},
+		"👋🏿": {"hand", "wave", "waving", "dark"},
+	} {
+		if got := tags[grapheme]; !slices.Equal(got, want) {
+			t.Errorf("tags of %s: got %v, want %v", grapheme, got, want)
+		}
+	}
+}
+
+func TestParseSynonyms(t *testing.T) {
+	synonyms, err := ParseSynonyms(strings.NewReader(`{"lol": ["🤣", "😂"], "rofl": ["🤣"]}`))
+	if err != nil {
+		t.Fatalf("ParseSynonyms: %v", err)
+	}
+	if got, want := synonyms["🤣"], []string{"lol", "rofl"}; !slices.Equal(got, want) {
+		t.Errorf("synonyms of 🤣: got %v, want %v", got, want)
+	}
+
+	// Synonyms are merged with, rather than overwrite, existing tags.
+	tags := MergeTags(map[string][]string{"🤣": {"face", "floor", "laugh"}}, synonyms)
+	if got, want := tags["🤣"], []string{"face", "floor", "laugh", "lol", "rofl"}; !slices.Equal(got, want) {
+		t.Errorf("merged tags of 🤣: got %v, want %v", got, want)
+	}
+	emoji := &Emoji{Grapheme: "🤣", Name: "rolling on the floor laughing", Tags: tags["🤣"]}
+	if got := Lookup([]*Emoji{emoji}, "lol"); len(got) != 1 {
+		t.Errorf(`Lookup("lol"): got %v, want 🤣`, graphemes(got))
+	}
+}
+
+func TestParseCRLF(t *testing.T) {
+	emojis, err := ParseString(strings.ReplaceAll(testEmojiTest, "\n", "\r\n"))
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+	if got, want := len(emojis), 12; got != want {
+		t.Errorf("got %d emojis, want %d", got, want)
+	}
+	for _, emoji := range emojis {
+		for _, field := range []string{emoji.Grapheme, emoji.Name, emoji.Group, emoji.Subgroup, emoji.Version} {
+			if strings.Contains(field, "\r") {
+				t.Errorf("%s: field %q contains a carriage return", emoji.Grapheme, field)
+			}
+		}
+	}
+}
+
+func TestCodesString(t *testing.T) {
+	for _, test := range []struct {
+		codes []rune
+		want  string
+	}{
+		{[]rune{0x1F600}, "U+1F600"},
+		{[]rune{0x23, 0xFE0F, 0x20E3}, "U+0023 U+FE0F U+20E3"},
+		{[]rune{0x1F408, 0x200D, 0x2B1B}, "U+1F408 U+200D U+2B1B"},
+	} {
+		emoji := &Emoji{Codes: test.codes}
+		if got := emoji.CodesString(); got != test.want {
+			t.Errorf("CodesString(%U): got %q, want %q", test.codes, got, test.want)
+		}
+	}
+}
+
+func TestParseWhitespace(t *testing.T) {
+	input := "# group: Smileys & Emotion\n" +
+		"# subgroup: face-smiling\n" +
+		"1F600\t;\tfully-qualified\t#\t😀\tE1.0\tgrinning face\n" +
+		"1F603  ;  fully-qualified  #  😃  E0.6  grinning face with big eyes  \n" +
+		"2639 FE0F;fully-qualified# ☹️ E0.7 frowning face\n"
+	emojis, err := ParseString(input)
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+	var got []string
+	for _, emoji := range emojis {
+		got = append(got, emoji.Grapheme+" "+emoji.Version+" "+emoji.Name)
+	}
+	want := []string{"😀 1.0 grinning face", "😃 0.6 grinning face with big eyes", "☹️ 0.7 frowning face"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseString(t *testing.T) {
+	want, err := Parse(strings.NewReader(testEmojiTest))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	got, err := ParseString(testEmojiTest)
+	if err != nil {
+		t.Fatalf("ParseString: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseString and Parse differ")
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	tags, _, err := ParseTags(strings.NewReader(testDataJSON))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	regional, _, err := ParseTags(strings.NewReader(`[
+		{"emoji": "🐈", "tags": ["pet", "moggy"]},
+		{"emoji": "🦝", "tags": ["raccoon", "trash panda"]}
+	]`))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+
+	merged := MergeTags(tags, regional)
+	for grapheme, want := range map[string][]string{
+		"🐈": {"cat", "pet", "moggy"},
+		"🦝": {"raccoon", "trash panda"},
+		"😀": {"face", "grin"},
+	} {
+		if got := merged[grapheme]; !slices.Equal(got, want) {
+			t.Errorf("merged tags of %s: got %v, want %v", grapheme, got, want)
+		}
+	}
+	if _, ok := merged["🇦"]; !ok {
+		t.Errorf("merged tags are missing 🇦, which has no tags")
+	}
+}
+
+func TestParseNormalizeNames(t *testing.T) {
+	// The name's é is an e followed by a combining acute accent.
+	const input = "1F600 ; fully-qualified # 😀 E1.0 cafe\u0301 face\n"
+	for _, test := range []struct {
+		normalize bool
+		want      string
+	}{
+		{false, "cafe\u0301 face"},
+		{true, "caf\u00e9 face"},
+	} {
+		emojis, err := ParseWithOptions(strings.NewReader(input), ParseOptions{NormalizeNames: test.normalize})
+		if err != nil {
+			t.Fatalf("ParseWithOptions: %v", err)
+		}
+		if got := emojis[0].Name; got != test.want {
+			t.Errorf("NormalizeNames=%t: got name %+q, want %+q", test.normalize, got, test.want)
+		}
+	}
+}
+
+func TestParseCodes(t *testing.T) {
+	for _, test := range []struct {
+		codes   []string
+		want    []rune
+		wantErr bool
+	}{
+		{[]string{"1F600"}, []rune{0x1F600}, false},
+		{[]string{"2639", "FE0F"}, []rune{0x2639, 0xFE0F}, false},
+		{[]string{"10FFFF"}, []rune{0x10FFFF}, false},
+		{[]string{"D800"}, nil, true},
+		{[]string{"DFFF"}, nil, true},
+		{[]string{"1F600", "DC00"}, nil, true},
+		{[]string{"110000"}, nil, true},
+		{[]string{"XYZ"}, nil, true},
+	} {
+		got, err := parseCodes(test.codes)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("parseCodes(%q): got %U, want error", test.codes, got)
+			}
+		} else if err != nil {
+			t.Errorf("parseCodes(%q): %v", test.codes, err)
+		} else if !slices.Equal(got, test.want) {
+			t.Errorf("parseCodes(%q): got %U, want %U", test.codes, got, test.want)
+		}
+	}
+}
+
+func TestParseTagsOrder(t *testing.T) {
+	tags, _, err := ParseTags(strings.NewReader(`[{"emoji": "😀", "tags": ["smile", "grin", "face"]}]`))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	emoji := &Emoji{Grapheme: "😀", Name: "grinning face", Tags: tags["😀"]}
+	if got, want := emoji.Tags, []string{"smile", "grin", "face"}; !slices.Equal(got, want) {
+		t.Errorf("got tags %v, want data.json order %v", got, want)
+	}
+	AssignTokens([]*Emoji{emoji}, TokensOptions{})
+	if got, want := emoji.Tokens, []string{"face", "grin", "grinning", "smile"}; !slices.Equal(got, want) {
+		t.Errorf("got tokens %v, want sorted %v", got, want)
+	}
+}
+
+func TestParseTagsKeywords(t *testing.T) {
+	const data = `[
+		{"emoji": "😀", "label": "grinning face", "tags": ["face", "grin"], "keywords": ["grin", "happy"], "unknown": 1},
+		{"emoji": "🐈", "keywords": ["cat", "pet"]}
+	]`
+	tags, _, err := ParseTags(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	for grapheme, want := range map[string][]string{
+		"😀": {"face", "grin", "happy"},
+		"🐈": {"cat", "pet"},
+	} {
+		if got := tags[grapheme]; !slices.Equal(got, want) {
+			t.Errorf("tags of %s: got %v, want %v", grapheme, got, want)
+		}
+	}
+}
+
+func TestParseGroupSpacing(t *testing.T) {
+	for _, header := range []string{
+		"# group: Smileys & Emotion\n# subgroup: face-smiling\n",
+		"#group:Smileys & Emotion\n#subgroup:face-smiling\n",
+		"#  group :  Smileys & Emotion  \n# subgroup :face-smiling\n",
+	} {
+		emojis, err := ParseString(header + "1F600 ; fully-qualified # 😀 E1.0 grinning face\n")
+		if err != nil {
+			t.Fatalf("ParseString: %v", err)
+		}
+		if len(emojis) != 1 || emojis[0].Group != "Smileys & Emotion" || emojis[0].Subgroup != "face-smiling" {
+			t.Errorf("%q: got %+v, want 😀 in Smileys & Emotion/face-smiling", header, emojis)
+		}
+	}
+}
+
+// testMismatch is an emoji-test.txt line whose code points (😃) don't match
+// its grapheme (😀).
+const testMismatch = "1F603 ; fully-qualified # 😀 E1.0 grinning face\n"
+
+func TestParseRuneMismatch(t *testing.T) {
+	_, err := ParseString(testEmojiTest + testMismatch)
+	var mismatch *RuneMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("got error %v, want a *RuneMismatchError", err)
+	}
+	if mismatch.Grapheme != "😀" || !slices.Equal(mismatch.Got, []rune{0x1F603}) || !slices.Equal(mismatch.Want, []rune{0x1F600}) {
+		t.Errorf("got %+v, want 😀 with got [U+1F603] and want [U+1F600]", mismatch)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	input := testEmojiTest + testMismatch + "D800 ; fully-qualified # 😀 E1.0 grinning face\n"
+
+	// A strict parse fails on the first bad line.
+	if _, err := ParseString(input); err == nil {
+		t.Errorf("strict parse: got no error, want error")
+	}
+
+	// A lenient parse skips and reports the bad lines.
+	var stats Stats
+	emojis, err := ParseWithOptions(strings.NewReader(input), ParseOptions{Lenient: true, Stats: &stats})
+	if err != nil {
+		t.Fatalf("lenient parse: %v", err)
+	}
+	if got, want := len(emojis), 12; got != want {
+		t.Errorf("lenient parse: got %d emojis, want %d", got, want)
+	}
+	if got, want := len(stats.Warnings), 2; got != want {
+		t.Fatalf("lenient parse: got warnings %v, want %d", stats.Warnings, want)
+	}
+	var mismatch *RuneMismatchError
+	if !errors.As(stats.Warnings[0], &mismatch) {
+		t.Errorf("lenient parse: got warning %v, want a *RuneMismatchError", stats.Warnings[0])
+	}
+	if !strings.HasPrefix(stats.Warnings[1].Error(), "line 45: ") {
+		t.Errorf("lenient parse: got warning %q, want it to start with its line", stats.Warnings[1])
+	}
+
+	// A lenient parse must report the bad lines somewhere.
+	if _, err := ParseWithOptions(strings.NewReader(input), ParseOptions{Lenient: true}); err == nil {
+		t.Errorf("lenient parse without Stats or Logger: got no error, want error")
+	}
+	if _, err := ParseConcurrentWithOptions(strings.NewReader(input), 2, ParseOptions{Lenient: true}); err == nil {
+		t.Errorf("concurrent lenient parse without Stats or Logger: got no error, want error")
+	}
+}
+
+func TestParseCodesOverflow(t *testing.T) {
+	// These don't fit in a valid rune, and some would wrap around if parsed
+	// into 64 bits.
+	for _, code := range []string{"FFFFFFFF", "80000000", "100000000", "1FFFFFFFF", "FFFFFFFFFFFFFFFFFF"} {
+		if got, err := parseCodes([]string{code}); err == nil {
+			t.Errorf("parseCodes(%q): got %U, want error", code, got)
+		}
+	}
+}
+
+func TestParseSourceLines(t *testing.T) {
+	// Source lines are only set when requested.
+	for _, emoji := range mustParse(t, ParseOptions{}) {
+		if emoji.SourceLine != 0 {
+			t.Errorf("%s: got source line %d, want 0", emoji.Grapheme, emoji.SourceLine)
+		}
+	}
+
+	want := map[string]int{
+		"😀": 7, "😃": 8, "☹️": 11, "👋": 17, "👋🏻": 18, "🧑‍⚕️": 21,
+		"🐱": 32, "🐈": 33, "🐈‍⬛": 34, "#️⃣": 39, "*️⃣": 40, "2️⃣": 41,
+	}
+	lines := strings.Split(testEmojiTest, "\n")
+	for _, emoji := range mustParse(t, ParseOptions{SourceLines: true}) {
+		if got := emoji.SourceLine; got != want[emoji.Grapheme] {
+			t.Errorf("%s: got source line %d, want %d", emoji.Grapheme, got, want[emoji.Grapheme])
+			continue
+		}
+		if line := lines[emoji.SourceLine-1]; !strings.Contains(line, "# "+emoji.Grapheme+" ") {
+			t.Errorf("%s: source line %d is %q", emoji.Grapheme, emoji.SourceLine, line)
+		}
+	}
+}
+
+func TestParseTagsKeyed(t *testing.T) {
+	const data = `
+	{
+		"1F600": {"label": "grinning face", "tags": ["face", "grin"]},
+		"1F44B": {"label": "waving hand", "tags": ["hand", "wave"], "skins": [
+			{"hexcode": "1F44B-1F3FB", "tags": ["light skin tone"]}
+		]},
+		"1F408-200D-2B1B": {"label": "black cat", "tags": ["black", "cat"]},
+		"0023-FE0F-20E3": {"emoji": "#️⃣", "tags": ["keycap"]}
+	}`
+	tags, skins, err := ParseTags(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	want := map[string][]string{
+		"😀":   {"face", "grin"},
+		"👋":   {"hand", "wave"},
+		"👋🏻":  {"hand", "wave", "light skin tone"},
+		"🐈‍⬛": {"black", "cat"},
+		"#️⃣": {"keycap"},
+	}
+	if !maps.EqualFunc(tags, want, slices.Equal[[]string]) {
+		t.Errorf("got tags %v, want %v", tags, want)
+	}
+	if got, want := skins["👋"], []string{"👋🏻"}; !slices.Equal(got, want) {
+		t.Errorf("skins of 👋: got %v, want %v", got, want)
+	}
+
+	// The keyed and array formats parse the same.
+	const array = `[{"emoji": "😀", "tags": ["face", "grin"]}]`
+	const keyed = `{"1F600": {"tags": ["face", "grin"]}}`
+	arrayTags, _, err := ParseTags(strings.NewReader(array))
+	if err != nil {
+		t.Fatalf("ParseTags(array): %v", err)
+	}
+	keyedTags, _, err := ParseTags(strings.NewReader(keyed))
+	if err != nil {
+		t.Fatalf("ParseTags(keyed): %v", err)
+	}
+	if !maps.EqualFunc(arrayTags, keyedTags, slices.Equal[[]string]) {
+		t.Errorf("got keyed tags %v, want %v", keyedTags, arrayTags)
+	}
+
+	if _, _, err := ParseTags(strings.NewReader(`{"ZZZZ": {"tags": ["bad"]}}`)); err == nil {
+		t.Errorf("ParseTags with a bad hexcode: got no error, want error")
+	}
+}
+
+func TestParseNeedsVariationSelector(t *testing.T) {
+	opts := ParseOptions{IncludeUnqualified: true, IncludeMinimallyQualified: true, IncludeComponents: true}
+	byGrapheme := ByGrapheme(mustParse(t, opts))
+	for grapheme, want := range map[string]bool{
+		"☹️": true,
+		"☹":  true, // unqualified
+		"😀":  false,
+		"👋🏻": false,
+		"🏻":  false,
+		// The variation selector follows ⚕, not 🧑.
+		"🧑‍⚕️": false,
+		"🧑‍⚕":  false, // minimally qualified
+		"#️⃣":  true,
+		"🐈‍⬛":  false,
+	} {
+		emoji, ok := byGrapheme[grapheme]
+		if !ok {
+			t.Errorf("%s: not parsed", grapheme)
+			continue
+		}
+		if got := emoji.NeedsVariationSelector; got != want {
+			t.Errorf("%s: got NeedsVariationSelector %t, want %t", grapheme, got, want)
+		}
+	}
+}
+
+// recordHandler is a slog.Handler that records every record.
+type recordHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *recordHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+// lines returns "level message: line" for every record, sorted.
+func (h *recordHandler) lines() []string {
+	var lines []string
+	for _, r := range h.records {
+		line := ""
+		r.Attrs(func(a slog.Attr) bool {
+			if a.Key == "line" || a.Key == "grapheme" {
+				line += " " + a.Value.String()
+			}
+			return true
+		})
+		lines = append(lines, r.Level.String()+" "+r.Message+":"+line)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func TestParseLogger(t *testing.T) {
+	// 😀 is listed again on line 45, and the last two lines are bad.
+	input := testEmojiTest +
+		"not an emoji\n" +
+		"1F600 ; fully-qualified # 😀 E1.0 grinning face\n" +
+		testMismatch +
+		"D800 ; fully-qualified # 😀 E1.0 grinning face\n"
+	want := []string{
+		"DEBUG skipped emoji: 😀",
+		"DEBUG skipped line: 12 ☹",
+		"DEBUG skipped line: 22 🧑‍⚕",
+		"DEBUG skipped line: 27 🏻",
+		"DEBUG skipped line: 44",
+		"WARN skipped line: 46",
+		"WARN skipped line: 47",
+	}
+	for _, parse := range []struct {
+		name string
+		f    func(ParseOptions) ([]*Emoji, error)
+	}{
+		{"ParseWithOptions", func(opts ParseOptions) ([]*Emoji, error) {
+			return ParseWithOptions(strings.NewReader(input), opts)
+		}},
+		{"ParseConcurrentWithOptions", func(opts ParseOptions) ([]*Emoji, error) {
+			return ParseConcurrentWithOptions(strings.NewReader(input), 4, opts)
+		}},
+	} {
+		var handler recordHandler
+		if _, err := parse.f(ParseOptions{Lenient: true, Logger: slog.New(&handler)}); err != nil {
+			t.Fatalf("%s: %v", parse.name, err)
+		}
+		if got := handler.lines(); !slices.Equal(got, want) {
+			t.Errorf("%s: got records %q, want %q", parse.name, got, want)
+		}
+	}
+}
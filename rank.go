@@ -0,0 +1,51 @@
+package emojis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ParseRanks parses a json file that maps the graphemes of emojis to their
+// ranks (e.g., {"😂": 1, "❤️": 2}), like a list of the most frequently used
+// emojis. Lower ranks are better.
+func ParseRanks(r io.Reader) (map[string]int, error) {
+	decoder := json.NewDecoder(r)
+	var ranks map[string]int
+	if err := decoder.Decode(&ranks); err != nil {
+		return nil, fmt.Errorf("json decode: %w", err)
+	}
+	return ranks, nil
+}
+
+// Top returns the n best ranked emojis, like those returned by ParseRanks,
+// sorted by rank. Emojis are looked up in ranks ignoring variation selectors
+// (see Normalize), since frequency lists often omit them. Emojis without a
+// rank sort last, in the order of emojis. Fewer than n emojis are returned if
+// there are fewer than n emojis.
+func Top(emojis []*Emoji, ranks map[string]int, n int) []*Emoji {
+	normalized := make(map[string]int, len(ranks))
+	for grapheme, rank := range ranks {
+		normalized[Normalize(grapheme)] = rank
+	}
+	rank := func(emoji *Emoji) (int, bool) {
+		if r, ok := ranks[emoji.Grapheme]; ok {
+			return r, true
+		}
+		r, ok := normalized[Normalize(emoji.Grapheme)]
+		return r, ok
+	}
+
+	sorted := make([]*Emoji, len(emojis))
+	copy(sorted, emojis)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		x, xok := rank(sorted[i])
+		y, yok := rank(sorted[j])
+		if xok != yok {
+			return xok
+		}
+		return x < y
+	})
+	return sorted[:min(max(n, 0), len(sorted))]
+}
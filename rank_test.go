@@ -0,0 +1,55 @@
+package emojis
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+// testRanks is a small frequency list. ☹ is listed without its variation
+// selector, and 🦄 isn't in testEmojiTest.
+const testRanks = `{"🐈": 1, "😀": 2, "☹": 3, "🦄": 4, "😃": 5}`
+
+func TestParseRanks(t *testing.T) {
+	ranks, err := ParseRanks(strings.NewReader(testRanks))
+	if err != nil {
+		t.Fatalf("ParseRanks: %v", err)
+	}
+	if got, want := ranks["😀"], 2; got != want {
+		t.Errorf("ranks[😀]: got %d, want %d", got, want)
+	}
+	if _, err := ParseRanks(strings.NewReader(`["😀"]`)); err == nil {
+		t.Errorf("ParseRanks of an array: got no error, want error")
+	}
+}
+
+func TestTop(t *testing.T) {
+	ranks, err := ParseRanks(strings.NewReader(testRanks))
+	if err != nil {
+		t.Fatalf("ParseRanks: %v", err)
+	}
+	emojis := mustParse(t, ParseOptions{})
+	for _, test := range []struct {
+		n    int
+		want []string
+	}{
+		{0, nil},
+		{-1, nil},
+		{1, []string{"🐈"}},
+		{3, []string{"🐈", "😀", "☹️"}},
+		{4, []string{"🐈", "😀", "☹️", "😃"}},
+		// Unranked emojis sort last, in file order.
+		{6, []string{"🐈", "😀", "☹️", "😃", "👋", "👋🏻"}},
+		{100, []string{"🐈", "😀", "☹️", "😃", "👋", "👋🏻", "🧑‍⚕️", "🐱", "🐈‍⬛", "#️⃣", "*️⃣", "2️⃣"}},
+	} {
+		if got := graphemes(Top(emojis, ranks, test.n)); !slices.Equal(got, test.want) {
+			t.Errorf("Top(%d): got %v, want %v", test.n, got, test.want)
+		}
+	}
+
+	// Top doesn't reorder its input.
+	if got, want := graphemes(emojis), graphemes(mustParse(t, ParseOptions{})); !slices.Equal(got, want) {
+		t.Errorf("Top reordered its input: got %v, want %v", got, want)
+	}
+}
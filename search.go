@@ -0,0 +1,337 @@
+package emojis
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Lookup returns every emoji whose tokens contain all of the tokens in query.
+// An emoji's tokens are the tokens of its tags, name, group, and subgroup. For
+// example, Lookup(emojis, "happy face") returns every emoji with both a
+// "happy" and a "face" token. The returned emojis are sorted by grapheme.
+func Lookup(emojis []*Emoji, query string) []*Emoji {
+	want := Tokenize([]string{query})
+	var matches []*Emoji
+	for _, emoji := range emojis {
+		if containsAll(Tokens(emoji), want) {
+			matches = append(matches, emoji)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Grapheme < matches[j].Grapheme
+	})
+	return matches
+}
+
+// ByTag returns every emoji with a tag equal to tag, ignoring case. Unlike
+// Lookup, ByTag matches whole tags rather than tokens, so ByTag(emojis,
+// "face") doesn't return an emoji tagged only "face with tears of joy". The
+// returned emojis are sorted by grapheme.
+func ByTag(emojis []*Emoji, tag string) []*Emoji {
+	var matches []*Emoji
+	for _, emoji := range emojis {
+		if slices.ContainsFunc(emoji.Tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			matches = append(matches, emoji)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Grapheme < matches[j].Grapheme
+	})
+	return matches
+}
+
+// containsAll returns whether the sorted slice tokens contains every token in
+// want.
+func containsAll(tokens, want []string) bool {
+	for _, token := range want {
+		if _, found := slices.BinarySearch(tokens, token); !found {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchOptions configures Search. The zero value matches query tokens
+// exactly, like Lookup.
+type SearchOptions struct {
+	// If Prefix is true, a query token matches any emoji token that it is a
+	// prefix of. For example, "smi" matches "smile" and "smiling".
+	Prefix bool
+
+	// A query token matches any emoji token within MaxEditDistance
+	// (Levenshtein distance) of it. For example, with a MaxEditDistance of 1,
+	// "smilie" matches "smile".
+	MaxEditDistance int
+
+	// Offset and Limit page through the ranked results. The first Offset
+	// results are skipped, and at most Limit results are returned. A Limit of
+	// zero returns all results.
+	Offset int
+	Limit  int
+
+	// Weights weighs matches by where the matched token appears. The zero
+	// value uses DefaultWeights.
+	Weights Weights
+
+	// If Stem is true, plural query and emoji tokens are folded into their
+	// singular forms, so that "cats" matches "cat" and vice versa. See
+	// TokenizeOptions.Stem.
+	Stem bool
+
+	// Usage maps graphemes to how often they were used (e.g., by the user of
+	// an emoji picker). A used emoji's score is boosted by ln(1 + count), so
+	// among equally close matches, frequently used emojis sort first. The
+	// logarithm keeps heavy use from drowning out how well an emoji matches:
+	// an emoji used e times more often gains only 1 more point.
+	Usage map[string]int
+}
+
+// Weights weighs search matches by where the matched emoji token appears. A
+// query token that matches a token appearing in more than one place gets the
+// largest applicable weight.
+type Weights struct {
+	Name     float64 // the weight of a match in the emoji's name
+	Tags     float64 // the weight of a match in the emoji's tags
+	Category float64 // the weight of a match in the emoji's group or subgroup
+}
+
+// DefaultWeights are the default search weights. Names are the most precise
+// description of an emoji, so name matches weigh the most. Categories are the
+// least precise, so category matches weigh the least.
+var DefaultWeights = Weights{Name: 3, Tags: 2, Category: 1}
+
+// weight returns the weight of a match of token against emoji. If stem is
+// true, the emoji's tokens are stemmed.
+func (w Weights) weight(emoji *Emoji, token string, stem bool) float64 {
+	opts := TokenizeOptions{Stem: stem}
+	weight := 0.0
+	if slices.Contains(TokenizeWithOptions([]string{emoji.Name}, opts), token) {
+		weight = max(weight, w.Name)
+	}
+	if slices.Contains(TokenizeWithOptions(emoji.Tags, opts), token) {
+		weight = max(weight, w.Tags)
+	}
+	if slices.Contains(TokenizeWithOptions([]string{emoji.Group, emoji.Subgroup}, opts), token) {
+		weight = max(weight, w.Category)
+	}
+	return weight
+}
+
+// Search returns every emoji whose tokens match all of the tokens in query,
+// where matching is configured by opts. Results are ranked by how closely
+// they match. Every query token contributes a cost of 0 if it matches an
+// emoji token exactly, 1 if it matches as a prefix, and 1 plus the edit
+// distance if it matches within opts.MaxEditDistance. Every query token also
+// contributes a score, the weight of where its match appears (see Weights),
+// and frequently used emojis get a boost (see SearchOptions.Usage).
+// Emojis are sorted by ascending total cost, then by descending total score,
+// and then by grapheme. So, exact matches sort before fuzzy ones, and among
+// equally close matches, name matches sort before tag matches. Because the
+// ranking is deterministic, paging through results with opts.Offset and
+// opts.Limit is stable.
+func Search(emojis []*Emoji, query string, opts SearchOptions) []*Emoji {
+	matches := SearchMatches(emojis, query, opts)
+	results := make([]*Emoji, len(matches))
+	for i, match := range matches {
+		results[i] = match.Emoji
+	}
+	return results
+}
+
+// Score returns how well emoji matches the query tokens (e.g., the tokens
+// returned by Tokenize), so that callers can combine it with their own
+// ranking signals (e.g., how recently an emoji was used). Every query token
+// that exactly matches one of the emoji's tokens contributes the weight of
+// where the match appears (see DefaultWeights): 3 for the emoji's name, 2 for
+// its tags, and 1 for its group or subgroup. Query tokens that don't match
+// contribute nothing. So, the more query tokens match, and the more precise
+// the matches, the higher the score. This is the score used by Search for
+// exact matches.
+func Score(emoji *Emoji, queryTokens []string) float64 {
+	tokens := Tokens(emoji)
+	score := 0.0
+	for _, token := range queryTokens {
+		if _, found := slices.BinarySearch(tokens, token); found {
+			score += DefaultWeights.weight(emoji, token, false)
+		}
+	}
+	return score
+}
+
+// Match is an emoji returned by SearchMatches.
+type Match struct {
+	Emoji *Emoji
+
+	// The sorted emoji tokens matched by the query tokens (e.g., to highlight
+	// them). If every query token matched exactly, these are the query
+	// tokens. Otherwise, they include the emoji tokens that were matched by
+	// prefix or edit distance (e.g., "smile" for the query "smi").
+	MatchedTokens []string
+}
+
+// SearchMatches is like Search but also returns which tokens of every emoji
+// were matched.
+func SearchMatches(emojis []*Emoji, query string, opts SearchOptions) []Match {
+	type result struct {
+		match Match
+		cost  int
+		score float64
+	}
+
+	weights := opts.Weights
+	if weights == (Weights{}) {
+		weights = DefaultWeights
+	}
+	want := TokenizeWithOptions([]string{query}, TokenizeOptions{Stem: opts.Stem})
+	var results []result
+	for _, emoji := range emojis {
+		tokens := TokensWithOptions(emoji, TokensOptions{Stem: opts.Stem})
+		total, score := 0, 0.0
+		var matched []string
+		ok := true
+		for _, token := range want {
+			var match string
+			var cost int
+			match, cost, ok = bestMatch(tokens, token, opts)
+			if !ok {
+				break
+			}
+			total += cost
+			score += weights.weight(emoji, match, opts.Stem)
+			matched = append(matched, match)
+		}
+		if ok {
+			if count := opts.Usage[emoji.Grapheme]; count > 0 {
+				score += math.Log1p(float64(count))
+			}
+			sort.Strings(matched)
+			matched = slices.Compact(matched)
+			results = append(results, result{Match{emoji, matched}, total, score})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].cost != results[j].cost {
+			return results[i].cost < results[j].cost
+		}
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+		return results[i].match.Emoji.Grapheme < results[j].match.Emoji.Grapheme
+	})
+	results = results[min(max(opts.Offset, 0), len(results)):]
+	if opts.Limit > 0 && opts.Limit < len(results) {
+		results = results[:opts.Limit]
+	}
+	matches := make([]Match, len(results))
+	for i, result := range results {
+		matches[i] = result.match
+	}
+	return matches
+}
+
+// bestMatch returns the token among the sorted tokens with the lowest cost of
+// matching query, and that cost, as described in Search. It returns false if
+// query doesn't match any token.
+func bestMatch(tokens []string, query string, opts SearchOptions) (string, int, bool) {
+	if _, found := slices.BinarySearch(tokens, query); found {
+		return query, 0, true
+	}
+
+	best, bestCost, matched := "", 0, false
+	for _, token := range tokens {
+		cost := -1
+		if opts.Prefix && strings.HasPrefix(token, query) {
+			cost = 1
+		} else if opts.MaxEditDistance > 0 {
+			if d := editDistance(query, token); d <= opts.MaxEditDistance {
+				cost = 1 + d
+			}
+		}
+		if cost >= 0 && (!matched || cost < bestCost) {
+			best, bestCost, matched = token, cost, true
+		}
+	}
+	return best, bestCost, matched
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	prev := make([]int, len(y)+1)
+	curr := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		curr[0] = i
+		for j := 1; j <= len(y); j++ {
+			substitution := prev[j-1]
+			if x[i-1] != y[j-1] {
+				substitution++
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, substitution)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(y)]
+}
+
+// phraseStopWords are the stop words of the phrases passed to Best. Besides
+// DefaultStopWords, they include common words of everyday phrases (e.g., "i'm
+// so hungry") that rarely describe an emoji.
+var phraseStopWords = func() map[string]bool {
+	stop := maps.Clone(DefaultStopWords)
+	for _, word := range strings.Fields("am are be but do is it its me my so that this was we you your") {
+		stop[word] = true
+	}
+	return stop
+}()
+
+// Best returns the emoji that best matches an arbitrary phrase (e.g., "i love
+// pizza"), or false if no emoji shares a token with the phrase. The phrase is
+// tokenized without stop words or single letters (e.g., the "m" of "i'm"),
+// and every emoji is scored by the tokens it shares with the phrase. Rare
+// tokens are more descriptive than common ones, so every shared token is
+// weighted by its inverse document frequency, the log of how many emojis
+// there are per emoji with the token. For example, "pizza" weighs more than
+// "food". Ties are broken in favor of emojis with fewer tokens, which are
+// more specific, and then by grapheme.
+func Best(emojis []*Emoji, phrase string) (*Emoji, bool) {
+	want := slices.DeleteFunc(TokenizeWithStopWords([]string{phrase}, phraseStopWords), func(token string) bool {
+		return len(token) < 2
+	})
+	tokens := make([][]string, len(emojis))
+	frequency := map[string]int{}
+	for i, emoji := range emojis {
+		tokens[i] = Tokens(emoji)
+		for _, token := range tokens[i] {
+			frequency[token]++
+		}
+	}
+
+	var best *Emoji
+	bestScore, bestTokens := 0.0, 0
+	for i, emoji := range emojis {
+		score := 0.0
+		for _, token := range want {
+			if _, found := slices.BinarySearch(tokens[i], token); found {
+				score += math.Log(1 + float64(len(emojis))/float64(frequency[token]))
+			}
+		}
+		if score == 0 {
+			continue
+		}
+		better := best == nil || score > bestScore ||
+			(score == bestScore && len(tokens[i]) < bestTokens) ||
+			(score == bestScore && len(tokens[i]) == bestTokens && emoji.Grapheme < best.Grapheme)
+		if better {
+			best, bestScore, bestTokens = emoji, score, len(tokens[i])
+		}
+	}
+	return best, best != nil
+}
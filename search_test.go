@@ -0,0 +1,238 @@
+package emojis
+
+import (
+	"testing"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestLookup(t *testing.T) {
+	emojis := testEmojis(t)
+	for _, test := range []struct {
+		query string
+		want  []string
+	}{
+		{"cat", []string{"🐈", "🐈‍⬛", "🐱"}},
+		{"CAT", []string{"🐈", "🐈‍⬛", "🐱"}},
+		{"happy face", []string{"😃"}},
+		{"black cat", []string{"🐈‍⬛"}},
+		{"face pet", []string{"🐱"}},
+		{"unicorn", nil},
+		{"happy cat", nil},
+	} {
+		if got := graphemes(Lookup(emojis, test.query)); !slices.Equal(got, test.want) {
+			t.Errorf("Lookup(%q): got %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	emojis := testEmojis(t)
+	for _, test := range []struct {
+		query string
+		opts  SearchOptions
+		want  []string
+	}{
+		{"smi", SearchOptions{}, nil},
+		{"smi", SearchOptions{Prefix: true}, []string{"😃", "☹️", "😀"}},
+		{"kat", SearchOptions{}, nil},
+		{"kat", SearchOptions{MaxEditDistance: 1}, []string{"🐈", "🐈‍⬛", "🐱"}},
+		{"smilie", SearchOptions{MaxEditDistance: 1}, []string{"😃"}},
+		// Exact matches sort before prefix matches.
+		{"grin", SearchOptions{Prefix: true}, []string{"😀", "😃"}},
+	} {
+		if got := graphemes(Search(emojis, test.query, test.opts)); !slices.Equal(got, test.want) {
+			t.Errorf("Search(%q, %+v): got %v, want %v", test.query, test.opts, got, test.want)
+		}
+	}
+}
+
+func TestSearchStem(t *testing.T) {
+	emojis, err := All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	opts := SearchOptions{Stem: true}
+	for _, test := range []struct {
+		query    string
+		want     []string
+		dontWant []string
+	}{
+		{"tomatoes", []string{"🍅"}, nil},
+		{"christmas", []string{"🎄"}, nil},
+		// "news" isn't the plural of "new".
+		{"new", []string{"🆕"}, []string{"📰", "🗞️"}},
+	} {
+		got := graphemes(Search(emojis, test.query, opts))
+		for _, want := range test.want {
+			if !slices.Contains(got, want) {
+				t.Errorf("Search(%q, %+v): got %v, want %s", test.query, opts, got, want)
+			}
+		}
+		for _, dontWant := range test.dontWant {
+			if slices.Contains(got, dontWant) {
+				t.Errorf("Search(%q, %+v): got %v, don't want %s", test.query, opts, got, dontWant)
+			}
+		}
+	}
+}
+
+func TestSearchPaging(t *testing.T) {
+	emojis := testEmojis(t)
+	all := graphemes(Search(emojis, "face", SearchOptions{}))
+	if len(all) < 4 {
+		t.Fatalf("got %d results for face, want at least 4", len(all))
+	}
+	for _, test := range []struct {
+		offset, limit int
+		want          []string
+	}{
+		{0, 0, all},
+		{0, 2, all[:2]},
+		{2, 0, all[2:]},
+		{1, 2, all[1:3]},
+		{len(all) - 1, 5, all[len(all)-1:]},
+		{len(all), 0, nil},
+		{len(all) + 10, 1, nil},
+		{-1, 1, all[:1]},
+	} {
+		got := graphemes(Search(emojis, "face", SearchOptions{Offset: test.offset, Limit: test.limit}))
+		if !slices.Equal(got, test.want) {
+			t.Errorf("Offset=%d Limit=%d: got %v, want %v", test.offset, test.limit, got, test.want)
+		}
+	}
+}
+
+func TestSearchMatches(t *testing.T) {
+	emojis := testEmojis(t)
+	for _, test := range []struct {
+		query string
+		opts  SearchOptions
+		want  map[string][]string // matched tokens by grapheme
+	}{
+		{"black cat", SearchOptions{}, map[string][]string{"🐈‍⬛": {"black", "cat"}}},
+		{"pet cat", SearchOptions{}, map[string][]string{"🐈": {"cat", "pet"}, "🐱": {"cat", "pet"}}},
+		{"happy smi", SearchOptions{Prefix: true}, map[string][]string{"😃": {"happy", "smile"}}},
+		{"unluckyy", SearchOptions{MaxEditDistance: 1}, map[string][]string{"🐈‍⬛": {"unlucky"}}},
+	} {
+		matches := SearchMatches(emojis, test.query, test.opts)
+		got := map[string][]string{}
+		for _, match := range matches {
+			got[match.Emoji.Grapheme] = match.MatchedTokens
+		}
+		if !maps.EqualFunc(got, test.want, slices.Equal[[]string]) {
+			t.Errorf("SearchMatches(%q): got %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+func TestSearchWeights(t *testing.T) {
+	// Both emojis are tokenized to "cat", but only one is named a cat.
+	named := &Emoji{Grapheme: "🐈", Name: "cat", Group: "Animals & Nature"}
+	tagged := &Emoji{Grapheme: "🐅", Name: "tiger", Group: "Animals & Nature", Tags: []string{"big cat"}}
+	category := &Emoji{Grapheme: "🐾", Name: "paw prints", Group: "Animals & Nature", Subgroup: "cat-like"}
+	emojis := []*Emoji{category, tagged, named}
+
+	if got, want := graphemes(Search(emojis, "cat", SearchOptions{})), []string{"🐈", "🐅", "🐾"}; !slices.Equal(got, want) {
+		t.Errorf("default weights: got %v, want %v", got, want)
+	}
+	weights := Weights{Name: 1, Tags: 2, Category: 3}
+	if got, want := graphemes(Search(emojis, "cat", SearchOptions{Weights: weights})), []string{"🐾", "🐅", "🐈"}; !slices.Equal(got, want) {
+		t.Errorf("weights %+v: got %v, want %v", weights, got, want)
+	}
+}
+
+func TestByTag(t *testing.T) {
+	emojis := testEmojis(t)
+	for _, test := range []struct {
+		tag  string
+		want []string
+	}{
+		{"cat", []string{"🐈", "🐈‍⬛", "🐱"}},
+		{"face", []string{"☹️", "🐱", "😀", "😃"}},
+		{"FACE", []string{"☹️", "🐱", "😀", "😃"}},
+		{"Pet", []string{"🐈", "🐱"}},
+		// Tags match whole, not by prefix or by token.
+		{"fac", nil},
+		{"black cat", nil},
+		{"", nil},
+	} {
+		if got := graphemes(ByTag(emojis, test.tag)); !slices.Equal(got, test.want) {
+			t.Errorf("ByTag(%q): got %v, want %v", test.tag, got, test.want)
+		}
+	}
+}
+
+func TestBest(t *testing.T) {
+	emojis := testEmojis(t)
+	for _, test := range []struct {
+		phrase string
+		want   string
+	}{
+		{"i'm so happy", "😃"},
+		{"my black cat", "🐈‍⬛"},
+		{"we need a doctor", "🧑‍⚕️"},
+		{"WAVE hello", "👋"},
+		{"so, that's a frown", "☹️"},
+		// "face" and "cat" are shared by several emojis, but "grin" isn't.
+		{"cat face grin", "😀"},
+	} {
+		got, ok := Best(emojis, test.phrase)
+		if !ok || got.Grapheme != test.want {
+			t.Errorf("Best(%q): got %v, %t, want %s", test.phrase, got, ok, test.want)
+		}
+	}
+
+	for _, phrase := range []string{"", "pizza tonight", "it is what it is", "i m"} {
+		if got, ok := Best(emojis, phrase); ok {
+			t.Errorf("Best(%q): got %s, want false", phrase, got.Grapheme)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	blackCat := ByGrapheme(testEmojis(t))["🐈‍⬛"]
+	for _, test := range []struct {
+		tokens []string
+		want   float64
+	}{
+		{nil, 0},
+		{[]string{"pizza"}, 0},
+		{[]string{"animal"}, 1},  // the group
+		{[]string{"unlucky"}, 2}, // a tag
+		{[]string{"black"}, 3},   // the name
+		{[]string{"cat", "pizza"}, 3},
+		{[]string{"cat", "black"}, 6},
+		{[]string{"cat", "black", "unlucky"}, 8},
+	} {
+		if got := Score(blackCat, test.tokens); got != test.want {
+			t.Errorf("Score(🐈‍⬛, %q): got %v, want %v", test.tokens, got, test.want)
+		}
+	}
+
+	// More overlap scores higher.
+	less := Score(blackCat, Tokenize([]string{"cat"}))
+	more := Score(blackCat, Tokenize([]string{"unlucky black cat"}))
+	if less >= more {
+		t.Errorf("Score(🐈‍⬛, cat) = %v, want less than Score(🐈‍⬛, unlucky black cat) = %v", less, more)
+	}
+}
+
+func TestSearchUsage(t *testing.T) {
+	// 😀 and 😃 match "grinning" equally well.
+	emojis := testEmojis(t)
+	if got, want := graphemes(Search(emojis, "grinning", SearchOptions{})), []string{"😀", "😃"}; !slices.Equal(got, want) {
+		t.Errorf("no usage: got %v, want %v", got, want)
+	}
+	usage := map[string]int{"😀": 1, "😃": 10}
+	if got, want := graphemes(Search(emojis, "grinning", SearchOptions{Usage: usage})), []string{"😃", "😀"}; !slices.Equal(got, want) {
+		t.Errorf("usage %v: got %v, want %v", usage, got, want)
+	}
+
+	// Usage boosts matched emojis, but doesn't make unmatched emojis match.
+	usage = map[string]int{"🐱": 1000, "😀": 1000}
+	if got, want := graphemes(Search(emojis, "cat", SearchOptions{Usage: usage})), []string{"🐱", "🐈", "🐈‍⬛"}; !slices.Equal(got, want) {
+		t.Errorf("usage %v: got %v, want %v", usage, got, want)
+	}
+}
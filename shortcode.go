@@ -0,0 +1,48 @@
+package emojis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// shortcodeRegex matches the runs of characters in an emoji's name that are
+// replaced with underscores in its shortcode.
+var shortcodeRegex = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// AssignShortcodes sets the Shortcode of every emoji. An emoji's shortcode is
+// derived from its name by lowercasing it, removing apostrophes and periods,
+// spelling out symbols (e.g., "keycap: #" becomes "keycap_number_sign"), and
+// replacing every other run of punctuation and spaces with an underscore. For
+// example, "grinning face" becomes "grinning_face". If two emojis derive the
+// same shortcode, the first keeps it and later ones get a "_2", "_3", etc.
+// suffix, skipping suffixed shortcodes that another emoji derives (e.g.,
+// "keycap_2" for "keycap: 2"). So, shortcodes are unique and deterministic
+// given the order of emojis.
+func AssignShortcodes(emojis []*Emoji) {
+	derived := map[string]bool{}
+	for _, emoji := range emojis {
+		derived[shortcode(emoji.Name)] = true
+	}
+	taken := map[string]bool{}
+	for _, emoji := range emojis {
+		base := shortcode(emoji.Name)
+		code := base
+		// A suffixed shortcode must not be one that another emoji derives,
+		// or the other emoji would be left without its shortcode.
+		for n := 2; taken[code] || (code != base && derived[code]); n++ {
+			code = fmt.Sprintf("%s_%d", base, n)
+		}
+		taken[code] = true
+		emoji.Shortcode = code
+	}
+}
+
+// shortcode derives a shortcode from an emoji name, as described in
+// AssignShortcodes.
+func shortcode(name string) string {
+	s := strings.ToLower(name)
+	s = strings.NewReplacer("'", "", "’", "", ".", "", "#", " number sign ", "*", " asterisk ").Replace(s)
+	s = shortcodeRegex.ReplaceAllLiteralString(s, "_")
+	return strings.Trim(s, "_")
+}
@@ -0,0 +1,66 @@
+package emojis
+
+import "testing"
+
+func TestAssignShortcodes(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{})
+	AssignShortcodes(emojis)
+	byGrapheme := ByGrapheme(emojis)
+	for grapheme, want := range map[string]string{
+		"😀":   "grinning_face",
+		"😃":   "grinning_face_with_big_eyes",
+		"👋🏻":  "waving_hand_light_skin_tone",
+		"#️⃣": "keycap_number_sign",
+		"*️⃣": "keycap_asterisk",
+		"2️⃣": "keycap_2",
+	} {
+		if got := byGrapheme[grapheme].Shortcode; got != want {
+			t.Errorf("shortcode of %s: got %q, want %q", grapheme, got, want)
+		}
+	}
+}
+
+func TestAssignShortcodesCollisions(t *testing.T) {
+	for _, test := range []struct {
+		names []string
+		want  []string
+	}{
+		{
+			[]string{"ok hand", "OK hand", "ok-hand"},
+			[]string{"ok_hand", "ok_hand_2", "ok_hand_3"},
+		},
+		{
+			// The second "keycap" doesn't take "keycap_2" from "keycap 2".
+			[]string{"keycap", "keycap", "keycap 2", "keycap", "keycap: 3"},
+			[]string{"keycap", "keycap_4", "keycap_2", "keycap_5", "keycap_3"},
+		},
+	} {
+		var emojis []*Emoji
+		for _, name := range test.names {
+			emojis = append(emojis, &Emoji{Name: name})
+		}
+		AssignShortcodes(emojis)
+		for i, emoji := range emojis {
+			if emoji.Shortcode != test.want[i] {
+				t.Errorf("%q: got shortcode %q, want %q", emoji.Name, emoji.Shortcode, test.want[i])
+			}
+		}
+	}
+}
+
+func TestAssignShortcodesUnique(t *testing.T) {
+	// Besides the keycaps of the shared fixture, we check the keycaps of
+	// emoji-test.txt, whose names differ only in symbols and digits.
+	emojis := mustParse(t, ParseOptions{})
+	for _, name := range []string{"0", "1", "3", "4", "5", "6", "7", "8", "9", "10"} {
+		emojis = append(emojis, &Emoji{Grapheme: "keycap " + name, Name: "keycap: " + name})
+	}
+	AssignShortcodes(emojis)
+	seen := map[string]string{}
+	for _, emoji := range emojis {
+		if other, ok := seen[emoji.Shortcode]; ok {
+			t.Errorf("%s and %s share shortcode %q", other, emoji.Grapheme, emoji.Shortcode)
+		}
+		seen[emoji.Shortcode] = emoji.Grapheme
+	}
+}
@@ -0,0 +1,32 @@
+package emojis
+
+import "golang.org/x/exp/slices"
+
+// skinTones are the five skin tone modifiers, from light (U+1F3FB) to dark
+// (U+1F3FF).
+var skinTones = []rune{0x1F3FB, 0x1F3FC, 0x1F3FD, 0x1F3FE, 0x1F3FF}
+
+// SkinToneVariants returns the graphemes of the five skin tone variants of
+// base (e.g., ✋🏻, ✋🏼, ✋🏽, ✋🏾, ✋🏿 for ✋), from light to dark. A
+// modifier is inserted after the first code point of base, replacing the
+// emoji variation selector that may follow it (e.g., ☝️ becomes ☝🏻). For
+// zero width joiner sequences, the modifier is applied to the first
+// component, which is the person (e.g., 🏃‍♀️ becomes 🏃🏻‍♀️).
+// SkinToneVariants doesn't check that the variants are valid emojis; only
+// emojis with skins (see Emoji.Skins) have them.
+func SkinToneVariants(base string) []string {
+	codes := []rune(base)
+	if len(codes) == 0 {
+		return nil
+	}
+	rest := codes[1:]
+	if len(rest) > 0 && rest[0] == emojiVariationSelector {
+		rest = rest[1:]
+	}
+	variants := make([]string, len(skinTones))
+	for i, tone := range skinTones {
+		variant := append([]rune{codes[0], tone}, slices.Clone(rest)...)
+		variants[i] = string(variant)
+	}
+	return variants
+}
@@ -0,0 +1,50 @@
+package emojis
+
+import (
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestSkinToneVariants(t *testing.T) {
+	for _, test := range []struct {
+		base string
+		want []string
+	}{
+		// raised hand
+		{"✋", []string{
+			"✋\U0001F3FB", "✋\U0001F3FC", "✋\U0001F3FD", "✋\U0001F3FE", "✋\U0001F3FF",
+		}},
+		// index pointing up, whose variation selector is replaced
+		{"☝️", []string{
+			"☝\U0001F3FB", "☝\U0001F3FC", "☝\U0001F3FD", "☝\U0001F3FE", "☝\U0001F3FF",
+		}},
+		// woman running, whose person component is modified
+		{"\U0001F3C3\u200D\u2640\uFE0F", []string{
+			"\U0001F3C3\U0001F3FB\u200D\u2640\uFE0F",
+			"\U0001F3C3\U0001F3FC\u200D\u2640\uFE0F",
+			"\U0001F3C3\U0001F3FD\u200D\u2640\uFE0F",
+			"\U0001F3C3\U0001F3FE\u200D\u2640\uFE0F",
+			"\U0001F3C3\U0001F3FF\u200D\u2640\uFE0F",
+		}},
+		{"", nil},
+	} {
+		if got := SkinToneVariants(test.base); !slices.Equal(got, test.want) {
+			t.Errorf("SkinToneVariants(%q): got %q, want %q", test.base, got, test.want)
+		}
+	}
+
+	// The variants are emojis.
+	emojis, err := All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	byGrapheme := ByGrapheme(emojis)
+	for _, base := range []string{"✋", "☝️", "👋", "\U0001F3C3\u200D\u2640\uFE0F"} {
+		for _, variant := range SkinToneVariants(base) {
+			if _, ok := byGrapheme[variant]; !ok {
+				t.Errorf("SkinToneVariants(%s): %q isn't an emoji", base, variant)
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package emojis
+
+// TagProvider provides the tags of emojis (e.g., from data.json or a
+// database).
+type TagProvider interface {
+	// Tags returns the tags of the emoji with the provided grapheme, or nil
+	// if the emoji has no tags.
+	Tags(grapheme string) []string
+}
+
+// TagMap is a TagProvider backed by a map from grapheme to tags, like those
+// returned by ParseTags, ParseSynonyms, and MergeTags.
+type TagMap map[string][]string
+
+// Tags implements TagProvider.
+func (m TagMap) Tags(grapheme string) []string {
+	return m[grapheme]
+}
+
+// AssignTags sets the Tags of every emoji to its tags in provider.
+func AssignTags(emojis []*Emoji, provider TagProvider) {
+	for _, emoji := range emojis {
+		emoji.Tags = provider.Tags(emoji.Grapheme)
+	}
+}
@@ -0,0 +1,58 @@
+package emojis
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+// fakeTagProvider tags every emoji with the words of its grapheme's name in
+// names and records the graphemes it's asked about.
+type fakeTagProvider struct {
+	names map[string]string
+	asked []string
+}
+
+func (p *fakeTagProvider) Tags(grapheme string) []string {
+	p.asked = append(p.asked, grapheme)
+	return strings.Fields(p.names[grapheme])
+}
+
+func TestAssignTags(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{})
+	provider := &fakeTagProvider{names: map[string]string{"😀": "happy grin", "🐈": "kitty"}}
+	AssignTags(emojis, provider)
+	if got, want := provider.asked, graphemes(emojis); !slices.Equal(got, want) {
+		t.Errorf("provider asked about %v, want %v", got, want)
+	}
+	byGrapheme := ByGrapheme(emojis)
+	for grapheme, want := range map[string][]string{
+		"😀": {"happy", "grin"},
+		"🐈": {"kitty"},
+		"😃": nil,
+	} {
+		if got := byGrapheme[grapheme].Tags; !slices.Equal(got, want) {
+			t.Errorf("tags of %s: got %v, want %v", grapheme, got, want)
+		}
+	}
+
+	// Tags from the provider are searchable.
+	if got, want := graphemes(Lookup(emojis, "kitty")), []string{"🐈"}; !slices.Equal(got, want) {
+		t.Errorf("Lookup(kitty): got %v, want %v", got, want)
+	}
+}
+
+func TestTagMap(t *testing.T) {
+	tags, _, err := ParseTags(strings.NewReader(testDataJSON))
+	if err != nil {
+		t.Fatalf("ParseTags: %v", err)
+	}
+	emojis := mustParse(t, ParseOptions{})
+	AssignTags(emojis, TagMap(tags))
+	for _, emoji := range emojis {
+		if got, want := emoji.Tags, tags[emoji.Grapheme]; !slices.Equal(got, want) {
+			t.Errorf("tags of %s: got %v, want %v", emoji.Grapheme, got, want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package emojis
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/exp/slices"
+)
+
+// DefaultStopWords is a small list of common English words that appear in
+// many emoji names (e.g., "face with tears of joy") and are rarely useful
+// search tokens. It can be passed to TokenizeWithStopWords.
+var DefaultStopWords = map[string]bool{
+	"a":    true,
+	"an":   true,
+	"and":  true,
+	"at":   true,
+	"by":   true,
+	"for":  true,
+	"in":   true,
+	"of":   true,
+	"on":   true,
+	"or":   true,
+	"the":  true,
+	"to":   true,
+	"with": true,
+}
+
+// TokenizeOptions configures TokenizeWithOptions. The zero value tokenizes
+// like Tokenize.
+type TokenizeOptions struct {
+	// StopWords are tokens to drop (e.g., DefaultStopWords).
+	StopWords map[string]bool
+
+	// If KeepNumbers is true, digits are kept in tokens instead of being
+	// treated as separators. For example, "keycap: 10" is tokenized into
+	// ["10", "keycap"] rather than ["keycap"].
+	KeepNumbers bool
+
+	// By default, periods are removed before tokenizing, so that
+	// abbreviations like "U.S.A" are tokenized into ["usa"]. If KeepDots is
+	// true, periods are instead kept and treated as separators like any
+	// other punctuation, so "U.S.A" is tokenized into ["a", "s", "u"].
+	KeepDots bool
+
+	// By default, hyphens are separators, so "t-shirt" is tokenized into
+	// ["shirt", "t"]. If JoinHyphens is true, hyphens are instead removed
+	// like periods, so "t-shirt" is tokenized into ["tshirt"].
+	JoinHyphens bool
+
+	// If Phrases is true, every string with more than one word is also
+	// tokenized into a single phrase token of its words joined by
+	// underscores. For example, "rolling on the floor" is tokenized into
+	// ["floor", "on", "rolling", "rolling_on_the_floor", "the"]. Stop words
+	// are kept in phrase tokens.
+	Phrases bool
+
+	// If Stem is true, plural tokens are folded into their singular forms
+	// (e.g., "cats" into "cat" and "boxes" into "box"), so that plural and
+	// singular words match. Stemming is conservative, so some plurals aren't
+	// folded (e.g., "berries" or "maracas"), but words that merely end in s
+	// (e.g., "glass", "christmas", or "news") aren't mangled.
+	Stem bool
+}
+
+// Tokenize tokenizes a set of strings. For example, calling Tokenize on the
+// strings ["Foo bar", "moo-cow"] will return ["bar", "cow" "foo", "moo"].
+func Tokenize(ss []string) []string {
+	return TokenizeWithOptions(ss, TokenizeOptions{})
+}
+
+// TokenizeWithStopWords is like Tokenize but drops any token in stop. For
+// example, calling TokenizeWithStopWords on the string "face with tears of
+// joy" with DefaultStopWords will return ["face", "joy", "tears"].
+func TokenizeWithStopWords(ss []string, stop map[string]bool) []string {
+	return TokenizeWithOptions(ss, TokenizeOptions{StopWords: stop})
+}
+
+// TokenizeWithOptions is like Tokenize but configured by opts.
+func TokenizeWithOptions(ss []string, opts TokenizeOptions) []string {
+	// Tokenizing is on the hot path of generating output, so we avoid
+	// regexes and intermediate maps. Every token is a substring of a
+	// lowercased input, and duplicates are removed after sorting.
+	var tokens []string
+	for _, s := range ss {
+		s = strings.ToLower(s)
+		if !opts.KeepDots {
+			s = strings.ReplaceAll(s, ".", "")
+		}
+		if opts.JoinHyphens {
+			s = strings.ReplaceAll(s, "-", "")
+		}
+		var words []string
+		add := func(token string) {
+			if opts.Stem {
+				token = stem(token)
+			}
+			if opts.Phrases {
+				words = append(words, token)
+			}
+			if !opts.StopWords[token] {
+				tokens = append(tokens, token)
+			}
+		}
+		start := -1
+		for i, r := range s {
+			if isTokenRune(r, opts.KeepNumbers) {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+			if start >= 0 {
+				add(s[start:i])
+				start = -1
+			}
+		}
+		if start >= 0 {
+			add(s[start:])
+		}
+		if len(words) > 1 {
+			tokens = append(tokens, strings.Join(words, "_"))
+		}
+	}
+	sort.Strings(tokens)
+	return slices.Compact(tokens)
+}
+
+// isTokenRune returns whether r is part of a token, rather than a separator
+// between tokens. Words like "animal-mammal" are tokenized into "animal" and
+// "mammal". Letters of any script are part of tokens (e.g., "café" is a
+// single token), as are combining marks (e.g., the combining acute accent of
+// a decomposed "é").
+func isTokenRune(r rune, keepNumbers bool) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || (keepNumbers && unicode.IsDigit(r))
+}
+
+// oeWords are the words ending in "oe", whose plurals stem folds by trimming
+// only the s (e.g., "shoes" -> "shoe"). Stem trims "es" from other plurals
+// ending in "oes" (e.g., "tomatoes" -> "tomato").
+var oeWords = map[string]bool{
+	"canoe":     true,
+	"foe":       true,
+	"hoe":       true,
+	"horseshoe": true,
+	"oboe":      true,
+	"shoe":      true,
+	"snowshoe":  true,
+	"toe":       true,
+}
+
+// stem folds a plural token into its singular form. See TokenizeOptions.Stem.
+func stem(token string) string {
+	switch {
+	case len(token) <= 3:
+		// Short words like "gas" and "bus" are rarely plurals.
+		return token
+	case strings.HasSuffix(token, "ies"), strings.HasSuffix(token, "ches"):
+		// The singulars of these are ambiguous without a dictionary (e.g.,
+		// "berries" and "cookies", or "peaches" and "mustaches"), and some
+		// aren't plurals at all (e.g., "aries"), so they're left alone.
+		return token
+	case strings.HasSuffix(token, "sses"), strings.HasSuffix(token, "xes"),
+		strings.HasSuffix(token, "shes"):
+		// "glasses" -> "glass", "boxes" -> "box", "dishes" -> "dish".
+		return strings.TrimSuffix(token, "es")
+	case strings.HasSuffix(token, "oes"):
+		// "tomatoes" -> "tomato", but "shoes" -> "shoe".
+		if singular := strings.TrimSuffix(token, "s"); oeWords[singular] {
+			return singular
+		}
+		return strings.TrimSuffix(token, "es")
+	case strings.HasSuffix(token, "ss"), strings.HasSuffix(token, "us"),
+		strings.HasSuffix(token, "is"), strings.HasSuffix(token, "as"),
+		strings.HasSuffix(token, "ews"):
+		// "glass", "cactus", "iris", "christmas", and "news" aren't plurals.
+		return token
+	case strings.HasSuffix(token, "s"):
+		// "cats" -> "cat", "faces" -> "face".
+		return strings.TrimSuffix(token, "s")
+	default:
+		return token
+	}
+}
+
+// Tokens returns the sorted, deduplicated tokens of an emoji's tags, name,
+// group, and subgroup. These are the tokens searched by Lookup.
+//
+// Because an emoji's group and subgroup are tokenized, searching for a broad
+// category like "animal" or "food" finds every emoji in the category (e.g.,
+// every emoji in the "animal-mammal" and "animal-bird" subgroups), even if
+// the emoji isn't tagged with it. See TokensWithOptions to exclude them.
+func Tokens(e *Emoji) []string {
+	return TokensWithOptions(e, TokensOptions{})
+}
+
+// TokensOptions configures TokensWithOptions. The zero value computes tokens
+// like Tokens.
+type TokensOptions struct {
+	// If NoCategories is true, an emoji's group and subgroup are not
+	// tokenized. This makes searches more precise, but searching for a
+	// category like "animal" then only finds emojis tagged or named with it.
+	NoCategories bool
+
+	// If Phrases is true, multi-word tags are also tokenized into phrase
+	// tokens (e.g., "rolling_on_the_floor"), so that searches can match a
+	// whole phrase. See TokenizeOptions.
+	Phrases bool
+
+	// If Stem is true, plural tokens are folded into their singular forms.
+	// See TokenizeOptions.Stem.
+	Stem bool
+}
+
+// TokensWithOptions is like Tokens but configured by opts.
+func TokensWithOptions(e *Emoji, opts TokensOptions) []string {
+	inputs := append(slices.Clone(e.Tags), e.Name)
+	if !opts.NoCategories {
+		inputs = append(inputs, e.Group, e.Subgroup)
+	}
+	tokens := TokenizeWithOptions(inputs, TokenizeOptions{Stem: opts.Stem})
+	if opts.Phrases {
+		tokens = append(tokens, TokenizeWithOptions(e.Tags, TokenizeOptions{Phrases: true, Stem: opts.Stem})...)
+		sort.Strings(tokens)
+		tokens = slices.Compact(tokens)
+	}
+	return tokens
+}
+
+// AssignTokens sets the Tokens of every emoji to the emoji's tokens. See
+// TokensWithOptions.
+func AssignTokens(emojis []*Emoji, opts TokensOptions) {
+	for _, emoji := range emojis {
+		emoji.Tokens = TokensWithOptions(emoji, opts)
+	}
+}
+
+// TokenIndex returns a map from every token to the sorted graphemes of the
+// emojis with that token. See TokensWithOptions.
+func TokenIndex(emojis []*Emoji, opts TokensOptions) map[string][]string {
+	index := map[string][]string{}
+	for _, emoji := range emojis {
+		for _, token := range TokensWithOptions(emoji, opts) {
+			index[token] = append(index[token], emoji.Grapheme)
+		}
+	}
+	for _, graphemes := range index {
+		sort.Strings(graphemes)
+	}
+	return index
+}
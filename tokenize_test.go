@@ -0,0 +1,306 @@
+package emojis
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestTokens(t *testing.T) {
+	byGrapheme := ByGrapheme(testEmojis(t))
+	for grapheme, want := range map[string][]string{
+		"😀":   {"emotion", "face", "grin", "grinning", "smileys", "smiling"},
+		"🐈‍⬛": {"animal", "animals", "black", "cat", "mammal", "nature", "unlucky"},
+		"2️⃣": {"keycap", "symbols"},
+	} {
+		if got := Tokens(byGrapheme[grapheme]); !slices.Equal(got, want) {
+			t.Errorf("Tokens(%s): got %v, want %v", grapheme, got, want)
+		}
+	}
+}
+
+func TestTokenizeWithStopWords(t *testing.T) {
+	for _, test := range []struct {
+		ss   []string
+		stop map[string]bool
+		want []string
+	}{
+		{[]string{"face with tears of joy"}, nil, []string{"face", "joy", "of", "tears", "with"}},
+		{[]string{"face with tears of joy"}, DefaultStopWords, []string{"face", "joy", "tears"}},
+		{[]string{"face with tears"}, map[string]bool{"with": true, "of": true}, []string{"face", "tears"}},
+		{[]string{"The cat", "a dog and a cow"}, DefaultStopWords, []string{"cat", "cow", "dog"}},
+	} {
+		if got := TokenizeWithStopWords(test.ss, test.stop); !slices.Equal(got, test.want) {
+			t.Errorf("TokenizeWithStopWords(%q, %v): got %v, want %v", test.ss, test.stop, got, test.want)
+		}
+	}
+}
+
+func TestTokenIndex(t *testing.T) {
+	emojis := testEmojis(t)
+	index := TokenIndex(emojis, TokensOptions{})
+	if got, want := index["cat"], []string{"🐈", "🐈‍⬛", "🐱"}; !slices.Equal(got, want) {
+		t.Errorf(`index["cat"]: got %v, want %v`, got, want)
+	}
+
+	// The index is the inverse of Tokens.
+	for _, emoji := range emojis {
+		for _, token := range Tokens(emoji) {
+			if !slices.Contains(index[token], emoji.Grapheme) {
+				t.Errorf("index[%q] = %v is missing %s", token, index[token], emoji.Grapheme)
+			}
+		}
+	}
+}
+
+func TestTokenizeKeepNumbers(t *testing.T) {
+	for _, test := range []struct {
+		s           string
+		keepNumbers bool
+		want        []string
+	}{
+		{"keycap: 10", false, []string{"keycap"}},
+		{"keycap: 10", true, []string{"10", "keycap"}},
+		{"1st place medal", true, []string{"1st", "medal", "place"}},
+		{"1st place medal", false, []string{"medal", "place", "st"}},
+	} {
+		got := TokenizeWithOptions([]string{test.s}, TokenizeOptions{KeepNumbers: test.keepNumbers})
+		if !slices.Equal(got, test.want) {
+			t.Errorf("TokenizeWithOptions(%q, KeepNumbers=%t): got %v, want %v", test.s, test.keepNumbers, got, test.want)
+		}
+	}
+}
+
+func TestTokensCategories(t *testing.T) {
+	emojis := testEmojis(t)
+
+	// Every emoji has the tokens of its group and subgroup, unless
+	// NoCategories is set.
+	for _, emoji := range emojis {
+		categories := TokenizeWithOptions([]string{emoji.Group, emoji.Subgroup}, TokenizeOptions{})
+		if tokens := Tokens(emoji); !containsAll(tokens, categories) {
+			t.Errorf("Tokens(%s) = %v is missing category tokens %v", emoji.Grapheme, tokens, categories)
+		}
+	}
+	byGrapheme := ByGrapheme(emojis)
+	if got, want := TokensWithOptions(byGrapheme["🐈"], TokensOptions{NoCategories: true}), []string{"cat", "pet"}; !slices.Equal(got, want) {
+		t.Errorf("TokensWithOptions(🐈, NoCategories): got %v, want %v", got, want)
+	}
+
+	// Searching a category finds every emoji in it, even untagged ones.
+	for _, test := range []struct {
+		query string
+		want  []string
+	}{
+		{"animal", []string{"🐈", "🐈‍⬛", "🐱"}},
+		{"symbols", []string{"#️⃣", "*️⃣", "2️⃣"}},
+	} {
+		if got := graphemes(Lookup(emojis, test.query)); !slices.Equal(got, test.want) {
+			t.Errorf("Lookup(%q): got %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+// tokenRegex splits lowercased strings into tokens in regexTokenize.
+var tokenRegex = regexp.MustCompile(`[^a-z]+`)
+
+// regexTokenize is the original regex and map based implementation of
+// Tokenize, before it was optimized. It only supports ASCII letters.
+func regexTokenize(ss []string) []string {
+	tokens := map[string]bool{}
+	for _, s := range ss {
+		s = strings.ToLower(s)
+		s = strings.ReplaceAll(s, ".", "")
+		for _, token := range tokenRegex.Split(s, -1) {
+			if token != "" {
+				tokens[token] = true
+			}
+		}
+	}
+	sorted := maps.Keys(tokens)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// tokenizeInputs returns the strings tokenized by Tokens for every embedded
+// emoji, keeping only the strings that are ASCII if ascii is true.
+func tokenizeInputs(t testing.TB, ascii bool) [][]string {
+	t.Helper()
+	emojis, err := All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	var inputs [][]string
+	for _, emoji := range emojis {
+		ss := slices.DeleteFunc(append(slices.Clone(emoji.Tags), emoji.Name, emoji.Group, emoji.Subgroup), func(s string) bool {
+			return ascii && strings.IndexFunc(s, func(r rune) bool { return r > unicode.MaxASCII }) >= 0
+		})
+		inputs = append(inputs, ss)
+	}
+	return inputs
+}
+
+func TestTokenizeMatchesRegex(t *testing.T) {
+	inputs := append(tokenizeInputs(t, true), [][]string{
+		{"Foo bar", "moo-cow"},
+		{"U.S.A", "flag: United States"},
+		{"keycap: 10", "A button (blood type)"},
+		{"", " ", "--"},
+		nil,
+	}...)
+	for _, ss := range inputs {
+		got, want := Tokenize(ss), regexTokenize(ss)
+		if len(got) == 0 && len(want) == 0 {
+			continue
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Tokenize(%q): got %v, want %v", ss, got, want)
+		}
+	}
+}
+
+func BenchmarkTokenize(b *testing.B) {
+	inputs := tokenizeInputs(b, false)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, ss := range inputs {
+			Tokenize(ss)
+		}
+	}
+}
+
+func BenchmarkTokenizeRegex(b *testing.B) {
+	inputs := tokenizeInputs(b, false)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, ss := range inputs {
+			regexTokenize(ss)
+		}
+	}
+}
+
+func TestTokenizePunctuation(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		opts TokenizeOptions
+		want []string
+	}{
+		{"t-shirt", TokenizeOptions{}, []string{"shirt", "t"}},
+		{"t-shirt", TokenizeOptions{JoinHyphens: true}, []string{"tshirt"}},
+		{"U.S.A", TokenizeOptions{}, []string{"usa"}},
+		{"U.S.A", TokenizeOptions{KeepDots: true}, []string{"a", "s", "u"}},
+		{"Mrs. Claus", TokenizeOptions{}, []string{"claus", "mrs"}},
+	} {
+		if got := TokenizeWithOptions([]string{test.s}, test.opts); !slices.Equal(got, test.want) {
+			t.Errorf("TokenizeWithOptions(%q, %+v): got %v, want %v", test.s, test.opts, got, test.want)
+		}
+	}
+}
+
+func TestTokenizePhrases(t *testing.T) {
+	ss := []string{"rolling on the floor", "lol"}
+	for _, test := range []struct {
+		opts TokenizeOptions
+		want []string
+	}{
+		{TokenizeOptions{}, []string{"floor", "lol", "on", "rolling", "the"}},
+		{TokenizeOptions{Phrases: true}, []string{"floor", "lol", "on", "rolling", "rolling_on_the_floor", "the"}},
+		{TokenizeOptions{Phrases: true, StopWords: DefaultStopWords}, []string{"floor", "lol", "rolling", "rolling_on_the_floor"}},
+	} {
+		if got := TokenizeWithOptions(ss, test.opts); !slices.Equal(got, test.want) {
+			t.Errorf("TokenizeWithOptions(%q, %+v): got %v, want %v", ss, test.opts, got, test.want)
+		}
+	}
+
+	emoji := &Emoji{Name: "rolling on the floor laughing", Tags: []string{"rolling on the floor", "rofl"}}
+	tokens := TokensWithOptions(emoji, TokensOptions{Phrases: true})
+	for _, want := range []string{"rolling", "floor", "rolling_on_the_floor", "rofl"} {
+		if !slices.Contains(tokens, want) {
+			t.Errorf("TokensWithOptions(Phrases) = %v is missing %q", tokens, want)
+		}
+	}
+	// The name isn't a tag, so it isn't a phrase.
+	if slices.Contains(tokens, "rolling_on_the_floor_laughing") {
+		t.Errorf("TokensWithOptions(Phrases) = %v has a phrase for the name", tokens)
+	}
+}
+
+func TestTokenizeUnicode(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want []string
+	}{
+		{"café", []string{"café"}},
+		{"CAFÉ crème", []string{"café", "crème"}},
+		{"cafe\u0301", []string{"cafe\u0301"}}, // decomposed é
+		{"visage souriant aux yeux rieurs", []string{"aux", "rieurs", "souriant", "visage", "yeux"}},
+		{"Straße", []string{"straße"}},
+		{"猫の顔", []string{"猫の顔"}},
+		{"кошка", []string{"кошка"}},
+		{"piñata—fiesta", []string{"fiesta", "piñata"}},
+	} {
+		if got := Tokenize([]string{test.s}); !slices.Equal(got, test.want) {
+			t.Errorf("Tokenize(%q): got %q, want %q", test.s, got, test.want)
+		}
+	}
+
+	emoji := &Emoji{Name: "hot beverage", Tags: []string{"café"}}
+	if tokens := Tokens(emoji); !slices.Contains(tokens, "café") || slices.Contains(tokens, "caf") {
+		t.Errorf("Tokens(%v): got %v, want café and not caf", emoji.Tags, tokens)
+	}
+}
+
+func TestStem(t *testing.T) {
+	for _, test := range []struct {
+		token, want string
+	}{
+		// Plurals are folded.
+		{"cats", "cat"},
+		{"faces", "face"},
+		{"hearts", "heart"},
+		{"shoes", "shoe"},
+		{"toes", "toe"},
+		{"tomatoes", "tomato"},
+		{"dominoes", "domino"},
+		{"houses", "house"},
+		{"glasses", "glass"},
+		{"boxes", "box"},
+		{"dishes", "dish"},
+		{"ashes", "ash"},
+		// Words that aren't plurals, or whose singulars are ambiguous,
+		// aren't mangled.
+		{"gas", "gas"},
+		{"bus", "bus"},
+		{"glass", "glass"},
+		{"cactus", "cactus"},
+		{"iris", "iris"},
+		{"christmas", "christmas"},
+		{"canvas", "canvas"},
+		{"atlas", "atlas"},
+		{"news", "news"},
+		{"aries", "aries"},
+		{"cookies", "cookies"},
+		{"movies", "movies"},
+		{"berries", "berries"},
+		{"mustaches", "mustaches"},
+		{"peaches", "peaches"},
+		{"cat", "cat"},
+	} {
+		if got := stem(test.token); got != test.want {
+			t.Errorf("stem(%q): got %q, want %q", test.token, got, test.want)
+		}
+	}
+
+	// Plural and singular words tokenize the same.
+	opts := TokenizeOptions{Stem: true}
+	if got, want := TokenizeWithOptions([]string{"two cats in glasses"}, opts), TokenizeWithOptions([]string{"cat glass in two"}, opts); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,2975 @@
+package emojis
+
+// Taken from https://github.com/mwhittaker/emojis.
+var emojisByToken = map[string][]string{
+	"a":                {"🅰️", "💠", "😘"},
+	"ab":               {"🆎"},
+	"abacus":           {"🧮"},
+	"abc":              {"🔤"},
+	"abcd":             {"🔠", "🔡"},
+	"absentminded":     {"😶\u200d🌫️"},
+	"absorbing":        {"🧽"},
+	"ac":               {"🇦🇨"},
+	"acceptable":       {"🉑"},
+	"accessibility":    {"🐕\u200d🦺", "👨\u200d🦯", "👨\u200d🦼", "👨\u200d🦽", "👨🏻\u200d🦯", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼\u200d🦯", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽\u200d🦯", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾\u200d🦯", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿\u200d🦯", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩\u200d🦯", "👩\u200d🦼", "👩\u200d🦽", "👩🏻\u200d🦯", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼\u200d🦯", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽\u200d🦯", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾\u200d🦯", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿\u200d🦯", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "🛗", "🦮", "🦯", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🦼", "🦽", "🦾", "🦿", "🧏", "🧏🏻", "🧏🏼", "🧏🏽", "🧏🏾", "🧏🏿", "🧑\u200d🦯", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻\u200d🦯", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼\u200d🦯", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽\u200d🦯", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾\u200d🦯", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿\u200d🦯", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽"},
+	"accordion":        {"🪗"},
+	"accounting":       {"🧾"},
+	"activities":       {"♟️", "♠️", "♣️", "♥️", "♦️", "⚽", "⚾", "⛳", "⛸️", "✨", "🀄", "🃏", "🎀", "🎁", "🎃", "🎄", "🎆", "🎇", "🎈", "🎉", "🎊", "🎋", "🎍", "🎎", "🎏", "🎐", "🎑", "🎖️", "🎗️", "🎟️", "🎣", "🎨", "🎫", "🎭", "🎮", "🎯", "🎰", "🎱", "🎲", "🎳", "🎴", "🎽", "🎾", "🎿", "🏀", "🏅", "🏆", "🏈", "🏉", "🏏", "🏐", "🏑", "🏒", "🏓", "🏸", "🔫", "🔮", "🕹️", "🖼️", "🛷", "🤿", "🥅", "🥇", "🥈", "🥉", "🥊", "🥋", "🥌", "🥍", "🥎", "🥏", "🧧", "🧨", "🧩", "🧵", "🧶", "🧸", "🪀", "🪁", "🪄", "🪅", "🪆", "🪡", "🪢", "🪩"},
+	"activity":         {"🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "👨\u200d🦯", "👨\u200d🦼", "👨\u200d🦽", "👨🏻\u200d🦯", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼\u200d🦯", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽\u200d🦯", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾\u200d🦯", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿\u200d🦯", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩\u200d🦯", "👩\u200d🦼", "👩\u200d🦽", "👩🏻\u200d🦯", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼\u200d🦯", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽\u200d🦯", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾\u200d🦯", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿\u200d🦯", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👯", "👯\u200d♀️", "👯\u200d♂️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧑\u200d🦯", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻\u200d🦯", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼\u200d🦯", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽\u200d🦯", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾\u200d🦯", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿\u200d🦯", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️"},
+	"actor":            {"👨\u200d🎤", "👨🏻\u200d🎤", "👨🏼\u200d🎤", "👨🏽\u200d🎤", "👨🏾\u200d🎤", "👨🏿\u200d🎤", "👩\u200d🎤", "👩🏻\u200d🎤", "👩🏼\u200d🎤", "👩🏽\u200d🎤", "👩🏾\u200d🎤", "👩🏿\u200d🎤", "🧑\u200d🎤", "🧑🏻\u200d🎤", "🧑🏼\u200d🎤", "🧑🏽\u200d🎤", "🧑🏾\u200d🎤", "🧑🏿\u200d🎤"},
+	"ad":               {"🇦🇩"},
+	"address":          {"📢"},
+	"adhesive":         {"🩹"},
+	"admission":        {"🎟️", "🎫"},
+	"adore":            {"🥰"},
+	"adult":            {"👨", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨🏻", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏼", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏽", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏾", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏿", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👩", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩🏻", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏼", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏽", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏾", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏿", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "🧑", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑🏻", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏼", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏽", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏾", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏿", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿"},
+	"ae":               {"🇦🇪"},
+	"aerial":           {"🚡"},
+	"aeroplane":        {"✈️", "🛩️", "🛫", "🛬"},
+	"aesculapius":      {"⚕️"},
+	"af":               {"🇦🇫"},
+	"affection":        {"☺️", "😍", "😗", "😘", "😙", "😚", "🤩", "🥰", "🥲"},
+	"afghanistan":      {"🇦🇫"},
+	"africa":           {"🇿🇦", "🌍"},
+	"african":          {"🇨🇫"},
+	"ag":               {"🇦🇬"},
+	"age":              {"🔞"},
+	"agreement":        {"🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾"},
+	"ai":               {"🇦🇮"},
+	"aid":              {"⛑️", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🩼"},
+	"air":              {"✈️", "💺", "🚀", "🚁", "🚟", "🚠", "🚡", "🛩️", "🛫", "🛬", "🛰️", "🛸", "🪂", "🪟"},
+	"airplane":         {"✈️", "🛩️", "🛫", "🛬"},
+	"al":               {"🇦🇱"},
+	"alarm":            {"⏰"},
+	"albania":          {"🇦🇱"},
+	"alembic":          {"⚗️"},
+	"algeria":          {"🇩🇿"},
+	"alien":            {"👽", "👾"},
+	"alpaca":           {"🦙"},
+	"alphabet":         {"🔤"},
+	"alphanum":         {"ℹ️", "Ⓜ️", "㊗️", "㊙️", "🅰️", "🅱️", "🅾️", "🅿️", "🆎", "🆑", "🆒", "🆓", "🆔", "🆕", "🆖", "🆗", "🆘", "🆙", "🆚", "🈁", "🈂️", "🈚", "🈯", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑", "🔠", "🔡", "🔢", "🔣", "🔤"},
+	"alternation":      {"〽️"},
+	"am":               {"🇦🇲"},
+	"amazement":        {"🫢"},
+	"ambulance":        {"🚑"},
+	"american":         {"🇦🇸", "🏈"},
+	"americas":         {"🌎"},
+	"amoeba":           {"🦠"},
+	"amount":           {"🈷️", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿"},
+	"amphibian":        {"🐸"},
+	"amphora":          {"🏺"},
+	"amulet":           {"🧿", "🪬"},
+	"amusement":        {"🎡", "🎢", "🛝"},
+	"anatomical":       {"🫀"},
+	"anchor":           {"⚓", "🔱"},
+	"and":              {"☠️", "☪️", "⚒️", "⛈️", "🍴", "🍽️", "🏹", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "🔩", "🛋️", "🛠️", "🫢", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"andorra":          {"🇦🇩"},
+	"angel":            {"👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "😇"},
+	"anger":            {"💢", "🗯️", "😠"},
+	"angola":           {"🇦🇴"},
+	"angry":            {"👿", "💢", "🗯️", "😠", "😡", "🥹"},
+	"anguilla":         {"🇦🇮"},
+	"anguished":        {"😧"},
+	"animal":           {"🐀", "🐁", "🐂", "🐃", "🐄", "🐅", "🐆", "🐇", "🐈", "🐈\u200d⬛", "🐉", "🐊", "🐋", "🐌", "🐍", "🐎", "🐏", "🐐", "🐑", "🐒", "🐓", "🐔", "🐕", "🐕\u200d🦺", "🐖", "🐗", "🐘", "🐙", "🐚", "🐛", "🐜", "🐝", "🐞", "🐟", "🐠", "🐡", "🐢", "🐣", "🐤", "🐥", "🐦", "🐦\u200d⬛", "🐧", "🐨", "🐩", "🐪", "🐫", "🐬", "🐭", "🐮", "🐯", "🐰", "🐱", "🐲", "🐳", "🐴", "🐵", "🐶", "🐷", "🐸", "🐹", "🐺", "🐻", "🐻\u200d❄️", "🐼", "🐽", "🐾", "🐿️", "🕊️", "🕷️", "🕸️", "🦁", "🦂", "🦃", "🦄", "🦅", "🦆", "🦇", "🦈", "🦉", "🦊", "🦋", "🦌", "🦍", "🦎", "🦏", "🦒", "🦓", "🦔", "🦕", "🦖", "🦗", "🦘", "🦙", "🦚", "🦛", "🦜", "🦝", "🦟", "🦠", "🦡", "🦢", "🦣", "🦤", "🦥", "🦦", "🦧", "🦨", "🦩", "🦫", "🦬", "🦭", "🦮", "🪰", "🪱", "🪲", "🪳", "🪶", "🪸", "🪼", "🪽", "🪿", "🫎", "🫏"},
+	"animals":          {"☘️", "🌱", "🌲", "🌳", "🌴", "🌵", "🌷", "🌸", "🌹", "🌺", "🌻", "🌼", "🌾", "🌿", "🍀", "🍁", "🍂", "🍃", "🍄", "🏵️", "🐀", "🐁", "🐂", "🐃", "🐄", "🐅", "🐆", "🐇", "🐈", "🐈\u200d⬛", "🐉", "🐊", "🐋", "🐌", "🐍", "🐎", "🐏", "🐐", "🐑", "🐒", "🐓", "🐔", "🐕", "🐕\u200d🦺", "🐖", "🐗", "🐘", "🐙", "🐚", "🐛", "🐜", "🐝", "🐞", "🐟", "🐠", "🐡", "🐢", "🐣", "🐤", "🐥", "🐦", "🐦\u200d⬛", "🐧", "🐨", "🐩", "🐪", "🐫", "🐬", "🐭", "🐮", "🐯", "🐰", "🐱", "🐲", "🐳", "🐴", "🐵", "🐶", "🐷", "🐸", "🐹", "🐺", "🐻", "🐻\u200d❄️", "🐼", "🐽", "🐾", "🐿️", "💐", "💮", "🕊️", "🕷️", "🕸️", "🥀", "🦁", "🦂", "🦃", "🦄", "🦅", "🦆", "🦇", "🦈", "🦉", "🦊", "🦋", "🦌", "🦍", "🦎", "🦏", "🦒", "🦓", "🦔", "🦕", "🦖", "🦗", "🦘", "🦙", "🦚", "🦛", "🦜", "🦝", "🦟", "🦠", "🦡", "🦢", "🦣", "🦤", "🦥", "🦦", "🦧", "🦨", "🦩", "🦫", "🦬", "🦭", "🦮", "🪰", "🪱", "🪲", "🪳", "🪴", "🪶", "🪷", "🪸", "🪹", "🪺", "🪻", "🪼", "🪽", "🪿", "🫎", "🫏"},
+	"annelid":          {"🪱"},
+	"ant":              {"🐜"},
+	"antarctica":       {"🇦🇶"},
+	"antenna":          {"📡", "📶"},
+	"anticlockwise":    {"🔄"},
+	"antigua":          {"🇦🇬"},
+	"anxious":          {"😰", "🫦"},
+	"ao":               {"🇦🇴"},
+	"ape":              {"🦧"},
+	"apology":          {"🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️"},
+	"apple":            {"🍎", "🍏"},
+	"application":      {"🈸"},
+	"aq":               {"🇦🇶"},
+	"aquarius":         {"♒", "🏺"},
+	"ar":               {"🇦🇷"},
+	"arab":             {"🇦🇪"},
+	"arabia":           {"🇸🇦"},
+	"archer":           {"🏹"},
+	"architect":        {"👨\u200d💼", "👨🏻\u200d💼", "👨🏼\u200d💼", "👨🏽\u200d💼", "👨🏾\u200d💼", "👨🏿\u200d💼", "👩\u200d💼", "👩🏻\u200d💼", "👩🏼\u200d💼", "👩🏽\u200d💼", "👩🏾\u200d💼", "👩🏿\u200d💼", "🧑\u200d💼", "🧑🏻\u200d💼", "🧑🏼\u200d💼", "🧑🏽\u200d💼", "🧑🏾\u200d💼", "🧑🏿\u200d💼"},
+	"arctic":           {"🐻\u200d❄️"},
+	"arepa":            {"🫓"},
+	"argentina":        {"🇦🇷"},
+	"aries":            {"♈", "🐏"},
+	"arm":              {"🦾"},
+	"armenia":          {"🇦🇲"},
+	"army":             {"🪖"},
+	"arrival":          {"🛬"},
+	"arrivals":         {"🛬"},
+	"arriving":         {"🛬"},
+	"arrow":            {"↔️", "↕️", "↖️", "↗️", "↘️", "↙️", "↩️", "↪️", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "▶️", "◀️", "➡️", "⤴️", "⤵️", "⬅️", "⬆️", "⬇️", "🏹", "💘", "📩", "📲", "🔀", "🔁", "🔂", "🔃", "🔄", "🔙", "🔚", "🔛", "🔜", "🔝", "🔼", "🔽"},
+	"arrows":           {"🔃", "🔄"},
+	"art":              {"🎨", "🖼️"},
+	"articulated":      {"🚛"},
+	"artist":           {"🎨", "👨\u200d🎨", "👨🏻\u200d🎨", "👨🏼\u200d🎨", "👨🏽\u200d🎨", "👨🏾\u200d🎨", "👨🏿\u200d🎨", "👩\u200d🎨", "👩🏻\u200d🎨", "👩🏼\u200d🎨", "👩🏽\u200d🎨", "👩🏾\u200d🎨", "👩🏿\u200d🎨", "🧑\u200d🎨", "🧑🏻\u200d🎨", "🧑🏼\u200d🎨", "🧑🏽\u200d🎨", "🧑🏾\u200d🎨", "🧑🏿\u200d🎨"},
+	"arts":             {"🎨", "🎭", "🖼️", "🥋", "🧵", "🧶", "🪡", "🪢"},
+	"aruba":            {"🇦🇼"},
+	"as":               {"🇦🇸"},
+	"ascension":        {"🇦🇨"},
+	"ashes":            {"⚱️"},
+	"asia":             {"🌏"},
+	"asian":            {"🍘", "🍙", "🍚", "🍛", "🍜", "🍝", "🍠", "🍡", "🍢", "🍣", "🍤", "🍥", "🍱", "🥟", "🥠", "🥡", "🥮"},
+	"ask":              {"🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿"},
+	"assembly":         {"👨\u200d🏭", "👨🏻\u200d🏭", "👨🏼\u200d🏭", "👨🏽\u200d🏭", "👨🏾\u200d🏭", "👨🏿\u200d🏭", "👩\u200d🏭", "👩🏻\u200d🏭", "👩🏼\u200d🏭", "👩🏽\u200d🏭", "👩🏾\u200d🏭", "👩🏿\u200d🏭", "🧑\u200d🏭", "🧑🏻\u200d🏭", "🧑🏼\u200d🏭", "🧑🏽\u200d🏭", "🧑🏾\u200d🏭", "🧑🏿\u200d🏭"},
+	"assistance":       {"🐕\u200d🦺"},
+	"asterisk":         {"✳️"},
+	"astonished":       {"😲"},
+	"astronaut":        {"👨\u200d🚀", "👨🏻\u200d🚀", "👨🏼\u200d🚀", "👨🏽\u200d🚀", "👨🏾\u200d🚀", "👨🏿\u200d🚀", "👩\u200d🚀", "👩🏻\u200d🚀", "👩🏼\u200d🚀", "👩🏽\u200d🚀", "👩🏾\u200d🚀", "👩🏿\u200d🚀", "🧑\u200d🚀", "🧑🏻\u200d🚀", "🧑🏼\u200d🚀", "🧑🏽\u200d🚀", "🧑🏾\u200d🚀", "🧑🏿\u200d🚀"},
+	"at":               {"🇦🇹", "🌆", "🌉", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"atheist":          {"⚛️"},
+	"athletic":         {"👟"},
+	"athletics":        {"🎽"},
+	"atm":              {"🏧"},
+	"atom":             {"⚛️"},
+	"attraction":       {"🧲"},
+	"au":               {"🇦🇺"},
+	"aubergine":        {"🍆"},
+	"australia":        {"🇦🇺", "🌏", "🦘", "🪃"},
+	"austria":          {"🇦🇹"},
+	"auto":             {"🛺"},
+	"automated":        {"🏧"},
+	"automobile":       {"🚗", "🚘"},
+	"autumn":           {"🥮"},
+	"av":               {"⏏️", "⏩", "⏪", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "⏸️", "⏹️", "⏺️", "▶️", "◀️", "🎦", "📳", "📴", "📶", "🔀", "🔁", "🔂", "🔅", "🔆", "🔼", "🔽", "🛜"},
+	"avocado":          {"🥑"},
+	"aw":               {"🇦🇼"},
+	"award":            {"🎖️", "🏅", "🏆", "🥇", "🥈", "🥉"},
+	"away":             {"💨"},
+	"awe":              {"🫢"},
+	"ax":               {"🇦🇽"},
+	"axe":              {"🪓"},
+	"az":               {"🇦🇿"},
+	"azerbaijan":       {"🇦🇿"},
+	"b":                {"🅱️"},
+	"ba":               {"🇧🇦"},
+	"baby":             {"🍼", "🐣", "🐤", "🐥", "👨\u200d🍼", "👨🏻\u200d🍼", "👨🏼\u200d🍼", "👨🏽\u200d🍼", "👨🏾\u200d🍼", "👨🏿\u200d🍼", "👩\u200d🍼", "👩🏻\u200d🍼", "👩🏼\u200d🍼", "👩🏽\u200d🍼", "👩🏾\u200d🍼", "👩🏿\u200d🍼", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "🚼", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🧑\u200d🍼", "🧑🏻\u200d🍼", "🧑🏼\u200d🍼", "🧑🏽\u200d🍼", "🧑🏾\u200d🍼", "🧑🏿\u200d🍼"},
+	"back":             {"🔙", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🥹"},
+	"backhand":         {"👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿"},
+	"backpack":         {"🎒"},
+	"backpacking":      {"🥾"},
+	"bacon":            {"🥓"},
+	"bacteria":         {"🦠", "🧫"},
+	"bactrian":         {"🐫"},
+	"badge":            {"📛"},
+	"badger":           {"🦡"},
+	"badminton":        {"🏸"},
+	"bag":              {"🎒", "👜", "👝", "💰", "🛍️"},
+	"bagel":            {"🥯"},
+	"baggage":          {"🛄", "🛅"},
+	"bags":             {"🛍️"},
+	"baguette":         {"🥖"},
+	"bahamas":          {"🇧🇸"},
+	"bahrain":          {"🇧🇭"},
+	"bait":             {"🪤"},
+	"bakery":           {"🥯", "🧁"},
+	"balance":          {"⚖️", "🤹", "🤹🏻", "🤹🏼", "🤹🏽", "🤹🏾", "🤹🏿"},
+	"bald":             {"👨\u200d🦲", "👨🏻\u200d🦲", "👨🏼\u200d🦲", "👨🏽\u200d🦲", "👨🏾\u200d🦲", "👨🏿\u200d🦲", "👩\u200d🦲", "👩🏻\u200d🦲", "👩🏼\u200d🦲", "👩🏽\u200d🦲", "👩🏾\u200d🦲", "👩🏿\u200d🦲", "🧑\u200d🦲", "🧑🏻\u200d🦲", "🧑🏼\u200d🦲", "🧑🏽\u200d🦲", "🧑🏾\u200d🦲", "🧑🏿\u200d🦲"},
+	"ball":             {"⚽", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "🍙", "🎊", "🎱", "🎳", "🎾", "🏀", "🏈", "🏉", "🏌️", "🏌🏻", "🏌🏼", "🏌🏽", "🏌🏾", "🏌🏿", "🏏", "🏐", "🏑", "🏓", "🔮", "🤾", "🤾🏻", "🤾🏼", "🤾🏽", "🤾🏾", "🤾🏿", "🥍", "🥎", "🧶", "🪩"},
+	"ballet":           {"🥿", "🩰"},
+	"balloon":          {"🎈", "👁️\u200d🗨️", "💬", "💭", "🗨️", "🗯️"},
+	"ballot":           {"🗳️"},
+	"ballpoint":        {"🖊️"},
+	"bamboo":           {"🎍"},
+	"banana":           {"🍌"},
+	"bandage":          {"🤕", "🩹"},
+	"bangbang":         {"‼️"},
+	"bangladesh":       {"🇧🇩"},
+	"banjo":            {"🪕"},
+	"bank":             {"🏦", "🏧", "💱"},
+	"banknote":         {"💴", "💵", "💶", "💷", "💸"},
+	"banner":           {"🎋"},
+	"bar":              {"⏸️", "🍫", "🍶", "🍷", "🍹", "🍺", "🍻", "🍾", "🏮", "📊", "📶", "🧼"},
+	"barbados":         {"🇧🇧"},
+	"barber":           {"💇", "💇🏻", "💇🏼", "💇🏽", "💇🏾", "💇🏿", "💈"},
+	"barbuda":          {"🇦🇬"},
+	"bargain":          {"🉐"},
+	"barrier":          {"🚧"},
+	"bars":             {"📶"},
+	"barthélemy":       {"🇧🇱"},
+	"baseball":         {"⚾", "🧢"},
+	"basket":           {"🧺"},
+	"basketball":       {"🏀"},
+	"bat":              {"🏏", "🏓", "🦇"},
+	"bath":             {"🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛁"},
+	"bathing":          {"🧼", "🩱", "🩲", "🩳"},
+	"bathroom":         {"🚻", "🚾", "🪥"},
+	"bathtub":          {"🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛁"},
+	"battery":          {"🔋", "🪫"},
+	"bb":               {"🇧🇧"},
+	"bd":               {"🇧🇩"},
+	"be":               {"🇧🇪"},
+	"beach":            {"🏖️", "🩴"},
+	"beacon":           {"🚨"},
+	"bead":             {"🧿"},
+	"beads":            {"📿"},
+	"beaming":          {"😁"},
+	"beans":            {"🫘"},
+	"bear":             {"🐻", "🐻\u200d❄️", "🧸"},
+	"beard":            {"🧔", "🧔\u200d♀️", "🧔\u200d♂️", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️"},
+	"bearer":           {"⛎", "🐍"},
+	"beat":             {"🪘"},
+	"beating":          {"💓"},
+	"beauty":           {"💇", "💇🏻", "💇🏼", "💇🏽", "💇🏾", "💇🏿"},
+	"beaver":           {"🦫"},
+	"beckon":           {"🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"bed":              {"🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🛏️"},
+	"bee":              {"🐝"},
+	"beer":             {"🍺", "🍻"},
+	"beetle":           {"🐞", "🪲"},
+	"begging":          {"🥺"},
+	"beginner":         {"🔰"},
+	"behind":           {"⛅", "🌤️", "🌥️", "🌦️"},
+	"belarus":          {"🇧🇾"},
+	"belgium":          {"🇧🇪"},
+	"belize":           {"🇧🇿"},
+	"bell":             {"🎐", "🔔", "🔕", "🛎️", "🫑"},
+	"bellhop":          {"🛎️"},
+	"belly":            {"🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿"},
+	"benin":            {"🇧🇯"},
+	"bento":            {"🍱"},
+	"bermuda":          {"🇧🇲"},
+	"berries":          {"🍒"},
+	"berry":            {"🍓", "🫐"},
+	"beverage":         {"☕", "🍵", "🍶", "🍷", "🧃"},
+	"bf":               {"🇧🇫"},
+	"bg":               {"🇧🇬"},
+	"bh":               {"🇧🇭"},
+	"bhutan":           {"🇧🇹"},
+	"bi":               {"🇧🇮"},
+	"biceps":           {"💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿"},
+	"bicycle":          {"🚲", "🚳", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️"},
+	"bicycles":         {"🚳"},
+	"bicyclist":        {"🚵", "🚵🏻", "🚵🏼", "🚵🏽", "🚵🏾", "🚵🏿"},
+	"big":              {"😃"},
+	"bike":             {"🚳", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️"},
+	"biking":           {"🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️"},
+	"bikini":           {"👙"},
+	"bilberry":         {"🫐"},
+	"bill":             {"💴", "💵", "💶", "💷", "💸"},
+	"billed":           {"🧢"},
+	"billiard":         {"🎱"},
+	"bin":              {"🚮"},
+	"biohazard":        {"☣️"},
+	"biologist":        {"👨\u200d🔬", "👨🏻\u200d🔬", "👨🏼\u200d🔬", "👨🏽\u200d🔬", "👨🏾\u200d🔬", "👨🏿\u200d🔬", "👩\u200d🔬", "👩🏻\u200d🔬", "👩🏼\u200d🔬", "👩🏽\u200d🔬", "👩🏾\u200d🔬", "👩🏿\u200d🔬", "🧑\u200d🔬", "🧑🏻\u200d🔬", "🧑🏼\u200d🔬", "🧑🏽\u200d🔬", "🧑🏾\u200d🔬", "🧑🏿\u200d🔬", "🧫", "🧬"},
+	"biology":          {"🧫"},
+	"bird":             {"🐓", "🐔", "🐣", "🐤", "🐥", "🐦", "🐦\u200d⬛", "🐧", "🕊️", "🦃", "🦅", "🦆", "🦉", "🦚", "🦜", "🦢", "🦤", "🦩", "🪶", "🪽", "🪿"},
+	"birdie":           {"🏸"},
+	"birthday":         {"🎂"},
+	"bison":            {"🦬"},
+	"bisque":           {"🦞"},
+	"bissau":           {"🇬🇼"},
+	"biting":           {"🫦"},
+	"bj":               {"🇧🇯"},
+	"bl":               {"🇧🇱"},
+	"black":            {"▪️", "◼️", "◾", "⚫", "✒️", "⬛", "🏴", "🐈\u200d⬛", "🐦\u200d⬛", "🔲", "🖤"},
+	"bleed":            {"🩸"},
+	"blind":            {"👨\u200d🦯", "👨🏻\u200d🦯", "👨🏼\u200d🦯", "👨🏽\u200d🦯", "👨🏾\u200d🦯", "👨🏿\u200d🦯", "👩\u200d🦯", "👩🏻\u200d🦯", "👩🏼\u200d🦯", "👩🏽\u200d🦯", "👩🏾\u200d🦯", "👩🏿\u200d🦯", "🦮", "🦯", "🧑\u200d🦯", "🧑🏻\u200d🦯", "🧑🏼\u200d🦯", "🧑🏽\u200d🦯", "🧑🏾\u200d🦯", "🧑🏿\u200d🦯"},
+	"bloated":          {"🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿"},
+	"blond":            {"👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️"},
+	"blonde":           {"👱\u200d♀️", "👱🏻\u200d♀️", "👱🏼\u200d♀️", "👱🏽\u200d♀️", "👱🏾\u200d♀️", "👱🏿\u200d♀️"},
+	"blood":            {"🅰️", "🅱️", "🅾️", "🆎", "🩸"},
+	"blossom":          {"🌸", "🌼"},
+	"blow":             {"🌬️", "🍃"},
+	"blowfish":         {"🐡"},
+	"blowing":          {"😘"},
+	"blown":            {"🤯"},
+	"blu":              {"📀"},
+	"blue":             {"🏳️\u200d⚧️", "💙", "📘", "🔵", "🔷", "🔹", "😰", "🟦", "🥶", "🩵", "🫐"},
+	"blueberries":      {"🫐"},
+	"blueberry":        {"🫐"},
+	"blush":            {"😊"},
+	"bm":               {"🇧🇲"},
+	"bn":               {"🇧🇳"},
+	"bo":               {"🇧🇴"},
+	"boar":             {"🐗"},
+	"board":            {"🎬", "🛹"},
+	"boat":             {"⛴️", "🚢", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚤", "🛥️", "🛶"},
+	"body":             {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "⛷️", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👀", "👁️", "👂", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👄", "👅", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👣", "👤", "👥", "👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨", "👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦽", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👪", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👯", "👯\u200d♀️", "👯\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💏", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🗣️", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤺", "🤼", "🤼\u200d♀️", "🤼\u200d♂️", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🦴", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦷", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🦾", "🦿", "🧌", "🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑", "🧑\u200d⚕️", "🧑\u200d⚖️", "🧑\u200d✈️", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎄", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑\u200d🤝\u200d🧑", "🧑\u200d🦯", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔", "🧔\u200d♀️", "🧔\u200d♂️", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜", "🧜\u200d♀️", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🧞", "🧞\u200d♀️", "🧞\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️", "🧠", "🫀", "🫁", "🫂", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿", "🫦", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿", "🫶", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"bok":              {"🥬"},
+	"bolivia":          {"🇧🇴"},
+	"bolt":             {"🔩"},
+	"bomb":             {"💣"},
+	"bone":             {"🍖", "🍗", "🦴"},
+	"bones":            {"🩻"},
+	"book":             {"🏷️", "📃", "📄", "📑", "📒", "📓", "📔", "📕", "📖", "📗", "📘", "📙", "📚", "📜", "📰", "🔖", "🗞️"},
+	"bookkeeping":      {"🧾"},
+	"bookmark":         {"📑", "🔖"},
+	"books":            {"📚"},
+	"boom":             {"💥"},
+	"boomerang":        {"🪃"},
+	"boot":             {"👢", "🥾"},
+	"bored":            {"🥱"},
+	"boring":           {"🪴"},
+	"bosnia":           {"🇧🇦"},
+	"botswana":         {"🇧🇼"},
+	"bottle":           {"🍶", "🍼", "🍾", "🧴"},
+	"boulder":          {"🪨"},
+	"bouncing":         {"⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️"},
+	"bouquet":          {"💐"},
+	"bouvet":           {"🇧🇻"},
+	"bow":              {"🏹", "🙇", "🙇🏻", "🙇🏼", "🙇🏽", "🙇🏾", "🙇🏿"},
+	"bowing":           {"🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️"},
+	"bowl":             {"🍜", "🥣"},
+	"bowling":          {"🎳"},
+	"box":              {"☑️", "🍱", "🎁", "🗃️", "🗳️", "🥡", "🧃", "🪗"},
+	"boxing":           {"🥊"},
+	"boy":              {"👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧\u200d👦", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧\u200d👦", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧\u200d👦", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧\u200d👦"},
+	"bq":               {"🇧🇶"},
+	"br":               {"🇧🇷"},
+	"brachiosaurus":    {"🦕"},
+	"brain":            {"🧠"},
+	"brazil":           {"🇧🇷"},
+	"brazzaville":      {"🇨🇬"},
+	"bread":            {"🍞", "🥐", "🥖", "🥪"},
+	"break":            {"💔"},
+	"breakfast":        {"🍩", "🍳", "🥐", "🥓", "🥚", "🥞", "🥣", "🥯", "🧇"},
+	"breast":           {"🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿"},
+	"breath":           {"🫁"},
+	"brick":            {"🧱"},
+	"bricks":           {"🧱"},
+	"bride":            {"👰", "👰🏻", "👰🏼", "👰🏽", "👰🏾", "👰🏿"},
+	"bridge":           {"🌉"},
+	"briefcase":        {"💼"},
+	"briefs":           {"🩲"},
+	"bright":           {"☀️", "🌝", "🌞", "🔆", "😎"},
+	"brightness":       {"🔅", "🔆"},
+	"british":          {"🇮🇴", "🇻🇬"},
+	"broccoli":         {"🥦"},
+	"broken":           {"💔"},
+	"brontosaurus":     {"🦕"},
+	"bronze":           {"🥉"},
+	"broom":            {"🧹"},
+	"brown":            {"🟤", "🟫", "🤎"},
+	"brunei":           {"🇧🇳"},
+	"brush":            {"🪥"},
+	"bs":               {"🇧🇸"},
+	"bt":               {"🇧🇹"},
+	"bubble":           {"👁️\u200d🗨️", "💬", "💭", "🗨️", "🗯️", "🧋"},
+	"bubbles":          {"🫧"},
+	"bucket":           {"🪣"},
+	"buddhism":         {"🪷"},
+	"buddhist":         {"☸️"},
+	"buffalo":          {"🐃", "🦬"},
+	"bug":              {"🐌", "🐛", "🐜", "🐝", "🐞", "🕷️", "🕸️", "🦂", "🦋", "🦗", "🦟", "🦠", "🪰", "🪱", "🪲", "🪳"},
+	"building":         {"🏗️", "🏘️", "🏚️", "🏛️", "🏟️", "🏠", "🏡", "🏢", "🏣", "🏤", "🏥", "🏦", "🏨", "🏩", "🏪", "🏫", "🏬", "🏭", "🏯", "🏰", "💒", "🗼", "🗽", "🛖", "🧱", "🪨", "🪵"},
+	"bulb":             {"💡"},
+	"bulgaria":         {"🇧🇬"},
+	"bull":             {"🐂"},
+	"bullet":           {"🚅"},
+	"bullseye":         {"🎯"},
+	"bunny":            {"🐇", "🐰", "👯", "👯\u200d♀️", "👯\u200d♂️"},
+	"buoy":             {"🛟"},
+	"burger":           {"🍔"},
+	"burkina":          {"🇧🇫"},
+	"burma":            {"🇲🇲"},
+	"burn":             {"❤️\u200d🔥"},
+	"burp":             {"🫧"},
+	"burrito":          {"🌯"},
+	"burundi":          {"🇧🇮"},
+	"bus":              {"🚌", "🚍", "🚎", "🚏", "🚐"},
+	"business":         {"🈺", "👨\u200d💼", "👨🏻\u200d💼", "👨🏼\u200d💼", "👨🏽\u200d💼", "👨🏾\u200d💼", "👨🏿\u200d💼", "👩\u200d💼", "👩🏻\u200d💼", "👩🏼\u200d💼", "👩🏽\u200d💼", "👩🏾\u200d💼", "👩🏿\u200d💼", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🧑\u200d💼", "🧑🏻\u200d💼", "🧑🏼\u200d💼", "🧑🏽\u200d💼", "🧑🏾\u200d💼", "🧑🏿\u200d💼"},
+	"bust":             {"👤", "👥"},
+	"busts":            {"👥"},
+	"but":              {"😥"},
+	"butter":           {"🧈"},
+	"butterfly":        {"🦋"},
+	"button":           {"⏏️", "⏩", "⏪", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "⏸️", "⏹️", "⏺️", "▶️", "◀️", "✅", "❎", "㊗️", "㊙️", "🅰️", "🅱️", "🅾️", "🅿️", "🆎", "🆑", "🆒", "🆓", "🆔", "🆕", "🆖", "🆗", "🆘", "🆙", "🆚", "🈁", "🈂️", "🈚", "🈯", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑", "🔀", "🔁", "🔂", "🔄", "🔅", "🔆", "🔘", "🔲", "🔳", "🔼", "🔽"},
+	"bv":               {"🇧🇻"},
+	"bw":               {"🇧🇼"},
+	"by":               {"🇧🇾"},
+	"bz":               {"🇧🇿"},
+	"c":                {"©️"},
+	"ca":               {"🇨🇦"},
+	"cabbage":          {"🥦", "🥬"},
+	"cabinet":          {"🗄️"},
+	"cable":            {"🚠", "🚡"},
+	"cableway":         {"🚠"},
+	"cactus":           {"🌵"},
+	"caicos":           {"🇹🇨"},
+	"cake":             {"🍥", "🍰", "🎂", "🥮"},
+	"calculation":      {"🧮"},
+	"caledonia":        {"🇳🇨"},
+	"calendar":         {"📅", "📆", "🗓️"},
+	"call":             {"🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿"},
+	"cambodia":         {"🇰🇭"},
+	"camel":            {"🐪", "🐫"},
+	"camera":           {"🎥", "🎦", "📷", "📸", "📹", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿"},
+	"cameroon":         {"🇨🇲"},
+	"camping":          {"🏕️", "🥾"},
+	"can":              {"🥫"},
+	"canada":           {"🇨🇦"},
+	"canary":           {"🇮🇨"},
+	"cancel":           {"✖️", "❌"},
+	"cancer":           {"♋", "🦀"},
+	"candelabrum":      {"🕎"},
+	"candle":           {"🕯️"},
+	"candlestick":      {"🕎"},
+	"candy":            {"🍬", "🍭"},
+	"cane":             {"👨\u200d🦯", "👨🏻\u200d🦯", "👨🏼\u200d🦯", "👨🏽\u200d🦯", "👨🏾\u200d🦯", "👨🏿\u200d🦯", "👩\u200d🦯", "👩🏻\u200d🦯", "👩🏼\u200d🦯", "👩🏽\u200d🦯", "👩🏾\u200d🦯", "👩🏿\u200d🦯", "🦯", "🧑\u200d🦯", "🧑🏻\u200d🦯", "🧑🏼\u200d🦯", "🧑🏽\u200d🦯", "🧑🏾\u200d🦯", "🧑🏿\u200d🦯", "🩼"},
+	"canned":           {"🥫"},
+	"canoe":            {"🛶"},
+	"cap":              {"🎓", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "🧢"},
+	"cape":             {"🇨🇻"},
+	"capped":           {"🏔️"},
+	"capricorn":        {"♑", "🐐"},
+	"capsicum":         {"🫑"},
+	"captivated":       {"🫣"},
+	"car":              {"🏎️", "🚃", "🚋", "🚓", "🚔", "🚗", "🚞", "🚡", "🚨"},
+	"card":             {"♠️", "♣️", "♥️", "♦️", "🃏", "🎴", "💳", "📇", "🗂️", "🗃️", "🪪"},
+	"cardinal":         {"➡️", "⬅️", "⬆️", "⬇️"},
+	"cardiology":       {"🫀"},
+	"cards":            {"🎴"},
+	"care":             {"💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿"},
+	"caribbean":        {"🇧🇶"},
+	"carousel":         {"🎠"},
+	"carp":             {"🎏"},
+	"carpenter":        {"🪚"},
+	"carpentry":        {"🪚"},
+	"carrot":           {"🥕"},
+	"cart":             {"🛒"},
+	"cartwheel":        {"🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️"},
+	"cartwheeling":     {"🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️"},
+	"cask":             {"🪣"},
+	"casserole":        {"🥘"},
+	"castle":           {"🏯", "🏰"},
+	"cat":              {"🐈", "🐈\u200d⬛", "🐱", "😸", "😹", "😺", "😻", "😼", "😽", "😾", "😿", "🙀"},
+	"catch":            {"🪝", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"cayman":           {"🇰🇾"},
+	"cc":               {"🇨🇨"},
+	"cd":               {"🇨🇩"},
+	"celebrate":        {"🥂"},
+	"celebration":      {"🎀", "🎁", "🎂", "🎃", "🎄", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🎆", "🎇", "🎈", "🎉", "🎊", "🎋", "🎌", "🎍", "🎎", "🎏", "🎐", "🎑", "🎖️", "🎗️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🥳", "🪅"},
+	"cell":             {"📱", "📲", "📳", "📴", "📵", "📶"},
+	"cemetery":         {"🪦"},
+	"central":          {"🇨🇫"},
+	"cereal":           {"🥣"},
+	"ceremony":         {"🎑"},
+	"ceuta":            {"🇪🇦"},
+	"cf":               {"🇨🇫"},
+	"cg":               {"🇨🇬"},
+	"ch":               {"🇨🇭"},
+	"chad":             {"🇹🇩"},
+	"chain":            {"⛓️"},
+	"chains":           {"⛓️"},
+	"chair":            {"💺", "🪑"},
+	"chapel":           {"💒"},
+	"charge":           {"🈂️", "🈚", "🈶"},
+	"charm":            {"🧿"},
+	"chart":            {"💹", "📈", "📉", "📊"},
+	"check":            {"☑️", "✅", "✔️", "🛫"},
+	"checkered":        {"🏁"},
+	"cheering":         {"📣"},
+	"cheese":           {"🍕", "🧀", "🫕"},
+	"chef":             {"👨\u200d🍳", "👨🏻\u200d🍳", "👨🏼\u200d🍳", "👨🏽\u200d🍳", "👨🏾\u200d🍳", "👨🏿\u200d🍳", "👩\u200d🍳", "👩🏻\u200d🍳", "👩🏼\u200d🍳", "👩🏽\u200d🍳", "👩🏾\u200d🍳", "👩🏿\u200d🍳", "🧑\u200d🍳", "🧑🏻\u200d🍳", "🧑🏼\u200d🍳", "🧑🏽\u200d🍳", "🧑🏾\u200d🍳", "🧑🏿\u200d🍳"},
+	"chemist":          {"👨\u200d🔬", "👨🏻\u200d🔬", "👨🏼\u200d🔬", "👨🏽\u200d🔬", "👨🏾\u200d🔬", "👨🏿\u200d🔬", "👩\u200d🔬", "👩🏻\u200d🔬", "👩🏼\u200d🔬", "👩🏽\u200d🔬", "👩🏾\u200d🔬", "👩🏿\u200d🔬", "🧑\u200d🔬", "🧑🏻\u200d🔬", "🧑🏼\u200d🔬", "🧑🏽\u200d🔬", "🧑🏾\u200d🔬", "🧑🏿\u200d🔬", "🧪"},
+	"chemistry":        {"⚗️", "🧪"},
+	"chequered":        {"🏁"},
+	"cherries":         {"🍒"},
+	"cherry":           {"🌸", "🍒"},
+	"chess":            {"♟️"},
+	"chest":            {"🧰"},
+	"chestnut":         {"🌰"},
+	"chevron":          {"🔰"},
+	"chick":            {"🐣", "🐤", "🐥"},
+	"chicken":          {"🍗", "🐔"},
+	"chickpea":         {"🧆"},
+	"child":            {"🚸", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿"},
+	"children":         {"🚸"},
+	"chile":            {"🇨🇱"},
+	"chime":            {"🎐"},
+	"china":            {"🇨🇳", "🇭🇰", "🇲🇴"},
+	"chipmunk":         {"🐿️"},
+	"chocolate":        {"🍫", "🫕"},
+	"chop":             {"🥩", "🪓"},
+	"chopsticks":       {"🥢"},
+	"choy":             {"🥬"},
+	"christian":        {"☦️", "✝️"},
+	"christmas":        {"🇨🇽", "🎄", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🧑\u200d🎄", "🧑🏻\u200d🎄", "🧑🏼\u200d🎄", "🧑🏽\u200d🎄", "🧑🏾\u200d🎄", "🧑🏿\u200d🎄"},
+	"church":           {"⛪"},
+	"ci":               {"🇨🇮"},
+	"cigarette":        {"🚬"},
+	"cinema":           {"🎞️", "🎥", "🎦", "📽️"},
+	"circle":           {"⏺️", "Ⓜ️", "⚪", "⚫", "⭕", "🔴", "🔵", "🛞", "🟠", "🟡", "🟢", "🟣", "🟤"},
+	"circled":          {"Ⓜ️"},
+	"circus":           {"🎪"},
+	"citrus":           {"🍋"},
+	"city":             {"🇻🇦", "🌆", "🏙️"},
+	"cityscape":        {"🌆", "🏙️"},
+	"ck":               {"🇨🇰"},
+	"cl":               {"🆑", "🇨🇱"},
+	"claim":            {"🛄"},
+	"clamp":            {"🗜️"},
+	"clap":             {"👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿"},
+	"clapper":          {"🎬"},
+	"clapping":         {"👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿"},
+	"classical":        {"🏛️"},
+	"claus":            {"🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🧑\u200d🎄", "🧑🏻\u200d🎄", "🧑🏼\u200d🎄", "🧑🏽\u200d🎄", "🧑🏾\u200d🎄", "🧑🏿\u200d🎄"},
+	"claws":            {"🦞"},
+	"clay":             {"🧱"},
+	"clean":            {"🪥", "🫧"},
+	"cleaning":         {"🧹", "🧼", "🧽"},
+	"clenched":         {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿"},
+	"climb":            {"🪜"},
+	"climber":          {"🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️"},
+	"climbing":         {"🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️"},
+	"clink":            {"🍻", "🥂"},
+	"clinking":         {"🍻", "🥂"},
+	"clipboard":        {"📋"},
+	"clipperton":       {"🇨🇵"},
+	"clock":            {"⏰", "⏱️", "⏲️", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛", "🕰️"},
+	"clockwise":        {"🔁", "🔂", "🔃"},
+	"closed":           {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "🌂", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "📕", "📪", "📫", "🔐", "😚", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿"},
+	"closet":           {"🚾"},
+	"clothes":          {"👚"},
+	"clothing":         {"☂️", "⛑️", "🌂", "🎒", "🎓", "🎩", "👑", "👒", "👓", "👔", "👕", "👖", "👗", "👘", "👙", "👚", "👛", "👜", "👝", "👞", "👟", "👠", "👡", "👢", "👣", "💄", "💍", "💎", "📿", "🕶️", "🛍️", "🥻", "🥼", "🥽", "🥾", "🥿", "🦺", "🧢", "🧣", "🧤", "🧥", "🧦", "🩰", "🩱", "🩲", "🩳", "🩴", "🪖", "🪭", "🪮"},
+	"cloud":            {"☁️", "⛅", "⛈️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️"},
+	"clouds":           {"😶\u200d🌫️"},
+	"clover":           {"🍀"},
+	"clown":            {"🤡"},
+	"club":             {"♣️"},
+	"clue":             {"🗝️", "🧩"},
+	"clutch":           {"👝"},
+	"cm":               {"🇨🇲"},
+	"cn":               {"🇨🇳"},
+	"co":               {"🇨🇴"},
+	"coaster":          {"🎢"},
+	"coat":             {"🥼", "🧥"},
+	"cockroach":        {"🪳"},
+	"cocktail":         {"🍸"},
+	"coconut":          {"🥥"},
+	"cocos":            {"🇨🇨"},
+	"coder":            {"👨\u200d💻", "👨🏻\u200d💻", "👨🏼\u200d💻", "👨🏽\u200d💻", "👨🏾\u200d💻", "👨🏿\u200d💻", "👩\u200d💻", "👩🏻\u200d💻", "👩🏼\u200d💻", "👩🏽\u200d💻", "👩🏾\u200d💻", "👩🏿\u200d💻", "🧑\u200d💻", "🧑🏻\u200d💻", "🧑🏼\u200d💻", "🧑🏽\u200d💻", "🧑🏾\u200d💻", "🧑🏿\u200d💻"},
+	"coffin":           {"⚰️"},
+	"cog":              {"⚙️"},
+	"cogwheel":         {"⚙️"},
+	"coin":             {"👛", "🪙"},
+	"colada":           {"🥥"},
+	"cold":             {"☃️", "❄️", "🌨️", "🏔️", "💧", "😅", "😓", "😰", "😷", "🥶", "🧊"},
+	"collar":           {"👨\u200d💼", "👨🏻\u200d💼", "👨🏼\u200d💼", "👨🏽\u200d💼", "👨🏾\u200d💼", "👨🏿\u200d💼", "👩\u200d💼", "👩🏻\u200d💼", "👩🏼\u200d💼", "👩🏽\u200d💼", "👩🏾\u200d💼", "👩🏿\u200d💼", "🧑\u200d💼", "🧑🏻\u200d💼", "🧑🏼\u200d💼", "🧑🏽\u200d💼", "🧑🏾\u200d💼", "🧑🏿\u200d💼"},
+	"collision":        {"💥"},
+	"colombia":         {"🇨🇴"},
+	"come":             {"🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"comet":            {"☄️"},
+	"comic":            {"💠", "💡", "💢", "💤", "💥", "💦", "💧", "💨", "💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "💫", "💬", "💭"},
+	"comoros":          {"🇰🇲"},
+	"compass":          {"🧭"},
+	"compress":         {"🗜️"},
+	"computer":         {"⌨️", "💻", "💽", "💾", "💿", "📀", "🔋", "🔌", "🖥️", "🖨️", "🖱️", "🖲️", "🧮", "🪫"},
+	"concerned":        {"☹️", "😓", "😕", "😖", "😞", "😟", "😢", "😣", "😥", "😦", "😧", "😨", "😩", "😫", "😭", "😮", "😯", "😰", "😱", "😲", "😳", "🙁", "🥱", "🥹", "🥺", "🫤"},
+	"concertina":       {"🪗"},
+	"condiment":        {"🧂", "🫙"},
+	"confetti":         {"🎊"},
+	"confounded":       {"😖"},
+	"confused":         {"😕"},
+	"conga":            {"🪘"},
+	"congee":           {"🥣"},
+	"congo":            {"🇨🇩", "🇨🇬"},
+	"congratulations":  {"㊗️"},
+	"construction":     {"🏗️", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "🚧"},
+	"container":        {"🫙"},
+	"control":          {"🎛️", "🛂"},
+	"convenience":      {"🏪"},
+	"cook":             {"🇨🇰", "👨\u200d🍳", "👨🏻\u200d🍳", "👨🏼\u200d🍳", "👨🏽\u200d🍳", "👨🏾\u200d🍳", "👨🏿\u200d🍳", "👩\u200d🍳", "👩🏻\u200d🍳", "👩🏼\u200d🍳", "👩🏽\u200d🍳", "👩🏾\u200d🍳", "👩🏿\u200d🍳", "🧑\u200d🍳", "🧑🏻\u200d🍳", "🧑🏼\u200d🍳", "🧑🏽\u200d🍳", "🧑🏾\u200d🍳", "🧑🏿\u200d🍳"},
+	"cooked":           {"🍚"},
+	"cookie":           {"🍪", "🥠"},
+	"cooking":          {"🍳", "🍴", "🍽️", "🏺", "🔪"},
+	"cool":             {"🆒", "😎"},
+	"cop":              {"👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️"},
+	"copyright":        {"©️"},
+	"coral":            {"🪸"},
+	"cork":             {"🍾"},
+	"corn":             {"🌽"},
+	"cosmetics":        {"💄", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿"},
+	"costa":            {"🇨🇷"},
+	"costume":          {"👹", "👺", "👻", "👽", "👾", "💩", "🤖", "🤡"},
+	"couch":            {"🛋️"},
+	"counterclockwise": {"🔄"},
+	"country":          {"🇦🇨", "🇦🇩", "🇦🇪", "🇦🇫", "🇦🇬", "🇦🇮", "🇦🇱", "🇦🇲", "🇦🇴", "🇦🇶", "🇦🇷", "🇦🇸", "🇦🇹", "🇦🇺", "🇦🇼", "🇦🇽", "🇦🇿", "🇧🇦", "🇧🇧", "🇧🇩", "🇧🇪", "🇧🇫", "🇧🇬", "🇧🇭", "🇧🇮", "🇧🇯", "🇧🇱", "🇧🇲", "🇧🇳", "🇧🇴", "🇧🇶", "🇧🇷", "🇧🇸", "🇧🇹", "🇧🇻", "🇧🇼", "🇧🇾", "🇧🇿", "🇨🇦", "🇨🇨", "🇨🇩", "🇨🇫", "🇨🇬", "🇨🇭", "🇨🇮", "🇨🇰", "🇨🇱", "🇨🇲", "🇨🇳", "🇨🇴", "🇨🇵", "🇨🇷", "🇨🇺", "🇨🇻", "🇨🇼", "🇨🇽", "🇨🇾", "🇨🇿", "🇩🇪", "🇩🇬", "🇩🇯", "🇩🇰", "🇩🇲", "🇩🇴", "🇩🇿", "🇪🇦", "🇪🇨", "🇪🇪", "🇪🇬", "🇪🇭", "🇪🇷", "🇪🇸", "🇪🇹", "🇪🇺", "🇫🇮", "🇫🇯", "🇫🇰", "🇫🇲", "🇫🇴", "🇫🇷", "🇬🇦", "🇬🇧", "🇬🇩", "🇬🇪", "🇬🇫", "🇬🇬", "🇬🇭", "🇬🇮", "🇬🇱", "🇬🇲", "🇬🇳", "🇬🇵", "🇬🇶", "🇬🇷", "🇬🇸", "🇬🇹", "🇬🇺", "🇬🇼", "🇬🇾", "🇭🇰", "🇭🇲", "🇭🇳", "🇭🇷", "🇭🇹", "🇭🇺", "🇮🇨", "🇮🇩", "🇮🇪", "🇮🇱", "🇮🇲", "🇮🇳", "🇮🇴", "🇮🇶", "🇮🇷", "🇮🇸", "🇮🇹", "🇯🇪", "🇯🇲", "🇯🇴", "🇯🇵", "🇰🇪", "🇰🇬", "🇰🇭", "🇰🇮", "🇰🇲", "🇰🇳", "🇰🇵", "🇰🇷", "🇰🇼", "🇰🇾", "🇰🇿", "🇱🇦", "🇱🇧", "🇱🇨", "🇱🇮", "🇱🇰", "🇱🇷", "🇱🇸", "🇱🇹", "🇱🇺", "🇱🇻", "🇱🇾", "🇲🇦", "🇲🇨", "🇲🇩", "🇲🇪", "🇲🇫", "🇲🇬", "🇲🇭", "🇲🇰", "🇲🇱", "🇲🇲", "🇲🇳", "🇲🇴", "🇲🇵", "🇲🇶", "🇲🇷", "🇲🇸", "🇲🇹", "🇲🇺", "🇲🇻", "🇲🇼", "🇲🇽", "🇲🇾", "🇲🇿", "🇳🇦", "🇳🇨", "🇳🇪", "🇳🇫", "🇳🇬", "🇳🇮", "🇳🇱", "🇳🇴", "🇳🇵", "🇳🇷", "🇳🇺", "🇳🇿", "🇴🇲", "🇵🇦", "🇵🇪", "🇵🇫", "🇵🇬", "🇵🇭", "🇵🇰", "🇵🇱", "🇵🇲", "🇵🇳", "🇵🇷", "🇵🇸", "🇵🇹", "🇵🇼", "🇵🇾", "🇶🇦", "🇷🇪", "🇷🇴", "🇷🇸", "🇷🇺", "🇷🇼", "🇸🇦", "🇸🇧", "🇸🇨", "🇸🇩", "🇸🇪", "🇸🇬", "🇸🇭", "🇸🇮", "🇸🇯", "🇸🇰", "🇸🇱", "🇸🇲", "🇸🇳", "🇸🇴", "🇸🇷", "🇸🇸", "🇸🇹", "🇸🇻", "🇸🇽", "🇸🇾", "🇸🇿", "🇹🇦", "🇹🇨", "🇹🇩", "🇹🇫", "🇹🇬", "🇹🇭", "🇹🇯", "🇹🇰", "🇹🇱", "🇹🇲", "🇹🇳", "🇹🇴", "🇹🇷", "🇹🇹", "🇹🇻", "🇹🇼", "🇹🇿", "🇺🇦", "🇺🇬", "🇺🇲", "🇺🇳", "🇺🇸", "🇺🇾", "🇺🇿", "🇻🇦", "🇻🇨", "🇻🇪", "🇻🇬", "🇻🇮", "🇻🇳", "🇻🇺", "🇼🇫", "🇼🇸", "🇽🇰", "🇾🇪", "🇾🇹", "🇿🇦", "🇿🇲", "🇿🇼"},
+	"couple":           {"👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "💏", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿"},
+	"cover":            {"📔"},
+	"cow":              {"🐄", "🐮"},
+	"cowboy":           {"🤠"},
+	"cowgirl":          {"🤠"},
+	"cp":               {"🇨🇵"},
+	"cr":               {"🇨🇷"},
+	"crab":             {"🦀"},
+	"cracker":          {"🍘"},
+	"crafts":           {"🎨", "🎭", "🖼️", "🧵", "🧶", "🪡", "🪢"},
+	"crayon":           {"🖍️"},
+	"cream":            {"🍦", "🍨"},
+	"creature":         {"👹", "👺", "👻", "👾"},
+	"credentials":      {"🪪"},
+	"credit":           {"💳"},
+	"crescent":         {"☪️", "🌒", "🌘", "🌙"},
+	"cricket":          {"🏏", "🦗"},
+	"criminal":         {"🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️"},
+	"croatia":          {"🇭🇷"},
+	"crochet":          {"🧶"},
+	"crocodile":        {"🐊"},
+	"croissant":        {"🥐"},
+	"crook":            {"🪝"},
+	"cross":            {"☦️", "⛑️", "✝️", "❌", "❎", "🎌", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿"},
+	"crossbones":       {"☠️"},
+	"crossed":          {"⚔️", "🎌", "🔀", "😵", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"crossing":         {"🚸"},
+	"crown":            {"👑", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"crush":            {"🥰"},
+	"crutch":           {"🩼"},
+	"cry":              {"😢", "😭", "😿", "🥹"},
+	"crying":           {"😢", "😭", "😿"},
+	"crystal":          {"🔮"},
+	"crêpe":            {"🥞"},
+	"cu":               {"🇨🇺"},
+	"cuba":             {"🇨🇺"},
+	"cube":             {"🧊"},
+	"cucumber":         {"🥒"},
+	"culture":          {"🧫"},
+	"cunha":            {"🇹🇦"},
+	"cup":              {"🍵", "🍶", "🥤", "🪠"},
+	"cupcake":          {"🧁"},
+	"cupid":            {"💘"},
+	"curaçao":          {"🇨🇼"},
+	"curious":          {"🦝"},
+	"curl":             {"➰", "➿", "📃"},
+	"curling":          {"🥌"},
+	"curly":            {"➰", "➿", "👨\u200d🦱", "👨🏻\u200d🦱", "👨🏼\u200d🦱", "👨🏽\u200d🦱", "👨🏾\u200d🦱", "👨🏿\u200d🦱", "👩\u200d🦱", "👩🏻\u200d🦱", "👩🏼\u200d🦱", "👩🏽\u200d🦱", "👩🏾\u200d🦱", "👩🏿\u200d🦱", "🧑\u200d🦱", "🧑🏻\u200d🦱", "🧑🏼\u200d🦱", "🧑🏽\u200d🦱", "🧑🏾\u200d🦱", "🧑🏿\u200d🦱"},
+	"currency":         {"💱", "💲", "💴", "💵", "💶", "💷"},
+	"curry":            {"🍛"},
+	"curve":            {"🪝"},
+	"curving":          {"↩️", "↪️", "⤴️", "⤵️"},
+	"custard":          {"🍮"},
+	"customs":          {"🛃"},
+	"cut":              {"🥩"},
+	"cutlery":          {"🍴"},
+	"cutting":          {"✂️"},
+	"cv":               {"🇨🇻"},
+	"cw":               {"🇨🇼"},
+	"cx":               {"🇨🇽"},
+	"cy":               {"🇨🇾"},
+	"cyclist":          {"🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️"},
+	"cyclone":          {"🌀"},
+	"cygnet":           {"🦢"},
+	"cyprus":           {"🇨🇾"},
+	"cz":               {"🇨🇿"},
+	"czechia":          {"🇨🇿"},
+	"côte":             {"🇨🇮"},
+	"d":                {"🇨🇮"},
+	"da":               {"🇹🇦"},
+	"dagger":           {"🗡️"},
+	"dairy":            {"🧈"},
+	"dam":              {"🦫"},
+	"dance":            {"💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🩰", "🪩"},
+	"dancer":           {"👯", "👯\u200d♀️", "👯\u200d♂️"},
+	"dancing":          {"💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿"},
+	"dango":            {"🍡"},
+	"dark":             {"☝🏾", "☝🏿", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "✊🏾", "✊🏿", "✋🏾", "✋🏿", "✌🏾", "✌🏿", "✍🏾", "✍🏿", "🌑", "🎅🏾", "🎅🏿", "🏂🏾", "🏂🏿", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇🏾", "🏇🏿", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👂🏾", "👂🏿", "👃🏾", "👃🏿", "👆🏾", "👆🏿", "👇🏾", "👇🏿", "👈🏾", "👈🏿", "👉🏾", "👉🏿", "👊🏾", "👊🏿", "👋🏾", "👋🏿", "👌🏾", "👌🏿", "👍🏾", "👍🏿", "👎🏾", "👎🏿", "👏🏾", "👏🏿", "👐🏾", "👐🏿", "👦🏾", "👦🏿", "👧🏾", "👧🏿", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👫🏾", "👫🏿", "👬🏾", "👬🏿", "👭🏾", "👭🏿", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲🏾", "👲🏿", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴🏾", "👴🏿", "👵🏾", "👵🏿", "👶🏾", "👶🏿", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸🏾", "👸🏿", "👼🏾", "👼🏿", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃🏾", "💃🏿", "💅🏾", "💅🏿", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💏🏾", "💏🏿", "💑🏾", "💑🏿", "💪🏾", "💪🏿", "🕴🏾", "🕴🏿", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕶️", "🕺🏾", "🕺🏿", "🖐🏾", "🖐🏿", "🖕🏾", "🖕🏿", "🖖🏾", "🖖🏿", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌🏾", "🙌🏿", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏🏾", "🙏🏿", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀🏾", "🛀🏿", "🛌🏾", "🛌🏿", "🤌🏾", "🤌🏿", "🤏🏾", "🤏🏿", "🤘🏾", "🤘🏿", "🤙🏾", "🤙🏿", "🤚🏾", "🤚🏿", "🤛🏾", "🤛🏿", "🤜🏾", "🤜🏿", "🤝🏾", "🤝🏿", "🤞🏾", "🤞🏿", "🤟🏾", "🤟🏿", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰🏾", "🤰🏿", "🤱🏾", "🤱🏿", "🤲🏾", "🤲🏿", "🤳🏾", "🤳🏿", "🤴🏾", "🤴🏿", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶🏾", "🤶🏿", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷🏾", "🥷🏿", "🦵🏾", "🦵🏿", "🦶🏾", "🦶🏿", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦻🏾", "🦻🏿", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒🏾", "🧒🏿", "🧓🏾", "🧓🏿", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕🏾", "🧕🏿", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🫃🏾", "🫃🏿", "🫄🏾", "🫄🏿", "🫅🏾", "🫅🏿", "🫰🏾", "🫰🏿", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲🏾", "🫲🏿", "🫳🏾", "🫳🏿", "🫴🏾", "🫴🏿", "🫵🏾", "🫵🏿", "🫶🏾", "🫶🏿", "🫷🏾", "🫷🏿", "🫸🏾", "🫸🏿"},
+	"dart":             {"🎯"},
+	"dash":             {"〰️", "💨"},
+	"dashing":          {"💨"},
+	"date":             {"📅"},
+	"david":            {"✡️"},
+	"dazed":            {"😳"},
+	"de":               {"⚜️", "🇩🇪"},
+	"dead":             {"😵", "🧟", "🧟\u200d♀️", "🧟\u200d♂️"},
+	"deaf":             {"🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️"},
+	"death":            {"☠️", "⚰️", "⚱️", "💀"},
+	"deciduous":        {"🌳"},
+	"decorated":        {"📔"},
+	"decoration":       {"🎍", "💟"},
+	"decorative":       {"📔"},
+	"decreasing":       {"📉"},
+	"deer":             {"🦌"},
+	"dejected":         {"😔"},
+	"delicious":        {"😋"},
+	"delivery":         {"🚚"},
+	"demon":            {"👿"},
+	"demonstration":    {"🪧"},
+	"denmark":          {"🇩🇰"},
+	"dental":           {"🪥"},
+	"dentist":          {"🦷"},
+	"department":       {"🏬"},
+	"departure":        {"🛫"},
+	"departures":       {"🛫"},
+	"depressed":        {"🫥"},
+	"derelict":         {"🏚️"},
+	"desert":           {"🏜️", "🏝️"},
+	"desktop":          {"🖥️"},
+	"dessert":          {"🍡", "🍦", "🍧", "🍨", "🍩", "🍪", "🍫", "🍬", "🍭", "🍮", "🍰", "🎂"},
+	"detective":        {"🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️"},
+	"developer":        {"👨\u200d💻", "👨🏻\u200d💻", "👨🏼\u200d💻", "👨🏽\u200d💻", "👨🏾\u200d💻", "👨🏿\u200d💻", "👩\u200d💻", "👩🏻\u200d💻", "👩🏼\u200d💻", "👩🏽\u200d💻", "👩🏾\u200d💻", "👩🏿\u200d💻", "🧑\u200d💻", "🧑🏻\u200d💻", "🧑🏼\u200d💻", "🧑🏽\u200d💻", "🧑🏾\u200d💻", "🧑🏿\u200d💻"},
+	"devil":            {"👿"},
+	"dg":               {"🇩🇬"},
+	"dharma":           {"☸️"},
+	"diagonal":         {"🫤"},
+	"dialog":           {"💬", "🗨️"},
+	"diamond":          {"♦️", "💍", "💎", "💠", "🔶", "🔷", "🔸", "🔹"},
+	"diaper":           {"🧷"},
+	"dice":             {"🎲"},
+	"die":              {"🎲"},
+	"diego":            {"🇩🇬"},
+	"dim":              {"🔅"},
+	"diplodocus":       {"🦕"},
+	"direct":           {"🎯"},
+	"direction":        {"↖️", "↗️", "↘️", "↙️", "➡️", "⬅️", "⬆️", "⬇️"},
+	"disability":       {"🩼"},
+	"disappear":        {"🫠", "🫥"},
+	"disappointed":     {"😞", "😥", "🫤"},
+	"disbelief":        {"🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🫢"},
+	"disc":             {"🥏"},
+	"disco":            {"🪩"},
+	"discount":         {"🈹"},
+	"disease":          {"🦟", "🪰"},
+	"disguise":         {"🥸"},
+	"disguised":        {"🥸"},
+	"dish":             {"📡", "🧫"},
+	"dishware":         {"🍴", "🍽️", "🏺", "🔪", "🥄", "🥢", "🫙"},
+	"disk":             {"💽", "💾", "💿", "📀"},
+	"dismiss":          {"🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿"},
+	"dissolve":         {"🫠"},
+	"distrust":         {"🤨"},
+	"divide":           {"➗"},
+	"dividers":         {"🗂️"},
+	"diving":           {"🤿", "🦪"},
+	"division":         {"➗"},
+	"diya":             {"🪔"},
+	"dizzy":            {"🌀", "💫", "😵\u200d💫", "🥴"},
+	"dj":               {"🇩🇯"},
+	"djibouti":         {"🇩🇯"},
+	"djinn":            {"🧞", "🧞\u200d♀️", "🧞\u200d♂️"},
+	"dk":               {"🇩🇰"},
+	"dm":               {"🇩🇲"},
+	"dna":              {"🧬"},
+	"do":               {"🇩🇴"},
+	"doctor":           {"🏥", "👨\u200d⚕️", "👨🏻\u200d⚕️", "👨🏼\u200d⚕️", "👨🏽\u200d⚕️", "👨🏾\u200d⚕️", "👨🏿\u200d⚕️", "👩\u200d⚕️", "👩🏻\u200d⚕️", "👩🏼\u200d⚕️", "👩🏽\u200d⚕️", "👩🏾\u200d⚕️", "👩🏿\u200d⚕️", "💊", "😷", "🥼", "🧑\u200d⚕️", "🧑🏻\u200d⚕️", "🧑🏼\u200d⚕️", "🧑🏽\u200d⚕️", "🧑🏾\u200d⚕️", "🧑🏿\u200d⚕️", "🩺", "🩻"},
+	"document":         {"📃", "📄"},
+	"dodo":             {"🦤"},
+	"dog":              {"🌭", "🐕", "🐕\u200d🦺", "🐩", "🐶", "🦮"},
+	"doll":             {"🎎", "🪆"},
+	"dollar":           {"💲", "💵"},
+	"dolls":            {"🎎", "🪆"},
+	"dolphin":          {"🐬"},
+	"dominica":         {"🇩🇲"},
+	"dominican":        {"🇩🇴"},
+	"donation":         {"🩸"},
+	"done":             {"⌛", "⏳"},
+	"donkey":           {"🫏"},
+	"donut":            {"🍩"},
+	"door":             {"🚪"},
+	"dot":              {"💠"},
+	"dotted":           {"🔯", "🫥"},
+	"double":           {"‼️", "⏫", "⏬", "⏸️", "➿"},
+	"doubt":            {"🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️"},
+	"doughnut":         {"🍩"},
+	"dove":             {"🕊️"},
+	"down":             {"↕️", "↘️", "↙️", "⏬", "⤵️", "⬇️", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "📉", "🔻", "🔽", "🙃", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿"},
+	"downcast":         {"😓"},
+	"downwards":        {"🔽"},
+	"dracula":          {"🧛", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♂️"},
+	"dragon":           {"🀄", "🐉", "🐲"},
+	"dress":            {"👗", "🥻"},
+	"drink":            {"☕", "🌭", "🌮", "🌯", "🌰", "🌶️", "🌽", "🍅", "🍆", "🍇", "🍈", "🍉", "🍊", "🍋", "🍌", "🍍", "🍎", "🍏", "🍐", "🍑", "🍒", "🍓", "🍔", "🍕", "🍖", "🍗", "🍘", "🍙", "🍚", "🍛", "🍜", "🍝", "🍞", "🍟", "🍠", "🍡", "🍢", "🍣", "🍤", "🍥", "🍦", "🍧", "🍨", "🍩", "🍪", "🍫", "🍬", "🍭", "🍮", "🍯", "🍰", "🍱", "🍲", "🍳", "🍴", "🍵", "🍶", "🍷", "🍸", "🍹", "🍺", "🍻", "🍼", "🍽️", "🍾", "🍿", "🎂", "🏺", "🔪", "🥂", "🥃", "🥄", "🥐", "🥑", "🥒", "🥓", "🥔", "🥕", "🥖", "🥗", "🥘", "🥙", "🥚", "🥛", "🥜", "🥝", "🥞", "🥟", "🥠", "🥡", "🥢", "🥣", "🥤", "🥥", "🥦", "🥧", "🥨", "🥩", "🥪", "🥫", "🥬", "🥭", "🥮", "🥯", "🦀", "🦐", "🦑", "🦞", "🦪", "🧀", "🧁", "🧂", "🧃", "🧄", "🧅", "🧆", "🧇", "🧈", "🧉", "🧊", "🧋", "🫐", "🫑", "🫒", "🫓", "🫔", "🫕", "🫖", "🫗", "🫘", "🫙", "🫚", "🫛"},
+	"drinking":         {"🚰", "🚱"},
+	"dromedary":        {"🐪"},
+	"drooling":         {"🤤"},
+	"drop":             {"💧", "🩸", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿"},
+	"droplet":          {"💧"},
+	"droplets":         {"💦"},
+	"drops":            {"☔"},
+	"drum":             {"🛢️", "🥁", "🪘"},
+	"drumstick":        {"🍗"},
+	"drumsticks":       {"🥁"},
+	"duck":             {"🦆"},
+	"duckling":         {"🦢"},
+	"dumpling":         {"🥟"},
+	"dung":             {"💩"},
+	"dupe":             {"♟️"},
+	"dusk":             {"🌆", "🌇"},
+	"dvd":              {"📀"},
+	"dynamite":         {"🧨"},
+	"dz":               {"🇩🇿"},
+	"e":                {"📧", "📨", "📩"},
+	"ea":               {"🇪🇦"},
+	"eagle":            {"🦅"},
+	"ear":              {"🌽", "🌾", "👂", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👯", "👯\u200d♀️", "👯\u200d♂️", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🧏", "🧏🏻", "🧏🏼", "🧏🏽", "🧏🏾", "🧏🏿"},
+	"ears":             {"👯", "👯\u200d♀️", "👯\u200d♂️"},
+	"earth":            {"🌐"},
+	"earthworm":        {"🪱"},
+	"east":             {"➡️"},
+	"ec":               {"🇪🇨"},
+	"ecuador":          {"🇪🇨"},
+	"edge":             {"📏"},
+	"ee":               {"🇪🇪"},
+	"eg":               {"🇪🇬"},
+	"egg":              {"🍳", "🥚"},
+	"eggplant":         {"🍆"},
+	"eggs":             {"🪺"},
+	"egypt":            {"🇪🇬"},
+	"eh":               {"🇪🇭"},
+	"eight":            {"✳️", "✴️", "🎱", "🕗", "🕣"},
+	"eighteen":         {"🔞"},
+	"eject":            {"⏏️"},
+	"el":               {"🇸🇻"},
+	"electric":         {"💡", "🔌", "🔦", "🚃"},
+	"electrician":      {"👨\u200d🔧", "👨🏻\u200d🔧", "👨🏼\u200d🔧", "👨🏽\u200d🔧", "👨🏾\u200d🔧", "👨🏿\u200d🔧", "👩\u200d🔧", "👩🏻\u200d🔧", "👩🏼\u200d🔧", "👩🏽\u200d🔧", "👩🏾\u200d🔧", "👩🏿\u200d🔧", "🧑\u200d🔧", "🧑🏻\u200d🔧", "🧑🏼\u200d🔧", "🧑🏽\u200d🔧", "🧑🏾\u200d🔧", "🧑🏿\u200d🔧"},
+	"electricity":      {"🔌"},
+	"electronic":       {"🪫"},
+	"elephant":         {"🐘"},
+	"elevator":         {"🛗"},
+	"eleven":           {"🕚", "🕦"},
+	"elf":              {"🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️"},
+	"email":            {"✉️", "📧", "📨", "📩"},
+	"embarrass":        {"🫢"},
+	"emblem":           {"🔱"},
+	"embroidery":       {"🪡"},
+	"emergency":        {"🦺"},
+	"emirates":         {"🇦🇪"},
+	"emotion":          {"☠️", "☹️", "☺️", "❣️", "❤️", "❤️\u200d🔥", "❤️\u200d🩹", "👁️\u200d🗨️", "👹", "👺", "👻", "👽", "👾", "👿", "💀", "💋", "💌", "💓", "💔", "💕", "💖", "💗", "💘", "💙", "💚", "💛", "💜", "💝", "💞", "💟", "💢", "💤", "💥", "💦", "💨", "💩", "💫", "💬", "💭", "💯", "🕳️", "🖤", "🗨️", "🗯️", "😀", "😁", "😂", "😃", "😄", "😅", "😆", "😇", "😈", "😉", "😊", "😋", "😌", "😍", "😎", "😏", "😐", "😑", "😒", "😓", "😔", "😕", "😖", "😗", "😘", "😙", "😚", "😛", "😜", "😝", "😞", "😟", "😠", "😡", "😢", "😣", "😤", "😥", "😦", "😧", "😨", "😩", "😪", "😫", "😬", "😭", "😮", "😮\u200d💨", "😯", "😰", "😱", "😲", "😳", "😴", "😵", "😵\u200d💫", "😶", "😶\u200d🌫️", "😷", "😸", "😹", "😺", "😻", "😼", "😽", "😾", "😿", "🙀", "🙁", "🙂", "🙃", "🙄", "🙈", "🙉", "🙊", "🤍", "🤎", "🤐", "🤑", "🤒", "🤓", "🤔", "🤕", "🤖", "🤗", "🤠", "🤡", "🤢", "🤣", "🤤", "🤥", "🤧", "🤨", "🤩", "🤪", "🤫", "🤬", "🤭", "🤮", "🤯", "🥰", "🥱", "🥲", "🥳", "🥴", "🥵", "🥶", "🥸", "🥹", "🥺", "🧐", "🧡", "🩵", "🩶", "🩷", "🫠", "🫡", "🫢", "🫣", "🫤", "🫥", "🫨"},
+	"empanada":         {"🥟"},
+	"empty":            {"🪹", "🫗", "🫙"},
+	"end":              {"🔚"},
+	"energy":           {"🪫"},
+	"engine":           {"🚂", "🚒"},
+	"engineer":         {"👨\u200d🔬", "👨🏻\u200d🔬", "👨🏼\u200d🔬", "👨🏽\u200d🔬", "👨🏾\u200d🔬", "👨🏿\u200d🔬", "👩\u200d🔬", "👩🏻\u200d🔬", "👩🏼\u200d🔬", "👩🏽\u200d🔬", "👩🏾\u200d🔬", "👩🏿\u200d🔬", "🧑\u200d🔬", "🧑🏻\u200d🔬", "🧑🏼\u200d🔬", "🧑🏽\u200d🔬", "🧑🏾\u200d🔬", "🧑🏿\u200d🔬"},
+	"england":          {"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"},
+	"enraged":          {"😡"},
+	"ensnare":          {"🪝"},
+	"entertainer":      {"👨\u200d🎤", "👨🏻\u200d🎤", "👨🏼\u200d🎤", "👨🏽\u200d🎤", "👨🏾\u200d🎤", "👨🏿\u200d🎤", "👩\u200d🎤", "👩🏻\u200d🎤", "👩🏼\u200d🎤", "👩🏽\u200d🎤", "👩🏾\u200d🎤", "👩🏿\u200d🎤", "🧑\u200d🎤", "🧑🏻\u200d🎤", "🧑🏼\u200d🎤", "🧑🏽\u200d🎤", "🧑🏾\u200d🎤", "🧑🏿\u200d🎤"},
+	"entry":            {"⛔", "🚫"},
+	"envelope":         {"✉️", "📨", "📩", "🧧"},
+	"equality":         {"🟰"},
+	"equals":           {"🟰"},
+	"equatorial":       {"🇬🇶"},
+	"equestrian":       {"🐎"},
+	"er":               {"🇪🇷"},
+	"eritrea":          {"🇪🇷"},
+	"eruption":         {"🌋"},
+	"es":               {"🇪🇸"},
+	"estonia":          {"🇪🇪"},
+	"eswatini":         {"🇸🇿"},
+	"et":               {"🇪🇹"},
+	"ethiopia":         {"🇪🇹"},
+	"eu":               {"🇪🇺"},
+	"euro":             {"💶"},
+	"europe":           {"🌍"},
+	"european":         {"🇪🇺", "🏤", "🏰"},
+	"evening":          {"🌆"},
+	"event":            {"✨", "🎀", "🎁", "🎃", "🎄", "🎆", "🎇", "🎈", "🎉", "🎊", "🎋", "🎍", "🎎", "🎏", "🎐", "🎑", "🎗️", "🎟️", "🎫", "🧧", "🧨"},
+	"evergreen":        {"🌲"},
+	"evidence":         {"🧾"},
+	"evil":             {"🖤", "🙈", "🙉", "🙊", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🧿"},
+	"evolution":        {"🧬"},
+	"ewe":              {"🐑"},
+	"exasperation":     {"🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️"},
+	"exchange":         {"💱"},
+	"excited":          {"💖", "💗"},
+	"exclamation":      {"‼️", "⁉️", "❕", "❗", "❣️"},
+	"exhalation":       {"🫁"},
+	"exhale":           {"😮\u200d💨"},
+	"exhaling":         {"😮\u200d💨"},
+	"expendable":       {"♟️"},
+	"expensive":        {"🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"experiment":       {"🥼", "🧪"},
+	"exploding":        {"🤯"},
+	"explosive":        {"🧨"},
+	"expressionless":   {"😑"},
+	"extinction":       {"🦣", "🦤"},
+	"extinguish":       {"🧯"},
+	"extinguisher":     {"🧯"},
+	"extraterrestrial": {"👾"},
+	"eye":              {"👀", "👁️", "👁️\u200d🗨️", "🕶️", "😁", "😄", "😊", "😍", "😙", "😚", "😜", "😝", "😸", "😻", "😽", "🤪", "🥽", "🧿", "🫣"},
+	"eyebrow":          {"🤨"},
+	"eyeroll":          {"🙄"},
+	"eyes":             {"👀", "😁", "😃", "😄", "😊", "😍", "😙", "😚", "😵", "😵\u200d💫", "😸", "😻", "🙄", "🤩", "🥴", "🥺", "🫢"},
+	"eyewear":          {"🕶️"},
+	"face":             {"☠️", "☹️", "☺️", "⛑️", "🌚", "🌛", "🌜", "🌝", "🌞", "🌬️", "🐨", "🐭", "🐮", "🐯", "🐰", "🐱", "🐲", "🐳", "🐴", "🐵", "🐶", "🐷", "🐸", "🐹", "🐺", "🐻", "🐼", "🐽", "👀", "👹", "👺", "👻", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "👽", "👾", "👿", "💀", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💩", "🗣️", "🗿", "😀", "😁", "😂", "😃", "😄", "😅", "😆", "😇", "😈", "😉", "😊", "😋", "😌", "😍", "😎", "😏", "😐", "😑", "😒", "😓", "😔", "😕", "😖", "😗", "😘", "😙", "😚", "😛", "😜", "😝", "😞", "😟", "😠", "😡", "😢", "😣", "😤", "😥", "😦", "😧", "😨", "😩", "😪", "😫", "😬", "😭", "😮", "😮\u200d💨", "😯", "😰", "😱", "😲", "😳", "😴", "😵", "😵\u200d💫", "😶", "😶\u200d🌫️", "😷", "😸", "😹", "😺", "😻", "😼", "😽", "😾", "😿", "🙀", "🙁", "🙂", "🙃", "🙄", "🙈", "🙉", "🙊", "🤐", "🤑", "🤒", "🤓", "🤔", "🤕", "🤖", "🤗", "🤠", "🤡", "🤢", "🤣", "🤤", "🤥", "🤦", "🤦🏻", "🤦🏼", "🤦🏽", "🤦🏾", "🤦🏿", "🤧", "🤨", "🤩", "🤪", "🤫", "🤬", "🤭", "🤮", "🤯", "🥰", "🥱", "🥲", "🥳", "🥴", "🥵", "🥶", "🥸", "🥹", "🥺", "🦁", "🦄", "🦊", "🧐", "🫠", "🫡", "🫢", "🫣", "🫤", "🫥", "🫨"},
+	"faced":            {"🥵", "🥶"},
+	"facepalm":         {"🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️"},
+	"facepalming":      {"🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️"},
+	"facing":           {"🐥", "📄", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿"},
+	"factory":          {"🏭", "👨\u200d🏭", "👨🏻\u200d🏭", "👨🏼\u200d🏭", "👨🏽\u200d🏭", "👨🏾\u200d🏭", "👨🏿\u200d🏭", "👩\u200d🏭", "👩🏻\u200d🏭", "👩🏼\u200d🏭", "👩🏽\u200d🏭", "👩🏾\u200d🏭", "👩🏿\u200d🏭", "🧑\u200d🏭", "🧑🏻\u200d🏭", "🧑🏼\u200d🏭", "🧑🏽\u200d🏭", "🧑🏾\u200d🏭", "🧑🏿\u200d🏭"},
+	"fairy":            {"🐉", "🐲", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👹", "👺", "👻", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💀", "🔮", "😈", "🧌", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️"},
+	"falafel":          {"🥙", "🧆"},
+	"falkland":         {"🇫🇰"},
+	"fallen":           {"🍂"},
+	"falling":          {"🌠", "🍁", "🍂"},
+	"family":           {"👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👪", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "💏", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿"},
+	"fan":              {"🪭"},
+	"fantasy":          {"🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👹", "👺", "👻", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "👿", "🔮", "😇", "😈", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🧌", "🧑\u200d🎄", "🧑🏻\u200d🎄", "🧑🏼\u200d🎄", "🧑🏽\u200d🎄", "🧑🏾\u200d🎄", "🧑🏿\u200d🎄", "🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜", "🧜\u200d♀️", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🧞", "🧞\u200d♀️", "🧞\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️"},
+	"farmer":           {"👨\u200d🌾", "👨🏻\u200d🌾", "👨🏼\u200d🌾", "👨🏽\u200d🌾", "👨🏾\u200d🌾", "👨🏿\u200d🌾", "👩\u200d🌾", "👩🏻\u200d🌾", "👩🏼\u200d🌾", "👩🏽\u200d🌾", "👩🏾\u200d🌾", "👩🏿\u200d🌾", "🧑\u200d🌾", "🧑🏻\u200d🌾", "🧑🏼\u200d🌾", "🧑🏽\u200d🌾", "🧑🏾\u200d🌾", "🧑🏿\u200d🌾"},
+	"farming":          {"🧺"},
+	"faroe":            {"🇫🇴"},
+	"faso":             {"🇧🇫"},
+	"fast":             {"⏩", "⏪", "⏫", "⏬"},
+	"father":           {"🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿"},
+	"fatima":           {"🪬"},
+	"favor":            {"🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️"},
+	"fax":              {"📠"},
+	"fear":             {"😨", "😱", "🫦"},
+	"fearful":          {"😨"},
+	"feather":          {"🪶"},
+	"feeding":          {"👨\u200d🍼", "👨🏻\u200d🍼", "👨🏼\u200d🍼", "👨🏽\u200d🍼", "👨🏾\u200d🍼", "👨🏿\u200d🍼", "👩\u200d🍼", "👩🏻\u200d🍼", "👩🏼\u200d🍼", "👩🏽\u200d🍼", "👩🏾\u200d🍼", "👩🏿\u200d🍼", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🧑\u200d🍼", "🧑🏻\u200d🍼", "🧑🏼\u200d🍼", "🧑🏽\u200d🍼", "🧑🏾\u200d🍼", "🧑🏿\u200d🍼"},
+	"feet":             {"🐾"},
+	"female":           {"♀️", "🐑"},
+	"fencer":           {"🤺"},
+	"fencing":          {"🤺"},
+	"ferris":           {"🎡"},
+	"ferry":            {"⛴️"},
+	"festival":         {"🎎", "🥮"},
+	"fever":            {"🦟"},
+	"feverish":         {"🥵"},
+	"fi":               {"🇫🇮"},
+	"field":            {"🏑"},
+	"fighter":          {"🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿"},
+	"fiji":             {"🇫🇯"},
+	"file":             {"📁", "📂", "🗃️", "🗄️"},
+	"filing":           {"🗄️"},
+	"filling":          {"🥧"},
+	"film":             {"🎞️", "🎦", "📽️"},
+	"finger":           {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"fingers":          {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱", "🫱🏻", "🫱🏼", "🫱🏽", "🫱🏾", "🫱🏿", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"finland":          {"🇫🇮"},
+	"fire":             {"❤️\u200d🔥", "🔥", "🚒", "🧯"},
+	"firecracker":      {"🧨"},
+	"firefighter":      {"👨\u200d🚒", "👨🏻\u200d🚒", "👨🏼\u200d🚒", "👨🏽\u200d🚒", "👨🏾\u200d🚒", "👨🏿\u200d🚒", "👩\u200d🚒", "👩🏻\u200d🚒", "👩🏼\u200d🚒", "👩🏽\u200d🚒", "👩🏾\u200d🚒", "👩🏿\u200d🚒", "🧑\u200d🚒", "🧑🏻\u200d🚒", "🧑🏼\u200d🚒", "🧑🏽\u200d🚒", "🧑🏾\u200d🚒", "🧑🏿\u200d🚒"},
+	"firetruck":        {"👨\u200d🚒", "👨🏻\u200d🚒", "👨🏼\u200d🚒", "👨🏽\u200d🚒", "👨🏾\u200d🚒", "👨🏿\u200d🚒", "👩\u200d🚒", "👩🏻\u200d🚒", "👩🏼\u200d🚒", "👩🏽\u200d🚒", "👩🏾\u200d🚒", "👩🏿\u200d🚒", "🧑\u200d🚒", "🧑🏻\u200d🚒", "🧑🏼\u200d🚒", "🧑🏽\u200d🚒", "🧑🏾\u200d🚒", "🧑🏿\u200d🚒"},
+	"fireworks":        {"🎆", "🎇", "🧨"},
+	"first":            {"🌓", "🌛", "🥇"},
+	"fish":             {"🍥", "🎣", "🐟", "🐠", "🐡", "🦈"},
+	"fishing":          {"🎣", "🦦"},
+	"fist":             {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿"},
+	"five":             {"✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "🕔", "🕠", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿"},
+	"fj":               {"🇫🇯"},
+	"fk":               {"🇫🇰"},
+	"flag":             {"⛳", "🇦🇨", "🇦🇩", "🇦🇪", "🇦🇫", "🇦🇬", "🇦🇮", "🇦🇱", "🇦🇲", "🇦🇴", "🇦🇶", "🇦🇷", "🇦🇸", "🇦🇹", "🇦🇺", "🇦🇼", "🇦🇽", "🇦🇿", "🇧🇦", "🇧🇧", "🇧🇩", "🇧🇪", "🇧🇫", "🇧🇬", "🇧🇭", "🇧🇮", "🇧🇯", "🇧🇱", "🇧🇲", "🇧🇳", "🇧🇴", "🇧🇶", "🇧🇷", "🇧🇸", "🇧🇹", "🇧🇻", "🇧🇼", "🇧🇾", "🇧🇿", "🇨🇦", "🇨🇨", "🇨🇩", "🇨🇫", "🇨🇬", "🇨🇭", "🇨🇮", "🇨🇰", "🇨🇱", "🇨🇲", "🇨🇳", "🇨🇴", "🇨🇵", "🇨🇷", "🇨🇺", "🇨🇻", "🇨🇼", "🇨🇽", "🇨🇾", "🇨🇿", "🇩🇪", "🇩🇬", "🇩🇯", "🇩🇰", "🇩🇲", "🇩🇴", "🇩🇿", "🇪🇦", "🇪🇨", "🇪🇪", "🇪🇬", "🇪🇭", "🇪🇷", "🇪🇸", "🇪🇹", "🇪🇺", "🇫🇮", "🇫🇯", "🇫🇰", "🇫🇲", "🇫🇴", "🇫🇷", "🇬🇦", "🇬🇧", "🇬🇩", "🇬🇪", "🇬🇫", "🇬🇬", "🇬🇭", "🇬🇮", "🇬🇱", "🇬🇲", "🇬🇳", "🇬🇵", "🇬🇶", "🇬🇷", "🇬🇸", "🇬🇹", "🇬🇺", "🇬🇼", "🇬🇾", "🇭🇰", "🇭🇲", "🇭🇳", "🇭🇷", "🇭🇹", "🇭🇺", "🇮🇨", "🇮🇩", "🇮🇪", "🇮🇱", "🇮🇲", "🇮🇳", "🇮🇴", "🇮🇶", "🇮🇷", "🇮🇸", "🇮🇹", "🇯🇪", "🇯🇲", "🇯🇴", "🇯🇵", "🇰🇪", "🇰🇬", "🇰🇭", "🇰🇮", "🇰🇲", "🇰🇳", "🇰🇵", "🇰🇷", "🇰🇼", "🇰🇾", "🇰🇿", "🇱🇦", "🇱🇧", "🇱🇨", "🇱🇮", "🇱🇰", "🇱🇷", "🇱🇸", "🇱🇹", "🇱🇺", "🇱🇻", "🇱🇾", "🇲🇦", "🇲🇨", "🇲🇩", "🇲🇪", "🇲🇫", "🇲🇬", "🇲🇭", "🇲🇰", "🇲🇱", "🇲🇲", "🇲🇳", "🇲🇴", "🇲🇵", "🇲🇶", "🇲🇷", "🇲🇸", "🇲🇹", "🇲🇺", "🇲🇻", "🇲🇼", "🇲🇽", "🇲🇾", "🇲🇿", "🇳🇦", "🇳🇨", "🇳🇪", "🇳🇫", "🇳🇬", "🇳🇮", "🇳🇱", "🇳🇴", "🇳🇵", "🇳🇷", "🇳🇺", "🇳🇿", "🇴🇲", "🇵🇦", "🇵🇪", "🇵🇫", "🇵🇬", "🇵🇭", "🇵🇰", "🇵🇱", "🇵🇲", "🇵🇳", "🇵🇷", "🇵🇸", "🇵🇹", "🇵🇼", "🇵🇾", "🇶🇦", "🇷🇪", "🇷🇴", "🇷🇸", "🇷🇺", "🇷🇼", "🇸🇦", "🇸🇧", "🇸🇨", "🇸🇩", "🇸🇪", "🇸🇬", "🇸🇭", "🇸🇮", "🇸🇯", "🇸🇰", "🇸🇱", "🇸🇲", "🇸🇳", "🇸🇴", "🇸🇷", "🇸🇸", "🇸🇹", "🇸🇻", "🇸🇽", "🇸🇾", "🇸🇿", "🇹🇦", "🇹🇨", "🇹🇩", "🇹🇫", "🇹🇬", "🇹🇭", "🇹🇯", "🇹🇰", "🇹🇱", "🇹🇲", "🇹🇳", "🇹🇴", "🇹🇷", "🇹🇹", "🇹🇻", "🇹🇼", "🇹🇿", "🇺🇦", "🇺🇬", "🇺🇲", "🇺🇳", "🇺🇸", "🇺🇾", "🇺🇿", "🇻🇦", "🇻🇨", "🇻🇪", "🇻🇬", "🇻🇮", "🇻🇳", "🇻🇺", "🇼🇫", "🇼🇸", "🇽🇰", "🇾🇪", "🇾🇹", "🇿🇦", "🇿🇲", "🇿🇼", "🎌", "🏁", "🏳️", "🏳️\u200d⚧️", "🏳️\u200d🌈", "🏴", "🏴\u200d☠️", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "📪", "📫", "📬", "📭", "🚩"},
+	"flags":            {"🇦🇨", "🇦🇩", "🇦🇪", "🇦🇫", "🇦🇬", "🇦🇮", "🇦🇱", "🇦🇲", "🇦🇴", "🇦🇶", "🇦🇷", "🇦🇸", "🇦🇹", "🇦🇺", "🇦🇼", "🇦🇽", "🇦🇿", "🇧🇦", "🇧🇧", "🇧🇩", "🇧🇪", "🇧🇫", "🇧🇬", "🇧🇭", "🇧🇮", "🇧🇯", "🇧🇱", "🇧🇲", "🇧🇳", "🇧🇴", "🇧🇶", "🇧🇷", "🇧🇸", "🇧🇹", "🇧🇻", "🇧🇼", "🇧🇾", "🇧🇿", "🇨🇦", "🇨🇨", "🇨🇩", "🇨🇫", "🇨🇬", "🇨🇭", "🇨🇮", "🇨🇰", "🇨🇱", "🇨🇲", "🇨🇳", "🇨🇴", "🇨🇵", "🇨🇷", "🇨🇺", "🇨🇻", "🇨🇼", "🇨🇽", "🇨🇾", "🇨🇿", "🇩🇪", "🇩🇬", "🇩🇯", "🇩🇰", "🇩🇲", "🇩🇴", "🇩🇿", "🇪🇦", "🇪🇨", "🇪🇪", "🇪🇬", "🇪🇭", "🇪🇷", "🇪🇸", "🇪🇹", "🇪🇺", "🇫🇮", "🇫🇯", "🇫🇰", "🇫🇲", "🇫🇴", "🇫🇷", "🇬🇦", "🇬🇧", "🇬🇩", "🇬🇪", "🇬🇫", "🇬🇬", "🇬🇭", "🇬🇮", "🇬🇱", "🇬🇲", "🇬🇳", "🇬🇵", "🇬🇶", "🇬🇷", "🇬🇸", "🇬🇹", "🇬🇺", "🇬🇼", "🇬🇾", "🇭🇰", "🇭🇲", "🇭🇳", "🇭🇷", "🇭🇹", "🇭🇺", "🇮🇨", "🇮🇩", "🇮🇪", "🇮🇱", "🇮🇲", "🇮🇳", "🇮🇴", "🇮🇶", "🇮🇷", "🇮🇸", "🇮🇹", "🇯🇪", "🇯🇲", "🇯🇴", "🇯🇵", "🇰🇪", "🇰🇬", "🇰🇭", "🇰🇮", "🇰🇲", "🇰🇳", "🇰🇵", "🇰🇷", "🇰🇼", "🇰🇾", "🇰🇿", "🇱🇦", "🇱🇧", "🇱🇨", "🇱🇮", "🇱🇰", "🇱🇷", "🇱🇸", "🇱🇹", "🇱🇺", "🇱🇻", "🇱🇾", "🇲🇦", "🇲🇨", "🇲🇩", "🇲🇪", "🇲🇫", "🇲🇬", "🇲🇭", "🇲🇰", "🇲🇱", "🇲🇲", "🇲🇳", "🇲🇴", "🇲🇵", "🇲🇶", "🇲🇷", "🇲🇸", "🇲🇹", "🇲🇺", "🇲🇻", "🇲🇼", "🇲🇽", "🇲🇾", "🇲🇿", "🇳🇦", "🇳🇨", "🇳🇪", "🇳🇫", "🇳🇬", "🇳🇮", "🇳🇱", "🇳🇴", "🇳🇵", "🇳🇷", "🇳🇺", "🇳🇿", "🇴🇲", "🇵🇦", "🇵🇪", "🇵🇫", "🇵🇬", "🇵🇭", "🇵🇰", "🇵🇱", "🇵🇲", "🇵🇳", "🇵🇷", "🇵🇸", "🇵🇹", "🇵🇼", "🇵🇾", "🇶🇦", "🇷🇪", "🇷🇴", "🇷🇸", "🇷🇺", "🇷🇼", "🇸🇦", "🇸🇧", "🇸🇨", "🇸🇩", "🇸🇪", "🇸🇬", "🇸🇭", "🇸🇮", "🇸🇯", "🇸🇰", "🇸🇱", "🇸🇲", "🇸🇳", "🇸🇴", "🇸🇷", "🇸🇸", "🇸🇹", "🇸🇻", "🇸🇽", "🇸🇾", "🇸🇿", "🇹🇦", "🇹🇨", "🇹🇩", "🇹🇫", "🇹🇬", "🇹🇭", "🇹🇯", "🇹🇰", "🇹🇱", "🇹🇲", "🇹🇳", "🇹🇴", "🇹🇷", "🇹🇹", "🇹🇻", "🇹🇼", "🇹🇿", "🇺🇦", "🇺🇬", "🇺🇲", "🇺🇳", "🇺🇸", "🇺🇾", "🇺🇿", "🇻🇦", "🇻🇨", "🇻🇪", "🇻🇬", "🇻🇮", "🇻🇳", "🇻🇺", "🇼🇫", "🇼🇸", "🇽🇰", "🇾🇪", "🇾🇹", "🇿🇦", "🇿🇲", "🇿🇼", "🎌", "🏁", "🏳️", "🏳️\u200d⚧️", "🏳️\u200d🌈", "🏴", "🏴\u200d☠️", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "🚩"},
+	"flamboyant":       {"🦩"},
+	"flame":            {"🔥"},
+	"flamingo":         {"🦩"},
+	"flash":            {"📸"},
+	"flashlight":       {"🔦"},
+	"flat":             {"🥿"},
+	"flatbread":        {"🥙", "🫓"},
+	"flavoring":        {"🧄", "🧅"},
+	"fleur":            {"⚜️"},
+	"flex":             {"💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿"},
+	"flexed":           {"💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿"},
+	"flight":           {"🪶"},
+	"flipper":          {"🐬"},
+	"flirting":         {"🫦"},
+	"float":            {"🛟"},
+	"floor":            {"🤣"},
+	"floppy":           {"💾"},
+	"flower":           {"🌷", "🌸", "🌹", "🌺", "🌻", "🌼", "🎴", "🏵️", "💐", "💮", "🥀", "🪷", "🪻"},
+	"fluctuate":        {"🪀"},
+	"flushed":          {"😳"},
+	"flute":            {"🪈"},
+	"flutter":          {"🍃"},
+	"fluttering":       {"🍃"},
+	"fly":              {"💸", "🕊️", "🪁", "🪰"},
+	"flying":           {"🛸", "🥏"},
+	"fm":               {"🇫🇲"},
+	"fo":               {"🇫🇴"},
+	"fog":              {"🌁", "🌫️", "😶\u200d🌫️"},
+	"foggy":            {"🌁"},
+	"folded":           {"🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿"},
+	"folder":           {"📁", "📂"},
+	"folding":          {"🪭"},
+	"fondue":           {"🫕"},
+	"food":             {"☕", "🌭", "🌮", "🌯", "🌰", "🌶️", "🌽", "🍅", "🍆", "🍇", "🍈", "🍉", "🍊", "🍋", "🍌", "🍍", "🍎", "🍏", "🍐", "🍑", "🍒", "🍓", "🍔", "🍕", "🍖", "🍗", "🍘", "🍙", "🍚", "🍛", "🍜", "🍝", "🍞", "🍟", "🍠", "🍡", "🍢", "🍣", "🍤", "🍥", "🍦", "🍧", "🍨", "🍩", "🍪", "🍫", "🍬", "🍭", "🍮", "🍯", "🍰", "🍱", "🍲", "🍳", "🍴", "🍵", "🍶", "🍷", "🍸", "🍹", "🍺", "🍻", "🍼", "🍽️", "🍾", "🍿", "🎂", "🏺", "🔪", "😋", "🥂", "🥃", "🥄", "🥐", "🥑", "🥒", "🥓", "🥔", "🥕", "🥖", "🥗", "🥘", "🥙", "🥚", "🥛", "🥜", "🥝", "🥞", "🥟", "🥠", "🥡", "🥢", "🥣", "🥤", "🥥", "🥦", "🥧", "🥨", "🥩", "🥪", "🥫", "🥬", "🥭", "🥮", "🥯", "🦀", "🦐", "🦑", "🦞", "🦪", "🧀", "🧁", "🧂", "🧃", "🧄", "🧅", "🧆", "🧇", "🧈", "🧉", "🧊", "🧋", "🫐", "🫑", "🫒", "🫓", "🫔", "🫕", "🫖", "🫗", "🫘", "🫙", "🫚", "🫛"},
+	"foot":             {"🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿"},
+	"football":         {"🏈", "🏉"},
+	"footprint":        {"👣"},
+	"footprints":       {"👣"},
+	"for":              {"🈺", "🔰"},
+	"forbidden":        {"📵", "🔕", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙈", "🙉", "🙊", "🚫", "🚯", "🚳", "🚷"},
+	"force":            {"🪠"},
+	"forever":          {"♾️"},
+	"fork":             {"🍴", "🍽️"},
+	"fortune":          {"🔮", "🔯", "🥠"},
+	"forward":          {"⏩"},
+	"fountain":         {"⛲", "🖋️"},
+	"four":             {"🍀", "🕓", "🕟"},
+	"fox":              {"🦊"},
+	"fr":               {"🇫🇷"},
+	"frame":            {"🖼️", "🪟"},
+	"framed":           {"🖼️"},
+	"frames":           {"🎞️"},
+	"france":           {"🇫🇷"},
+	"frankfurter":      {"🌭"},
+	"free":             {"🆓", "🈚", "🈶"},
+	"freezing":         {"🥶"},
+	"french":           {"🇬🇫", "🇵🇫", "🇹🇫", "🍟", "🥐", "🥖"},
+	"fresh":            {"🪟"},
+	"fried":            {"🍤"},
+	"fries":            {"🍟"},
+	"frog":             {"🐸"},
+	"from":             {"😤"},
+	"front":            {"🐥"},
+	"frostbite":        {"🥶"},
+	"frown":            {"☹️", "😦", "🙁", "🙍", "🙍🏻", "🙍🏼", "🙍🏽", "🙍🏾", "🙍🏿"},
+	"frowning":         {"☹️", "😦", "🙁", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️"},
+	"fruit":            {"🍅", "🍇", "🍈", "🍉", "🍊", "🍋", "🍌", "🍍", "🍎", "🍏", "🍐", "🍑", "🍒", "🍓", "🥑", "🥝", "🥥", "🥭", "🫐", "🫒"},
+	"frying":           {"🍳"},
+	"fuel":             {"⛽"},
+	"fuji":             {"🗻"},
+	"full":             {"🌕", "🌝", "💯", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿"},
+	"funeral":          {"⚱️"},
+	"futuna":           {"🇼🇫"},
+	"ga":               {"🇬🇦"},
+	"gabon":            {"🇬🇦"},
+	"gambia":           {"🇬🇲"},
+	"game":             {"♟️", "♠️", "♣️", "♥️", "♦️", "🀄", "🃏", "🎮", "🎯", "🎰", "🎱", "🎲", "🎳", "🎴", "🏏", "🏐", "🏑", "🏒", "🏓", "🏸", "🔫", "🔮", "🕹️", "🥌", "🧩", "🧸", "🪀", "🪁", "🪄", "🪅", "🪆", "🪩"},
+	"garcia":           {"🇩🇬"},
+	"garden":           {"🏡"},
+	"gardener":         {"👨\u200d🌾", "👨🏻\u200d🌾", "👨🏼\u200d🌾", "👨🏽\u200d🌾", "👨🏾\u200d🌾", "👨🏿\u200d🌾", "👩\u200d🌾", "👩🏻\u200d🌾", "👩🏼\u200d🌾", "👩🏽\u200d🌾", "👩🏾\u200d🌾", "👩🏿\u200d🌾", "🧑\u200d🌾", "🧑🏻\u200d🌾", "🧑🏼\u200d🌾", "🧑🏽\u200d🌾", "🧑🏾\u200d🌾", "🧑🏿\u200d🌾"},
+	"garlic":           {"🧄"},
+	"gasp":             {"😮\u200d💨"},
+	"gb":               {"🇬🇧"},
+	"gbeng":            {"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"},
+	"gbsct":            {"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+	"gbwls":            {"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f"},
+	"gd":               {"🇬🇩"},
+	"ge":               {"🇬🇪"},
+	"gear":             {"⚙️"},
+	"geek":             {"🤓"},
+	"gem":              {"💎"},
+	"gemini":           {"♊", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿"},
+	"gender":           {"♀️", "♂️", "⚧️", "🧑", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑🏻", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏼", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏽", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏾", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏿", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿"},
+	"gene":             {"🧬"},
+	"genetics":         {"🧬"},
+	"genie":            {"🧞", "🧞\u200d♀️", "🧞\u200d♂️"},
+	"geographic":       {"⛰️", "🌋", "🏔️", "🏕️", "🏖️", "🏜️", "🏝️", "🏞️", "🗻"},
+	"geometric":        {"▪️", "▫️", "◻️", "◼️", "◽", "◾", "⚪", "⚫", "⬛", "⬜", "💠", "🔘", "🔲", "🔳", "🔴", "🔵", "🔶", "🔷", "🔸", "🔹", "🔺", "🔻", "🟠", "🟡", "🟢", "🟣", "🟤", "🟥", "🟦", "🟧", "🟨", "🟩", "🟪", "🟫"},
+	"georgia":          {"🇬🇪", "🇬🇸"},
+	"germany":          {"🇩🇪"},
+	"gesture":          {"💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️"},
+	"gesturing":        {"🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️"},
+	"gesundheit":       {"🤧"},
+	"getting":          {"💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️"},
+	"gf":               {"🇬🇫"},
+	"gg":               {"🇬🇬"},
+	"gh":               {"🇬🇭"},
+	"ghana":            {"🇬🇭"},
+	"ghost":            {"👻"},
+	"gi":               {"🇬🇮"},
+	"gibbous":          {"🌔", "🌖"},
+	"gibraltar":        {"🇬🇮"},
+	"gift":             {"🎁", "🧧"},
+	"ginger":           {"🫚"},
+	"giraffe":          {"🦒"},
+	"girl":             {"👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧"},
+	"gl":               {"🇬🇱"},
+	"glass":            {"🍷", "🍸", "🔍", "🔎", "🥂", "🥃", "🥛", "🫗"},
+	"glasses":          {"👓", "🕶️", "😎", "🤓", "🥂", "🥸", "🧐"},
+	"glide":            {"🪂"},
+	"glitter":          {"🪩"},
+	"glittery":         {"🌟"},
+	"globe":            {"🌍", "🌎", "🌏", "🌐"},
+	"glove":            {"🥊", "🥎"},
+	"gloves":           {"🧤"},
+	"glow":             {"🌟"},
+	"glowing":          {"🌟"},
+	"gm":               {"🇬🇲"},
+	"gn":               {"🇬🇳"},
+	"goal":             {"🥅", "🥍"},
+	"goat":             {"🐐"},
+	"goblin":           {"👺"},
+	"goggles":          {"🥽"},
+	"gold":             {"🥇", "🪙"},
+	"golf":             {"🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️"},
+	"golfing":          {"🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️"},
+	"gondola":          {"🚠", "🚡"},
+	"good":             {"💤", "😪", "😴", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🧧"},
+	"goodbye":          {"🫂"},
+	"goofy":            {"🤪"},
+	"goose":            {"🪿"},
+	"gorilla":          {"🦍"},
+	"gp":               {"🇬🇵"},
+	"gq":               {"🇬🇶"},
+	"gr":               {"🇬🇷"},
+	"grade":            {"🈴"},
+	"graduate":         {"👨\u200d🎓", "👨🏻\u200d🎓", "👨🏼\u200d🎓", "👨🏽\u200d🎓", "👨🏾\u200d🎓", "👨🏿\u200d🎓", "👩\u200d🎓", "👩🏻\u200d🎓", "👩🏼\u200d🎓", "👩🏽\u200d🎓", "👩🏾\u200d🎓", "👩🏿\u200d🎓", "🧑\u200d🎓", "🧑🏻\u200d🎓", "🧑🏼\u200d🎓", "🧑🏽\u200d🎓", "🧑🏾\u200d🎓", "🧑🏿\u200d🎓"},
+	"graduation":       {"🎓"},
+	"grain":            {"🌾"},
+	"grape":            {"🍇"},
+	"grapes":           {"🍇"},
+	"graph":            {"💹", "📈", "📉", "📊"},
+	"grasshopper":      {"🦗"},
+	"grateful":         {"🥲"},
+	"grave":            {"🪦"},
+	"graveyard":        {"🪦"},
+	"greece":           {"🇬🇷"},
+	"green":            {"🍏", "💚", "📗", "🟢", "🟩", "🥗", "🥬"},
+	"greenland":        {"🇬🇱"},
+	"grenada":          {"🇬🇩"},
+	"grenadines":       {"🇻🇨"},
+	"grey":             {"🩶"},
+	"grimace":          {"😬"},
+	"grimacing":        {"😬"},
+	"grin":             {"😀", "😁", "😸"},
+	"grinning":         {"😀", "😃", "😄", "😅", "😆", "😸", "😺", "🤩"},
+	"groan":            {"😮\u200d💨"},
+	"groom":            {"🤵", "🤵🏻", "🤵🏼", "🤵🏽", "🤵🏾", "🤵🏿"},
+	"ground":           {"⛱️", "⛽", "🏍️", "🏎️", "🚂", "🚃", "🚄", "🚅", "🚆", "🚇", "🚈", "🚉", "🚊", "🚋", "🚌", "🚍", "🚎", "🚏", "🚐", "🚑", "🚒", "🚓", "🚔", "🚕", "🚖", "🚗", "🚘", "🚙", "🚚", "🚛", "🚜", "🚝", "🚞", "🚥", "🚦", "🚧", "🚨", "🚲", "🛑", "🛞", "🛢️", "🛣️", "🛤️", "🛴", "🛵", "🛹", "🛺", "🛻", "🛼", "🦼", "🦽"},
+	"grow":             {"🪴"},
+	"growing":          {"💗"},
+	"growth":           {"💹", "📈"},
+	"gs":               {"🇬🇸"},
+	"gt":               {"🇬🇹"},
+	"gu":               {"🇬🇺"},
+	"gua":              {"👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿"},
+	"guadeloupe":       {"🇬🇵"},
+	"guam":             {"🇬🇺"},
+	"guanaco":          {"🦙"},
+	"guard":            {"💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️"},
+	"guatemala":        {"🇬🇹"},
+	"guernsey":         {"🇬🇬"},
+	"guiana":           {"🇬🇫"},
+	"guide":            {"🦮"},
+	"guinea":           {"🇬🇳", "🇬🇶", "🇬🇼", "🇵🇬"},
+	"guitar":           {"🎸"},
+	"gun":              {"🔫"},
+	"guyana":           {"🇬🇾"},
+	"gw":               {"🇬🇼"},
+	"gy":               {"🇬🇾"},
+	"gymnastics":       {"🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️"},
+	"gyro":             {"🥙"},
+	"gyōza":            {"🥟"},
+	"hair":             {"👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦳", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦳", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦳", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦳", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦳", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦳", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦳", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦳", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦳", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦳", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦳", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦳", "👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦳", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦳", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦳", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦳", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦳", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦳", "🪮"},
+	"haircut":          {"💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💈"},
+	"haired":           {"👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️"},
+	"haiti":            {"🇭🇹"},
+	"halloween":        {"🎃"},
+	"halo":             {"😇"},
+	"hamburger":        {"🍔"},
+	"hammer":           {"⚒️", "🔨", "🛠️"},
+	"hamsa":            {"🪬"},
+	"hamster":          {"🐹"},
+	"hand":             {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤔", "🤗", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤫", "🤭", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧤", "🪬", "🪭", "🫡", "🫢", "🫣", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"handbag":          {"👜"},
+	"handball":         {"🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️"},
+	"handgun":          {"🔫"},
+	"handle":           {"🍵"},
+	"hands":            {"👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🤗", "🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫶", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿"},
+	"handshake":        {"🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾"},
+	"hang":             {"🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🪂"},
+	"happy":            {"🙋", "🙋🏻", "🙋🏼", "🙋🏽", "🙋🏾", "🙋🏿"},
+	"hard":             {"🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿"},
+	"hashi":            {"🥢"},
+	"hat":              {"⛑️", "🎩", "👒", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👷", "👷🏻", "👷🏼", "👷🏽", "👷🏾", "👷🏿", "🤠", "🥳", "🥸"},
+	"hatchet":          {"🪓"},
+	"hatching":         {"🐣"},
+	"head":             {"🗣️", "😶\u200d🌫️", "🤕", "🤯"},
+	"headphone":        {"🎧"},
+	"headscarf":        {"🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿"},
+	"headstone":        {"🪦"},
+	"health":           {"👨\u200d⚕️", "👨🏻\u200d⚕️", "👨🏼\u200d⚕️", "👨🏽\u200d⚕️", "👨🏾\u200d⚕️", "👨🏿\u200d⚕️", "👩\u200d⚕️", "👩🏻\u200d⚕️", "👩🏼\u200d⚕️", "👩🏽\u200d⚕️", "👩🏾\u200d⚕️", "👩🏿\u200d⚕️", "🧑\u200d⚕️", "🧑🏻\u200d⚕️", "🧑🏼\u200d⚕️", "🧑🏽\u200d⚕️", "🧑🏾\u200d⚕️", "🧑🏿\u200d⚕️"},
+	"healthcare":       {"👨\u200d⚕️", "👨🏻\u200d⚕️", "👨🏼\u200d⚕️", "👨🏽\u200d⚕️", "👨🏾\u200d⚕️", "👨🏿\u200d⚕️", "👩\u200d⚕️", "👩🏻\u200d⚕️", "👩🏼\u200d⚕️", "👩🏽\u200d⚕️", "👩🏾\u200d⚕️", "👩🏿\u200d⚕️", "🧑\u200d⚕️", "🧑🏻\u200d⚕️", "🧑🏼\u200d⚕️", "🧑🏽\u200d⚕️", "🧑🏾\u200d⚕️", "🧑🏿\u200d⚕️"},
+	"healthier":        {"❤️\u200d🩹"},
+	"hear":             {"🙉", "🧏", "🧏🏻", "🧏🏼", "🧏🏽", "🧏🏾", "🧏🏿"},
+	"heard":            {"🇭🇲"},
+	"hearing":          {"🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿"},
+	"heart":            {"♥️", "❣️", "❤️", "❤️\u200d🔥", "❤️\u200d🩹", "👨\u200d❤️\u200d👨", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "💌", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💓", "💔", "💕", "💖", "💗", "💘", "💙", "💚", "💛", "💜", "💝", "💞", "💟", "🖤", "😍", "😻", "🤍", "🤎", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧡", "🩵", "🩶", "🩷", "🩺", "🫀", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫶", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿"},
+	"heartbeat":        {"💓"},
+	"hearts":           {"💕", "💞", "🥰"},
+	"heat":             {"🥵"},
+	"heavy":            {"💲", "🟰", "🪨"},
+	"hedgehog":         {"🦔"},
+	"heel":             {"👠"},
+	"heeled":           {"👠"},
+	"helena":           {"🇸🇭"},
+	"helicopter":       {"🚁"},
+	"hello":            {"🫂"},
+	"helmet":           {"⛑️", "🪖"},
+	"help":             {"🆘", "💁", "💁🏻", "💁🏼", "💁🏽", "💁🏾", "💁🏿"},
+	"herb":             {"🌿"},
+	"herd":             {"🦬"},
+	"here":             {"🈁"},
+	"hero":             {"🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️"},
+	"heroine":          {"🦸", "🦸\u200d♀️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏿", "🦸🏿\u200d♀️"},
+	"herzegovina":      {"🇧🇦"},
+	"hibiscus":         {"🌺"},
+	"hidden":           {"🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿"},
+	"hide":             {"🫥"},
+	"high":             {"⚡", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "👠", "🔊", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚄"},
+	"highway":          {"🛣️"},
+	"hijab":            {"🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿"},
+	"hike":             {"🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️"},
+	"hiking":           {"🥾"},
+	"hindu":            {"🕉️", "🛕"},
+	"hinduism":         {"🪷"},
+	"hippo":            {"🦛"},
+	"hippopotamus":     {"🦛"},
+	"hit":              {"🎯"},
+	"hk":               {"🇭🇰"},
+	"hm":               {"🇭🇲"},
+	"hn":               {"🇭🇳"},
+	"hocho":            {"🔪"},
+	"hockey":           {"🏑", "🏒"},
+	"hoist":            {"🛗"},
+	"hold":             {"👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿"},
+	"holding":          {"👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "🥹", "🧑\u200d🤝\u200d🧑", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿"},
+	"hole":             {"⛳", "🕳️"},
+	"hollow":           {"⭕"},
+	"home":             {"🏡"},
+	"honduras":         {"🇭🇳"},
+	"honey":            {"🍯", "🦡"},
+	"honeybee":         {"🐝"},
+	"honeypot":         {"🍯"},
+	"hong":             {"🇭🇰"},
+	"hook":             {"🪝"},
+	"hoop":             {"🏀"},
+	"hooray":           {"🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿"},
+	"horizontal":       {"🚥"},
+	"horn":             {"📯", "🥳"},
+	"horns":            {"👿", "😈", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿"},
+	"horrible":         {"😝"},
+	"horse":            {"🎠", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🐎", "🐴"},
+	"horseshoe":        {"🧲"},
+	"hospital":         {"🏥"},
+	"hot":              {"☕", "♨️", "🌭", "🌶️", "🥵"},
+	"hotcake":          {"🥞"},
+	"hotdog":           {"🌭"},
+	"hotel":            {"🏨", "🏩", "🛋️", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🛍️", "🛎️", "🛏️", "🧳"},
+	"hotsprings":       {"♨️"},
+	"hourglass":        {"⌛", "⏳"},
+	"house":            {"🏚️", "🏠", "🏡", "🛖", "🪴"},
+	"household":        {"🚪", "🚽", "🚿", "🛁", "🛋️", "🛏️", "🛒", "🛗", "🧯", "🧴", "🧷", "🧹", "🧺", "🧻", "🧼", "🧽", "🪑", "🪒", "🪞", "🪟", "🪠", "🪣", "🪤", "🪥", "🫧"},
+	"houses":           {"🏘️"},
+	"hr":               {"🇭🇷"},
+	"ht":               {"🇭🇹"},
+	"hu":               {"🇭🇺"},
+	"hug":              {"🤗", "🫂"},
+	"hugging":          {"🤗", "🫂"},
+	"hump":             {"🐪", "🐫"},
+	"hundred":          {"💯"},
+	"hungary":          {"🇭🇺"},
+	"hurricane":        {"🌀"},
+	"hurt":             {"🤕", "🩼"},
+	"hushed":           {"😯"},
+	"hut":              {"🛖"},
+	"hyacinth":         {"🪻"},
+	"hygiene":          {"🪥"},
+	"hypnotized":       {"😵\u200d💫"},
+	"hóngbāo":          {"🧧"},
+	"i":                {"ℹ️"},
+	"ic":               {"🇮🇨"},
+	"ice":              {"⛸️", "🍦", "🍧", "🍨", "🏒", "🧊"},
+	"iceberg":          {"🧊"},
+	"icecream":         {"🍦"},
+	"iceland":          {"🇮🇸"},
+	"icicles":          {"🥶"},
+	"id":               {"🆔", "🇮🇩", "🪪"},
+	"idea":             {"💡"},
+	"identification":   {"🪪"},
+	"identity":         {"🆔"},
+	"ideograph":        {"㊗️", "㊙️", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑"},
+	"ie":               {"🇮🇪"},
+	"ignorance":        {"🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️"},
+	"il":               {"🇮🇱"},
+	"ill":              {"🤒"},
+	"ily":              {"🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿"},
+	"im":               {"🇮🇲"},
+	"imp":              {"👿"},
+	"improving":        {"❤️\u200d🩹"},
+	"in":               {"⛳", "🇮🇳", "🍃", "👁️\u200d🗨️", "👤", "👥", "👨\u200d🦼", "👨\u200d🦽", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩\u200d🦼", "👩\u200d🦽", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "😱", "😶\u200d🌫️", "🚮", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🛫", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🥰", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️"},
+	"inbox":            {"📥"},
+	"incognito":        {"🥸"},
+	"incoming":         {"📨"},
+	"increasing":       {"💹", "📈"},
+	"indecisive":       {"🧇"},
+	"index":            {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "📇", "🗂️", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"india":            {"🇮🇳", "🪷"},
+	"indian":           {"🇮🇴"},
+	"indifference":     {"🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️"},
+	"indonesia":        {"🇮🇩"},
+	"industrial":       {"👨\u200d🏭", "👨🏻\u200d🏭", "👨🏼\u200d🏭", "👨🏽\u200d🏭", "👨🏾\u200d🏭", "👨🏿\u200d🏭", "👩\u200d🏭", "👩🏻\u200d🏭", "👩🏼\u200d🏭", "👩🏽\u200d🏭", "👩🏾\u200d🏭", "👩🏿\u200d🏭", "🧑\u200d🏭", "🧑🏻\u200d🏭", "🧑🏼\u200d🏭", "🧑🏽\u200d🏭", "🧑🏾\u200d🏭", "🧑🏿\u200d🏭"},
+	"inexpressive":     {"😑"},
+	"infinity":         {"♾️"},
+	"information":      {"ℹ️", "💁", "💁🏻", "💁🏼", "💁🏽", "💁🏾", "💁🏿"},
+	"inhalation":       {"🫁"},
+	"injury":           {"🤕", "🩸"},
+	"ink":              {"🔏"},
+	"innocent":         {"😇"},
+	"input":            {"🔠", "🔡", "🔢", "🔣", "🔤"},
+	"insect":           {"🐛", "🐜", "🐝", "🐞", "🕷️", "🦋", "🪲", "🪳"},
+	"inside":           {"💠"},
+	"instructor":       {"👨\u200d🏫", "👨🏻\u200d🏫", "👨🏼\u200d🏫", "👨🏽\u200d🏫", "👨🏾\u200d🏫", "👨🏿\u200d🏫", "👩\u200d🏫", "👩🏻\u200d🏫", "👩🏼\u200d🏫", "👩🏽\u200d🏫", "👩🏾\u200d🏫", "👩🏿\u200d🏫", "🧑\u200d🏫", "🧑🏻\u200d🏫", "🧑🏼\u200d🏫", "🧑🏽\u200d🏫", "🧑🏾\u200d🏫", "🧑🏿\u200d🏫"},
+	"instrument":       {"🎷", "🎸", "🎹", "🎺", "🎻", "🥁", "🪇", "🪈", "🪕", "🪗", "🪘"},
+	"intelligent":      {"🧠"},
+	"intercardinal":    {"↖️", "↗️", "↘️", "↙️"},
+	"interlocking":     {"🧩"},
+	"interrobang":      {"⁉️"},
+	"interrogation":    {"🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿"},
+	"intoxicated":      {"🥴"},
+	"introvert":        {"🫥"},
+	"inventor":         {"👨\u200d💻", "👨🏻\u200d💻", "👨🏼\u200d💻", "👨🏽\u200d💻", "👨🏾\u200d💻", "👨🏿\u200d💻", "👩\u200d💻", "👩🏻\u200d💻", "👩🏼\u200d💻", "👩🏽\u200d💻", "👩🏾\u200d💻", "👩🏿\u200d💻", "🧑\u200d💻", "🧑🏻\u200d💻", "🧑🏼\u200d💻", "🧑🏽\u200d💻", "🧑🏾\u200d💻", "🧑🏿\u200d💻"},
+	"invisible":        {"🫥"},
+	"io":               {"🇮🇴"},
+	"iq":               {"🇮🇶"},
+	"ir":               {"🇮🇷"},
+	"iran":             {"🇮🇷"},
+	"iraq":             {"🇮🇶"},
+	"ireland":          {"🇮🇪"},
+	"iron":             {"🧇"},
+	"ironic":           {"😼"},
+	"is":               {"🇮🇸"},
+	"islam":            {"☪️", "🕋", "🕌"},
+	"island":           {"🇦🇨", "🇧🇻", "🇨🇵", "🇨🇽", "🇳🇫", "🏝️"},
+	"islands":          {"🇦🇽", "🇨🇨", "🇨🇰", "🇫🇰", "🇫🇴", "🇬🇸", "🇭🇲", "🇮🇨", "🇰🇾", "🇲🇭", "🇲🇵", "🇵🇳", "🇸🇧", "🇹🇨", "🇺🇲", "🇻🇬", "🇻🇮"},
+	"isle":             {"🇮🇲"},
+	"israel":           {"🇮🇱"},
+	"it":               {"🇮🇹"},
+	"italy":            {"🇮🇹"},
+	"ivoire":           {"🇨🇮"},
+	"jack":             {"🎃"},
+	"jacket":           {"🧥"},
+	"jamaica":          {"🇯🇲"},
+	"jan":              {"🇸🇯"},
+	"japan":            {"🇯🇵", "🗾"},
+	"japanese":         {"㊗️", "㊙️", "🈁", "🈂️", "🈚", "🈯", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑", "🍙", "🍡", "🎋", "🎌", "🎍", "🎎", "🎴", "🏣", "🏯", "🔰"},
+	"jar":              {"🫙"},
+	"je":               {"🇯🇪"},
+	"jeans":            {"👖"},
+	"jellyfish":        {"🪼"},
+	"jersey":           {"🇯🇪"},
+	"jew":              {"✡️", "🕍"},
+	"jewel":            {"💎"},
+	"jewish":           {"✡️", "🕍"},
+	"jiaozi":           {"🥟"},
+	"jigsaw":           {"🧩"},
+	"jm":               {"🇯🇲"},
+	"jo":               {"🇯🇴"},
+	"jockey":           {"🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿"},
+	"joey":             {"🦘"},
+	"joke":             {"😜"},
+	"joker":            {"🃏"},
+	"jolly":            {"🏴\u200d☠️"},
+	"jordan":           {"🇯🇴"},
+	"joy":              {"😂", "😹"},
+	"joystick":         {"🕹️"},
+	"jp":               {"🇯🇵"},
+	"judge":            {"👨\u200d⚖️", "👨🏻\u200d⚖️", "👨🏼\u200d⚖️", "👨🏽\u200d⚖️", "👨🏾\u200d⚖️", "👨🏿\u200d⚖️", "👩\u200d⚖️", "👩🏻\u200d⚖️", "👩🏼\u200d⚖️", "👩🏽\u200d⚖️", "👩🏾\u200d⚖️", "👩🏿\u200d⚖️", "🧑\u200d⚖️", "🧑🏻\u200d⚖️", "🧑🏼\u200d⚖️", "🧑🏽\u200d⚖️", "🧑🏾\u200d⚖️", "🧑🏿\u200d⚖️"},
+	"judo":             {"🥋"},
+	"jug":              {"🏺"},
+	"juggle":           {"🤹", "🤹🏻", "🤹🏼", "🤹🏽", "🤹🏾", "🤹🏿"},
+	"juggling":         {"🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️"},
+	"juice":            {"🥤", "🧃"},
+	"jump":             {"🦘"},
+	"justice":          {"⚖️", "👨\u200d⚖️", "👨🏻\u200d⚖️", "👨🏼\u200d⚖️", "👨🏽\u200d⚖️", "👨🏾\u200d⚖️", "👨🏿\u200d⚖️", "👩\u200d⚖️", "👩🏻\u200d⚖️", "👩🏼\u200d⚖️", "👩🏽\u200d⚖️", "👩🏾\u200d⚖️", "👩🏿\u200d⚖️", "🧑\u200d⚖️", "🧑🏻\u200d⚖️", "🧑🏼\u200d⚖️", "🧑🏽\u200d⚖️", "🧑🏾\u200d⚖️", "🧑🏿\u200d⚖️"},
+	"kaaba":            {"🕋"},
+	"kale":             {"🥬"},
+	"kangaroo":         {"🦘"},
+	"karaoke":          {"🎤"},
+	"karate":           {"🥋"},
+	"katakana":         {"🈁", "🈂️"},
+	"kazakhstan":       {"🇰🇿"},
+	"ke":               {"🇰🇪"},
+	"kebab":            {"🍢", "🥙"},
+	"keeling":          {"🇨🇨"},
+	"kenya":            {"🇰🇪"},
+	"key":              {"🔐", "🔑", "🗝️"},
+	"keyboard":         {"⌨️", "🎹"},
+	"keycap":           {"#️⃣", "*️⃣", "0️⃣", "1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣", "6️⃣", "7️⃣", "8️⃣", "9️⃣", "🔟"},
+	"kg":               {"🇰🇬"},
+	"kh":               {"🇰🇭"},
+	"khanda":           {"🪯"},
+	"ki":               {"🇰🇮"},
+	"kick":             {"🛴", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿"},
+	"kidney":           {"🫘"},
+	"kimono":           {"👘"},
+	"king":             {"👑"},
+	"kingdom":          {"🇬🇧"},
+	"kinshasa":         {"🇨🇩"},
+	"kiribati":         {"🇰🇮"},
+	"kiss":             {"👨\u200d❤️\u200d💋\u200d👨", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "💋", "💏", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "😗", "😘", "😙", "😚", "😽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾"},
+	"kissing":          {"😗", "😙", "😚", "😽"},
+	"kitchen":          {"🔪"},
+	"kite":             {"🪁"},
+	"kitts":            {"🇰🇳"},
+	"kiwi":             {"🥝"},
+	"km":               {"🇰🇲"},
+	"kn":               {"🇰🇳"},
+	"kneel":            {"🧎", "🧎🏻", "🧎🏼", "🧎🏽", "🧎🏾", "🧎🏿"},
+	"kneeling":         {"🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️"},
+	"knife":            {"🍴", "🍽️", "🔪", "🗡️"},
+	"knit":             {"🧶"},
+	"knobs":            {"🎛️"},
+	"knocked":          {"😵"},
+	"knot":             {"🪢"},
+	"koala":            {"🐨"},
+	"kong":             {"🇭🇰"},
+	"korea":            {"🇰🇵", "🇰🇷"},
+	"kosovo":           {"🇽🇰"},
+	"kp":               {"🇰🇵"},
+	"kr":               {"🇰🇷"},
+	"kuwait":           {"🇰🇼"},
+	"kw":               {"🇰🇼"},
+	"ky":               {"🇰🇾"},
+	"kyrgyzstan":       {"🇰🇬"},
+	"kz":               {"🇰🇿"},
+	"la":               {"🇱🇦"},
+	"lab":              {"🥼", "🧪", "🧫"},
+	"label":            {"🏷️"},
+	"lacrosse":         {"🥍"},
+	"ladder":           {"🪜"},
+	"lady":             {"🐞"},
+	"ladybird":         {"🐞"},
+	"ladybug":          {"🐞"},
+	"lai":              {"🧧"},
+	"lambchop":         {"🥩"},
+	"lamp":             {"🛋️", "🪔"},
+	"landing":          {"🛬"},
+	"landscape":        {"🌆"},
+	"lanka":            {"🇱🇰"},
+	"lantern":          {"🎃", "🏮"},
+	"laos":             {"🇱🇦"},
+	"laptop":           {"💻"},
+	"large":            {"⬛", "⬜", "🌥️", "🔶", "🔷", "🤪", "🦣", "🦤"},
+	"last":             {"⏮️", "🌗", "🌜"},
+	"lather":           {"🧼"},
+	"latin":            {"✝️", "🔠", "🔡", "🔤"},
+	"latvia":           {"🇱🇻"},
+	"laugh":            {"😂", "😆", "🤣"},
+	"laughing":         {"🤣"},
+	"laundry":          {"🧺"},
+	"lavash":           {"🫓"},
+	"lavatory":         {"🚻", "🚾"},
+	"lazy":             {"🦥"},
+	"lb":               {"🇱🇧"},
+	"lc":               {"🇱🇨"},
+	"leaf":             {"🌿", "🍀", "🍁", "🍂", "🍃", "🔰"},
+	"leafy":            {"🥬"},
+	"lebanon":          {"🇱🇧"},
+	"ledger":           {"📒"},
+	"left":             {"↔️", "↖️", "↙️", "↩️", "↪️", "◀️", "⬅️", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "🔍", "🗨️", "🛅", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿"},
+	"leftward":         {"🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿"},
+	"leftwards":        {"🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿"},
+	"leg":              {"🍗", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦿"},
+	"legume":           {"🫘"},
+	"lemon":            {"🍋"},
+	"leo":              {"♌", "🦁"},
+	"leone":            {"🇸🇱"},
+	"leopard":          {"🐆"},
+	"lesotho":          {"🇱🇸"},
+	"leste":            {"🇹🇱"},
+	"letter":           {"✉️", "💌", "📧", "📨"},
+	"letters":          {"🔠", "🔡", "🔤"},
+	"lettuce":          {"🥬"},
+	"level":            {"🎚️"},
+	"levitating":       {"🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿"},
+	"li":               {"🇱🇮"},
+	"liberia":          {"🇱🇷"},
+	"liberty":          {"🗽"},
+	"libra":            {"♎", "⚖️"},
+	"libya":            {"🇱🇾"},
+	"license":          {"🪪"},
+	"lie":              {"🤥"},
+	"liechtenstein":    {"🇱🇮"},
+	"life":             {"🛟", "🧬"},
+	"lift":             {"🛗"},
+	"lifter":           {"🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️"},
+	"lifting":          {"🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️"},
+	"light":            {"☝🏻", "☝🏼", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "✊🏻", "✊🏼", "✋🏻", "✋🏼", "✌🏻", "✌🏼", "✍🏻", "✍🏼", "🎅🏻", "🎅🏼", "🎞️", "🎥", "🎬", "🏂🏻", "🏂🏼", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏇🏻", "🏇🏼", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏮", "🏳️\u200d⚧️", "👂🏻", "👂🏼", "👃🏻", "👃🏼", "👆🏻", "👆🏼", "👇🏻", "👇🏼", "👈🏻", "👈🏼", "👉🏻", "👉🏼", "👊🏻", "👊🏼", "👋🏻", "👋🏼", "👌🏻", "👌🏼", "👍🏻", "👍🏼", "👎🏻", "👎🏼", "👏🏻", "👏🏼", "👐🏻", "👐🏼", "👦🏻", "👦🏼", "👧🏻", "👧🏼", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👫🏻", "👫🏼", "👬🏻", "👬🏼", "👭🏻", "👭🏼", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👲🏻", "👲🏼", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👴🏻", "👴🏼", "👵🏻", "👵🏼", "👶🏻", "👶🏼", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👸🏻", "👸🏼", "👼🏻", "👼🏼", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💃🏻", "💃🏼", "💅🏻", "💅🏼", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💏🏻", "💏🏼", "💑🏻", "💑🏼", "💡", "💪🏻", "💪🏼", "📷", "📸", "📹", "📺", "📼", "📽️", "🔍", "🔎", "🔦", "🕯️", "🕴🏻", "🕴🏼", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕺🏻", "🕺🏼", "🖐🏻", "🖐🏼", "🖕🏻", "🖕🏼", "🖖🏻", "🖖🏼", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙌🏻", "🙌🏼", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙏🏻", "🙏🏼", "🚈", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚥", "🚦", "🚨", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🛀🏻", "🛀🏼", "🛌🏻", "🛌🏼", "🤌🏻", "🤌🏼", "🤏🏻", "🤏🏼", "🤘🏻", "🤘🏼", "🤙🏻", "🤙🏼", "🤚🏻", "🤚🏼", "🤛🏻", "🤛🏼", "🤜🏻", "🤜🏼", "🤝🏻", "🤝🏼", "🤞🏻", "🤞🏼", "🤟🏻", "🤟🏼", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤰🏻", "🤰🏼", "🤱🏻", "🤱🏼", "🤲🏻", "🤲🏼", "🤳🏻", "🤳🏼", "🤴🏻", "🤴🏼", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤶🏻", "🤶🏼", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🥷🏻", "🥷🏼", "🦵🏻", "🦵🏼", "🦶🏻", "🦶🏼", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦻🏻", "🦻🏼", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧒🏻", "🧒🏼", "🧓🏻", "🧓🏼", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧕🏻", "🧕🏼", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🩵", "🪔", "🪶", "🫃🏻", "🫃🏼", "🫄🏻", "🫄🏼", "🫅🏻", "🫅🏼", "🫰🏻", "🫰🏼", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫲🏻", "🫲🏼", "🫳🏻", "🫳🏼", "🫴🏻", "🫴🏼", "🫵🏻", "🫵🏼", "🫶🏻", "🫶🏼", "🫷🏻", "🫷🏼", "🫸🏻", "🫸🏼"},
+	"lightning":        {"⛈️", "🌩️"},
+	"limb":             {"🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿"},
+	"line":             {"🫥"},
+	"link":             {"🔗", "🖇️"},
+	"linked":           {"🖇️"},
+	"lion":             {"🦁", "🦭"},
+	"lip":              {"🫦"},
+	"lips":             {"👄", "💋"},
+	"lipstick":         {"💄"},
+	"liquid":           {"🫗", "🫠"},
+	"liquor":           {"🥃"},
+	"lis":              {"⚜️"},
+	"lithuania":        {"🇱🇹"},
+	"litter":           {"🚮", "🚯"},
+	"littering":        {"🚯"},
+	"lizard":           {"🦎"},
+	"lk":               {"🇱🇰"},
+	"llama":            {"🦙"},
+	"loaf":             {"🍞"},
+	"lobster":          {"🦞"},
+	"lock":             {"🔏", "🔐", "🔑", "🔒", "🔓", "🗝️"},
+	"locked":           {"🔏", "🔐", "🔒"},
+	"locker":           {"🛅"},
+	"locomotive":       {"🚂"},
+	"log":              {"🪵"},
+	"lollipop":         {"🍭"},
+	"long":             {"🪘"},
+	"loop":             {"➰", "➿"},
+	"loose":            {"🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿"},
+	"lorry":            {"🚛"},
+	"lotion":           {"🧴"},
+	"lotus":            {"🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🪷"},
+	"loud":             {"📢", "🔊"},
+	"loudly":           {"😭"},
+	"loudspeaker":      {"📢"},
+	"love":             {"❤️\u200d🔥", "🏩", "👨\u200d❤️\u200d👨", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "💌", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💕", "😍", "😻", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🥰", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫶", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿"},
+	"low":              {"🔅", "🔈", "🪫"},
+	"lowercase":        {"🔡"},
+	"lowered":          {"📪", "📭"},
+	"lr":               {"🇱🇷"},
+	"ls":               {"🇱🇸"},
+	"lt":               {"🇱🇹"},
+	"lu":               {"🇱🇺"},
+	"lucia":            {"🇱🇨"},
+	"luck":             {"🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🧧"},
+	"luggage":          {"🛅", "🧳"},
+	"lumber":           {"🪚", "🪵"},
+	"lungs":            {"🫁"},
+	"lust":             {"❤️\u200d🔥"},
+	"luxembourg":       {"🇱🇺"},
+	"lv":               {"🇱🇻"},
+	"ly":               {"🇱🇾"},
+	"lying":            {"🤥"},
+	"m":                {"Ⓜ️"},
+	"ma":               {"🇲🇦"},
+	"maarten":          {"🇸🇽"},
+	"macao":            {"🇲🇴"},
+	"macedonia":        {"🇲🇰"},
+	"machine":          {"🎰", "📠"},
+	"mad":              {"💢", "🗯️", "😠", "😡"},
+	"madagascar":       {"🇲🇬"},
+	"mage":             {"🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️"},
+	"maggot":           {"🪰"},
+	"magic":            {"🪄"},
+	"magical":          {"🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️"},
+	"magnet":           {"🧲"},
+	"magnetic":         {"🧭", "🧲"},
+	"magnifying":       {"🔍", "🔎"},
+	"mahjong":          {"🀄"},
+	"mail":             {"✉️", "💌", "📤", "📥", "📦", "📧", "📨", "📩", "📪", "📫", "📬", "📭", "📮", "🗳️"},
+	"mailbox":          {"📪", "📫", "📬", "📭", "📮"},
+	"maize":            {"🌽"},
+	"makeup":           {"💄"},
+	"malaria":          {"🦟"},
+	"malawi":           {"🇲🇼"},
+	"malaysia":         {"🇲🇾"},
+	"maldives":         {"🇲🇻"},
+	"male":             {"♂️", "🐏"},
+	"mali":             {"🇲🇱"},
+	"malta":            {"🇲🇹"},
+	"mammal":           {"🐀", "🐁", "🐂", "🐃", "🐄", "🐅", "🐆", "🐇", "🐈", "🐈\u200d⬛", "🐎", "🐏", "🐐", "🐑", "🐒", "🐕", "🐕\u200d🦺", "🐖", "🐗", "🐘", "🐨", "🐩", "🐪", "🐫", "🐭", "🐮", "🐯", "🐰", "🐱", "🐴", "🐵", "🐶", "🐷", "🐹", "🐺", "🐻", "🐻\u200d❄️", "🐼", "🐽", "🐾", "🐿️", "🦁", "🦄", "🦇", "🦊", "🦌", "🦍", "🦏", "🦒", "🦓", "🦔", "🦘", "🦙", "🦛", "🦝", "🦡", "🦣", "🦥", "🦦", "🦧", "🦨", "🦫", "🦬", "🦮", "🫎", "🫏"},
+	"mammoth":          {"🦣"},
+	"man":              {"♂️", "⛹️\u200d♂️", "⛹🏻\u200d♂️", "⛹🏼\u200d♂️", "⛹🏽\u200d♂️", "⛹🏾\u200d♂️", "⛹🏿\u200d♂️", "🇮🇲", "🏃\u200d♂️", "🏃🏻\u200d♂️", "🏃🏼\u200d♂️", "🏃🏽\u200d♂️", "🏃🏾\u200d♂️", "🏃🏿\u200d♂️", "🏄\u200d♂️", "🏄🏻\u200d♂️", "🏄🏼\u200d♂️", "🏄🏽\u200d♂️", "🏄🏾\u200d♂️", "🏄🏿\u200d♂️", "🏊\u200d♂️", "🏊🏻\u200d♂️", "🏊🏼\u200d♂️", "🏊🏽\u200d♂️", "🏊🏾\u200d♂️", "🏊🏿\u200d♂️", "🏋️\u200d♂️", "🏋🏻\u200d♂️", "🏋🏼\u200d♂️", "🏋🏽\u200d♂️", "🏋🏾\u200d♂️", "🏋🏿\u200d♂️", "🏌️\u200d♂️", "🏌🏻\u200d♂️", "🏌🏼\u200d♂️", "🏌🏽\u200d♂️", "🏌🏾\u200d♂️", "🏌🏿\u200d♂️", "👞", "👨", "👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦽", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d💋\u200d👨", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👮\u200d♂️", "👮🏻\u200d♂️", "👮🏼\u200d♂️", "👮🏽\u200d♂️", "👮🏾\u200d♂️", "👮🏿\u200d♂️", "👰\u200d♂️", "👰🏻\u200d♂️", "👰🏼\u200d♂️", "👰🏽\u200d♂️", "👰🏾\u200d♂️", "👰🏿\u200d♂️", "👱\u200d♂️", "👱🏻\u200d♂️", "👱🏼\u200d♂️", "👱🏽\u200d♂️", "👱🏾\u200d♂️", "👱🏿\u200d♂️", "👳\u200d♂️", "👳🏻\u200d♂️", "👳🏼\u200d♂️", "👳🏽\u200d♂️", "👳🏾\u200d♂️", "👳🏿\u200d♂️", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👷\u200d♂️", "👷🏻\u200d♂️", "👷🏼\u200d♂️", "👷🏽\u200d♂️", "👷🏾\u200d♂️", "👷🏿\u200d♂️", "💁\u200d♂️", "💁🏻\u200d♂️", "💁🏼\u200d♂️", "💁🏽\u200d♂️", "💁🏾\u200d♂️", "💁🏿\u200d♂️", "💂\u200d♂️", "💂🏻\u200d♂️", "💂🏼\u200d♂️", "💂🏽\u200d♂️", "💂🏾\u200d♂️", "💂🏿\u200d♂️", "💆\u200d♂️", "💆🏻\u200d♂️", "💆🏼\u200d♂️", "💆🏽\u200d♂️", "💆🏾\u200d♂️", "💆🏿\u200d♂️", "💇\u200d♂️", "💇🏻\u200d♂️", "💇🏼\u200d♂️", "💇🏽\u200d♂️", "💇🏾\u200d♂️", "💇🏿\u200d♂️", "🕵️\u200d♂️", "🕵🏻\u200d♂️", "🕵🏼\u200d♂️", "🕵🏽\u200d♂️", "🕵🏾\u200d♂️", "🕵🏿\u200d♂️", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🙅\u200d♂️", "🙅🏻\u200d♂️", "🙅🏼\u200d♂️", "🙅🏽\u200d♂️", "🙅🏾\u200d♂️", "🙅🏿\u200d♂️", "🙆\u200d♂️", "🙆🏻\u200d♂️", "🙆🏼\u200d♂️", "🙆🏽\u200d♂️", "🙆🏾\u200d♂️", "🙆🏿\u200d♂️", "🙇\u200d♂️", "🙇🏻\u200d♂️", "🙇🏼\u200d♂️", "🙇🏽\u200d♂️", "🙇🏾\u200d♂️", "🙇🏿\u200d♂️", "🙋\u200d♂️", "🙋🏻\u200d♂️", "🙋🏼\u200d♂️", "🙋🏽\u200d♂️", "🙋🏾\u200d♂️", "🙋🏿\u200d♂️", "🙍\u200d♂️", "🙍🏻\u200d♂️", "🙍🏼\u200d♂️", "🙍🏽\u200d♂️", "🙍🏾\u200d♂️", "🙍🏿\u200d♂️", "🙎\u200d♂️", "🙎🏻\u200d♂️", "🙎🏼\u200d♂️", "🙎🏽\u200d♂️", "🙎🏾\u200d♂️", "🙎🏿\u200d♂️", "🚣\u200d♂️", "🚣🏻\u200d♂️", "🚣🏼\u200d♂️", "🚣🏽\u200d♂️", "🚣🏾\u200d♂️", "🚣🏿\u200d♂️", "🚴\u200d♂️", "🚴🏻\u200d♂️", "🚴🏼\u200d♂️", "🚴🏽\u200d♂️", "🚴🏾\u200d♂️", "🚴🏿\u200d♂️", "🚵\u200d♂️", "🚵🏻\u200d♂️", "🚵🏼\u200d♂️", "🚵🏽\u200d♂️", "🚵🏾\u200d♂️", "🚵🏿\u200d♂️", "🚶\u200d♂️", "🚶🏻\u200d♂️", "🚶🏼\u200d♂️", "🚶🏽\u200d♂️", "🚶🏾\u200d♂️", "🚶🏿\u200d♂️", "🤦\u200d♂️", "🤦🏻\u200d♂️", "🤦🏼\u200d♂️", "🤦🏽\u200d♂️", "🤦🏾\u200d♂️", "🤦🏿\u200d♂️", "🤵\u200d♂️", "🤵🏻\u200d♂️", "🤵🏼\u200d♂️", "🤵🏽\u200d♂️", "🤵🏾\u200d♂️", "🤵🏿\u200d♂️", "🤷\u200d♂️", "🤷🏻\u200d♂️", "🤷🏼\u200d♂️", "🤷🏽\u200d♂️", "🤷🏾\u200d♂️", "🤷🏿\u200d♂️", "🤸\u200d♂️", "🤸🏻\u200d♂️", "🤸🏼\u200d♂️", "🤸🏽\u200d♂️", "🤸🏾\u200d♂️", "🤸🏿\u200d♂️", "🤹\u200d♂️", "🤹🏻\u200d♂️", "🤹🏼\u200d♂️", "🤹🏽\u200d♂️", "🤹🏾\u200d♂️", "🤹🏿\u200d♂️", "🤽\u200d♂️", "🤽🏻\u200d♂️", "🤽🏼\u200d♂️", "🤽🏽\u200d♂️", "🤽🏾\u200d♂️", "🤽🏿\u200d♂️", "🤾\u200d♂️", "🤾🏻\u200d♂️", "🤾🏼\u200d♂️", "🤾🏽\u200d♂️", "🤾🏾\u200d♂️", "🤾🏿\u200d♂️", "🦸\u200d♂️", "🦸🏻\u200d♂️", "🦸🏼\u200d♂️", "🦸🏽\u200d♂️", "🦸🏾\u200d♂️", "🦸🏿\u200d♂️", "🦹\u200d♂️", "🦹🏻\u200d♂️", "🦹🏼\u200d♂️", "🦹🏽\u200d♂️", "🦹🏾\u200d♂️", "🦹🏿\u200d♂️", "🧍\u200d♂️", "🧍🏻\u200d♂️", "🧍🏼\u200d♂️", "🧍🏽\u200d♂️", "🧍🏾\u200d♂️", "🧍🏿\u200d♂️", "🧎\u200d♂️", "🧎🏻\u200d♂️", "🧎🏼\u200d♂️", "🧎🏽\u200d♂️", "🧎🏾\u200d♂️", "🧎🏿\u200d♂️", "🧏\u200d♂️", "🧏🏻\u200d♂️", "🧏🏼\u200d♂️", "🧏🏽\u200d♂️", "🧏🏾\u200d♂️", "🧏🏿\u200d♂️", "🧔\u200d♂️", "🧔🏻\u200d♂️", "🧔🏼\u200d♂️", "🧔🏽\u200d♂️", "🧔🏾\u200d♂️", "🧔🏿\u200d♂️", "🧖\u200d♂️", "🧖🏻\u200d♂️", "🧖🏼\u200d♂️", "🧖🏽\u200d♂️", "🧖🏾\u200d♂️", "🧖🏿\u200d♂️", "🧗\u200d♂️", "🧗🏻\u200d♂️", "🧗🏼\u200d♂️", "🧗🏽\u200d♂️", "🧗🏾\u200d♂️", "🧗🏿\u200d♂️", "🧘\u200d♂️", "🧘🏻\u200d♂️", "🧘🏼\u200d♂️", "🧘🏽\u200d♂️", "🧘🏾\u200d♂️", "🧘🏿\u200d♂️", "🧙\u200d♂️", "🧙🏻\u200d♂️", "🧙🏼\u200d♂️", "🧙🏽\u200d♂️", "🧙🏾\u200d♂️", "🧙🏿\u200d♂️", "🧚\u200d♂️", "🧚🏻\u200d♂️", "🧚🏼\u200d♂️", "🧚🏽\u200d♂️", "🧚🏾\u200d♂️", "🧚🏿\u200d♂️", "🧛\u200d♂️", "🧛🏻\u200d♂️", "🧛🏼\u200d♂️", "🧛🏽\u200d♂️", "🧛🏾\u200d♂️", "🧛🏿\u200d♂️", "🧝\u200d♂️", "🧝🏻\u200d♂️", "🧝🏼\u200d♂️", "🧝🏽\u200d♂️", "🧝🏾\u200d♂️", "🧝🏿\u200d♂️", "🧞\u200d♂️", "🧟\u200d♂️", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿"},
+	"manager":          {"👨\u200d💼", "👨🏻\u200d💼", "👨🏼\u200d💼", "👨🏽\u200d💼", "👨🏾\u200d💼", "👨🏿\u200d💼", "👩\u200d💼", "👩🏻\u200d💼", "👩🏼\u200d💼", "👩🏽\u200d💼", "👩🏾\u200d💼", "👩🏿\u200d💼", "🧑\u200d💼", "🧑🏻\u200d💼", "🧑🏼\u200d💼", "🧑🏽\u200d💼", "🧑🏾\u200d💼", "🧑🏿\u200d💼"},
+	"mango":            {"🥭"},
+	"manicure":         {"💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿"},
+	"mantelpiece":      {"🕰️"},
+	"mantilla":         {"🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿"},
+	"manual":           {"👨\u200d🦽", "👨🏻\u200d🦽", "👨🏼\u200d🦽", "👨🏽\u200d🦽", "👨🏾\u200d🦽", "👨🏿\u200d🦽", "👩\u200d🦽", "👩🏻\u200d🦽", "👩🏼\u200d🦽", "👩🏽\u200d🦽", "👩🏾\u200d🦽", "👩🏿\u200d🦽", "🦽", "🧑\u200d🦽", "🧑🏻\u200d🦽", "🧑🏼\u200d🦽", "🧑🏽\u200d🦽", "🧑🏾\u200d🦽", "🧑🏿\u200d🦽"},
+	"mao":              {"👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿"},
+	"map":              {"🌍", "🌎", "🌏", "🌐", "🗺️", "🗾", "🧭"},
+	"maple":            {"🍁"},
+	"maracas":          {"🪇"},
+	"marathon":         {"🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️"},
+	"mariana":          {"🇲🇵"},
+	"marine":           {"🐋", "🐙", "🐚", "🐟", "🐠", "🐡", "🐬", "🐳", "🦀", "🦈", "🦐", "🦑", "🦞", "🦪", "🦭", "🪸", "🪼"},
+	"marino":           {"🇸🇲"},
+	"mark":             {"‼️", "⁉️", "™️", "✅", "✔️", "❌", "❎", "❓", "❔", "❕", "❗", "❣️", "〽️", "🆙", "💋", "📑", "🔖", "🔛"},
+	"marker":           {"📑"},
+	"marshall":         {"🇲🇭"},
+	"marsupial":        {"🐨", "🦘"},
+	"martial":          {"🥋"},
+	"martin":           {"🇲🇫"},
+	"martinique":       {"🇲🇶"},
+	"mary":             {"🪬"},
+	"mask":             {"😷", "🤿"},
+	"massage":          {"💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️"},
+	"mate":             {"🧉"},
+	"math":             {"♾️", "✖️", "➕", "➖", "➗", "🟰"},
+	"mauritania":       {"🇲🇷"},
+	"mauritius":        {"🇲🇺", "🦤"},
+	"mayen":            {"🇸🇯"},
+	"mayotte":          {"🇾🇹"},
+	"maze":             {"🌽"},
+	"mc":               {"🇲🇨"},
+	"mcdonald":         {"🇭🇲"},
+	"md":               {"🇲🇩"},
+	"me":               {"🇲🇪", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿"},
+	"meat":             {"🍖", "🥓", "🥩"},
+	"meatball":         {"🧆"},
+	"mechanic":         {"👨\u200d🔧", "👨🏻\u200d🔧", "👨🏼\u200d🔧", "👨🏽\u200d🔧", "👨🏾\u200d🔧", "👨🏿\u200d🔧", "👩\u200d🔧", "👩🏻\u200d🔧", "👩🏼\u200d🔧", "👩🏽\u200d🔧", "👩🏾\u200d🔧", "👩🏿\u200d🔧", "🧑\u200d🔧", "🧑🏻\u200d🔧", "🧑🏼\u200d🔧", "🧑🏽\u200d🔧", "🧑🏾\u200d🔧", "🧑🏿\u200d🔧", "🧰"},
+	"mechanical":       {"🦾", "🦿"},
+	"medal":            {"🎖️", "🏅", "🏆", "🥇", "🥈", "🥉"},
+	"medical":          {"⚕️", "💉", "💊", "😷", "🩸", "🩹", "🩺", "🩻", "🩼"},
+	"medicine":         {"⚕️", "🏥", "💉", "💊", "🩸", "🩺"},
+	"meditation":       {"🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️"},
+	"medium":           {"◻️", "◼️", "◽", "◾", "☝🏼", "☝🏽", "☝🏾", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "✊🏼", "✊🏽", "✊🏾", "✋🏼", "✋🏽", "✋🏾", "✌🏼", "✌🏽", "✌🏾", "✍🏼", "✍🏽", "✍🏾", "🎅🏼", "🎅🏽", "🎅🏾", "🏂🏼", "🏂🏽", "🏂🏾", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏇🏼", "🏇🏽", "🏇🏾", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "👂🏼", "👂🏽", "👂🏾", "👃🏼", "👃🏽", "👃🏾", "👆🏼", "👆🏽", "👆🏾", "👇🏼", "👇🏽", "👇🏾", "👈🏼", "👈🏽", "👈🏾", "👉🏼", "👉🏽", "👉🏾", "👊🏼", "👊🏽", "👊🏾", "👋🏼", "👋🏽", "👋🏾", "👌🏼", "👌🏽", "👌🏾", "👍🏼", "👍🏽", "👍🏾", "👎🏼", "👎🏽", "👎🏾", "👏🏼", "👏🏽", "👏🏾", "👐🏼", "👐🏽", "👐🏾", "👦🏼", "👦🏽", "👦🏾", "👧🏼", "👧🏽", "👧🏾", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👫🏼", "👫🏽", "👫🏾", "👬🏼", "👬🏽", "👬🏾", "👭🏼", "👭🏽", "👭🏾", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👲🏼", "👲🏽", "👲🏾", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👴🏼", "👴🏽", "👴🏾", "👵🏼", "👵🏽", "👵🏾", "👶🏼", "👶🏽", "👶🏾", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👸🏼", "👸🏽", "👸🏾", "👼🏼", "👼🏽", "👼🏾", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💃🏼", "💃🏽", "💃🏾", "💅🏼", "💅🏽", "💅🏾", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💏🏼", "💏🏽", "💏🏾", "💑🏼", "💑🏽", "💑🏾", "💪🏼", "💪🏽", "💪🏾", "🔉", "🕴🏼", "🕴🏽", "🕴🏾", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕺🏼", "🕺🏽", "🕺🏾", "🖐🏼", "🖐🏽", "🖐🏾", "🖕🏼", "🖕🏽", "🖕🏾", "🖖🏼", "🖖🏽", "🖖🏾", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙌🏼", "🙌🏽", "🙌🏾", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙏🏼", "🙏🏽", "🙏🏾", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🛀🏼", "🛀🏽", "🛀🏾", "🛌🏼", "🛌🏽", "🛌🏾", "🤌🏼", "🤌🏽", "🤌🏾", "🤏🏼", "🤏🏽", "🤏🏾", "🤘🏼", "🤘🏽", "🤘🏾", "🤙🏼", "🤙🏽", "🤙🏾", "🤚🏼", "🤚🏽", "🤚🏾", "🤛🏼", "🤛🏽", "🤛🏾", "🤜🏼", "🤜🏽", "🤜🏾", "🤝🏼", "🤝🏽", "🤝🏾", "🤞🏼", "🤞🏽", "🤞🏾", "🤟🏼", "🤟🏽", "🤟🏾", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤰🏼", "🤰🏽", "🤰🏾", "🤱🏼", "🤱🏽", "🤱🏾", "🤲🏼", "🤲🏽", "🤲🏾", "🤳🏼", "🤳🏽", "🤳🏾", "🤴🏼", "🤴🏽", "🤴🏾", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤶🏼", "🤶🏽", "🤶🏾", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🥷🏼", "🥷🏽", "🥷🏾", "🦵🏼", "🦵🏽", "🦵🏾", "🦶🏼", "🦶🏽", "🦶🏾", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦻🏼", "🦻🏽", "🦻🏾", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧒🏼", "🧒🏽", "🧒🏾", "🧓🏼", "🧓🏽", "🧓🏾", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧕🏼", "🧕🏽", "🧕🏾", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🫃🏼", "🫃🏽", "🫃🏾", "🫄🏼", "🫄🏽", "🫄🏾", "🫅🏼", "🫅🏽", "🫅🏾", "🫰🏼", "🫰🏽", "🫰🏾", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲🏼", "🫲🏽", "🫲🏾", "🫳🏼", "🫳🏽", "🫳🏾", "🫴🏼", "🫴🏽", "🫴🏾", "🫵🏼", "🫵🏽", "🫵🏾", "🫶🏼", "🫶🏽", "🫶🏾", "🫷🏼", "🫷🏽", "🫷🏾", "🫸🏼", "🫸🏽", "🫸🏾"},
+	"meeting":          {"🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾"},
+	"megaphone":        {"📣"},
+	"meh":              {"😑", "😕", "🫤"},
+	"melilla":          {"🇪🇦"},
+	"melon":            {"🍈"},
+	"melt":             {"🫠"},
+	"melted":           {"🫕"},
+	"melting":          {"🫠"},
+	"memo":             {"📝"},
+	"men":              {"👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👯\u200d♂️", "🚹", "🤼\u200d♂️"},
+	"mending":          {"❤️\u200d🩹"},
+	"menorah":          {"🕎"},
+	"menstruation":     {"🩸"},
+	"mercy":            {"🥺"},
+	"meridians":        {"🌐"},
+	"mermaid":          {"🧜", "🧜\u200d♀️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏿", "🧜🏿\u200d♀️"},
+	"merman":           {"🧜", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♂️"},
+	"merperson":        {"🧜", "🧜🏻", "🧜🏼", "🧜🏽", "🧜🏾", "🧜🏿"},
+	"merwoman":         {"🧜", "🧜\u200d♀️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏿", "🧜🏿\u200d♀️"},
+	"metal":            {"🪙"},
+	"metro":            {"🚇"},
+	"mexican":          {"🌮", "🌯", "🫔"},
+	"mexico":           {"🇲🇽"},
+	"mf":               {"🇲🇫"},
+	"mg":               {"🇲🇬"},
+	"mh":               {"🇲🇭"},
+	"mic":              {"🎙️", "🎤"},
+	"microbe":          {"🦠"},
+	"micronesia":       {"🇫🇲"},
+	"microphone":       {"🎙️", "🎤"},
+	"microscope":       {"🔬"},
+	"middle":           {"🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿"},
+	"military":         {"🎖️", "🪖"},
+	"milk":             {"🍼", "🥛", "🧋"},
+	"milky":            {"🌌"},
+	"mind":             {"🤯"},
+	"minibus":          {"🚐"},
+	"minidisk":         {"💽"},
+	"mining":           {"⛏️"},
+	"minus":            {"➖"},
+	"miquelon":         {"🇵🇲"},
+	"miriam":           {"🪬"},
+	"mirror":           {"🪞", "🪩"},
+	"mk":               {"🇲🇰"},
+	"ml":               {"🇲🇱"},
+	"mm":               {"🇲🇲"},
+	"mn":               {"🇲🇳"},
+	"mo":               {"🇲🇴"},
+	"moai":             {"🗿"},
+	"mobile":           {"📱", "📲", "📳", "📴", "📵", "📶"},
+	"mobility":         {"🩼"},
+	"mode":             {"📳"},
+	"moisturizer":      {"🧴"},
+	"moldova":          {"🇲🇩"},
+	"molusc":           {"🦑"},
+	"monaco":           {"🇲🇨"},
+	"monarch":          {"🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"money":            {"💰", "💱", "💲", "💳", "💴", "💵", "💶", "💷", "💸", "💹", "🤑", "🧧", "🧾", "🪙", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"mongolia":         {"🇲🇳"},
+	"monkey":           {"🐒", "🐵", "🙈", "🙉", "🙊"},
+	"monocle":          {"🧐"},
+	"monorail":         {"🚝"},
+	"monster":          {"☠️", "👹", "👺", "👻", "👾", "💀", "💩", "🤖", "🧌"},
+	"montenegro":       {"🇲🇪"},
+	"monthly":          {"🈷️"},
+	"montserrat":       {"🇲🇸"},
+	"moon":             {"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🎑", "🥮"},
+	"moose":            {"🫎"},
+	"morning":          {"🌄", "🌅"},
+	"morocco":          {"🇲🇦"},
+	"mortar":           {"🧱"},
+	"mosque":           {"🕌"},
+	"mosquito":         {"🦟"},
+	"mother":           {"🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿"},
+	"motor":            {"🛥️", "🛵"},
+	"motorboat":        {"🛥️"},
+	"motorcycle":       {"🏍️"},
+	"motorized":        {"👨\u200d🦼", "👨🏻\u200d🦼", "👨🏼\u200d🦼", "👨🏽\u200d🦼", "👨🏾\u200d🦼", "👨🏿\u200d🦼", "👩\u200d🦼", "👩🏻\u200d🦼", "👩🏼\u200d🦼", "👩🏽\u200d🦼", "👩🏾\u200d🦼", "👩🏿\u200d🦼", "🦼", "🧑\u200d🦼", "🧑🏻\u200d🦼", "🧑🏼\u200d🦼", "🧑🏽\u200d🦼", "🧑🏾\u200d🦼", "🧑🏿\u200d🦼"},
+	"motorway":         {"🛣️"},
+	"mount":            {"🗻"},
+	"mountain":         {"⛰️", "🌄", "🌋", "🏔️", "🗻", "🚞", "🚠", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️"},
+	"mountains":        {"🌄"},
+	"mouse":            {"🐁", "🐭", "🖱️", "🪤"},
+	"mousetrap":        {"🪤"},
+	"mouth":            {"👄", "😃", "😄", "😆", "😦", "😮", "😶", "😺", "🤐", "🤑", "🤬", "🤭", "🥴", "🫢", "🫤"},
+	"movie":            {"🎞️", "🎥", "🎦", "📽️"},
+	"moyai":            {"🗿"},
+	"mozambique":       {"🇲🇿"},
+	"mp":               {"🇲🇵"},
+	"mq":               {"🇲🇶"},
+	"mr":               {"🇲🇷"},
+	"mrs":              {"🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿"},
+	"ms":               {"🇲🇸"},
+	"mt":               {"🇲🇹"},
+	"mu":               {"🇲🇺"},
+	"mug":              {"🍺", "🍻"},
+	"mugs":             {"🍻"},
+	"multiplication":   {"✖️", "❌"},
+	"multiply":         {"✖️", "❌"},
+	"multitask":        {"🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️"},
+	"munch":            {"😱"},
+	"muscle":           {"💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿"},
+	"museum":           {"🎨", "🖼️"},
+	"mushroom":         {"🍄"},
+	"music":            {"🎙️", "🎚️", "🎛️", "🎤", "🎧", "🎵", "🎶", "🎷", "🎸", "🎹", "🎺", "🎻", "🎼", "📻", "🥁", "🪕"},
+	"musical":          {"🎵", "🎶", "🎷", "🎸", "🎹", "🎺", "🎻", "🎼", "🥁", "🪇", "🪈", "🪕", "🪗", "🪘"},
+	"muslim":           {"☪️", "🕋", "🕌"},
+	"mute":             {"🔇", "🔕"},
+	"muted":            {"🔇"},
+	"mv":               {"🇲🇻"},
+	"mw":               {"🇲🇼"},
+	"mx":               {"🇲🇽", "🧑\u200d🎄", "🧑🏻\u200d🎄", "🧑🏼\u200d🎄", "🧑🏽\u200d🎄", "🧑🏾\u200d🎄", "🧑🏿\u200d🎄"},
+	"my":               {"🇲🇾"},
+	"myanmar":          {"🇲🇲"},
+	"mz":               {"🇲🇿"},
+	"na":               {"🇳🇦"},
+	"naan":             {"🫓"},
+	"nail":             {"💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿"},
+	"name":             {"📛"},
+	"namibia":          {"🇳🇦"},
+	"national":         {"🏞️"},
+	"nations":          {"🇺🇳"},
+	"nature":           {"☘️", "🌱", "🌲", "🌳", "🌴", "🌵", "🌷", "🌸", "🌹", "🌺", "🌻", "🌼", "🌾", "🌿", "🍀", "🍁", "🍂", "🍃", "🍄", "🏵️", "🐀", "🐁", "🐂", "🐃", "🐄", "🐅", "🐆", "🐇", "🐈", "🐈\u200d⬛", "🐉", "🐊", "🐋", "🐌", "🐍", "🐎", "🐏", "🐐", "🐑", "🐒", "🐓", "🐔", "🐕", "🐕\u200d🦺", "🐖", "🐗", "🐘", "🐙", "🐚", "🐛", "🐜", "🐝", "🐞", "🐟", "🐠", "🐡", "🐢", "🐣", "🐤", "🐥", "🐦", "🐦\u200d⬛", "🐧", "🐨", "🐩", "🐪", "🐫", "🐬", "🐭", "🐮", "🐯", "🐰", "🐱", "🐲", "🐳", "🐴", "🐵", "🐶", "🐷", "🐸", "🐹", "🐺", "🐻", "🐻\u200d❄️", "🐼", "🐽", "🐾", "🐿️", "💐", "💮", "🕊️", "🕷️", "🕸️", "🥀", "🦁", "🦂", "🦃", "🦄", "🦅", "🦆", "🦇", "🦈", "🦉", "🦊", "🦋", "🦌", "🦍", "🦎", "🦏", "🦒", "🦓", "🦔", "🦕", "🦖", "🦗", "🦘", "🦙", "🦚", "🦛", "🦜", "🦝", "🦟", "🦠", "🦡", "🦢", "🦣", "🦤", "🦥", "🦦", "🦧", "🦨", "🦩", "🦫", "🦬", "🦭", "🦮", "🪰", "🪱", "🪲", "🪳", "🪴", "🪶", "🪷", "🪸", "🪹", "🪺", "🪻", "🪼", "🪽", "🪿", "🫎", "🫏"},
+	"nauru":            {"🇳🇷"},
+	"nauseated":        {"🤢"},
+	"navigation":       {"🧭"},
+	"nazar":            {"🧿"},
+	"nc":               {"🇳🇨"},
+	"nd":               {"🥈"},
+	"ne":               {"🇳🇪"},
+	"neck":             {"🧣"},
+	"necklace":         {"📿"},
+	"necktie":          {"👔"},
+	"needle":           {"💉", "🧵", "🪡"},
+	"negative":         {"☠️", "👿", "💀", "😈", "😠", "😡", "😤", "🤬"},
+	"nepal":            {"🇳🇵"},
+	"nerd":             {"🤓"},
+	"nervous":          {"💗", "🫦"},
+	"nest":             {"🪹", "🪺"},
+	"nesting":          {"🪆", "🪹", "🪺"},
+	"net":              {"🥅"},
+	"netherlands":      {"🇧🇶", "🇳🇱"},
+	"neutral":          {"😏", "😐", "😑", "😒", "😬", "😮\u200d💨", "😶", "😶\u200d🌫️", "🙄", "🤐", "🤥", "🤨", "🧑", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑🏻", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏼", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏽", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏾", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏿", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🫥", "🫨"},
+	"nevis":            {"🇰🇳"},
+	"new":              {"🆕", "🇳🇨", "🇳🇿", "🇵🇬", "🌑", "🌚"},
+	"news":             {"📰", "🗞️"},
+	"newspaper":        {"📰", "🗞️"},
+	"next":             {"⏭️"},
+	"nf":               {"🇳🇫"},
+	"ng":               {"🆖", "🇳🇬"},
+	"ni":               {"🇳🇮"},
+	"nib":              {"✒️", "🔏"},
+	"nicaragua":        {"🇳🇮"},
+	"niger":            {"🇳🇪"},
+	"nigeria":          {"🇳🇬"},
+	"night":            {"🌃", "🌉", "💤", "😪", "😴", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿"},
+	"nine":             {"🕘", "🕤"},
+	"ninja":            {"🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿"},
+	"niue":             {"🇳🇺"},
+	"nl":               {"🇳🇱"},
+	"no":               {"⛔", "🇳🇴", "🈵", "📵", "🔞", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙈", "🙉", "🙊", "🚫", "🚭", "🚯", "🚳", "🚷"},
+	"noble":            {"🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"non":              {"🚱"},
+	"noodle":           {"🍜"},
+	"norfolk":          {"🇳🇫"},
+	"north":            {"⬆️", "🇰🇵", "🇲🇰"},
+	"northeast":        {"↗️"},
+	"northern":         {"🇲🇵"},
+	"northwest":        {"↖️"},
+	"norway":           {"🇳🇴"},
+	"nose":             {"🐽", "👃", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "😤", "🥸"},
+	"not":              {"⏳", "🈶", "🚫", "🚯", "🚷"},
+	"note":             {"🎵", "🎶", "💴", "💵", "💶", "💷", "🗒️"},
+	"notebook":         {"📒", "📓", "📔"},
+	"notepad":          {"🗒️"},
+	"notes":            {"🎶"},
+	"np":               {"🇳🇵"},
+	"nr":               {"🇳🇷"},
+	"nu":               {"🇳🇺"},
+	"numbers":          {"🔢"},
+	"nurse":            {"👨\u200d⚕️", "👨🏻\u200d⚕️", "👨🏼\u200d⚕️", "👨🏽\u200d⚕️", "👨🏾\u200d⚕️", "👨🏿\u200d⚕️", "👩\u200d⚕️", "👩🏻\u200d⚕️", "👩🏼\u200d⚕️", "👩🏽\u200d⚕️", "👩🏾\u200d⚕️", "👩🏿\u200d⚕️", "🧑\u200d⚕️", "🧑🏻\u200d⚕️", "🧑🏼\u200d⚕️", "🧑🏽\u200d⚕️", "🧑🏾\u200d⚕️", "🧑🏿\u200d⚕️"},
+	"nursing":          {"👨\u200d🍼", "👨🏻\u200d🍼", "👨🏼\u200d🍼", "👨🏽\u200d🍼", "👨🏾\u200d🍼", "👨🏿\u200d🍼", "👩\u200d🍼", "👩🏻\u200d🍼", "👩🏼\u200d🍼", "👩🏽\u200d🍼", "👩🏾\u200d🍼", "👩🏿\u200d🍼", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🧑\u200d🍼", "🧑🏻\u200d🍼", "🧑🏼\u200d🍼", "🧑🏽\u200d🍼", "🧑🏾\u200d🍼", "🧑🏿\u200d🍼"},
+	"nurturing":        {"🪴"},
+	"nut":              {"🔩", "🥜"},
+	"nz":               {"🇳🇿"},
+	"o":                {"🅾️", "🎃", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛"},
+	"oberon":           {"🧚", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♂️"},
+	"object":           {"⚰️", "⚱️", "🗿", "🚬", "🧿", "🪦", "🪧", "🪪", "🪬"},
+	"objects":          {"⌨️", "☎️", "⚒️", "⚔️", "⚖️", "⚗️", "⚙️", "⚰️", "⚱️", "⛏️", "⛑️", "⛓️", "✂️", "✉️", "✏️", "✒️", "🎒", "🎓", "🎙️", "🎚️", "🎛️", "🎞️", "🎤", "🎥", "🎧", "🎩", "🎬", "🎵", "🎶", "🎷", "🎸", "🎹", "🎺", "🎻", "🎼", "🏮", "🏷️", "🏹", "👑", "👒", "👓", "👔", "👕", "👖", "👗", "👘", "👙", "👚", "👛", "👜", "👝", "👞", "👟", "👠", "👡", "👢", "💄", "💉", "💊", "💍", "💎", "💡", "💣", "💰", "💳", "💴", "💵", "💶", "💷", "💸", "💹", "💻", "💼", "💽", "💾", "💿", "📀", "📁", "📂", "📃", "📄", "📅", "📆", "📇", "📈", "📉", "📊", "📋", "📌", "📍", "📎", "📏", "📐", "📑", "📒", "📓", "📔", "📕", "📖", "📗", "📘", "📙", "📚", "📜", "📝", "📞", "📟", "📠", "📡", "📢", "📣", "📤", "📥", "📦", "📧", "📨", "📩", "📪", "📫", "📬", "📭", "📮", "📯", "📰", "📱", "📲", "📷", "📸", "📹", "📺", "📻", "📼", "📽️", "📿", "🔇", "🔈", "🔉", "🔊", "🔋", "🔌", "🔍", "🔎", "🔏", "🔐", "🔑", "🔒", "🔓", "🔔", "🔕", "🔖", "🔗", "🔦", "🔧", "🔨", "🔩", "🔬", "🔭", "🕯️", "🕶️", "🖇️", "🖊️", "🖋️", "🖌️", "🖍️", "🖥️", "🖨️", "🖱️", "🖲️", "🗂️", "🗃️", "🗄️", "🗑️", "🗒️", "🗓️", "🗜️", "🗝️", "🗞️", "🗡️", "🗳️", "🗿", "🚪", "🚬", "🚽", "🚿", "🛁", "🛋️", "🛍️", "🛏️", "🛒", "🛗", "🛠️", "🛡️", "🥁", "🥻", "🥼", "🥽", "🥾", "🥿", "🦯", "🦺", "🧢", "🧣", "🧤", "🧥", "🧦", "🧪", "🧫", "🧬", "🧮", "🧯", "🧰", "🧲", "🧴", "🧷", "🧹", "🧺", "🧻", "🧼", "🧽", "🧾", "🧿", "🩰", "🩱", "🩲", "🩳", "🩴", "🩸", "🩹", "🩺", "🩻", "🩼", "🪃", "🪇", "🪈", "🪑", "🪒", "🪓", "🪔", "🪕", "🪖", "🪗", "🪘", "🪙", "🪚", "🪛", "🪜", "🪝", "🪞", "🪟", "🪠", "🪣", "🪤", "🪥", "🪦", "🪧", "🪪", "🪫", "🪬", "🪭", "🪮", "🫧"},
+	"ocean":            {"🇮🇴", "🌊", "🪸"},
+	"octagonal":        {"🛑"},
+	"octopus":          {"🐙"},
+	"oden":             {"🍢"},
+	"of":               {"☸️", "✡️", "🇮🇲", "🈚", "🈶", "🌽", "🌾", "🍲", "💩", "🗽", "🗾", "😂", "😹", "🛐", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🥘", "🥛", "🥩", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🧻", "🩸"},
+	"off":              {"📆", "📴"},
+	"offer":            {"🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"office":           {"✂️", "🏢", "🏣", "🏤", "👨\u200d💼", "👨🏻\u200d💼", "👨🏼\u200d💼", "👨🏽\u200d💼", "👨🏾\u200d💼", "👨🏿\u200d💼", "👩\u200d💼", "👩🏻\u200d💼", "👩🏼\u200d💼", "👩🏽\u200d💼", "👩🏾\u200d💼", "👩🏿\u200d💼", "💼", "📁", "📂", "📅", "📆", "📇", "📈", "📉", "📊", "📋", "📌", "📍", "📎", "📏", "📐", "🖇️", "🗂️", "🗃️", "🗄️", "🗑️", "🗒️", "🗓️", "🧑\u200d💼", "🧑🏻\u200d💼", "🧑🏼\u200d💼", "🧑🏽\u200d💼", "🧑🏾\u200d💼", "🧑🏿\u200d💼"},
+	"officer":          {"👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️"},
+	"ogre":             {"👹"},
+	"oh":               {"🙀"},
+	"oil":              {"🛢️", "🪔"},
+	"ok":               {"🆗", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🫡"},
+	"old":              {"👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "🗝️", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿"},
+	"older":            {"🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿"},
+	"olive":            {"🫒"},
+	"om":               {"🇴🇲", "🕉️"},
+	"oman":             {"🇴🇲"},
+	"on":               {"⛱️", "❤️\u200d🔥", "🍖", "🔛", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤣", "🤬", "🥿"},
+	"once":             {"🔂"},
+	"oncoming":         {"👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "🚍", "🚔", "🚖", "🚘"},
+	"one":              {"🔞", "🕐", "🕜", "🩱", "🩲"},
+	"onion":            {"🧅"},
+	"open":             {"✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "🈺", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "📂", "📖", "📬", "📭", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "😃", "😄", "😅", "😦", "😮", "😺", "🤗", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🫢", "🫱", "🫱🏻", "🫱🏼", "🫱🏽", "🫱🏾", "🫱🏿", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"opening":          {"🪟"},
+	"ophiuchus":        {"⛎", "🐍"},
+	"optical":          {"💽", "💿", "📀"},
+	"or":               {"⏯️"},
+	"orange":           {"🍊", "📙", "🔶", "🔸", "🟠", "🟧", "🧡"},
+	"orangutan":        {"🦧"},
+	"organ":            {"🫀", "🫁"},
+	"orienteering":     {"🧭"},
+	"orthodox":         {"☦️"},
+	"ostentatious":     {"🦚"},
+	"other":            {"©️", "®️", "™️", "☑️", "☘️", "♨️", "♻️", "⚕️", "⚜️", "⚰️", "⚱️", "⛲", "⛺", "✅", "✔️", "✳️", "✴️", "❇️", "❌", "❎", "➰", "➿", "⭕", "〽️", "🌁", "🌃", "🌄", "🌅", "🌆", "🌇", "🌉", "🌱", "🌲", "🌳", "🌴", "🌵", "🌾", "🌿", "🍀", "🍁", "🍂", "🍃", "🍄", "🎠", "🎡", "🎢", "🎪", "🏙️", "💈", "📛", "🔰", "🔱", "🗿", "🚬", "🛝", "🧿", "🪦", "🪧", "🪪", "🪬", "🪴", "🪹", "🪺"},
+	"otter":            {"🦦"},
+	"out":              {"😵"},
+	"outbox":           {"📤"},
+	"outgoing":         {"📩"},
+	"outlined":         {"☺️", "❔", "❕", "🔳"},
+	"outlying":         {"🇺🇲"},
+	"over":             {"🌄", "🤭", "🫢"},
+	"owl":              {"🦉"},
+	"ox":               {"🐂"},
+	"oyster":           {"🥡", "🦪"},
+	"p":                {"🅿️"},
+	"pa":               {"🇵🇦"},
+	"package":          {"📦"},
+	"packing":          {"🧳"},
+	"pad":              {"🗒️", "🗓️"},
+	"paddle":           {"🏓"},
+	"paella":           {"🥘"},
+	"page":             {"📃", "📄"},
+	"pager":            {"📟"},
+	"pail":             {"🥡", "🪣"},
+	"paintbrush":       {"🖌️"},
+	"painting":         {"🎨", "🖌️", "🖼️"},
+	"pakistan":         {"🇵🇰"},
+	"palau":            {"🇵🇼"},
+	"palestinian":      {"🇵🇸"},
+	"palette":          {"🎨", "👨\u200d🎨", "👨🏻\u200d🎨", "👨🏼\u200d🎨", "👨🏽\u200d🎨", "👨🏾\u200d🎨", "👨🏿\u200d🎨", "👩\u200d🎨", "👩🏻\u200d🎨", "👩🏼\u200d🎨", "👩🏽\u200d🎨", "👩🏾\u200d🎨", "👩🏿\u200d🎨", "🧑\u200d🎨", "🧑🏻\u200d🎨", "🧑🏼\u200d🎨", "🧑🏽\u200d🎨", "🧑🏾\u200d🎨", "🧑🏿\u200d🎨"},
+	"palm":             {"🌴", "🤦", "🤦🏻", "🤦🏼", "🤦🏽", "🤦🏾", "🤦🏿", "🥥", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"palms":            {"🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿"},
+	"pan":              {"🍳", "🥘"},
+	"panama":           {"🇵🇦"},
+	"pancake":          {"🥞"},
+	"pancakes":         {"🥞"},
+	"panda":            {"🐼"},
+	"pants":            {"👖", "🩳"},
+	"paper":            {"🏮", "🏷️", "📃", "📄", "📑", "📒", "📓", "📔", "📕", "📖", "📗", "📘", "📙", "📚", "📜", "📰", "🔖", "🗞️", "🧻"},
+	"paperclip":        {"📎", "🖇️"},
+	"paperclips":       {"🖇️"},
+	"papua":            {"🇵🇬"},
+	"parachute":        {"🪂"},
+	"paraguay":         {"🇵🇾"},
+	"parasail":         {"🪂"},
+	"parasite":         {"🪱"},
+	"park":             {"🎡", "🎢", "🏞️", "🛝"},
+	"parking":          {"🅿️"},
+	"parlor":           {"💇", "💇🏻", "💇🏼", "💇🏽", "💇🏾", "💇🏿"},
+	"parrot":           {"🦜"},
+	"part":             {"〽️"},
+	"partial":          {"✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"parts":            {"👀", "👁️", "👂", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👄", "👅", "💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "🦴", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦷", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🦾", "🦿", "🧠", "🫀", "🫁", "🫦"},
+	"party":            {"🎉", "🥳", "🪅", "🪩"},
+	"partying":         {"👯", "👯\u200d♀️", "👯\u200d♂️", "🥳"},
+	"passenger":        {"⛴️", "🚢", "🛳️"},
+	"passing":          {"🈴"},
+	"passport":         {"🛂"},
+	"password":         {"🔑"},
+	"pasta":            {"🍝"},
+	"pastry":           {"🍥", "🍰", "🎂", "🥧"},
+	"patrol":           {"🚓"},
+	"pause":            {"⏯️", "⏸️"},
+	"paw":              {"🐾"},
+	"pawn":             {"♟️"},
+	"pe":               {"🇵🇪"},
+	"pea":              {"🫛"},
+	"peace":            {"☮️", "🕊️"},
+	"peach":            {"🍑"},
+	"peacock":          {"🦚"},
+	"peahen":           {"🦚"},
+	"peanut":           {"🥜"},
+	"peanuts":          {"🥜"},
+	"pear":             {"🍐"},
+	"pearl":            {"🦪", "🧋"},
+	"pedestrian":       {"🚷", "🚸"},
+	"pedestrians":      {"🚷"},
+	"peeking":          {"🫣"},
+	"peep":             {"🫣"},
+	"pen":              {"✒️", "🔏", "🖊️", "🖋️"},
+	"pencil":           {"✏️", "📝"},
+	"penguin":          {"🐧"},
+	"pensive":          {"😔"},
+	"people":           {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "⛷️", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👀", "👁️", "👂", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👄", "👅", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👣", "👤", "👥", "👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨", "👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦽", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👪", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👯", "👯\u200d♀️", "👯\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💏", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🗣️", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤺", "🤼", "🤼\u200d♀️", "🤼\u200d♂️", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🦴", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦷", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🦾", "🦿", "🧌", "🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑", "🧑\u200d⚕️", "🧑\u200d⚖️", "🧑\u200d✈️", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎄", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑\u200d🤝\u200d🧑", "🧑\u200d🦯", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔", "🧔\u200d♀️", "🧔\u200d♂️", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜", "🧜\u200d♀️", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🧞", "🧞\u200d♀️", "🧞\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️", "🧠", "🫀", "🫁", "🫂", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿", "🫦", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿", "🫶", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿", "🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"pepper":           {"🌶️", "🫑"},
+	"performing":       {"🎭"},
+	"persevere":        {"😣"},
+	"persevering":      {"😣"},
+	"person":           {"⛷️", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👣", "👤", "👥", "👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨", "👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦽", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👯", "👯\u200d♀️", "👯\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🗣️", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤺", "🤼", "🤼\u200d♀️", "🤼\u200d♂️", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🧌", "🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑", "🧑\u200d⚕️", "🧑\u200d⚖️", "🧑\u200d✈️", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎄", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑\u200d🤝\u200d🧑", "🧑\u200d🦯", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔", "🧔\u200d♀️", "🧔\u200d♂️", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜", "🧜\u200d♀️", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🧞", "🧞\u200d♀️", "🧞\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️", "🫂", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"peru":             {"🇵🇪"},
+	"pest":             {"🦟", "🪰", "🪳"},
+	"pester":           {"🦡"},
+	"pet":              {"🐇", "🐰", "🐱", "🐶", "🐹"},
+	"petri":            {"🧫"},
+	"pf":               {"🇵🇫"},
+	"pg":               {"🇵🇬"},
+	"ph":               {"🇵🇭"},
+	"philippines":      {"🇵🇭"},
+	"phone":            {"☎️", "📞", "📟", "📠", "📱", "📲", "📳", "📴", "📵", "📶", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿"},
+	"phones":           {"📵"},
+	"physicist":        {"👨\u200d🔬", "👨🏻\u200d🔬", "👨🏼\u200d🔬", "👨🏽\u200d🔬", "👨🏾\u200d🔬", "👨🏿\u200d🔬", "👩\u200d🔬", "👩🏻\u200d🔬", "👩🏼\u200d🔬", "👩🏽\u200d🔬", "👩🏾\u200d🔬", "👩🏿\u200d🔬", "🧑\u200d🔬", "🧑🏻\u200d🔬", "🧑🏼\u200d🔬", "🧑🏽\u200d🔬", "🧑🏾\u200d🔬", "🧑🏿\u200d🔬"},
+	"pi":               {"👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿"},
+	"piano":            {"🎹"},
+	"pick":             {"⚒️", "⛏️", "🛻", "🪮"},
+	"picket":           {"🪧"},
+	"pickle":           {"🥒"},
+	"pickup":           {"🛻"},
+	"picnic":           {"🧺"},
+	"picture":          {"🖼️"},
+	"pie":              {"🥧"},
+	"piece":            {"🧩", "🩱", "🩲"},
+	"pierogi":          {"🥟"},
+	"pierre":           {"🇵🇲"},
+	"pig":              {"🐖", "🐗", "🐷", "🐽"},
+	"pile":             {"💩"},
+	"pill":             {"💊"},
+	"pilot":            {"👨\u200d✈️", "👨🏻\u200d✈️", "👨🏼\u200d✈️", "👨🏽\u200d✈️", "👨🏾\u200d✈️", "👨🏿\u200d✈️", "👩\u200d✈️", "👩🏻\u200d✈️", "👩🏼\u200d✈️", "👩🏽\u200d✈️", "👩🏾\u200d✈️", "👩🏿\u200d✈️", "🧑\u200d✈️", "🧑🏻\u200d✈️", "🧑🏼\u200d✈️", "🧑🏽\u200d✈️", "🧑🏾\u200d✈️", "🧑🏿\u200d✈️"},
+	"pin":              {"📌", "📍", "🧷"},
+	"pinched":          {"🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿"},
+	"pinching":         {"🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿"},
+	"pine":             {"🎍"},
+	"pineapple":        {"🍍"},
+	"ping":             {"🏓"},
+	"pink":             {"🏳️\u200d⚧️", "🩷"},
+	"pinocchio":        {"🤥"},
+	"pirate":           {"🏴\u200d☠️", "🦜"},
+	"pisces":           {"♓"},
+	"pistol":           {"🔫"},
+	"pita":             {"🫓"},
+	"pitcairn":         {"🇵🇳"},
+	"pizza":            {"🍕"},
+	"piña":             {"🥥"},
+	"piñata":           {"🪅"},
+	"pk":               {"🇵🇰"},
+	"pl":               {"🇵🇱"},
+	"placard":          {"🪧"},
+	"place":            {"♨️", "⛩️", "⛪", "⛰️", "⛲", "⛺", "🌁", "🌃", "🌄", "🌅", "🌆", "🌇", "🌉", "🌋", "🌍", "🌎", "🌏", "🌐", "🎠", "🎡", "🎢", "🎪", "🏔️", "🏕️", "🏖️", "🏗️", "🏘️", "🏙️", "🏚️", "🏛️", "🏜️", "🏝️", "🏞️", "🏟️", "🏠", "🏡", "🏢", "🏣", "🏤", "🏥", "🏦", "🏨", "🏩", "🏪", "🏫", "🏬", "🏭", "🏯", "🏰", "💈", "💒", "🕋", "🕌", "🕍", "🗺️", "🗻", "🗼", "🗽", "🗾", "🛐", "🛕", "🛖", "🛝", "🥇", "🥈", "🥉", "🧭", "🧱", "🪨", "🪵"},
+	"places":           {"⌚", "⌛", "⏰", "⏱️", "⏲️", "⏳", "☀️", "☁️", "☂️", "☃️", "☄️", "☔", "♨️", "⚓", "⚡", "⛄", "⛅", "⛈️", "⛩️", "⛪", "⛰️", "⛱️", "⛲", "⛴️", "⛵", "⛺", "⛽", "✈️", "❄️", "⭐", "🌀", "🌁", "🌂", "🌃", "🌄", "🌅", "🌆", "🌇", "🌈", "🌉", "🌊", "🌋", "🌌", "🌍", "🌎", "🌏", "🌐", "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🌞", "🌟", "🌠", "🌡️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️", "🎠", "🎡", "🎢", "🎪", "🏍️", "🏎️", "🏔️", "🏕️", "🏖️", "🏗️", "🏘️", "🏙️", "🏚️", "🏛️", "🏜️", "🏝️", "🏞️", "🏟️", "🏠", "🏡", "🏢", "🏣", "🏤", "🏥", "🏦", "🏨", "🏩", "🏪", "🏫", "🏬", "🏭", "🏯", "🏰", "💈", "💒", "💧", "💺", "🔥", "🕋", "🕌", "🕍", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛", "🕜", "🕝", "🕞", "🕟", "🕠", "🕡", "🕢", "🕣", "🕤", "🕥", "🕦", "🕧", "🕰️", "🗺️", "🗻", "🗼", "🗽", "🗾", "🚀", "🚁", "🚂", "🚃", "🚄", "🚅", "🚆", "🚇", "🚈", "🚉", "🚊", "🚋", "🚌", "🚍", "🚎", "🚏", "🚐", "🚑", "🚒", "🚓", "🚔", "🚕", "🚖", "🚗", "🚘", "🚙", "🚚", "🚛", "🚜", "🚝", "🚞", "🚟", "🚠", "🚡", "🚢", "🚤", "🚥", "🚦", "🚧", "🚨", "🚲", "🛎️", "🛑", "🛕", "🛖", "🛝", "🛞", "🛟", "🛢️", "🛣️", "🛤️", "🛥️", "🛩️", "🛫", "🛬", "🛰️", "🛳️", "🛴", "🛵", "🛶", "🛸", "🛹", "🛺", "🛻", "🛼", "🦼", "🦽", "🧭", "🧱", "🧳", "🪂", "🪐", "🪨", "🪵"},
+	"plane":            {"👨\u200d✈️", "👨🏻\u200d✈️", "👨🏼\u200d✈️", "👨🏽\u200d✈️", "👨🏾\u200d✈️", "👨🏿\u200d✈️", "👩\u200d✈️", "👩🏻\u200d✈️", "👩🏼\u200d✈️", "👩🏽\u200d✈️", "👩🏾\u200d✈️", "👩🏿\u200d✈️", "🧑\u200d✈️", "🧑🏻\u200d✈️", "🧑🏼\u200d✈️", "🧑🏽\u200d✈️", "🧑🏾\u200d✈️", "🧑🏿\u200d✈️"},
+	"planet":           {"🪐"},
+	"plant":            {"☘️", "🌰", "🌱", "🌲", "🌳", "🌴", "🌵", "🌷", "🌸", "🌹", "🌺", "🌻", "🌼", "🌾", "🌿", "🍀", "🍁", "🍂", "🍃", "🍄", "🏵️", "💐", "💮", "🥀", "🪴", "🪷", "🪹", "🪺", "🪻"},
+	"plate":            {"🍽️"},
+	"play":             {"⏯️", "▶️", "🛝"},
+	"playful":          {"🦦"},
+	"playground":       {"🛝"},
+	"playing":          {"🎴", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️"},
+	"plaything":        {"🧸"},
+	"pleading":         {"🥺"},
+	"please":           {"🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿"},
+	"plug":             {"🔌"},
+	"plumage":          {"🪶"},
+	"plumber":          {"👨\u200d🔧", "👨🏻\u200d🔧", "👨🏼\u200d🔧", "👨🏽\u200d🔧", "👨🏾\u200d🔧", "👨🏿\u200d🔧", "👩\u200d🔧", "👩🏻\u200d🔧", "👩🏼\u200d🔧", "👩🏽\u200d🔧", "👩🏾\u200d🔧", "👩🏿\u200d🔧", "🧑\u200d🔧", "🧑🏻\u200d🔧", "🧑🏼\u200d🔧", "🧑🏽\u200d🔧", "🧑🏾\u200d🔧", "🧑🏿\u200d🔧", "🪠"},
+	"plunder":          {"🏴\u200d☠️"},
+	"plunger":          {"🪠"},
+	"plus":             {"➕"},
+	"plush":            {"🧸"},
+	"pm":               {"🇵🇲"},
+	"pn":               {"🇵🇳"},
+	"pod":              {"🫛"},
+	"point":            {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🪝", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"pointed":          {"✴️", "🔯", "🔺", "🔻"},
+	"pointing":         {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"points":           {"💯"},
+	"poland":           {"🇵🇱"},
+	"polar":            {"🐻\u200d❄️"},
+	"pole":             {"🎣", "💈"},
+	"police":           {"👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "🚓", "🚔", "🚨"},
+	"polish":           {"💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿"},
+	"polo":             {"🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️"},
+	"polynesia":        {"🇵🇫"},
+	"pong":             {"🏓"},
+	"poo":              {"💩"},
+	"poodle":           {"🐩"},
+	"pool":             {"🎱"},
+	"poop":             {"💩"},
+	"popcorn":          {"🍿"},
+	"popper":           {"🎉"},
+	"popping":          {"🍾"},
+	"porkchop":         {"🥩"},
+	"porous":           {"🧽"},
+	"portugal":         {"🇵🇹"},
+	"position":         {"🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️"},
+	"post":             {"🏣", "🏤", "📯", "🚩"},
+	"postal":           {"📯"},
+	"postbox":          {"📮"},
+	"pot":              {"🍯", "🍲", "🫕", "🫖"},
+	"potable":          {"🚰", "🚱"},
+	"potato":           {"🍠", "🥔"},
+	"potsticker":       {"🥟"},
+	"potted":           {"🪴"},
+	"pouch":            {"👝"},
+	"poultry":          {"🍗"},
+	"pound":            {"💷"},
+	"pouring":          {"🫗"},
+	"pouting":          {"😡", "😾", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️"},
+	"pr":               {"🇵🇷"},
+	"prawn":            {"🍤"},
+	"pray":             {"🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿"},
+	"prayer":           {"📿", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿"},
+	"pregnant":         {"🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿"},
+	"prepared":         {"🌭", "🌮", "🌯", "🍔", "🍕", "🍖", "🍗", "🍞", "🍟", "🍲", "🍳", "🍿", "🥐", "🥓", "🥖", "🥗", "🥘", "🥙", "🥚", "🥞", "🥣", "🥨", "🥩", "🥪", "🥫", "🥯", "🧀", "🧂", "🧆", "🧇", "🧈", "🫓", "🫔", "🫕"},
+	"present":          {"🎁"},
+	"preserver":        {"🛟"},
+	"pretty":           {"🦋"},
+	"pretzel":          {"🥨"},
+	"previous":         {"⏮️"},
+	"pride":            {"🏳️\u200d🌈"},
+	"prince":           {"🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿"},
+	"princess":         {"👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿"},
+	"print":            {"🐾", "👣"},
+	"printer":          {"🖨️"},
+	"prints":           {"🐾"},
+	"privacy":          {"🔏"},
+	"professor":        {"👨\u200d🏫", "👨🏻\u200d🏫", "👨🏼\u200d🏫", "👨🏽\u200d🏫", "👨🏾\u200d🏫", "👨🏿\u200d🏫", "👩\u200d🏫", "👩🏻\u200d🏫", "👩🏼\u200d🏫", "👩🏽\u200d🏫", "👩🏾\u200d🏫", "👩🏿\u200d🏫", "🧑\u200d🏫", "🧑🏻\u200d🏫", "🧑🏼\u200d🏫", "🧑🏽\u200d🏫", "🧑🏾\u200d🏫", "🧑🏿\u200d🏫"},
+	"prohibited":       {"🈲", "🔞", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🚫", "🚯", "🚳", "🚷"},
+	"projector":        {"📽️"},
+	"proof":            {"🧾"},
+	"prop":             {"✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿"},
+	"prophecy":         {"🥠"},
+	"prosthetic":       {"🦾", "🦿"},
+	"protection":       {"🥽", "🪬"},
+	"protest":          {"🪧"},
+	"proud":            {"🥲", "🥹", "🦚"},
+	"príncipe":         {"🇸🇹"},
+	"ps":               {"🇵🇸"},
+	"pt":               {"🇵🇹"},
+	"public":           {"📢"},
+	"puck":             {"🏒", "🧚", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♂️"},
+	"pudding":          {"🍮"},
+	"puerto":           {"🇵🇷"},
+	"puke":             {"🤮"},
+	"pulsating":        {"💓"},
+	"pulse":            {"💗", "🫀"},
+	"pump":             {"⛽"},
+	"punch":            {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿"},
+	"punctuation":      {"‼️", "⁉️", "❓", "❔", "❕", "❗", "❣️", "〰️"},
+	"punk":             {"🧷"},
+	"puppy":            {"🥺"},
+	"purity":           {"🪷"},
+	"purple":           {"💜", "🟣", "🟪"},
+	"purse":            {"👛", "👜"},
+	"pushing":          {"🫷", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"pushpin":          {"📌", "📍"},
+	"puzzle":           {"🧩"},
+	"pw":               {"🇵🇼"},
+	"py":               {"🇵🇾"},
+	"qa":               {"🇶🇦"},
+	"qatar":            {"🇶🇦"},
+	"quarter":          {"🌓", "🌗", "🌛", "🌜"},
+	"queen":            {"👑"},
+	"quench":           {"🧯"},
+	"question":         {"⁉️", "❓", "❔"},
+	"quiet":            {"🔇", "🔕", "😶", "🤫"},
+	"r":                {"®️"},
+	"rabbit":           {"🐇", "🐰"},
+	"raccoon":          {"🦝"},
+	"racehorse":        {"🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🐎"},
+	"racing":           {"🏁", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏍️", "🏎️", "🐎"},
+	"racquet":          {"🎾", "🏸"},
+	"radio":            {"📻", "🔘"},
+	"radioactive":      {"☢️"},
+	"rage":             {"😡"},
+	"rail":             {"🚈"},
+	"railway":          {"🚂", "🚃", "🚄", "🚅", "🚆", "🚈", "🚉", "🚞", "🚟", "🛤️"},
+	"rain":             {"☂️", "☔", "⛈️", "⛱️", "🌂", "🌈", "🌦️", "🌧️"},
+	"rainbow":          {"🌈", "🏳️\u200d🌈"},
+	"raised":           {"✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "📫", "📬", "🙋", "🙋🏻", "🙋🏼", "🙋🏽", "🙋🏾", "🙋🏿", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤨"},
+	"raising":          {"🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿"},
+	"ram":              {"🐏"},
+	"ramen":            {"🍜"},
+	"rancher":          {"👨\u200d🌾", "👨🏻\u200d🌾", "👨🏼\u200d🌾", "👨🏽\u200d🌾", "👨🏾\u200d🌾", "👨🏿\u200d🌾", "👩\u200d🌾", "👩🏻\u200d🌾", "👩🏼\u200d🌾", "👩🏽\u200d🌾", "👩🏾\u200d🌾", "👩🏿\u200d🌾", "🧑\u200d🌾", "🧑🏻\u200d🌾", "🧑🏼\u200d🌾", "🧑🏽\u200d🌾", "🧑🏾\u200d🌾", "🧑🏿\u200d🌾"},
+	"rat":              {"🐀"},
+	"ray":              {"📀", "🩻"},
+	"rays":             {"☀️"},
+	"razor":            {"🪒"},
+	"rd":               {"🥉"},
+	"re":               {"🇷🇪"},
+	"rebound":          {"🪃"},
+	"receipt":          {"🧾"},
+	"receive":          {"📨", "📲"},
+	"receiver":         {"📞"},
+	"record":           {"⏺️"},
+	"recovering":       {"❤️\u200d🩹"},
+	"recreational":     {"🚙"},
+	"recuperating":     {"❤️\u200d🩹"},
+	"recycle":          {"♻️"},
+	"recycling":        {"♻️"},
+	"red":              {"❓", "❗", "❤️", "⭕", "🀄", "🍎", "🍒", "🏮", "👨\u200d🦰", "👨🏻\u200d🦰", "👨🏼\u200d🦰", "👨🏽\u200d🦰", "👨🏾\u200d🦰", "👨🏿\u200d🦰", "👩\u200d🦰", "👩🏻\u200d🦰", "👩🏼\u200d🦰", "👩🏽\u200d🦰", "👩🏾\u200d🦰", "👩🏿\u200d🦰", "🔴", "🔺", "🔻", "🔼", "🔽", "😡", "🟥", "🥵", "🧑\u200d🦰", "🧑🏻\u200d🦰", "🧑🏼\u200d🦰", "🧑🏽\u200d🦰", "🧑🏾\u200d🦰", "🧑🏿\u200d🦰", "🧧"},
+	"reef":             {"🪸"},
+	"reflection":       {"🪞"},
+	"reflector":        {"🪞"},
+	"regal":            {"🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"registered":       {"®️"},
+	"relaxed":          {"☺️"},
+	"relief":           {"😮\u200d💨"},
+	"relieved":         {"😌", "😥", "🥲"},
+	"religion":         {"☦️", "☪️", "☮️", "☯️", "☸️", "⚛️", "⛩️", "✝️", "✡️", "📿", "🔯", "🕉️", "🕋", "🕌", "🕍", "🕎", "🛐", "🪯"},
+	"religious":        {"⛩️", "⛪", "🕋", "🕌", "🕍", "🛕"},
+	"reload":           {"🔃"},
+	"reminder":         {"🎗️"},
+	"repeat":           {"🔁", "🔂"},
+	"repercussion":     {"🪃"},
+	"reptile":          {"🐉", "🐊", "🐍", "🐢", "🐲", "🦎", "🦕", "🦖"},
+	"republic":         {"🇨🇫", "🇩🇴"},
+	"rescue":           {"⛑️", "🛟"},
+	"reserved":         {"🈯"},
+	"resist":           {"🥹"},
+	"respiration":      {"🫁"},
+	"resting":          {"🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️"},
+	"restriction":      {"🔞"},
+	"restroom":         {"🚻", "🚾"},
+	"reverse":          {"⏪", "◀️"},
+	"revolver":         {"🔫"},
+	"revolving":        {"💞", "🚨"},
+	"rex":              {"🦖"},
+	"rhinoceros":       {"🦏"},
+	"rhythm":           {"🪘"},
+	"ribbon":           {"🎀", "🎗️", "💝"},
+	"rica":             {"🇨🇷"},
+	"rice":             {"🌾", "🍘", "🍙", "🍚", "🍛"},
+	"rickshaw":         {"🛺"},
+	"rico":             {"🇵🇷"},
+	"right":            {"↔️", "↗️", "↘️", "↩️", "↪️", "⏯️", "▶️", "➡️", "⤴️", "⤵️", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🔎", "🗯️", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🫱", "🫱🏻", "🫱🏼", "🫱🏽", "🫱🏾", "🫱🏿"},
+	"rightward":        {"🫱", "🫱🏻", "🫱🏼", "🫱🏽", "🫱🏾", "🫱🏿"},
+	"rightwards":       {"🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🫱", "🫱🏻", "🫱🏼", "🫱🏽", "🫱🏾", "🫱🏿", "🫸", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"ring":             {"💍", "🛟"},
+	"ringed":           {"🪐"},
+	"ro":               {"🇷🇴"},
+	"roach":            {"🪳"},
+	"road":             {"🛣️"},
+	"roasted":          {"🍠"},
+	"robot":            {"🤖"},
+	"rock":             {"👨\u200d🎤", "👨🏻\u200d🎤", "👨🏼\u200d🎤", "👨🏽\u200d🎤", "👨🏾\u200d🎤", "👨🏿\u200d🎤", "👩\u200d🎤", "👩🏻\u200d🎤", "👩🏼\u200d🎤", "👩🏽\u200d🎤", "👩🏾\u200d🎤", "👩🏿\u200d🎤", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🥌", "🧑\u200d🎤", "🧑🏻\u200d🎤", "🧑🏼\u200d🎤", "🧑🏽\u200d🎤", "🧑🏾\u200d🎤", "🧑🏿\u200d🎤", "🧷", "🪨"},
+	"rocket":           {"👨\u200d🚀", "👨🏻\u200d🚀", "👨🏼\u200d🚀", "👨🏽\u200d🚀", "👨🏾\u200d🚀", "👨🏿\u200d🚀", "👩\u200d🚀", "👩🏻\u200d🚀", "👩🏼\u200d🚀", "👩🏽\u200d🚀", "👩🏾\u200d🚀", "👩🏿\u200d🚀", "🚀", "🧑\u200d🚀", "🧑🏻\u200d🚀", "🧑🏼\u200d🚀", "🧑🏽\u200d🚀", "🧑🏾\u200d🚀", "🧑🏿\u200d🚀"},
+	"rofl":             {"🤣"},
+	"roger":            {"🏴\u200d☠️"},
+	"role":             {"👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🧑\u200d⚕️", "🧑\u200d⚖️", "🧑\u200d✈️", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🫃", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"roll":             {"🥐", "🧻"},
+	"rolled":           {"🗞️"},
+	"roller":           {"🎢", "🛼"},
+	"rolling":          {"🙄", "🤣"},
+	"rolodex":          {"📇"},
+	"romance":          {"💒"},
+	"romania":          {"🇷🇴"},
+	"room":             {"🚹", "🚺", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️"},
+	"rooster":          {"🐓"},
+	"root":             {"🫚"},
+	"rope":             {"🪢"},
+	"rose":             {"🌹"},
+	"rosette":          {"🏵️"},
+	"rotfl":            {"🤣"},
+	"rotting":          {"🪰"},
+	"round":            {"📍"},
+	"roundhouse":       {"🛖"},
+	"rowboat":          {"🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️"},
+	"rowing":           {"🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️"},
+	"royalty":          {"🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿"},
+	"rs":               {"🇷🇸"},
+	"ru":               {"🇷🇺"},
+	"rucksack":         {"🎒"},
+	"rugby":            {"🏉"},
+	"ruler":            {"📏", "📐"},
+	"rung":             {"🪜"},
+	"running":          {"🎽", "🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "👟", "💨"},
+	"rushed":           {"😰"},
+	"russia":           {"🇷🇺", "🪆"},
+	"rw":               {"🇷🇼"},
+	"rwanda":           {"🇷🇼"},
+	"réunion":          {"🇷🇪"},
+	"s":                {"⛑️", "👒", "👚", "👞", "👡", "👢", "🚹", "🚺"},
+	"sa":               {"🇸🇦"},
+	"sacred":           {"❤️\u200d🔥"},
+	"sad":              {"😢", "😥", "😭", "😿", "🥹"},
+	"safety":           {"🛟", "🦺", "🧷"},
+	"sagittarius":      {"♐", "🏹"},
+	"sahara":           {"🇪🇭"},
+	"sailboat":         {"⛵"},
+	"sake":             {"🍶"},
+	"salad":            {"🥗"},
+	"salon":            {"💆", "💆🏻", "💆🏼", "💆🏽", "💆🏾", "💆🏿"},
+	"salt":             {"🧂"},
+	"salute":           {"🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🫡"},
+	"saluting":         {"🫡"},
+	"salvador":         {"🇸🇻"},
+	"samoa":            {"🇦🇸", "🇼🇸"},
+	"san":              {"🇸🇲"},
+	"sandal":           {"👡", "🩴"},
+	"sandals":          {"🩴"},
+	"sandwich":         {"🇬🇸", "🥪"},
+	"santa":            {"🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿"},
+	"sar":              {"🇭🇰", "🇲🇴"},
+	"sarcastic":        {"🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿"},
+	"sari":             {"🥻"},
+	"sash":             {"🎽"},
+	"sassy":            {"💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️"},
+	"satchel":          {"🎒"},
+	"satellite":        {"📡", "🛰️"},
+	"satisfied":        {"😆"},
+	"saturn":           {"🪐"},
+	"saturnine":        {"🪐"},
+	"sauce":            {"🫙"},
+	"saucer":           {"🛸"},
+	"saudi":            {"🇸🇦"},
+	"sauna":            {"🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️"},
+	"sauropod":         {"🦕"},
+	"sausage":          {"🌭"},
+	"saver":            {"🛟"},
+	"savoring":         {"😋"},
+	"savouring":        {"😋"},
+	"saw":              {"🪚"},
+	"sax":              {"🎷"},
+	"saxophone":        {"🎷"},
+	"sb":               {"🇸🇧"},
+	"sc":               {"🇸🇨"},
+	"scale":            {"⚖️"},
+	"scales":           {"👨\u200d⚖️", "👨🏻\u200d⚖️", "👨🏼\u200d⚖️", "👨🏽\u200d⚖️", "👨🏾\u200d⚖️", "👨🏿\u200d⚖️", "👩\u200d⚖️", "👩🏻\u200d⚖️", "👩🏼\u200d⚖️", "👩🏽\u200d⚖️", "👩🏾\u200d⚖️", "👩🏿\u200d⚖️", "🧑\u200d⚖️", "🧑🏻\u200d⚖️", "🧑🏼\u200d⚖️", "🧑🏽\u200d⚖️", "🧑🏾\u200d⚖️", "🧑🏿\u200d⚖️"},
+	"scared":           {"😨", "😱", "🫢"},
+	"scarf":            {"🧣"},
+	"scene":            {"⏭️", "⏮️"},
+	"schmear":          {"🥯"},
+	"school":           {"🎒", "🏫"},
+	"science":          {"⚗️", "📡", "🔬", "🔭", "🧪", "🧫", "🧬"},
+	"scientist":        {"👨\u200d🔬", "👨🏻\u200d🔬", "👨🏼\u200d🔬", "👨🏽\u200d🔬", "👨🏾\u200d🔬", "👨🏿\u200d🔬", "👩\u200d🔬", "👩🏻\u200d🔬", "👩🏼\u200d🔬", "👩🏽\u200d🔬", "👩🏾\u200d🔬", "👩🏿\u200d🔬", "🥼", "🧑\u200d🔬", "🧑🏻\u200d🔬", "🧑🏼\u200d🔬", "🧑🏽\u200d🔬", "🧑🏾\u200d🔬", "🧑🏿\u200d🔬"},
+	"scissors":         {"✂️"},
+	"scooter":          {"🛴", "🛵"},
+	"score":            {"🎼", "💯"},
+	"scorpio":          {"♏", "🦂"},
+	"scorpion":         {"🦂"},
+	"scotland":         {"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+	"scream":           {"😱"},
+	"screaming":        {"😱"},
+	"screw":            {"🪛"},
+	"screwdriver":      {"🪛"},
+	"scroll":           {"📜"},
+	"scuba":            {"🤿"},
+	"sd":               {"🇸🇩"},
+	"se":               {"🇸🇪"},
+	"sea":              {"🦭"},
+	"seafood":          {"🍢", "🦞"},
+	"seal":             {"🦭"},
+	"search":           {"🔎"},
+	"seat":             {"💺", "🪑"},
+	"second":           {"🥈"},
+	"secret":           {"㊙️"},
+	"secure":           {"🔐"},
+	"security":         {"🪪"},
+	"see":              {"🙈", "🧧"},
+	"seedling":         {"🌱"},
+	"selfie":           {"🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿"},
+	"selling":          {"🪝"},
+	"semi":             {"🚛"},
+	"senegal":          {"🇸🇳"},
+	"serbia":           {"🇷🇸"},
+	"serpent":          {"⛎", "🐍"},
+	"service":          {"🈂️", "🐕\u200d🦺"},
+	"set":              {"📐"},
+	"seven":            {"🕖", "🕢"},
+	"sewing":           {"🧵", "🪡"},
+	"seychelles":       {"🇸🇨"},
+	"sg":               {"🇸🇬"},
+	"sh":               {"🇸🇭"},
+	"shaka":            {"🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿"},
+	"shake":            {"🤝", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾"},
+	"shaker":           {"🧂"},
+	"shaking":          {"🫨"},
+	"shallow":          {"🥘"},
+	"shampoo":          {"🧴"},
+	"shamrock":         {"☘️"},
+	"shark":            {"🦈"},
+	"sharp":            {"🪒"},
+	"shave":            {"🪒"},
+	"shaved":           {"🍧"},
+	"sheaf":            {"🌾"},
+	"shedding":         {"🌳"},
+	"sheep":            {"🐏", "🐑"},
+	"shell":            {"🐚"},
+	"shellfish":        {"🦐"},
+	"shield":           {"🛡️"},
+	"shining":          {"🌟"},
+	"shinkansen":       {"🚄", "🚅"},
+	"shinto":           {"⛩️"},
+	"ship":             {"🔱", "🚢", "🛳️"},
+	"shirt":            {"🎽", "👕"},
+	"shocked":          {"😲", "🤯"},
+	"shoe":             {"👞", "👟", "👠", "👡", "👢", "🥿"},
+	"shoes":            {"🩰"},
+	"shoo":             {"🫳", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿"},
+	"shooting":         {"🌠"},
+	"shopping":         {"🛍️", "🛒"},
+	"shortcake":        {"🍰"},
+	"shorts":           {"🩳"},
+	"shot":             {"💉", "🥃"},
+	"shower":           {"🚿"},
+	"showing":          {"🌍", "🌎", "🌏"},
+	"shrimp":           {"🍤", "🦐"},
+	"shrine":           {"⛩️"},
+	"shrug":            {"🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️"},
+	"shrugging":        {"🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️"},
+	"shuffle":          {"🔀"},
+	"shush":            {"🤫"},
+	"shushing":         {"🤫"},
+	"shuttlecock":      {"🏸"},
+	"si":               {"🇸🇮"},
+	"sick":             {"💉", "💊", "😷", "🤒", "🤮"},
+	"sierra":           {"🇸🇱"},
+	"sign":             {"☢️", "☣️", "♀️", "♂️", "♿", "✖️", "➕", "➖", "➗", "🏧", "💲", "🚮", "🚰", "🚹", "🚺", "🚻", "🚼", "🚾", "🛂", "🛃", "🛄", "🛅", "🛑", "🟰", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🪧"},
+	"signal":           {"🚥", "🚦"},
+	"silent":           {"🔇", "🔕", "😶"},
+	"silhouette":       {"👤", "👥", "🗣️"},
+	"silver":           {"🥈", "🪙"},
+	"singapore":        {"🇸🇬"},
+	"singer":           {"👨\u200d🎤", "👨🏻\u200d🎤", "👨🏼\u200d🎤", "👨🏽\u200d🎤", "👨🏾\u200d🎤", "👨🏿\u200d🎤", "👩\u200d🎤", "👩🏻\u200d🎤", "👩🏼\u200d🎤", "👩🏽\u200d🎤", "👩🏾\u200d🎤", "👩🏿\u200d🎤", "🧑\u200d🎤", "🧑🏻\u200d🎤", "🧑🏼\u200d🎤", "🧑🏽\u200d🎤", "🧑🏾\u200d🎤", "🧑🏿\u200d🎤"},
+	"single":           {"☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "🔂", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"sint":             {"🇸🇽"},
+	"sit":              {"🪑"},
+	"six":              {"🔯", "🕕", "🕡"},
+	"sj":               {"🇸🇯"},
+	"sk":               {"🇸🇰"},
+	"skate":            {"⛸️", "🛼"},
+	"skateboard":       {"🛹"},
+	"skeleton":         {"🦴", "🩻"},
+	"skeptic":          {"🤨"},
+	"skeptical":        {"😏", "😐", "😑", "😒", "😬", "😮\u200d💨", "😶", "😶\u200d🌫️", "🙄", "🤐", "🤥", "🤨", "🫤", "🫥", "🫨"},
+	"skewer":           {"🍡", "🍢"},
+	"ski":              {"⛷️", "🎿", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿"},
+	"skier":            {"⛷️"},
+	"skill":            {"🤹", "🤹🏻", "🤹🏼", "🤹🏽", "🤹🏾", "🤹🏿"},
+	"skin":             {"☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"skis":             {"🎿"},
+	"skull":            {"☠️", "💀"},
+	"skullcap":         {"👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿"},
+	"skunk":            {"🦨"},
+	"sky":              {"☀️", "☁️", "☂️", "☃️", "☄️", "☔", "⚡", "⛄", "⛅", "⛈️", "⛱️", "❄️", "⭐", "🌀", "🌂", "🌈", "🌊", "🌌", "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🌞", "🌟", "🌠", "🌡️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️", "💧", "🔥", "🪐"},
+	"skydive":          {"🪂"},
+	"sl":               {"🇸🇱"},
+	"slash":            {"🔕"},
+	"sled":             {"🛷"},
+	"sledge":           {"🛷"},
+	"sleep":            {"💤", "😪", "😴", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🛏️"},
+	"sleeping":         {"😴"},
+	"sleepy":           {"😌", "😔", "😪", "😴", "🤤"},
+	"sleigh":           {"🛷"},
+	"sleuth":           {"🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️"},
+	"slice":            {"🍕", "🍰"},
+	"slide":            {"🛝"},
+	"slider":           {"🎚️"},
+	"slightly":         {"🙁", "🙂"},
+	"slip":             {"🥿"},
+	"slipper":          {"🥿"},
+	"slot":             {"🎰"},
+	"sloth":            {"🦥"},
+	"slovakia":         {"🇸🇰"},
+	"slovenia":         {"🇸🇮"},
+	"slow":             {"🦥"},
+	"sly":              {"🦝"},
+	"sm":               {"🇸🇲"},
+	"small":            {"▪️", "▫️", "◽", "◾", "🌤️", "🔸", "🔹", "🛩️", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤪", "🦐"},
+	"smile":            {"☺️", "😁", "😃", "😄", "😅", "😆", "😈", "😊", "😋", "😍", "😙", "😸", "😺", "😻", "😼", "🙂"},
+	"smileys":          {"☠️", "☹️", "☺️", "❣️", "❤️", "❤️\u200d🔥", "❤️\u200d🩹", "👁️\u200d🗨️", "👹", "👺", "👻", "👽", "👾", "👿", "💀", "💋", "💌", "💓", "💔", "💕", "💖", "💗", "💘", "💙", "💚", "💛", "💜", "💝", "💞", "💟", "💢", "💤", "💥", "💦", "💨", "💩", "💫", "💬", "💭", "💯", "🕳️", "🖤", "🗨️", "🗯️", "😀", "😁", "😂", "😃", "😄", "😅", "😆", "😇", "😈", "😉", "😊", "😋", "😌", "😍", "😎", "😏", "😐", "😑", "😒", "😓", "😔", "😕", "😖", "😗", "😘", "😙", "😚", "😛", "😜", "😝", "😞", "😟", "😠", "😡", "😢", "😣", "😤", "😥", "😦", "😧", "😨", "😩", "😪", "😫", "😬", "😭", "😮", "😮\u200d💨", "😯", "😰", "😱", "😲", "😳", "😴", "😵", "😵\u200d💫", "😶", "😶\u200d🌫️", "😷", "😸", "😹", "😺", "😻", "😼", "😽", "😾", "😿", "🙀", "🙁", "🙂", "🙃", "🙄", "🙈", "🙉", "🙊", "🤍", "🤎", "🤐", "🤑", "🤒", "🤓", "🤔", "🤕", "🤖", "🤗", "🤠", "🤡", "🤢", "🤣", "🤤", "🤥", "🤧", "🤨", "🤩", "🤪", "🤫", "🤬", "🤭", "🤮", "🤯", "🥰", "🥱", "🥲", "🥳", "🥴", "🥵", "🥶", "🥸", "🥹", "🥺", "🧐", "🧡", "🩵", "🩶", "🩷", "🫠", "🫡", "🫢", "🫣", "🫤", "🫥", "🫨"},
+	"smiling":          {"☺️", "😀", "😁", "😂", "😃", "😄", "😅", "😆", "😇", "😈", "😉", "😊", "😍", "😎", "😙", "😸", "😻", "🙂", "🙃", "🤗", "🤣", "🥰", "🥲", "🫠"},
+	"smirk":            {"😏"},
+	"smirking":         {"😏"},
+	"smoking":          {"🚬", "🚭"},
+	"sn":               {"🇸🇳"},
+	"snail":            {"🐌"},
+	"snake":            {"⛎", "🐍"},
+	"snap":             {"🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"snare":            {"🪤"},
+	"sneaker":          {"👟"},
+	"sneeze":           {"🤧"},
+	"sneezing":         {"🤧"},
+	"snorkeling":       {"🤿"},
+	"snow":             {"☃️", "⛄", "⛷️", "❄️", "🌨️", "🎿", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏔️"},
+	"snowboard":        {"🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿"},
+	"snowboarder":      {"🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿"},
+	"snowflake":        {"❄️"},
+	"snowman":          {"☃️", "⛄"},
+	"so":               {"🇸🇴"},
+	"soap":             {"🧼", "🫧"},
+	"soapdish":         {"🧼"},
+	"soar":             {"🪁"},
+	"sob":              {"😭"},
+	"soccer":           {"⚽"},
+	"socks":            {"🧦"},
+	"soda":             {"🥤"},
+	"soft":             {"🍦"},
+	"softball":         {"🥎"},
+	"software":         {"👨\u200d💻", "👨🏻\u200d💻", "👨🏼\u200d💻", "👨🏽\u200d💻", "👨🏾\u200d💻", "👨🏿\u200d💻", "👩\u200d💻", "👩🏻\u200d💻", "👩🏼\u200d💻", "👩🏽\u200d💻", "👩🏾\u200d💻", "👩🏿\u200d💻", "🧑\u200d💻", "🧑🏻\u200d💻", "🧑🏼\u200d💻", "🧑🏽\u200d💻", "🧑🏾\u200d💻", "🧑🏿\u200d💻"},
+	"soldier":          {"🪖"},
+	"solid":            {"🪨"},
+	"solomon":          {"🇸🇧"},
+	"somalia":          {"🇸🇴"},
+	"soon":             {"🔜"},
+	"sorcerer":         {"🧙", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♂️"},
+	"sorceress":        {"🧙", "🧙\u200d♀️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏿", "🧙🏿\u200d♀️"},
+	"sorry":            {"🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️"},
+	"sos":              {"🆘"},
+	"sound":            {"📢", "📣", "📯", "🔇", "🔈", "🔉", "🔊", "🔔", "🔕"},
+	"south":            {"⬇️", "🇬🇸", "🇰🇷", "🇸🇸", "🇿🇦"},
+	"southeast":        {"↘️"},
+	"southern":         {"🇹🇫"},
+	"southwest":        {"↙️"},
+	"sow":              {"🐖"},
+	"space":            {"☄️", "🌌", "🚀", "🛰️"},
+	"spade":            {"♠️"},
+	"spaghetti":        {"🍝"},
+	"spain":            {"🇪🇸"},
+	"spanner":          {"🔧", "🛠️"},
+	"sparkle":          {"✨", "❇️", "🌟", "🎇", "💖"},
+	"sparkler":         {"🎇"},
+	"sparkles":         {"✨"},
+	"sparkling":        {"💖"},
+	"speak":            {"🗣️", "🙊"},
+	"speaker":          {"🔇", "🔈", "🔉", "🔊"},
+	"speaking":         {"🗣️"},
+	"speculum":         {"🪞"},
+	"speech":           {"👁️\u200d🗨️", "💬", "🗨️"},
+	"speed":            {"🚄", "🚅"},
+	"speedboat":        {"🚤"},
+	"spider":           {"🕷️", "🕸️"},
+	"spill":            {"🫗"},
+	"spiny":            {"🦔"},
+	"spiral":           {"🐚", "🗒️", "🗓️", "😵\u200d💫"},
+	"splashing":        {"💦"},
+	"splayed":          {"🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿"},
+	"split":            {"🪓"},
+	"spock":            {"🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿"},
+	"spoked":           {"✳️"},
+	"sponge":           {"🧽"},
+	"spool":            {"🧵"},
+	"spoon":            {"🥄", "🥣"},
+	"sport":            {"⚽", "⚾", "⛳", "⛷️", "⛸️", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "🎣", "🎳", "🎽", "🎾", "🎿", "🏀", "🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏈", "🏉", "🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "🏏", "🏐", "🏑", "🏒", "🏓", "🏸", "🚙", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🛷", "🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤺", "🤼", "🤼\u200d♀️", "🤼\u200d♂️", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🤿", "🥅", "🥊", "🥋", "🥌", "🥍", "🥎", "🥏"},
+	"sports":           {"🏅"},
+	"spots":            {"🦒"},
+	"spouting":         {"🐳"},
+	"springs":          {"♨️"},
+	"spy":              {"🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️"},
+	"square":           {"⏹️", "▪️", "▫️", "◻️", "◼️", "◽", "◾", "❎", "⬛", "⬜", "🔲", "🔳", "🟥", "🟦", "🟧", "🟨", "🟩", "🟪", "🟫"},
+	"squeeze":          {"🪗"},
+	"squid":            {"🦑"},
+	"squinting":        {"😆", "😝"},
+	"squirrel":         {"🐿️"},
+	"sr":               {"🇸🇷"},
+	"sri":              {"🇱🇰"},
+	"ss":               {"🇸🇸"},
+	"st":               {"🇧🇱", "🇰🇳", "🇱🇨", "🇲🇫", "🇵🇲", "🇸🇭", "🇸🇹", "🇻🇨", "🥇"},
+	"stadium":          {"🏟️"},
+	"staff":            {"⚕️"},
+	"stand":            {"🧍", "🧍🏻", "🧍🏼", "🧍🏽", "🧍🏾", "🧍🏿"},
+	"standing":         {"🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️"},
+	"star":             {"☪️", "✡️", "✨", "✴️", "⭐", "🌃", "🌟", "🌠", "👨\u200d🎤", "👨🏻\u200d🎤", "👨🏼\u200d🎤", "👨🏽\u200d🎤", "👨🏾\u200d🎤", "👨🏿\u200d🎤", "👩\u200d🎤", "👩🏻\u200d🎤", "👩🏼\u200d🎤", "👩🏽\u200d🎤", "👩🏾\u200d🎤", "👩🏿\u200d🎤", "💫", "🔯", "🤩", "🧑\u200d🎤", "🧑🏻\u200d🎤", "🧑🏼\u200d🎤", "🧑🏽\u200d🎤", "🧑🏾\u200d🎤", "🧑🏿\u200d🎤"},
+	"stare":            {"🫣"},
+	"stars":            {"🌃"},
+	"states":           {"🇺🇸"},
+	"station":          {"🚉"},
+	"statue":           {"🗽", "🗿"},
+	"steak":            {"🥩"},
+	"stealth":          {"🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿"},
+	"steam":            {"😤", "🚂", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️"},
+	"steaming":         {"♨️", "🍜"},
+	"steamy":           {"🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️"},
+	"step":             {"🪜"},
+	"stethoscope":      {"🩺"},
+	"stew":             {"🍲"},
+	"stick":            {"🍡", "🍢", "🏑", "🏒", "🥍", "🩼"},
+	"stink":            {"🦨"},
+	"stitches":         {"🪡"},
+	"stocking":         {"🧦"},
+	"stomp":            {"🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿"},
+	"stone":            {"💎", "🥌", "🪨"},
+	"stop":             {"⏹️", "🚏", "🛑"},
+	"stopwatch":        {"⏱️"},
+	"store":            {"🏪", "🏬", "🫙"},
+	"straight":         {"📏"},
+	"straw":            {"🥤", "🧃"},
+	"strawberry":       {"🍓"},
+	"streamer":         {"🎏"},
+	"string":           {"🧵"},
+	"stringed":         {"🪕"},
+	"stripe":           {"🦓"},
+	"stroke":           {"🥵"},
+	"struck":           {"🤩"},
+	"student":          {"👨\u200d🎓", "👨🏻\u200d🎓", "👨🏼\u200d🎓", "👨🏽\u200d🎓", "👨🏾\u200d🎓", "👨🏿\u200d🎓", "👩\u200d🎓", "👩🏻\u200d🎓", "👩🏼\u200d🎓", "👩🏽\u200d🎓", "👩🏾\u200d🎓", "👩🏿\u200d🎓", "🧑\u200d🎓", "🧑🏻\u200d🎓", "🧑🏼\u200d🎓", "🧑🏽\u200d🎓", "🧑🏾\u200d🎓", "🧑🏿\u200d🎓"},
+	"studio":           {"🎙️"},
+	"stuffed":          {"🥙", "🧸"},
+	"stuffy":           {"🧐"},
+	"stunned":          {"😯"},
+	"subdivision":      {"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f"},
+	"suction":          {"🪠"},
+	"sudan":            {"🇸🇩", "🇸🇸"},
+	"suit":             {"♠️", "♣️", "♥️", "♦️", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🩱", "🩲", "🩳"},
+	"sun":              {"☀️", "⛅", "⛱️", "🌄", "🌅", "🌇", "🌞", "🌤️", "🌥️", "🌦️", "🌻", "😎"},
+	"sunflower":        {"🌻"},
+	"sunglasses":       {"🕶️", "😎"},
+	"sunny":            {"☀️", "🫡"},
+	"sunrise":          {"🌄", "🌅"},
+	"sunscreen":        {"🧴"},
+	"sunset":           {"🌆", "🌇"},
+	"superhero":        {"🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️"},
+	"superpower":       {"🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️"},
+	"supervillain":     {"🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️"},
+	"surfing":          {"🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️"},
+	"suriname":         {"🇸🇷"},
+	"surprise":         {"🫢"},
+	"surprised":        {"😯", "🙀"},
+	"sushi":            {"🍣"},
+	"suspension":       {"🚟"},
+	"sutures":          {"🪡"},
+	"sv":               {"🇸🇻"},
+	"svalbard":         {"🇸🇯"},
+	"swan":             {"🦢"},
+	"swearing":         {"🤬"},
+	"sweat":            {"💦", "💧", "😅", "😓", "😰"},
+	"sweating":         {"🥵"},
+	"sweden":           {"🇸🇪"},
+	"sweeping":         {"🧹"},
+	"sweet":            {"🍠", "🍡", "🍦", "🍧", "🍨", "🍩", "🍪", "🍫", "🍬", "🍭", "🍮", "🍯", "🍰", "🎂", "🥧", "🧁", "🧃"},
+	"swim":             {"🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "👙"},
+	"swimming":         {"🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🥽"},
+	"swimsuit":         {"🩱", "🩲"},
+	"swirl":            {"🍥"},
+	"swiss":            {"🫕"},
+	"switzerland":      {"🇨🇭"},
+	"sword":            {"🤺"},
+	"swords":           {"⚔️"},
+	"sx":               {"🇸🇽"},
+	"sy":               {"🇸🇾"},
+	"symbol":           {"©️", "®️", "™️", "⏏️", "⏩", "⏪", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "⏸️", "⏹️", "⏺️", "▶️", "◀️", "☑️", "☮️", "♻️", "♿", "⚕️", "⚛️", "⚜️", "⚧️", "✅", "✔️", "✳️", "✴️", "❇️", "❌", "❎", "➰", "➿", "⭕", "〽️", "🎦", "👣", "👤", "👥", "💢", "📛", "📳", "📴", "📶", "🔀", "🔁", "🔂", "🔅", "🔆", "🔰", "🔱", "🔼", "🔽", "🗣️", "🚼", "🛜", "🫂"},
+	"symbols":          {"#️⃣", "*️⃣", "0️⃣", "1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣", "6️⃣", "7️⃣", "8️⃣", "9️⃣", "©️", "®️", "‼️", "⁉️", "™️", "ℹ️", "↔️", "↕️", "↖️", "↗️", "↘️", "↙️", "↩️", "↪️", "⏏️", "⏩", "⏪", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "⏸️", "⏹️", "⏺️", "Ⓜ️", "▪️", "▫️", "▶️", "◀️", "◻️", "◼️", "◽", "◾", "☑️", "☢️", "☣️", "☦️", "☪️", "☮️", "☯️", "☸️", "♀️", "♂️", "♈", "♉", "♊", "♋", "♌", "♍", "♎", "♏", "♐", "♑", "♒", "♓", "♻️", "♾️", "♿", "⚕️", "⚛️", "⚜️", "⚠️", "⚧️", "⚪", "⚫", "⛎", "⛔", "✅", "✔️", "✖️", "✝️", "✡️", "✳️", "✴️", "❇️", "❌", "❎", "❓", "❔", "❕", "❗", "➕", "➖", "➗", "➡️", "➰", "➿", "⤴️", "⤵️", "⬅️", "⬆️", "⬇️", "⬛", "⬜", "⭕", "〰️", "〽️", "㊗️", "㊙️", "🅰️", "🅱️", "🅾️", "🅿️", "🆎", "🆑", "🆒", "🆓", "🆔", "🆕", "🆖", "🆗", "🆘", "🆙", "🆚", "🈁", "🈂️", "🈚", "🈯", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑", "🎦", "🏧", "💠", "💱", "💲", "📛", "📳", "📴", "📵", "📶", "🔀", "🔁", "🔂", "🔃", "🔄", "🔅", "🔆", "🔘", "🔙", "🔚", "🔛", "🔜", "🔝", "🔞", "🔟", "🔠", "🔡", "🔢", "🔣", "🔤", "🔯", "🔰", "🔱", "🔲", "🔳", "🔴", "🔵", "🔶", "🔷", "🔸", "🔹", "🔺", "🔻", "🔼", "🔽", "🕉️", "🕎", "🚫", "🚭", "🚮", "🚯", "🚰", "🚱", "🚳", "🚷", "🚸", "🚹", "🚺", "🚻", "🚼", "🚾", "🛂", "🛃", "🛄", "🛅", "🛐", "🛜", "🟠", "🟡", "🟢", "🟣", "🟤", "🟥", "🟦", "🟧", "🟨", "🟩", "🟪", "🟫", "🟰", "🤬", "🪯"},
+	"sympathy":         {"😮"},
+	"synagogue":        {"🕍"},
+	"syria":            {"🇸🇾"},
+	"syringe":          {"💉"},
+	"sz":               {"🇸🇿"},
+	"são":              {"🇸🇹"},
+	"t":                {"👕", "🦖"},
+	"ta":               {"🇹🇦"},
+	"table":            {"🏓"},
+	"tableware":        {"🥄"},
+	"tabs":             {"📑"},
+	"taco":             {"🌮"},
+	"tada":             {"🎉"},
+	"taekwondo":        {"🥋"},
+	"tailoring":        {"🪡"},
+	"taiwan":           {"🇹🇼"},
+	"tajikistan":       {"🇹🇯"},
+	"takeout":          {"🥡"},
+	"taking":           {"🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿"},
+	"tale":             {"🐉", "🐲", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👹", "👺", "👻", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💀", "🔮", "😈", "🧌"},
+	"talisman":         {"🧿"},
+	"talk":             {"🦜"},
+	"tamale":           {"🫔"},
+	"tanabata":         {"🎋"},
+	"tangerine":        {"🍊"},
+	"tangled":          {"🪢"},
+	"tanzania":         {"🇹🇿"},
+	"tao":              {"☯️"},
+	"taoist":           {"☯️"},
+	"tape":             {"📼"},
+	"target":           {"🎯"},
+	"taste":            {"😝"},
+	"taurus":           {"♉", "🐂"},
+	"taxi":             {"🚕", "🚖"},
+	"tc":               {"🇹🇨"},
+	"td":               {"🇹🇩"},
+	"tea":              {"🍵", "🧋", "🫖"},
+	"teacher":          {"👨\u200d🏫", "👨🏻\u200d🏫", "👨🏼\u200d🏫", "👨🏽\u200d🏫", "👨🏾\u200d🏫", "👨🏿\u200d🏫", "👩\u200d🏫", "👩🏻\u200d🏫", "👩🏼\u200d🏫", "👩🏽\u200d🏫", "👩🏾\u200d🏫", "👩🏿\u200d🏫", "🧑\u200d🏫", "🧑🏻\u200d🏫", "🧑🏼\u200d🏫", "🧑🏽\u200d🏫", "🧑🏾\u200d🏫", "🧑🏿\u200d🏫"},
+	"teacup":           {"🍵"},
+	"teapot":           {"🫖"},
+	"tear":             {"📆", "😂", "😢", "😭", "😹", "😿", "🥲"},
+	"tears":            {"😂", "😹", "🥹"},
+	"technologist":     {"👨\u200d💻", "👨🏻\u200d💻", "👨🏼\u200d💻", "👨🏽\u200d💻", "👨🏾\u200d💻", "👨🏿\u200d💻", "👩\u200d💻", "👩🏻\u200d💻", "👩🏼\u200d💻", "👩🏽\u200d💻", "👩🏾\u200d💻", "👩🏿\u200d💻", "🧑\u200d💻", "🧑🏻\u200d💻", "🧑🏼\u200d💻", "🧑🏽\u200d💻", "🧑🏾\u200d💻", "🧑🏿\u200d💻"},
+	"teddy":            {"🧸"},
+	"teeth":            {"🪥"},
+	"telephone":        {"☎️", "📞", "📱", "📳", "📴"},
+	"telescope":        {"🔭"},
+	"television":       {"📺"},
+	"teller":           {"🏧"},
+	"temple":           {"🕍", "🛕"},
+	"tempura":          {"🍤"},
+	"ten":              {"🕙", "🕥"},
+	"tennis":           {"🎾", "🏓"},
+	"tent":             {"⛺", "🎪"},
+	"terrapin":         {"🐢"},
+	"territories":      {"🇵🇸", "🇹🇫"},
+	"territory":        {"🇮🇴"},
+	"test":             {"🧪"},
+	"tf":               {"🇹🇫"},
+	"tg":               {"🇹🇬"},
+	"th":               {"🇹🇭"},
+	"thailand":         {"🇹🇭"},
+	"thanks":           {"🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🫂"},
+	"the":              {"😶\u200d🌫️", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤣", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"therapist":        {"👨\u200d⚕️", "👨🏻\u200d⚕️", "👨🏼\u200d⚕️", "👨🏽\u200d⚕️", "👨🏾\u200d⚕️", "👨🏿\u200d⚕️", "👩\u200d⚕️", "👩🏻\u200d⚕️", "👩🏼\u200d⚕️", "👩🏽\u200d⚕️", "👩🏾\u200d⚕️", "👩🏿\u200d⚕️", "🧑\u200d⚕️", "🧑🏻\u200d⚕️", "🧑🏼\u200d⚕️", "🧑🏽\u200d⚕️", "🧑🏾\u200d⚕️", "🧑🏿\u200d⚕️"},
+	"thermometer":      {"🌡️", "🤒"},
+	"thinking":         {"🤔"},
+	"third":            {"🥉"},
+	"thirty":           {"🕜", "🕝", "🕞", "🕟", "🕠", "🕡", "🕢", "🕣", "🕤", "🕥", "🕦", "🕧"},
+	"thong":            {"🩴"},
+	"thongs":           {"🩴"},
+	"thought":          {"💭"},
+	"thread":           {"🧵"},
+	"three":            {"🕒", "🕞"},
+	"thumb":            {"👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"thumbs":           {"👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿"},
+	"thunder":          {"⛈️"},
+	"tichel":           {"🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿"},
+	"ticket":           {"🎟️", "🎫"},
+	"tickets":          {"🎟️"},
+	"tie":              {"👔", "🪢"},
+	"tiger":            {"🐅", "🐯"},
+	"tilted":           {"🔍", "🔎"},
+	"timber":           {"🪵"},
+	"time":             {"⌚", "⌛", "⏰", "⏱️", "⏲️", "⏳", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛", "🕜", "🕝", "🕞", "🕟", "🕠", "🕡", "🕢", "🕣", "🕤", "🕥", "🕦", "🕧", "🕰️"},
+	"timer":            {"⏲️"},
+	"timor":            {"🇹🇱"},
+	"tipping":          {"💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️"},
+	"tipsy":            {"🥴"},
+	"tire":             {"🛞"},
+	"tired":            {"😩", "😫", "🥱"},
+	"titania":          {"🧚", "🧚\u200d♀️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏿", "🧚🏿\u200d♀️"},
+	"tj":               {"🇹🇯"},
+	"tk":               {"🇹🇰"},
+	"tl":               {"🇹🇱"},
+	"tm":               {"™️", "🇹🇲"},
+	"tn":               {"🇹🇳"},
+	"to":               {"🇹🇴"},
+	"toadstool":        {"🍄"},
+	"tobago":           {"🇹🇹"},
+	"together":         {"🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿"},
+	"togo":             {"🇹🇬"},
+	"toilet":           {"🚻", "🚽", "🚾", "🧻", "🪠"},
+	"tokelau":          {"🇹🇰"},
+	"tokyo":            {"🗼"},
+	"tomato":           {"🍅"},
+	"tombstone":        {"🪦"},
+	"tomé":             {"🇸🇹"},
+	"tone":             {"☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d❤️\u200d💋\u200d👨🏻", "👨🏻\u200d❤️\u200d💋\u200d👨🏼", "👨🏻\u200d❤️\u200d💋\u200d👨🏽", "👨🏻\u200d❤️\u200d💋\u200d👨🏾", "👨🏻\u200d❤️\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d❤️\u200d💋\u200d👨🏻", "👨🏼\u200d❤️\u200d💋\u200d👨🏼", "👨🏼\u200d❤️\u200d💋\u200d👨🏽", "👨🏼\u200d❤️\u200d💋\u200d👨🏾", "👨🏼\u200d❤️\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d❤️\u200d💋\u200d👨🏻", "👨🏽\u200d❤️\u200d💋\u200d👨🏼", "👨🏽\u200d❤️\u200d💋\u200d👨🏽", "👨🏽\u200d❤️\u200d💋\u200d👨🏾", "👨🏽\u200d❤️\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d❤️\u200d💋\u200d👨🏻", "👨🏾\u200d❤️\u200d💋\u200d👨🏼", "👨🏾\u200d❤️\u200d💋\u200d👨🏽", "👨🏾\u200d❤️\u200d💋\u200d👨🏾", "👨🏾\u200d❤️\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d❤️\u200d💋\u200d👨🏻", "👨🏿\u200d❤️\u200d💋\u200d👨🏼", "👨🏿\u200d❤️\u200d💋\u200d👨🏽", "👨🏿\u200d❤️\u200d💋\u200d👨🏾", "👨🏿\u200d❤️\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💏🏻", "💏🏼", "💏🏽", "💏🏾", "💏🏿", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝🏻", "🤝🏼", "🤝🏽", "🤝🏾", "🤝🏿", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔🏻", "🧔🏻\u200d♀️", "🧔🏻\u200d♂️", "🧔🏼", "🧔🏼\u200d♀️", "🧔🏼\u200d♂️", "🧔🏽", "🧔🏽\u200d♀️", "🧔🏽\u200d♂️", "🧔🏾", "🧔🏾\u200d♀️", "🧔🏾\u200d♂️", "🧔🏿", "🧔🏿\u200d♀️", "🧔🏿\u200d♂️", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🫃🏻", "🫃🏼", "🫃🏽", "🫃🏾", "🫃🏿", "🫄🏻", "🫄🏼", "🫄🏽", "🫄🏾", "🫄🏿", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿", "🫱🏻", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾", "🫲🏻", "🫲🏼", "🫲🏽", "🫲🏾", "🫲🏿", "🫳🏻", "🫳🏼", "🫳🏽", "🫳🏾", "🫳🏿", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿", "🫶🏻", "🫶🏼", "🫶🏽", "🫶🏾", "🫶🏿", "🫷🏻", "🫷🏼", "🫷🏽", "🫷🏾", "🫷🏿", "🫸🏻", "🫸🏼", "🫸🏽", "🫸🏾", "🫸🏿"},
+	"tonga":            {"🇹🇴"},
+	"tongue":           {"👅", "😋", "😛", "😜", "😝", "🤑", "🤪"},
+	"tool":             {"⚒️", "⚔️", "⚖️", "⚗️", "⚙️", "⛏️", "⛓️", "✂️", "🏹", "💣", "🔎", "🔗", "🔥", "🔦", "🔧", "🔨", "🔩", "🔪", "🔫", "🔬", "🔭", "🔮", "🔱", "🗜️", "🗡️", "🛠️", "🛡️", "🦯", "🧰", "🧲", "🪃", "🪓", "🪚", "🪛", "🪜", "🪝"},
+	"toolbox":          {"🧰"},
+	"tooth":            {"🦷"},
+	"toothbrush":       {"🪥"},
+	"top":              {"🎩", "🔝"},
+	"tophat":           {"🎩"},
+	"torch":            {"🔦"},
+	"tornado":          {"🌪️"},
+	"tortoise":         {"🐢"},
+	"totally":          {"😲"},
+	"touched":          {"🥲"},
+	"towels":           {"🧻"},
+	"tower":            {"🗼"},
+	"toy":              {"🧸", "🪀"},
+	"tr":               {"🇹🇷"},
+	"track":            {"⏭️", "⏮️", "🛤️"},
+	"trackball":        {"🖲️"},
+	"tracks":           {"🔀"},
+	"tractor":          {"🚜"},
+	"trade":            {"™️"},
+	"trademark":        {"™️"},
+	"tradesperson":     {"👨\u200d🔧", "👨🏻\u200d🔧", "👨🏼\u200d🔧", "👨🏽\u200d🔧", "👨🏾\u200d🔧", "👨🏿\u200d🔧", "👩\u200d🔧", "👩🏻\u200d🔧", "👩🏼\u200d🔧", "👩🏽\u200d🔧", "👩🏾\u200d🔧", "👩🏿\u200d🔧", "🧑\u200d🔧", "🧑🏻\u200d🔧", "🧑🏼\u200d🔧", "🧑🏽\u200d🔧", "🧑🏾\u200d🔧", "🧑🏿\u200d🔧"},
+	"traffic":          {"🚥", "🚦", "🚸"},
+	"train":            {"🚂", "🚃", "🚄", "🚅", "🚆", "🚉", "🛤️"},
+	"tram":             {"🚃", "🚊", "🚋", "🚎"},
+	"tramway":          {"🚡"},
+	"transgender":      {"⚧️", "🏳️\u200d⚧️"},
+	"transparent":      {"🪟"},
+	"transport":        {"♿", "⚓", "⛴️", "⛵", "⛽", "✈️", "🏍️", "🏎️", "🏧", "💺", "🚀", "🚁", "🚂", "🚃", "🚄", "🚅", "🚆", "🚇", "🚈", "🚉", "🚊", "🚋", "🚌", "🚍", "🚎", "🚏", "🚐", "🚑", "🚒", "🚓", "🚔", "🚕", "🚖", "🚗", "🚘", "🚙", "🚚", "🚛", "🚜", "🚝", "🚞", "🚟", "🚠", "🚡", "🚢", "🚤", "🚥", "🚦", "🚧", "🚨", "🚮", "🚰", "🚲", "🚹", "🚺", "🚻", "🚼", "🚾", "🛂", "🛃", "🛄", "🛅", "🛑", "🛞", "🛟", "🛢️", "🛣️", "🛤️", "🛥️", "🛩️", "🛫", "🛬", "🛰️", "🛳️", "🛴", "🛵", "🛶", "🛸", "🛹", "🛺", "🛻", "🛼", "🦼", "🦽", "🪂"},
+	"trap":             {"🪤"},
+	"travel":           {"⌚", "⌛", "⏰", "⏱️", "⏲️", "⏳", "☀️", "☁️", "☂️", "☃️", "☄️", "☔", "♨️", "⚓", "⚡", "⛄", "⛅", "⛈️", "⛩️", "⛪", "⛰️", "⛱️", "⛲", "⛴️", "⛵", "⛺", "⛽", "✈️", "❄️", "⭐", "🌀", "🌁", "🌂", "🌃", "🌄", "🌅", "🌆", "🌇", "🌈", "🌉", "🌊", "🌋", "🌌", "🌍", "🌎", "🌏", "🌐", "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🌞", "🌟", "🌠", "🌡️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️", "🎠", "🎡", "🎢", "🎪", "🏍️", "🏎️", "🏔️", "🏕️", "🏖️", "🏗️", "🏘️", "🏙️", "🏚️", "🏛️", "🏜️", "🏝️", "🏞️", "🏟️", "🏠", "🏡", "🏢", "🏣", "🏤", "🏥", "🏦", "🏨", "🏩", "🏪", "🏫", "🏬", "🏭", "🏯", "🏰", "💈", "💒", "💧", "💺", "🔥", "🕋", "🕌", "🕍", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛", "🕜", "🕝", "🕞", "🕟", "🕠", "🕡", "🕢", "🕣", "🕤", "🕥", "🕦", "🕧", "🕰️", "🗺️", "🗻", "🗼", "🗽", "🗾", "🚀", "🚁", "🚂", "🚃", "🚄", "🚅", "🚆", "🚇", "🚈", "🚉", "🚊", "🚋", "🚌", "🚍", "🚎", "🚏", "🚐", "🚑", "🚒", "🚓", "🚔", "🚕", "🚖", "🚗", "🚘", "🚙", "🚚", "🚛", "🚜", "🚝", "🚞", "🚟", "🚠", "🚡", "🚢", "🚤", "🚥", "🚦", "🚧", "🚨", "🚲", "🛎️", "🛑", "🛕", "🛖", "🛝", "🛞", "🛟", "🛢️", "🛣️", "🛤️", "🛥️", "🛩️", "🛫", "🛬", "🛰️", "🛳️", "🛴", "🛵", "🛶", "🛸", "🛹", "🛺", "🛻", "🛼", "🦼", "🦽", "🧭", "🧱", "🧳", "🪂", "🪐", "🪨", "🪵"},
+	"tray":             {"📤", "📥"},
+	"treasure":         {"🏴\u200d☠️", "🪙"},
+	"tree":             {"🌲", "🌳", "🌴", "🎄", "🎋"},
+	"trend":            {"📈", "📉"},
+	"triangle":         {"⏭️", "⏮️", "⏯️", "▶️", "◀️", "📐", "🔺", "🔻"},
+	"triangular":       {"📐", "🚩"},
+	"trident":          {"🔱"},
+	"trinidad":         {"🇹🇹"},
+	"tristan":          {"🇹🇦"},
+	"triton":           {"🧜\u200d♂️", "🧜🏻\u200d♂️", "🧜🏼\u200d♂️", "🧜🏽\u200d♂️", "🧜🏾\u200d♂️", "🧜🏿\u200d♂️"},
+	"triumph":          {"😤"},
+	"troll":            {"🧌"},
+	"trolley":          {"🚎", "🛒"},
+	"trolleybus":       {"🚃", "🚊", "🚋", "🚎"},
+	"troops":           {"🫡"},
+	"trophy":           {"🏆"},
+	"tropical":         {"🍹", "🐠", "🥭", "🦩"},
+	"trouble":          {"😵\u200d💫"},
+	"trousers":         {"👖"},
+	"truck":            {"🚒", "🚚", "🚛", "🛻"},
+	"trumpet":          {"🎺"},
+	"tshirt":           {"👕"},
+	"tt":               {"🇹🇹"},
+	"tube":             {"🧪"},
+	"tuk":              {"🛺"},
+	"tulip":            {"🌷"},
+	"tumbler":          {"🥃"},
+	"tunisia":          {"🇹🇳"},
+	"turban":           {"👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️"},
+	"turkey":           {"🇹🇷", "🦃"},
+	"turkmenistan":     {"🇹🇲"},
+	"turks":            {"🇹🇨"},
+	"turn":             {"🛞"},
+	"turtle":           {"🐢"},
+	"tusk":             {"🦣"},
+	"tuvalu":           {"🇹🇻"},
+	"tuxedo":           {"🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️"},
+	"tv":               {"🇹🇻"},
+	"tw":               {"🇹🇼"},
+	"twelve":           {"🕛", "🕧"},
+	"twine":            {"🪢"},
+	"twins":            {"👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿"},
+	"twist":            {"🪢"},
+	"twisted":          {"🥨"},
+	"twister":          {"🌀"},
+	"two":              {"🐫", "💕", "🕑", "🕝"},
+	"type":             {"🅰️", "🅱️", "🅾️", "🆎"},
+	"typhoon":          {"🌀"},
+	"tyrannosaurus":    {"🦖"},
+	"tz":               {"🇹🇿"},
+	"ua":               {"🇺🇦"},
+	"ufo":              {"👾", "🛸"},
+	"ug":               {"🇺🇬"},
+	"uganda":           {"🇺🇬"},
+	"ugly":             {"🦢"},
+	"ukraine":          {"🇺🇦"},
+	"ultimate":         {"🥏"},
+	"um":               {"🇺🇲"},
+	"umbrella":         {"☂️", "☔", "⛱️", "🌂", "🏖️"},
+	"un":               {"🇺🇳"},
+	"unamused":         {"😒"},
+	"unbounded":        {"♾️"},
+	"uncomfortable":    {"🫦"},
+	"undead":           {"🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️"},
+	"under":            {"🔞"},
+	"underage":         {"🔞"},
+	"underarm":         {"🥎"},
+	"underwater":       {"🫧"},
+	"underwear":        {"🩲", "🩳"},
+	"uneven":           {"🥴"},
+	"unexpressive":     {"😑"},
+	"unhappy":          {"😒"},
+	"unicorn":          {"🦄"},
+	"uniform":          {"🥋"},
+	"union":            {"🇪🇺"},
+	"united":           {"🇦🇪", "🇬🇧", "🇺🇳", "🇺🇸"},
+	"universal":        {"♾️"},
+	"unlocked":         {"🔓"},
+	"unlucky":          {"🐈\u200d⬛"},
+	"unspecified":      {"🧑", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑🏻", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏼", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏽", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏾", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏿", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿"},
+	"unsure":           {"🫤"},
+	"unwell":           {"😵", "😵\u200d💫", "😷", "🤒", "🤕", "🤢", "🤧", "🤮", "🤯", "🥴", "🥵", "🥶"},
+	"up":               {"↕️", "↖️", "↗️", "⏫", "☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "⤴️", "⬆️", "🆙", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "📄", "🔝", "🔺", "🗞️", "🛻", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🫴", "🫴🏻", "🫴🏼", "🫴🏽", "🫴🏾", "🫴🏿"},
+	"uppercase":        {"🔠"},
+	"upside":           {"🙃"},
+	"upward":           {"📈"},
+	"upwards":          {"🔼"},
+	"urn":              {"⚱️"},
+	"uruguay":          {"🇺🇾"},
+	"us":               {"🇺🇲", "🇺🇸", "🇻🇮"},
+	"useless":          {"🪴"},
+	"utility":          {"🚙"},
+	"uy":               {"🇺🇾"},
+	"uz":               {"🇺🇿"},
+	"uzbekistan":       {"🇺🇿"},
+	"v":                {"✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿"},
+	"va":               {"🇻🇦"},
+	"vacancy":          {"🈳", "🈵"},
+	"valentine":        {"💝"},
+	"vampire":          {"🦇", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️"},
+	"vanuatu":          {"🇻🇺"},
+	"vat":              {"🪣"},
+	"vatican":          {"🇻🇦"},
+	"vc":               {"🇻🇨"},
+	"ve":               {"🇻🇪"},
+	"vegetable":        {"🌰", "🌶️", "🌽", "🍅", "🍆", "🥑", "🥒", "🥔", "🥕", "🥜", "🥦", "🥬", "🧄", "🧅", "🫑", "🫘", "🫚", "🫛"},
+	"vehicle":          {"🚁", "🚌", "🚕", "🚙", "🚜", "🚝"},
+	"veil":             {"👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️"},
+	"venezuela":        {"🇻🇪"},
+	"verde":            {"🇨🇻"},
+	"versus":           {"🆚"},
+	"vertical":         {"⏸️", "🔃", "🚦"},
+	"vest":             {"🦺"},
+	"vg":               {"🇻🇬"},
+	"vhs":              {"📼"},
+	"vi":               {"🇻🇮"},
+	"vibration":        {"📳"},
+	"vice":             {"🗜️"},
+	"victory":          {"✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿"},
+	"vicuña":           {"🦙"},
+	"video":            {"🎞️", "🎥", "🎬", "🎮", "🏮", "💡", "📷", "📸", "📹", "📺", "📼", "📽️", "🔍", "🔎", "🔦", "🕯️", "🕹️", "🪔"},
+	"videocassette":    {"📼"},
+	"vietnam":          {"🇻🇳", "🪷"},
+	"view":             {"🪟"},
+	"viewer":           {"🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"viewing":          {"🎑"},
+	"villain":          {"🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️"},
+	"vincent":          {"🇻🇨"},
+	"violin":           {"🎻"},
+	"virgin":           {"🇻🇬", "🇻🇮"},
+	"virgo":            {"♍", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿"},
+	"virus":            {"🦟", "🦠"},
+	"vn":               {"🇻🇳"},
+	"volcano":          {"🌋"},
+	"volleyball":       {"🏐"},
+	"voltage":          {"⚡"},
+	"volume":           {"🔈", "🔉", "🔊"},
+	"vomit":            {"🤢", "🤮"},
+	"vomiting":         {"🤮"},
+	"vs":               {"🆚"},
+	"vu":               {"🇻🇺"},
+	"vulcan":           {"🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿"},
+	"waffle":           {"🧇"},
+	"wales":            {"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f"},
+	"walk":             {"🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️"},
+	"walking":          {"🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️"},
+	"wall":             {"🧱"},
+	"wallis":           {"🇼🇫"},
+	"wand":             {"🪄"},
+	"waning":           {"🌖", "🌘"},
+	"warning":          {"☢️", "☣️", "⚠️", "⛔", "📵", "🔞", "🚫", "🚭", "🚯", "🚱", "🚳", "🚷", "🚸"},
+	"warrior":          {"🪖"},
+	"wastebasket":      {"🗑️"},
+	"watch":            {"⌚"},
+	"water":            {"⚓", "⛴️", "⛵", "🌊", "🐃", "🔫", "🚢", "🚤", "🚰", "🚱", "🚾", "🚿", "🛟", "🛥️", "🛳️", "🛶", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️"},
+	"watermelon":       {"🍉"},
+	"wave":             {"🌊", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿"},
+	"waving":           {"🏳️", "🏴", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿"},
+	"wavy":             {"〰️", "🥴"},
+	"waxing":           {"🌒", "🌔"},
+	"way":              {"🌌"},
+	"wc":               {"🚻", "🚾"},
+	"weapon":           {"⚔️", "🔪", "🔫", "🗡️", "🛡️"},
+	"wearing":          {"👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️"},
+	"weary":            {"😩", "🙀"},
+	"weather":          {"☀️", "☁️", "☂️", "☃️", "☄️", "☔", "⚡", "⛄", "⛅", "⛈️", "⛱️", "❄️", "⭐", "🌀", "🌂", "🌈", "🌊", "🌌", "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🌞", "🌟", "🌠", "🌡️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️", "💧", "🔥", "🪐"},
+	"web":              {"🕸️"},
+	"wedding":          {"👰", "👰🏻", "👰🏼", "👰🏽", "👰🏾", "👰🏿", "💒"},
+	"wedge":            {"🧀"},
+	"weight":           {"🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️"},
+	"weights":          {"🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️"},
+	"welding":          {"🥽"},
+	"well":             {"❤️\u200d🩹"},
+	"west":             {"⬅️"},
+	"western":          {"🇪🇭"},
+	"wf":               {"🇼🇫"},
+	"whale":            {"🐋", "🐳"},
+	"wheel":            {"☸️", "🎡", "🛞"},
+	"wheelchair":       {"♿", "👨\u200d🦼", "👨\u200d🦽", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩\u200d🦼", "👩\u200d🦽", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "🦼", "🦽", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽"},
+	"whew":             {"😥"},
+	"whirlwind":        {"🌪️"},
+	"whisky":           {"🥃"},
+	"whisper":          {"😮\u200d💨"},
+	"whistle":          {"😮\u200d💨"},
+	"white":            {"▫️", "◻️", "◽", "⚪", "❔", "❕", "⬜", "🏳️", "🏳️\u200d⚧️", "🐻\u200d❄️", "👨\u200d💼", "👨\u200d🦯", "👨\u200d🦳", "👨🏻\u200d💼", "👨🏻\u200d🦯", "👨🏻\u200d🦳", "👨🏼\u200d💼", "👨🏼\u200d🦯", "👨🏼\u200d🦳", "👨🏽\u200d💼", "👨🏽\u200d🦯", "👨🏽\u200d🦳", "👨🏾\u200d💼", "👨🏾\u200d🦯", "👨🏾\u200d🦳", "👨🏿\u200d💼", "👨🏿\u200d🦯", "👨🏿\u200d🦳", "👩\u200d💼", "👩\u200d🦯", "👩\u200d🦳", "👩🏻\u200d💼", "👩🏻\u200d🦯", "👩🏻\u200d🦳", "👩🏼\u200d💼", "👩🏼\u200d🦯", "👩🏼\u200d🦳", "👩🏽\u200d💼", "👩🏽\u200d🦯", "👩🏽\u200d🦳", "👩🏾\u200d💼", "👩🏾\u200d🦯", "👩🏾\u200d🦳", "👩🏿\u200d💼", "👩🏿\u200d🦯", "👩🏿\u200d🦳", "💮", "🔳", "🤍", "🦯", "🧑\u200d💼", "🧑\u200d🦯", "🧑\u200d🦳", "🧑🏻\u200d💼", "🧑🏻\u200d🦯", "🧑🏻\u200d🦳", "🧑🏼\u200d💼", "🧑🏼\u200d🦯", "🧑🏼\u200d🦳", "🧑🏽\u200d💼", "🧑🏽\u200d🦯", "🧑🏽\u200d🦳", "🧑🏾\u200d💼", "🧑🏾\u200d🦯", "🧑🏾\u200d🦳", "🧑🏿\u200d💼", "🧑🏿\u200d🦯", "🧑🏿\u200d🦳"},
+	"whoa":             {"😵\u200d💫"},
+	"whoops":           {"🤭"},
+	"wicked":           {"🖤"},
+	"wild":             {"🥦"},
+	"wildcard":         {"🃏"},
+	"wilted":           {"🥀"},
+	"wind":             {"🌬️", "🍃", "🎐"},
+	"window":           {"🪟"},
+	"wine":             {"🍷"},
+	"wing":             {"🪽"},
+	"wings":            {"💸"},
+	"wink":             {"😉", "😜"},
+	"winking":          {"😉", "😜"},
+	"wireless":         {"🛜"},
+	"wise":             {"🦉"},
+	"wisent":           {"🦬"},
+	"witch":            {"🧙", "🧙\u200d♀️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏿", "🧙🏿\u200d♀️", "🧹", "🪄"},
+	"with":             {"☑️", "☔", "⛈️", "🌃", "🌐", "🌞", "🌧️", "🌨️", "🌩️", "🍥", "🍽️", "🍾", "🏖️", "🏡", "👨\u200d❤️\u200d👨", "👨\u200d🦯", "👨🏻\u200d❤️\u200d👨🏻", "👨🏻\u200d❤️\u200d👨🏼", "👨🏻\u200d❤️\u200d👨🏽", "👨🏻\u200d❤️\u200d👨🏾", "👨🏻\u200d❤️\u200d👨🏿", "👨🏻\u200d🦯", "👨🏼\u200d❤️\u200d👨🏻", "👨🏼\u200d❤️\u200d👨🏼", "👨🏼\u200d❤️\u200d👨🏽", "👨🏼\u200d❤️\u200d👨🏾", "👨🏼\u200d❤️\u200d👨🏿", "👨🏼\u200d🦯", "👨🏽\u200d❤️\u200d👨🏻", "👨🏽\u200d❤️\u200d👨🏼", "👨🏽\u200d❤️\u200d👨🏽", "👨🏽\u200d❤️\u200d👨🏾", "👨🏽\u200d❤️\u200d👨🏿", "👨🏽\u200d🦯", "👨🏾\u200d❤️\u200d👨🏻", "👨🏾\u200d❤️\u200d👨🏼", "👨🏾\u200d❤️\u200d👨🏽", "👨🏾\u200d❤️\u200d👨🏾", "👨🏾\u200d❤️\u200d👨🏿", "👨🏾\u200d🦯", "👨🏿\u200d❤️\u200d👨🏻", "👨🏿\u200d❤️\u200d👨🏼", "👨🏿\u200d❤️\u200d👨🏽", "👨🏿\u200d❤️\u200d👨🏾", "👨🏿\u200d❤️\u200d👨🏿", "👨🏿\u200d🦯", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d🦯", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d🦯", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d🦯", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d🦯", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d🦯", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d🦯", "👯", "👯\u200d♀️", "👯\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👿", "💑", "💑🏻", "💑🏼", "💑🏽", "💑🏾", "💑🏿", "💘", "💝", "💠", "💸", "💹", "📃", "📔", "📩", "📪", "📫", "📬", "📭", "📲", "📸", "🔏", "🔐", "🔕", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🗳️", "😁", "😂", "😃", "😄", "😅", "😇", "😈", "😊", "😍", "😎", "😓", "😙", "😚", "😛", "😜", "😝", "😤", "😦", "😮", "😰", "😵", "😵\u200d💫", "😷", "😸", "😹", "😻", "😼", "🙄", "🤒", "🤕", "🤗", "🤨", "🤬", "🤭", "🥣", "🥤", "🥰", "🥲", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🧐", "🧑\u200d🦯", "🧑🏻\u200d❤️\u200d🧑🏼", "🧑🏻\u200d❤️\u200d🧑🏽", "🧑🏻\u200d❤️\u200d🧑🏾", "🧑🏻\u200d❤️\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏼\u200d❤️\u200d🧑🏻", "🧑🏼\u200d❤️\u200d🧑🏽", "🧑🏼\u200d❤️\u200d🧑🏾", "🧑🏼\u200d❤️\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏽\u200d❤️\u200d🧑🏻", "🧑🏽\u200d❤️\u200d🧑🏼", "🧑🏽\u200d❤️\u200d🧑🏾", "🧑🏽\u200d❤️\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏾\u200d❤️\u200d🧑🏻", "🧑🏾\u200d❤️\u200d🧑🏼", "🧑🏾\u200d❤️\u200d🧑🏽", "🧑🏾\u200d❤️\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏿\u200d❤️\u200d🧑🏻", "🧑🏿\u200d❤️\u200d🧑🏼", "🧑🏿\u200d❤️\u200d🧑🏽", "🧑🏿\u200d❤️\u200d🧑🏾", "🧑🏿\u200d🦯", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🪺", "🫅", "🫅🏻", "🫅🏼", "🫅🏽", "🫅🏾", "🫅🏿", "🫢", "🫣", "🫤", "🫰", "🫰🏻", "🫰🏼", "🫰🏽", "🫰🏾", "🫰🏿"},
+	"withershins":      {"🔄"},
+	"without":          {"⛄", "🍵", "😶"},
+	"witness":          {"👁️\u200d🗨️"},
+	"wizard":           {"🧙", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♂️", "🪄"},
+	"wolf":             {"🐺"},
+	"woman":            {"♀️", "⛹️\u200d♀️", "⛹🏻\u200d♀️", "⛹🏼\u200d♀️", "⛹🏽\u200d♀️", "⛹🏾\u200d♀️", "⛹🏿\u200d♀️", "🏃\u200d♀️", "🏃🏻\u200d♀️", "🏃🏼\u200d♀️", "🏃🏽\u200d♀️", "🏃🏾\u200d♀️", "🏃🏿\u200d♀️", "🏄\u200d♀️", "🏄🏻\u200d♀️", "🏄🏼\u200d♀️", "🏄🏽\u200d♀️", "🏄🏾\u200d♀️", "🏄🏿\u200d♀️", "🏊\u200d♀️", "🏊🏻\u200d♀️", "🏊🏼\u200d♀️", "🏊🏽\u200d♀️", "🏊🏾\u200d♀️", "🏊🏿\u200d♀️", "🏋️\u200d♀️", "🏋🏻\u200d♀️", "🏋🏼\u200d♀️", "🏋🏽\u200d♀️", "🏋🏾\u200d♀️", "🏋🏿\u200d♀️", "🏌️\u200d♀️", "🏌🏻\u200d♀️", "🏌🏼\u200d♀️", "🏌🏽\u200d♀️", "🏌🏾\u200d♀️", "🏌🏿\u200d♀️", "👒", "👚", "👠", "👡", "👢", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👩", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d❤️\u200d👨🏻", "👩🏻\u200d❤️\u200d👨🏼", "👩🏻\u200d❤️\u200d👨🏽", "👩🏻\u200d❤️\u200d👨🏾", "👩🏻\u200d❤️\u200d👨🏿", "👩🏻\u200d❤️\u200d👩🏻", "👩🏻\u200d❤️\u200d👩🏼", "👩🏻\u200d❤️\u200d👩🏽", "👩🏻\u200d❤️\u200d👩🏾", "👩🏻\u200d❤️\u200d👩🏿", "👩🏻\u200d❤️\u200d💋\u200d👨🏻", "👩🏻\u200d❤️\u200d💋\u200d👨🏼", "👩🏻\u200d❤️\u200d💋\u200d👨🏽", "👩🏻\u200d❤️\u200d💋\u200d👨🏾", "👩🏻\u200d❤️\u200d💋\u200d👨🏿", "👩🏻\u200d❤️\u200d💋\u200d👩🏻", "👩🏻\u200d❤️\u200d💋\u200d👩🏼", "👩🏻\u200d❤️\u200d💋\u200d👩🏽", "👩🏻\u200d❤️\u200d💋\u200d👩🏾", "👩🏻\u200d❤️\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d❤️\u200d👨🏻", "👩🏼\u200d❤️\u200d👨🏼", "👩🏼\u200d❤️\u200d👨🏽", "👩🏼\u200d❤️\u200d👨🏾", "👩🏼\u200d❤️\u200d👨🏿", "👩🏼\u200d❤️\u200d👩🏻", "👩🏼\u200d❤️\u200d👩🏼", "👩🏼\u200d❤️\u200d👩🏽", "👩🏼\u200d❤️\u200d👩🏾", "👩🏼\u200d❤️\u200d👩🏿", "👩🏼\u200d❤️\u200d💋\u200d👨🏻", "👩🏼\u200d❤️\u200d💋\u200d👨🏼", "👩🏼\u200d❤️\u200d💋\u200d👨🏽", "👩🏼\u200d❤️\u200d💋\u200d👨🏾", "👩🏼\u200d❤️\u200d💋\u200d👨🏿", "👩🏼\u200d❤️\u200d💋\u200d👩🏻", "👩🏼\u200d❤️\u200d💋\u200d👩🏼", "👩🏼\u200d❤️\u200d💋\u200d👩🏽", "👩🏼\u200d❤️\u200d💋\u200d👩🏾", "👩🏼\u200d❤️\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d❤️\u200d👨🏻", "👩🏽\u200d❤️\u200d👨🏼", "👩🏽\u200d❤️\u200d👨🏽", "👩🏽\u200d❤️\u200d👨🏾", "👩🏽\u200d❤️\u200d👨🏿", "👩🏽\u200d❤️\u200d👩🏻", "👩🏽\u200d❤️\u200d👩🏼", "👩🏽\u200d❤️\u200d👩🏽", "👩🏽\u200d❤️\u200d👩🏾", "👩🏽\u200d❤️\u200d👩🏿", "👩🏽\u200d❤️\u200d💋\u200d👨🏻", "👩🏽\u200d❤️\u200d💋\u200d👨🏼", "👩🏽\u200d❤️\u200d💋\u200d👨🏽", "👩🏽\u200d❤️\u200d💋\u200d👨🏾", "👩🏽\u200d❤️\u200d💋\u200d👨🏿", "👩🏽\u200d❤️\u200d💋\u200d👩🏻", "👩🏽\u200d❤️\u200d💋\u200d👩🏼", "👩🏽\u200d❤️\u200d💋\u200d👩🏽", "👩🏽\u200d❤️\u200d💋\u200d👩🏾", "👩🏽\u200d❤️\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d❤️\u200d👨🏻", "👩🏾\u200d❤️\u200d👨🏼", "👩🏾\u200d❤️\u200d👨🏽", "👩🏾\u200d❤️\u200d👨🏾", "👩🏾\u200d❤️\u200d👨🏿", "👩🏾\u200d❤️\u200d👩🏻", "👩🏾\u200d❤️\u200d👩🏼", "👩🏾\u200d❤️\u200d👩🏽", "👩🏾\u200d❤️\u200d👩🏾", "👩🏾\u200d❤️\u200d👩🏿", "👩🏾\u200d❤️\u200d💋\u200d👨🏻", "👩🏾\u200d❤️\u200d💋\u200d👨🏼", "👩🏾\u200d❤️\u200d💋\u200d👨🏽", "👩🏾\u200d❤️\u200d💋\u200d👨🏾", "👩🏾\u200d❤️\u200d💋\u200d👨🏿", "👩🏾\u200d❤️\u200d💋\u200d👩🏻", "👩🏾\u200d❤️\u200d💋\u200d👩🏼", "👩🏾\u200d❤️\u200d💋\u200d👩🏽", "👩🏾\u200d❤️\u200d💋\u200d👩🏾", "👩🏾\u200d❤️\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d❤️\u200d👨🏻", "👩🏿\u200d❤️\u200d👨🏼", "👩🏿\u200d❤️\u200d👨🏽", "👩🏿\u200d❤️\u200d👨🏾", "👩🏿\u200d❤️\u200d👨🏿", "👩🏿\u200d❤️\u200d👩🏻", "👩🏿\u200d❤️\u200d👩🏼", "👩🏿\u200d❤️\u200d👩🏽", "👩🏿\u200d❤️\u200d👩🏾", "👩🏿\u200d❤️\u200d👩🏿", "👩🏿\u200d❤️\u200d💋\u200d👨🏻", "👩🏿\u200d❤️\u200d💋\u200d👨🏼", "👩🏿\u200d❤️\u200d💋\u200d👨🏽", "👩🏿\u200d❤️\u200d💋\u200d👨🏾", "👩🏿\u200d❤️\u200d💋\u200d👨🏿", "👩🏿\u200d❤️\u200d💋\u200d👩🏻", "👩🏿\u200d❤️\u200d💋\u200d👩🏼", "👩🏿\u200d❤️\u200d💋\u200d👩🏽", "👩🏿\u200d❤️\u200d💋\u200d👩🏾", "👩🏿\u200d❤️\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👮\u200d♀️", "👮🏻\u200d♀️", "👮🏼\u200d♀️", "👮🏽\u200d♀️", "👮🏾\u200d♀️", "👮🏿\u200d♀️", "👰\u200d♀️", "👰🏻\u200d♀️", "👰🏼\u200d♀️", "👰🏽\u200d♀️", "👰🏾\u200d♀️", "👰🏿\u200d♀️", "👱\u200d♀️", "👱🏻\u200d♀️", "👱🏼\u200d♀️", "👱🏽\u200d♀️", "👱🏾\u200d♀️", "👱🏿\u200d♀️", "👳\u200d♀️", "👳🏻\u200d♀️", "👳🏼\u200d♀️", "👳🏽\u200d♀️", "👳🏾\u200d♀️", "👳🏿\u200d♀️", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👷\u200d♀️", "👷🏻\u200d♀️", "👷🏼\u200d♀️", "👷🏽\u200d♀️", "👷🏾\u200d♀️", "👷🏿\u200d♀️", "💁\u200d♀️", "💁🏻\u200d♀️", "💁🏼\u200d♀️", "💁🏽\u200d♀️", "💁🏾\u200d♀️", "💁🏿\u200d♀️", "💂\u200d♀️", "💂🏻\u200d♀️", "💂🏼\u200d♀️", "💂🏽\u200d♀️", "💂🏾\u200d♀️", "💂🏿\u200d♀️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💆\u200d♀️", "💆🏻\u200d♀️", "💆🏼\u200d♀️", "💆🏽\u200d♀️", "💆🏾\u200d♀️", "💆🏿\u200d♀️", "💇\u200d♀️", "💇🏻\u200d♀️", "💇🏼\u200d♀️", "💇🏽\u200d♀️", "💇🏾\u200d♀️", "💇🏿\u200d♀️", "🕵️\u200d♀️", "🕵🏻\u200d♀️", "🕵🏼\u200d♀️", "🕵🏽\u200d♀️", "🕵🏾\u200d♀️", "🕵🏿\u200d♀️", "🙅\u200d♀️", "🙅🏻\u200d♀️", "🙅🏼\u200d♀️", "🙅🏽\u200d♀️", "🙅🏾\u200d♀️", "🙅🏿\u200d♀️", "🙆\u200d♀️", "🙆🏻\u200d♀️", "🙆🏼\u200d♀️", "🙆🏽\u200d♀️", "🙆🏾\u200d♀️", "🙆🏿\u200d♀️", "🙇\u200d♀️", "🙇🏻\u200d♀️", "🙇🏼\u200d♀️", "🙇🏽\u200d♀️", "🙇🏾\u200d♀️", "🙇🏿\u200d♀️", "🙋\u200d♀️", "🙋🏻\u200d♀️", "🙋🏼\u200d♀️", "🙋🏽\u200d♀️", "🙋🏾\u200d♀️", "🙋🏿\u200d♀️", "🙍\u200d♀️", "🙍🏻\u200d♀️", "🙍🏼\u200d♀️", "🙍🏽\u200d♀️", "🙍🏾\u200d♀️", "🙍🏿\u200d♀️", "🙎\u200d♀️", "🙎🏻\u200d♀️", "🙎🏼\u200d♀️", "🙎🏽\u200d♀️", "🙎🏾\u200d♀️", "🙎🏿\u200d♀️", "🚣\u200d♀️", "🚣🏻\u200d♀️", "🚣🏼\u200d♀️", "🚣🏽\u200d♀️", "🚣🏾\u200d♀️", "🚣🏿\u200d♀️", "🚴\u200d♀️", "🚴🏻\u200d♀️", "🚴🏼\u200d♀️", "🚴🏽\u200d♀️", "🚴🏾\u200d♀️", "🚴🏿\u200d♀️", "🚵\u200d♀️", "🚵🏻\u200d♀️", "🚵🏼\u200d♀️", "🚵🏽\u200d♀️", "🚵🏾\u200d♀️", "🚵🏿\u200d♀️", "🚶\u200d♀️", "🚶🏻\u200d♀️", "🚶🏼\u200d♀️", "🚶🏽\u200d♀️", "🚶🏾\u200d♀️", "🚶🏿\u200d♀️", "🤦\u200d♀️", "🤦🏻\u200d♀️", "🤦🏼\u200d♀️", "🤦🏽\u200d♀️", "🤦🏾\u200d♀️", "🤦🏿\u200d♀️", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤵\u200d♀️", "🤵🏻\u200d♀️", "🤵🏼\u200d♀️", "🤵🏽\u200d♀️", "🤵🏾\u200d♀️", "🤵🏿\u200d♀️", "🤷\u200d♀️", "🤷🏻\u200d♀️", "🤷🏼\u200d♀️", "🤷🏽\u200d♀️", "🤷🏾\u200d♀️", "🤷🏿\u200d♀️", "🤸\u200d♀️", "🤸🏻\u200d♀️", "🤸🏼\u200d♀️", "🤸🏽\u200d♀️", "🤸🏾\u200d♀️", "🤸🏿\u200d♀️", "🤹\u200d♀️", "🤹🏻\u200d♀️", "🤹🏼\u200d♀️", "🤹🏽\u200d♀️", "🤹🏾\u200d♀️", "🤹🏿\u200d♀️", "🤽\u200d♀️", "🤽🏻\u200d♀️", "🤽🏼\u200d♀️", "🤽🏽\u200d♀️", "🤽🏾\u200d♀️", "🤽🏿\u200d♀️", "🤾\u200d♀️", "🤾🏻\u200d♀️", "🤾🏼\u200d♀️", "🤾🏽\u200d♀️", "🤾🏾\u200d♀️", "🤾🏿\u200d♀️", "🦸\u200d♀️", "🦸🏻\u200d♀️", "🦸🏼\u200d♀️", "🦸🏽\u200d♀️", "🦸🏾\u200d♀️", "🦸🏿\u200d♀️", "🦹\u200d♀️", "🦹🏻\u200d♀️", "🦹🏼\u200d♀️", "🦹🏽\u200d♀️", "🦹🏾\u200d♀️", "🦹🏿\u200d♀️", "🧍\u200d♀️", "🧍🏻\u200d♀️", "🧍🏼\u200d♀️", "🧍🏽\u200d♀️", "🧍🏾\u200d♀️", "🧍🏿\u200d♀️", "🧎\u200d♀️", "🧎🏻\u200d♀️", "🧎🏼\u200d♀️", "🧎🏽\u200d♀️", "🧎🏾\u200d♀️", "🧎🏿\u200d♀️", "🧏\u200d♀️", "🧏🏻\u200d♀️", "🧏🏼\u200d♀️", "🧏🏽\u200d♀️", "🧏🏾\u200d♀️", "🧏🏿\u200d♀️", "🧔\u200d♀️", "🧔🏻\u200d♀️", "🧔🏼\u200d♀️", "🧔🏽\u200d♀️", "🧔🏾\u200d♀️", "🧔🏿\u200d♀️", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖\u200d♀️", "🧖🏻\u200d♀️", "🧖🏼\u200d♀️", "🧖🏽\u200d♀️", "🧖🏾\u200d♀️", "🧖🏿\u200d♀️", "🧗\u200d♀️", "🧗🏻\u200d♀️", "🧗🏼\u200d♀️", "🧗🏽\u200d♀️", "🧗🏾\u200d♀️", "🧗🏿\u200d♀️", "🧘\u200d♀️", "🧘🏻\u200d♀️", "🧘🏼\u200d♀️", "🧘🏽\u200d♀️", "🧘🏾\u200d♀️", "🧘🏿\u200d♀️", "🧙\u200d♀️", "🧙🏻\u200d♀️", "🧙🏼\u200d♀️", "🧙🏽\u200d♀️", "🧙🏾\u200d♀️", "🧙🏿\u200d♀️", "🧚\u200d♀️", "🧚🏻\u200d♀️", "🧚🏼\u200d♀️", "🧚🏽\u200d♀️", "🧚🏾\u200d♀️", "🧚🏿\u200d♀️", "🧛\u200d♀️", "🧛🏻\u200d♀️", "🧛🏼\u200d♀️", "🧛🏽\u200d♀️", "🧛🏾\u200d♀️", "🧛🏿\u200d♀️", "🧝\u200d♀️", "🧝🏻\u200d♀️", "🧝🏼\u200d♀️", "🧝🏽\u200d♀️", "🧝🏾\u200d♀️", "🧝🏿\u200d♀️", "🧞\u200d♀️", "🧟\u200d♀️"},
+	"women":            {"👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👯\u200d♀️", "🚺", "🤼\u200d♀️"},
+	"won":              {"😤"},
+	"wood":             {"🪓", "🪵"},
+	"wool":             {"🦙"},
+	"woolly":           {"🦣"},
+	"woozy":            {"🥴"},
+	"worker":           {"⛑️", "👨\u200d⚕️", "👨\u200d🏭", "👨\u200d💼", "👨🏻\u200d⚕️", "👨🏻\u200d🏭", "👨🏻\u200d💼", "👨🏼\u200d⚕️", "👨🏼\u200d🏭", "👨🏼\u200d💼", "👨🏽\u200d⚕️", "👨🏽\u200d🏭", "👨🏽\u200d💼", "👨🏾\u200d⚕️", "👨🏾\u200d🏭", "👨🏾\u200d💼", "👨🏿\u200d⚕️", "👨🏿\u200d🏭", "👨🏿\u200d💼", "👩\u200d⚕️", "👩\u200d🏭", "👩\u200d💼", "👩🏻\u200d⚕️", "👩🏻\u200d🏭", "👩🏻\u200d💼", "👩🏼\u200d⚕️", "👩🏼\u200d🏭", "👩🏼\u200d💼", "👩🏽\u200d⚕️", "👩🏽\u200d🏭", "👩🏽\u200d💼", "👩🏾\u200d⚕️", "👩🏾\u200d🏭", "👩🏾\u200d💼", "👩🏿\u200d⚕️", "👩🏿\u200d🏭", "👩🏿\u200d💼", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "🧑\u200d⚕️", "🧑\u200d🏭", "🧑\u200d💼", "🧑🏻\u200d⚕️", "🧑🏻\u200d🏭", "🧑🏻\u200d💼", "🧑🏼\u200d⚕️", "🧑🏼\u200d🏭", "🧑🏼\u200d💼", "🧑🏽\u200d⚕️", "🧑🏽\u200d🏭", "🧑🏽\u200d💼", "🧑🏾\u200d⚕️", "🧑🏾\u200d🏭", "🧑🏾\u200d💼", "🧑🏿\u200d⚕️", "🧑🏿\u200d🏭", "🧑🏿\u200d💼"},
+	"world":            {"🌐", "🗺️"},
+	"worm":             {"🪱"},
+	"worried":          {"😟", "🫦"},
+	"worship":          {"🛐"},
+	"wrap":             {"🌯"},
+	"wrapped":          {"🎁", "🫔"},
+	"wrench":           {"🔧", "🛠️"},
+	"wrestle":          {"🤼", "🤼\u200d♀️", "🤼\u200d♂️"},
+	"wrestler":         {"🤼"},
+	"wrestling":        {"🤼", "🤼\u200d♀️", "🤼\u200d♂️"},
+	"write":            {"✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿"},
+	"writing":          {"✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "✏️", "✒️", "📝", "🖊️", "🖋️", "🖌️", "🖍️"},
+	"wry":              {"😼"},
+	"ws":               {"🇼🇸"},
+	"x":                {"✖️", "❌", "❎", "🩻"},
+	"xk":               {"🇽🇰"},
+	"yang":             {"☯️"},
+	"yarn":             {"🧶"},
+	"yawn":             {"🥱"},
+	"yawning":          {"🥱"},
+	"ye":               {"🇾🇪"},
+	"yellow":           {"💛", "🟡", "🟨"},
+	"yemen":            {"🇾🇪"},
+	"yen":              {"💴", "💹"},
+	"yes":              {"🫡"},
+	"yin":              {"☯️"},
+	"yo":               {"🪀"},
+	"yoga":             {"🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️"},
+	"you":              {"🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🫵", "🫵🏻", "🫵🏼", "🫵🏽", "🫵🏾", "🫵🏿"},
+	"young":            {"🌱", "👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿"},
+	"yt":               {"🇾🇹"},
+	"yum":              {"😋"},
+	"yurt":             {"🛖"},
+	"yuèbǐng":          {"🥮"},
+	"za":               {"🇿🇦"},
+	"zambia":           {"🇿🇲"},
+	"zany":             {"🤪"},
+	"zealand":          {"🇳🇿"},
+	"zebra":            {"🦓"},
+	"zimbabwe":         {"🇿🇼"},
+	"zipper":           {"🤐"},
+	"zm":               {"🇿🇲"},
+	"zodiac":           {"♈", "♉", "♊", "♋", "♌", "♍", "♎", "♏", "♐", "♑", "♒", "♓", "⚖️", "⛎", "🏹", "🏺", "🐂", "🐍", "🐏", "🐐", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "🦀", "🦁", "🦂"},
+	"zombie":           {"🧟", "🧟\u200d♀️", "🧟\u200d♂️"},
+	"zw":               {"🇿🇼"},
+	"zzz":              {"💤", "😴"},
+	"zōri":             {"🩴"},
+	"åland":            {"🇦🇽"},
+	"ココ":               {"🈁"},
+	"サ":                {"🈂️"},
+	"割":                {"🈹"},
+	"可":                {"🉑"},
+	"合":                {"🈴"},
+	"営":                {"🈺"},
+	"得":                {"🉐"},
+	"月":                {"🈷️"},
+	"有":                {"🈶"},
+	"満":                {"🈵"},
+	"申":                {"🈸"},
+	"祝":                {"㊗️"},
+	"禁":                {"🈲"},
+	"秘":                {"㊙️"},
+	"空":                {"🈳"},
+}
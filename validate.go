@@ -0,0 +1,32 @@
+package emojis
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Validate checks that every emoji has a name and code points, that every
+// emoji's code points match its grapheme, and that no two emojis have the same
+// grapheme. It returns an error describing every problem it finds, or nil if
+// there are none.
+func Validate(emojis []*Emoji) error {
+	var errs []error
+	seen := map[string]bool{}
+	for i, emoji := range emojis {
+		if emoji.Name == "" {
+			errs = append(errs, fmt.Errorf("emoji %d (%s): empty name", i, emoji.Grapheme))
+		}
+		if len(emoji.Codes) == 0 {
+			errs = append(errs, fmt.Errorf("emoji %d (%s): empty codes", i, emoji.Grapheme))
+		} else if !slices.Equal(emoji.Codes, []rune(emoji.Grapheme)) {
+			errs = append(errs, fmt.Errorf("emoji %d: %w", i, &RuneMismatchError{emoji.Grapheme, emoji.Codes, []rune(emoji.Grapheme)}))
+		}
+		if seen[emoji.Grapheme] {
+			errs = append(errs, fmt.Errorf("emoji %d (%s): duplicate grapheme", i, emoji.Grapheme))
+		}
+		seen[emoji.Grapheme] = true
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,49 @@
+package emojis
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	if err := Validate(mustParse(t, ParseOptions{})); err != nil {
+		t.Errorf("Validate(fixture): %v", err)
+	}
+
+	good := func() *Emoji { return &Emoji{Grapheme: "😀", Codes: []rune{0x1F600}, Name: "grinning face"} }
+	for _, test := range []struct {
+		name   string
+		emojis []*Emoji
+		want   []string // substrings of the error, one per problem
+	}{
+		{"empty name", []*Emoji{{Grapheme: "😀", Codes: []rune{0x1F600}}}, []string{"empty name"}},
+		{"empty codes", []*Emoji{{Grapheme: "😀", Name: "grinning face"}}, []string{"empty codes"}},
+		{"mismatched runes", []*Emoji{{Grapheme: "😀", Codes: []rune{0x1F603}, Name: "grinning face"}}, []string{"mismatched runes"}},
+		{"duplicate", []*Emoji{good(), good()}, []string{"emoji 1 (😀): duplicate grapheme"}},
+		{
+			"every problem",
+			[]*Emoji{good(), {Grapheme: "😃"}, good()},
+			[]string{"emoji 1 (😃): empty name", "emoji 1 (😃): empty codes", "emoji 2 (😀): duplicate grapheme"},
+		},
+	} {
+		err := Validate(test.emojis)
+		if err == nil {
+			t.Errorf("%s: got no error, want error", test.name)
+			continue
+		}
+		for _, want := range test.want {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("%s: got error %q, want it to contain %q", test.name, err, want)
+			}
+		}
+		if got := strings.Count(err.Error(), "\n") + 1; got != len(test.want) {
+			t.Errorf("%s: got %d problems, want %d: %v", test.name, got, len(test.want), err)
+		}
+	}
+
+	var mismatch *RuneMismatchError
+	if err := Validate([]*Emoji{{Grapheme: "😀", Codes: []rune{0x1F603}, Name: "grinning face"}}); !errors.As(err, &mismatch) {
+		t.Errorf("got error %v, want a *RuneMismatchError", err)
+	}
+}
@@ -0,0 +1,67 @@
+package emojis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CompareVersions numerically compares two emoji versions of the form
+// "major.minor" (e.g., "13.0" and "13.1"). It returns -1 if a < b, 0 if a ==
+// b, and 1 if a > b.
+func CompareVersions(a, b string) (int, error) {
+	x, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range x {
+		if x[i] < y[i] {
+			return -1, nil
+		}
+		if x[i] > y[i] {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// SinceVersion returns the emojis introduced in version or later (e.g., to
+// flag emojis that older platforms may not render), in the order of emojis.
+func SinceVersion(emojis []*Emoji, version string) ([]*Emoji, error) {
+	if _, err := parseVersion(version); err != nil {
+		return nil, err
+	}
+	var since []*Emoji
+	for _, emoji := range emojis {
+		cmp, err := CompareVersions(emoji.Version, version)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", emoji.Grapheme, err)
+		}
+		if cmp >= 0 {
+			since = append(since, emoji)
+		}
+	}
+	return since, nil
+}
+
+// parseVersion parses an emoji version (e.g., "13.1") into its major and
+// minor numbers (e.g., [13, 1]).
+func parseVersion(version string) ([2]int, error) {
+	major, minor, ok := strings.Cut(version, ".")
+	if !ok {
+		return [2]int{}, fmt.Errorf("invalid version %q: want major.minor", version)
+	}
+	x, err := strconv.Atoi(major)
+	if err != nil {
+		return [2]int{}, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	y, err := strconv.Atoi(minor)
+	if err != nil {
+		return [2]int{}, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	return [2]int{x, y}, nil
+}
@@ -0,0 +1,73 @@
+package emojis
+
+import (
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestCompareVersions(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		want int
+	}{
+		{"13.0", "13.0", 0},
+		{"13.0", "13.1", -1},
+		{"13.1", "13.0", 1},
+		{"2.0", "1.11", 1},
+		{"1.11", "1.2", 1},
+		{"0.6", "12.1", -1},
+	} {
+		got, err := CompareVersions(test.a, test.b)
+		if err != nil {
+			t.Errorf("CompareVersions(%q, %q): %v", test.a, test.b, err)
+		} else if got != test.want {
+			t.Errorf("CompareVersions(%q, %q): got %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+	for _, version := range []string{"", "13", "13.x", "v13.0"} {
+		if _, err := CompareVersions(version, "13.0"); err == nil {
+			t.Errorf("CompareVersions(%q, \"13.0\"): got no error, want error", version)
+		}
+	}
+}
+
+func TestCompareVersionsFilter(t *testing.T) {
+	// Filter the fixture like -max-version=12.1 does.
+	var got []string
+	for _, emoji := range mustParse(t, ParseOptions{}) {
+		cmp, err := CompareVersions(emoji.Version, "12.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmp > 0 {
+			got = append(got, emoji.Grapheme)
+		}
+	}
+	if want := []string{"🐈‍⬛"}; !slices.Equal(got, want) {
+		t.Errorf("got %v newer than 12.1, want %v", got, want)
+	}
+}
+
+func TestSinceVersion(t *testing.T) {
+	emojis := mustParse(t, ParseOptions{})
+	for _, test := range []struct {
+		version string
+		want    []string
+	}{
+		{"13.0", []string{"🐈‍⬛"}},
+		{"12.1", []string{"🧑‍⚕️", "🐈‍⬛"}},
+		{"2.0", []string{"🧑‍⚕️", "🐈‍⬛", "*️⃣"}},
+		{"14.0", nil},
+	} {
+		since, err := SinceVersion(emojis, test.version)
+		if err != nil {
+			t.Errorf("SinceVersion(%q): %v", test.version, err)
+		} else if got := graphemes(since); !slices.Equal(got, test.want) {
+			t.Errorf("SinceVersion(%q): got %v, want %v", test.version, got, test.want)
+		}
+	}
+	if _, err := SinceVersion(emojis, "fourteen"); err == nil {
+		t.Errorf(`SinceVersion("fourteen"): got no error, want error`)
+	}
+}